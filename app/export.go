@@ -3,6 +3,7 @@ package simapp
 import (
 	"encoding/json"
 	"log"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -15,12 +16,13 @@ import (
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
-// file.
+// file. The time of the last block is used by a zero height export, which unlocks the
+// tokenize share locks that have matured by then.
 func (app *SimApp) ExportAppStateAndValidators(
-	forZeroHeight bool, jailAllowedAddrs []string,
+	forZeroHeight bool, jailAllowedAddrs []string, lastBlockTime time.Time,
 ) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
-	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight(), Time: lastBlockTime})
 
 	// We export at last height + 1, because that's the height at which
 	// Tendermint will start InitChain.
@@ -78,6 +80,25 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []
 		return false
 	})
 
+	// withdraw the rewards of each tokenize share record to the record owner, since
+	// they would otherwise be left in the record module account, and release the records
+	// whose delegation no longer exists into claims, so their share tokens can be burned
+	for _, record := range app.StakingKeeper.GetAllTokenizeShareRecords(ctx) {
+		if err := app.DistrKeeper.WithdrawSingleShareRecordReward(ctx, record.Id); err != nil {
+			panic(err)
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+		if _, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr); !found {
+			if err := app.StakingKeeper.ReleaseTokenizeShareRecord(ctx, record.Id); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	// withdraw all delegator rewards
 	dels := app.StakingKeeper.GetAllDelegations(ctx)
 	for _, delegation := range dels {
@@ -185,6 +206,14 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []
 
 	iter.Close()
 
+	// unlock the tokenize share locks that have matured by the last block
+	app.StakingKeeper.RemoveExpiredTokenizeShareLocks(ctx, ctx.BlockTime())
+
+	// recompute the liquid staked totals, which can drift from the delegations
+	if err := app.StakingKeeper.RefreshTotalLiquidStaked(ctx); err != nil {
+		panic(err)
+	}
+
 	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	if err != nil {
 		log.Fatal(err)
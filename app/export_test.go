@@ -0,0 +1,113 @@
+package simapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/genutil"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestPrepForZeroHeightGenesisLiquidStaking(t *testing.T) {
+	app := Setup(t, false)
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2, Time: blockTime})
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valAddr := validator.GetOperator()
+
+	addrs := AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	delegator, owner, lockedAccount := addrs[0], addrs[1], addrs[2]
+
+	// delegate and tokenize part of the delegation
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	delegateAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(
+		delegator, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, delegateAmount)))
+	require.NoError(t, err)
+
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, tokenizeAmount),
+		TokenizedShareOwner: owner.String(),
+	})
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	// allocate rewards to the validator in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, rewards))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, rewards))
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(rewards...))
+
+	// add a record whose delegation no longer exists
+	orphanedRecord := stakingtypes.TokenizeShareRecord{
+		Id:            2,
+		Owner:         owner.String(),
+		ModuleAccount: stakingtypes.TokenizeShareModuleAccountPrefix + "2",
+		Validator:     valAddr.String(),
+	}
+	require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, orphanedRecord))
+	app.StakingKeeper.SetLastTokenizeShareRecordID(ctx, 2)
+	orphanedShares := sdk.NewCoins(sdk.NewCoin(orphanedRecord.GetShareTokenDenom(), tokenizeAmount))
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, orphanedShares))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, owner, orphanedShares))
+
+	// queue a tokenize share lock that has matured and one that has not
+	maturedTime := blockTime.Add(-time.Hour)
+	app.StakingKeeper.SetTokenizeSharesUnlockTime(ctx, lockedAccount, maturedTime)
+	app.StakingKeeper.SetPendingTokenizeShareAuthorizations(ctx, maturedTime, stakingtypes.PendingTokenizeShareAuthorizations{
+		Addresses: []string{lockedAccount.String()},
+	})
+	pendingTime := app.StakingKeeper.QueueTokenizeSharesAuthorization(ctx, delegator)
+
+	// let the liquid staked total drift
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewInt(999))
+
+	ownerBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+
+	app.prepForZeroHeightGenesis(ctx, []string{})
+
+	// the record rewards were sent to the owner
+	require.True(t, app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount.GT(ownerBalance.Amount))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// only the orphaned record was released, into a claim for its share tokens
+	records := app.StakingKeeper.GetAllTokenizeShareRecords(ctx)
+	require.Equal(t, []stakingtypes.TokenizeShareRecord{record}, records)
+	claim, found := app.StakingKeeper.GetTokenizeShareRecordClaim(ctx, orphanedRecord.GetShareTokenDenom())
+	require.True(t, found)
+	require.Equal(t, orphanedRecord, claim.Record)
+	require.True(t, claim.Tokens.IsZero())
+	require.Equal(t, blockTime, claim.CompletionTime)
+
+	// only the matured lock was removed
+	status, _ := app.StakingKeeper.GetTokenizeSharesLock(ctx, lockedAccount)
+	require.Equal(t, stakingtypes.TokenizeShareLockStatus_UNLOCKED, status)
+	status, unlockTime := app.StakingKeeper.GetTokenizeSharesLock(ctx, delegator)
+	require.Equal(t, stakingtypes.TokenizeShareLockStatus_LOCK_EXPIRING, status)
+	require.Equal(t, pendingTime, unlockTime)
+
+	// the liquid totals were recomputed from the record delegation
+	require.Equal(t, tokenizeAmount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	liquidValidator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+	liquidShares, err := liquidValidator.SharesFromTokens(tokenizeAmount)
+	require.NoError(t, err)
+	require.Equal(t, liquidShares, liquidValidator.TotalLiquidShares)
+
+	// the share tokens of the orphaned record are still backed in the exported genesis
+	genesisState := app.mm.ExportGenesis(ctx, app.appCodec)
+	require.NoError(t, genutil.ValidateLiquidStakingGenesis(app.appCodec, genesisState))
+}
@@ -0,0 +1,39 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	slashingtypes "github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// NewParamChangeProposalHandler wraps the x/params proposal handler to reject changes to
+// the subspaces of the modules that keep their params in their own store. Their subspaces
+// are only kept for the migrations, so such a change would pass without taking effect.
+// Since the governance module runs the handler when the proposal is submitted, these
+// proposals are rejected on submission
+func NewParamChangeProposalHandler(k paramskeeper.Keeper) govtypes.Handler {
+	handler := params.NewParamChangeProposalHandler(k)
+
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if c, ok := content.(*paramproposal.ParameterChangeProposal); ok {
+			for _, change := range c.Changes {
+				switch change.Subspace {
+				case stakingtypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName:
+					return sdkerrors.ErrInvalidRequest.Wrapf(
+						"the %s params are not stored in the params module, use an update params proposal of the %s module instead",
+						change.Subspace, change.Subspace,
+					)
+				}
+			}
+		}
+
+		return handler(ctx, content)
+	}
+}
@@ -0,0 +1,169 @@
+package simapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	slashingtypes "github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// submitProposal submits the proposal content with the minimum deposit on behalf of the
+// genesis delegator, who holds all the voting power
+func submitProposal(app *SimApp, ctx sdk.Context, content govtypes.Content) (uint64, error) {
+	proposer := app.StakingKeeper.GetAllDelegations(ctx)[0].GetDelegatorAddr()
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, app.GovKeeper.GetDepositParams(ctx).MinDeposit, proposer)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := govkeeper.NewMsgServerImpl(app.GovKeeper).SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ProposalId, nil
+}
+
+// passProposal submits the proposal content, votes yes on it with all the voting power and
+// ends the voting period, returning the context after the proposal was executed
+func passProposal(t *testing.T, app *SimApp, ctx sdk.Context, content govtypes.Content) sdk.Context {
+	t.Helper()
+
+	proposalID, err := submitProposal(app, ctx, content)
+	require.NoError(t, err)
+
+	voter := app.StakingKeeper.GetAllDelegations(ctx)[0].GetDelegatorAddr()
+	_, err = govkeeper.NewMsgServerImpl(app.GovKeeper).Vote(sdk.WrapSDKContext(ctx), govtypes.NewMsgVote(voter, proposalID, govtypes.OptionYes))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, found := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, govtypes.StatusPassed, proposal.Status, "proposal failed to execute")
+
+	return ctx
+}
+
+func TestUpdateParamsProposals(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = 50
+	stakingParams.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	ctx = passProposal(t, app, ctx, stakingtypes.NewUpdateParamsProposal("title", "description", stakingParams))
+	require.Equal(t, stakingParams, app.StakingKeeper.GetParams(ctx))
+
+	distrParams := app.DistrKeeper.GetParams(ctx)
+	distrParams.InsuranceFundTax = sdk.NewDecWithPrec(1, 2)
+	ctx = passProposal(t, app, ctx, distrtypes.NewUpdateParamsProposal("title", "description", distrParams))
+	require.Equal(t, distrParams, app.DistrKeeper.GetParams(ctx))
+
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.SlashFractionDowntime = sdk.NewDecWithPrec(2, 4)
+	ctx = passProposal(t, app, ctx, slashingtypes.NewUpdateParamsProposal("title", "description", slashingParams))
+	require.Equal(t, slashingParams, app.SlashingKeeper.GetParams(ctx))
+
+	// invalid params are rejected when the proposal is submitted
+	stakingParams.GlobalLiquidStakingCap = sdk.NewDec(2)
+	_, err := submitProposal(app, ctx, stakingtypes.NewUpdateParamsProposal("title", "description", stakingParams))
+	require.Error(t, err)
+}
+
+func TestParamChangeProposalOfMigratedSubspace(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	// the staking, distribution and slashing params are no longer read from their subspace
+	for _, change := range []paramproposal.ParamChange{
+		paramproposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), `50`),
+		paramproposal.NewParamChange(distrtypes.ModuleName, string(distrtypes.ParamStoreKeyCommunityTax), `"0.1"`),
+		paramproposal.NewParamChange(slashingtypes.ModuleName, string(slashingtypes.KeySignedBlocksWindow), `"50"`),
+	} {
+		content := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{change})
+		_, err := submitProposal(app, ctx, content)
+		require.ErrorContains(t, err, "use an update params proposal")
+	}
+
+	// the other subspaces can still be changed
+	content := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyBlocksPerYear), `"1000"`),
+	})
+	ctx = passProposal(t, app, ctx, content)
+	require.Equal(t, uint64(1000), app.MintKeeper.GetParams(ctx).BlocksPerYear)
+}
+
+func TestUpdateParamsProposalReEnablingLiquidStakingCap(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the liquid totals are recalculated once the proposal enforces the cap again
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	ctx = passProposal(t, app, ctx, stakingtypes.NewUpdateParamsProposal("title", "description", params))
+	require.True(t, app.StakingKeeper.IsTotalLiquidStakedRefreshInProgress(ctx))
+}
+
+func TestUpdateSlashInsuranceCoverageProposal(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	coverage := stakingtypes.SlashInsuranceCoverage{
+		CoverageFraction:  sdk.NewDecWithPrec(5, 1),
+		MaxPayoutPerSlash: sdk.NewInt(1_000_000),
+	}
+	ctx = passProposal(t, app, ctx, stakingtypes.NewUpdateSlashInsuranceCoverageProposal("title", "description", coverage))
+	require.Equal(t, coverage, app.StakingKeeper.GetSlashInsuranceCoverage(ctx))
+
+	// an invalid coverage is rejected when the proposal is submitted
+	coverage.CoverageFraction = sdk.NewDec(2)
+	_, err := submitProposal(app, ctx, stakingtypes.NewUpdateSlashInsuranceCoverageProposal("title", "description", coverage))
+	require.Error(t, err)
+}
+
+func TestTokenizationPauseProposals(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	require.NoError(t, app.StakingKeeper.CheckTokenizationAllowed(ctx, validator))
+
+	// pause the validator
+	pause := stakingtypes.TokenizationPause{ValidatorAddress: validator.OperatorAddress, PauseRedemptions: true}
+	ctx = passProposal(t, app, ctx, stakingtypes.NewPauseTokenizationProposal("title", "description", pause))
+	require.ErrorIs(t, app.StakingKeeper.CheckTokenizationAllowed(ctx, validator), stakingtypes.ErrTokenizationPaused)
+	require.ErrorIs(t, app.StakingKeeper.CheckRedemptionAllowed(ctx, validator), stakingtypes.ErrRedemptionPaused)
+
+	// pause every validator
+	ctx = passProposal(t, app, ctx, stakingtypes.NewPauseTokenizationProposal("title", "description", stakingtypes.TokenizationPause{}))
+	_, found := app.StakingKeeper.GetGlobalTokenizationPause(ctx)
+	require.True(t, found)
+
+	// lift both pauses
+	ctx = passProposal(t, app, ctx, stakingtypes.NewUnpauseTokenizationProposal("title", "description", validator.OperatorAddress))
+	ctx = passProposal(t, app, ctx, stakingtypes.NewUnpauseTokenizationProposal("title", "description", ""))
+	require.NoError(t, app.StakingKeeper.CheckTokenizationAllowed(ctx, validator))
+	require.NoError(t, app.StakingKeeper.CheckRedemptionAllowed(ctx, validator))
+
+	// lifting a pause that does not exist is rejected when the proposal is submitted
+	_, err := submitProposal(app, ctx, stakingtypes.NewUnpauseTokenizationProposal("title", "description", ""))
+	require.ErrorContains(t, err, stakingtypes.ErrTokenizationNotPaused.Error())
+}
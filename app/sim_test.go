@@ -8,6 +8,7 @@ import (
 	"runtime/debug"
 	"strings"
 	"testing"
+	"time"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/stretchr/testify/require"
@@ -31,7 +32,9 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	nfttypes "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
 	slashingtypes "github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -90,6 +93,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireLiquidStakingInvariants(t, app)
 
 	if config.Commit {
 		PrintStats(db)
@@ -128,6 +132,7 @@ func TestAppImportExport(t *testing.T) {
 	err = CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireLiquidStakingInvariants(t, app)
 
 	if config.Commit {
 		PrintStats(db)
@@ -135,7 +140,7 @@ func TestAppImportExport(t *testing.T) {
 
 	fmt.Printf("exporting genesis...\n")
 
-	exported, err := app.ExportAppStateAndValidators(false, []string{})
+	exported, err := app.ExportAppStateAndValidators(false, []string{}, time.Time{})
 	require.NoError(t, err)
 
 	fmt.Printf("importing genesis...\n")
@@ -171,6 +176,9 @@ func TestAppImportExport(t *testing.T) {
 	newApp.mm.InitGenesis(ctxB, app.AppCodec(), genesisState)
 	newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 
+	msg, broken := stakingkeeper.LiquidStakingInvariants(newApp.StakingKeeper)(ctxB)
+	require.False(t, broken, msg)
+
 	fmt.Printf("comparing stores...\n")
 
 	storeKeysPrefixes := []StoreKeysPrefixes{
@@ -191,6 +199,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{authzkeeper.GrantKey}},
+		{app.keys[nfttypes.StoreKey], newApp.keys[nfttypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -237,6 +246,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireLiquidStakingInvariants(t, app)
 
 	if config.Commit {
 		PrintStats(db)
@@ -249,7 +259,14 @@ func TestAppSimulationAfterImport(t *testing.T) {
 
 	fmt.Printf("exporting genesis...\n")
 
-	exported, err := app.ExportAppStateAndValidators(true, []string{})
+	// the simulation does not expose the time of its last block, which the staking
+	// historical info records unless no historical entries are kept
+	var lastBlockTime time.Time
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	if historicalInfo, found := app.StakingKeeper.GetHistoricalInfo(ctx, app.LastBlockHeight()); found {
+		lastBlockTime = historicalInfo.Header.Time
+	}
+	exported, err := app.ExportAppStateAndValidators(true, []string{}, lastBlockTime)
 	require.NoError(t, err)
 
 	fmt.Printf("importing genesis...\n")
@@ -281,6 +298,15 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		app.AppCodec(),
 	)
 	require.NoError(t, err)
+	requireLiquidStakingInvariants(t, newApp)
+}
+
+// requireLiquidStakingInvariants checks the liquid staking invariants against the last
+// committed state of the app
+func requireLiquidStakingInvariants(t *testing.T, app *SimApp) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	msg, broken := stakingkeeper.LiquidStakingInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken, msg)
 }
 
 // TODO: Make another test for the fuzzer itself, which just has noOp txs
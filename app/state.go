@@ -20,6 +20,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	stakingsim "github.com/iqlusioninc/liquidity-staking-module/x/staking/simulation"
 	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -123,7 +124,20 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			})
 		}
 
+		// add liquid staking provider accounts so that the liquid staking caps are exercised
+		authStateBz, ok := rawState[authtypes.ModuleName]
+		if !ok {
+			panic("auth genesis state is missing")
+		}
+		authState := new(authtypes.GenesisState)
+		err = cdc.UnmarshalJSON(authStateBz, authState)
+		if err != nil {
+			panic(err)
+		}
+		addLiquidStakingProviders(r, authState, bankState, stakingState.Params.BondDenom)
+
 		// change appState back
+		rawState[authtypes.ModuleName] = cdc.MustMarshalJSON(authState)
 		rawState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingState)
 		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
 
@@ -136,6 +150,48 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 	}
 }
 
+// addLiquidStakingProviders adds funded liquid staking provider module accounts to the auth
+// and bank genesis states. The simulated staking operations delegate on their behalf
+func addLiquidStakingProviders(r *rand.Rand, authState *authtypes.GenesisState, bankState *banktypes.GenesisState, bondDenom string) {
+	accounts, err := authtypes.UnpackAccounts(authState.Accounts)
+	if err != nil {
+		panic(err)
+	}
+
+	existing := make(map[string]bool, len(accounts))
+	for _, account := range accounts {
+		existing[account.GetAddress().String()] = true
+	}
+
+	for i := 0; i < stakingsim.NumLiquidStakingProviders; i++ {
+		address := stakingsim.LiquidStakingProviderAddress(i)
+		// a genesis file exported from a previous simulation already has the providers
+		if existing[address.String()] {
+			continue
+		}
+
+		account := authtypes.NewModuleAccount(
+			authtypes.NewBaseAccountWithAddress(address),
+			stakingsim.LiquidStakingProviderName(i),
+		)
+		accounts = append(accounts, account)
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(r.Int63n(1e12))))
+		bankState.Balances = append(bankState.Balances, banktypes.Balance{
+			Address: address.String(),
+			Coins:   coins,
+		})
+		if !bankState.Supply.Empty() {
+			bankState.Supply = bankState.Supply.Add(coins...)
+		}
+	}
+
+	authState.Accounts, err = authtypes.PackAccounts(accounts)
+	if err != nil {
+		panic(err)
+	}
+}
+
 // AppStateRandomizedFn creates calls each module's GenesisState generator function
 // and creates the simulation params
 func AppStateRandomizedFn(
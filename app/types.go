@@ -1,6 +1,8 @@
 package simapp
 
 import (
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -33,7 +35,7 @@ type App interface {
 
 	// Exports the state of the application for a genesis file.
 	ExportAppStateAndValidators(
-		forZeroHeight bool, jailAllowedAddrs []string,
+		forZeroHeight bool, jailAllowedAddrs []string, lastBlockTime time.Time,
 	) (types.ExportedApp, error)
 
 	// All the registered module account addreses.
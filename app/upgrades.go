@@ -1,38 +1,115 @@
 package simapp
 
-// import (
-// 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
-// 	sdk "github.com/cosmos/cosmos-sdk/types"
-// 	"github.com/cosmos/cosmos-sdk/types/module"
-// 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
-// )
-
-// // UpgradeName defines the on-chain upgrade name for the sample simap upgrade from v045 to v046.
-// //
-// // NOTE: This upgrade defines a reference implementation of what an upgrade could look like
-// // when an application is migrating from Cosmos SDK version v0.45.x to v0.46.x.
-// const UpgradeName = "v045-to-v046"
-
-// func (app SimApp) RegisterUpgradeHandlers() {
-// 	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName,
-// 		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-// 			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
-// 		})
-
-// 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
-// 	if err != nil {
-// 		panic(err)
-// 	}
-
-// 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-// 		storeUpgrades := storetypes.StoreUpgrades{
-// 			Added: []string{
-// 				group.ModuleName,
-// 				nft.ModuleName,
-// 			},
-// 		}
-
-// 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-// 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
-// 	}
-// }
+import (
+	"encoding/json"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	nfttypes "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+)
+
+// UpgradeName defines the on-chain upgrade name for onboarding the liquid staking module
+// onto a chain that runs the stock cosmos-sdk v0.45 staking, distribution and slashing modules.
+//
+// The modules keep the same names and store keys, so the existing stores are converted in
+// place by the module migrations (staking 2 -> 10, distribution 2 -> 4, slashing 2 -> 3).
+// The only store added is the one of the nft module, which holds the nfts that represent
+// the ownership of the tokenize share records.
+const UpgradeName = "v045-to-lsm"
+
+// LiquidStakingParams holds the liquid staking params set by the upgrade. Governance
+// provides them as JSON in the upgrade plan's info field, e.g.
+//
+//	{"validator_bond_factor":"250","global_liquid_staking_cap":"0.25","validator_liquid_staking_cap":"0.5"}
+//
+// Any param that is omitted keeps its default, which leaves the corresponding cap disabled.
+type LiquidStakingParams struct {
+	ValidatorBondFactor       sdk.Dec `json:"validator_bond_factor"`
+	GlobalLiquidStakingCap    sdk.Dec `json:"global_liquid_staking_cap"`
+	ValidatorLiquidStakingCap sdk.Dec `json:"validator_liquid_staking_cap"`
+}
+
+// ParseLiquidStakingParams parses the liquid staking params from an upgrade plan's info
+// An empty info leaves every param unset
+func ParseLiquidStakingParams(info string) (LiquidStakingParams, error) {
+	var params LiquidStakingParams
+	if info == "" {
+		return params, nil
+	}
+
+	if err := json.Unmarshal([]byte(info), &params); err != nil {
+		return params, fmt.Errorf("invalid liquid staking params in upgrade info: %w", err)
+	}
+
+	return params, nil
+}
+
+// RegisterUpgradeHandlers registers the on-chain upgrade handlers.
+func (app SimApp) RegisterUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		CreateLiquidStakingUpgradeHandler(app.mm, app.configurator, app.StakingKeeper),
+	)
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{nfttypes.StoreKey},
+		}
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+}
+
+// CreateLiquidStakingUpgradeHandler returns the handler for the upgrade that onboards the
+// liquid staking module. It runs the store migrations, sets the liquid staking params
+// provided in the plan info, and computes the liquid staked totals from the existing
+// delegations
+func CreateLiquidStakingUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	stakingKeeper stakingkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		liquidStakingParams, err := ParseLiquidStakingParams(plan.Info)
+		if err != nil {
+			return nil, err
+		}
+
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		params := stakingKeeper.GetParams(ctx)
+		if !liquidStakingParams.ValidatorBondFactor.IsNil() {
+			params.ValidatorBondFactor = liquidStakingParams.ValidatorBondFactor
+		}
+		if !liquidStakingParams.GlobalLiquidStakingCap.IsNil() {
+			params.GlobalLiquidStakingCap = liquidStakingParams.GlobalLiquidStakingCap
+		}
+		if !liquidStakingParams.ValidatorLiquidStakingCap.IsNil() {
+			params.ValidatorLiquidStakingCap = liquidStakingParams.ValidatorLiquidStakingCap
+		}
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+		stakingKeeper.SetParams(ctx, params)
+
+		// Existing delegations from liquid staking providers (e.g. ICA accounts) count
+		// against the caps from the start
+		if err := stakingKeeper.RefreshTotalLiquidStaked(ctx); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
@@ -0,0 +1,206 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdksimapp "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkstakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	nfttypes "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
+	slashingtypes "github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestParseLiquidStakingParams(t *testing.T) {
+	params, err := ParseLiquidStakingParams("")
+	require.NoError(t, err)
+	require.True(t, params.ValidatorBondFactor.IsNil())
+	require.True(t, params.GlobalLiquidStakingCap.IsNil())
+	require.True(t, params.ValidatorLiquidStakingCap.IsNil())
+
+	params, err = ParseLiquidStakingParams(`{"global_liquid_staking_cap":"0.25"}`)
+	require.NoError(t, err)
+	require.True(t, params.ValidatorBondFactor.IsNil())
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), params.GlobalLiquidStakingCap)
+
+	_, err = ParseLiquidStakingParams("not json")
+	require.Error(t, err)
+}
+
+// Starts a chain on the stock cosmos-sdk v0.45 simapp, then restarts it on this app at the
+// upgrade height and checks that the staking, distribution and slashing stores were converted
+func TestLiquidStakingUpgradeFromVanillaSDK(t *testing.T) {
+	db := dbm.NewMemDB()
+	home := t.TempDir()
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	upgradeHeight := int64(3)
+	power := func(p int64) sdk.Int { return sdk.TokensFromConsensusPower(p, sdk.DefaultPowerReduction) }
+
+	// Block 1 on the vanilla chain: create two validators, a delegation from a liquid
+	// staking provider (a 32 byte module account) and a regular delegation
+	vanillaEncCfg := sdksimapp.MakeTestEncodingConfig()
+	vanilla := sdksimapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, vanillaEncCfg, sdksimapp.EmptyAppOptions{})
+
+	stateBytes, err := json.Marshal(sdksimapp.NewDefaultGenesisState(vanillaEncCfg.Marshaler))
+	require.NoError(t, err)
+	vanilla.InitChain(abci.RequestInitChain{
+		Time:            genesisTime,
+		ConsensusParams: DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	header := tmproto.Header{Height: 1, Time: genesisTime}
+	vanilla.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := vanilla.BaseApp.NewContext(false, header)
+	msgServer := sdkstakingkeeper.NewMsgServerImpl(vanilla.StakingKeeper)
+
+	accounts := sdksimapp.AddTestAddrsIncremental(vanilla, ctx, 3, power(200))
+	pubKeys := sdksimapp.CreateTestPubKeys(2)
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(accounts[0]), sdk.ValAddress(accounts[1])}
+	for i, valAddr := range valAddrs {
+		msg, err := sdkstaking.NewMsgCreateValidator(
+			valAddr,
+			pubKeys[i],
+			sdk.NewCoin(sdk.DefaultBondDenom, power(100)),
+			sdkstaking.NewDescription("validator", "", "", "", ""),
+			sdkstaking.NewCommissionRates(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.01")),
+			sdk.OneInt(),
+		)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+
+	icaAddress := address.Module("ica", []byte("ica"))
+	vanilla.AccountKeeper.SetAccount(ctx, authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(icaAddress), "ica"))
+	require.NoError(t, sdksimapp.FundAccount(vanilla.BankKeeper, ctx, icaAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, power(10)))))
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx),
+		sdkstaking.NewMsgDelegate(icaAddress, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, power(10))))
+	require.NoError(t, err)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx),
+		sdkstaking.NewMsgDelegate(accounts[2], valAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, power(20))))
+	require.NoError(t, err)
+
+	// Governance schedules the upgrade with the liquid staking params in the plan info
+	require.NoError(t, vanilla.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{
+		Name:   UpgradeName,
+		Height: upgradeHeight,
+		Info:   `{"validator_bond_factor":"250","global_liquid_staking_cap":"0.25","validator_liquid_staking_cap":"0.5"}`,
+	}))
+
+	vanilla.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	vanilla.Commit()
+
+	// Block 2 on the vanilla chain stores historical info with the bonded validators
+	header = tmproto.Header{Height: 2, Time: genesisTime.Add(time.Minute)}
+	vanilla.BeginBlock(abci.RequestBeginBlock{Header: header})
+	vanilla.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	vanilla.Commit()
+
+	// The ICS fork stores the last validator updates under the tokenize share record prefix
+	ctx = vanilla.BaseApp.NewContext(true, header)
+	require.True(t, ctx.KVStore(vanilla.GetKey(sdkstaking.StoreKey)).Has(sdkstaking.ValidatorUpdatesKey))
+
+	// The vanilla chain halts at the upgrade height and writes the upgrade info for the
+	// new binary, which adds the stores of the new modules
+	require.NoError(t, vanilla.UpgradeKeeper.DumpUpgradeInfoToDisk(upgradeHeight, UpgradeName))
+
+	// Restart the chain on this app, which runs the upgrade in the first block
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, MakeTestEncodingConfig(), EmptyAppOptions{})
+	require.Equal(t, upgradeHeight-1, app.LastBlockHeight())
+
+	header = tmproto.Header{Height: upgradeHeight, Time: genesisTime.Add(2 * time.Minute)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = app.BaseApp.NewContext(false, header)
+
+	// The module versions were migrated
+	versions := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, app.mm.Modules[stakingtypes.ModuleName].ConsensusVersion(), versions[stakingtypes.ModuleName])
+	require.Equal(t, app.mm.Modules[distrtypes.ModuleName].ConsensusVersion(), versions[distrtypes.ModuleName])
+	require.Equal(t, app.mm.Modules[slashingtypes.ModuleName].ConsensusVersion(), versions[slashingtypes.ModuleName])
+	require.Equal(t, app.mm.Modules[nfttypes.ModuleName].ConsensusVersion(), versions[nfttypes.ModuleName])
+
+	// The existing params were kept, and the liquid staking params were set from the plan
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	require.Equal(t, sdk.DefaultBondDenom, stakingParams.BondDenom)
+	require.Equal(t, sdkstaking.DefaultUnbondingTime, stakingParams.UnbondingTime)
+	require.Equal(t, sdk.NewDec(250), stakingParams.ValidatorBondFactor)
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), stakingParams.GlobalLiquidStakingCap)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), stakingParams.ValidatorLiquidStakingCap)
+	require.Equal(t, distrtypes.DefaultParams(), app.DistrKeeper.GetParams(ctx))
+	require.Equal(t, slashingtypes.DefaultParams(), app.SlashingKeeper.GetParams(ctx))
+
+	// The validators were converted, and only the liquid staking provider's delegation is liquid
+	for i, valAddr := range valAddrs {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		require.True(t, found)
+		require.True(t, validator.IsBonded())
+		require.Equal(t, sdk.ZeroDec(), validator.TotalValidatorBondShares)
+		if i == 0 {
+			require.Equal(t, sdk.NewDecFromInt(power(10)), validator.TotalLiquidShares)
+		} else {
+			require.Equal(t, sdk.ZeroDec(), validator.TotalLiquidShares)
+		}
+	}
+	require.Equal(t, power(10), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	for _, delegation := range app.StakingKeeper.GetAllDelegations(ctx) {
+		require.False(t, delegation.ValidatorBond)
+	}
+
+	historicalInfo, found := app.StakingKeeper.GetHistoricalInfo(ctx, 2)
+	require.True(t, found)
+	require.Len(t, historicalInfo.Valset, 2)
+	for _, validator := range historicalInfo.Valset {
+		require.Equal(t, sdk.ZeroDec(), validator.TotalValidatorBondShares)
+	}
+
+	// The ICS validator updates were removed from under the tokenize share record prefix
+	require.False(t, ctx.KVStore(app.GetKey(stakingtypes.StoreKey)).Has(sdkstaking.ValidatorUpdatesKey))
+	require.Empty(t, app.StakingKeeper.GetAllTokenizeShareRecords(ctx))
+
+	// LSM messages work on the migrated state: after a validator bond, the regular
+	// delegation can be tokenized
+	lsmServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err = lsmServer.ValidatorBond(sdk.WrapSDKContext(ctx), &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: accounts[1].String(),
+		ValidatorAddress: valAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	_, err = lsmServer.TokenizeShares(sdk.WrapSDKContext(ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    accounts[2].String(),
+		ValidatorAddress:    valAddrs[1].String(),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, power(5)),
+		TokenizedShareOwner: accounts[2].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, power(15), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// The new record is represented by an nft held by its owner
+	records := app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, accounts[2])
+	require.Len(t, records, 1)
+	require.Equal(t, accounts[2], app.NFTKeeper.GetOwner(ctx, stakingtypes.TokenizeShareRecordNFTClassID, records[0].GetNFTID()))
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// All invariants hold on the upgraded state
+	ctx = app.BaseApp.NewContext(true, header)
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+}
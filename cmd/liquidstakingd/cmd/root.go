@@ -2,14 +2,18 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
+	tmdb "github.com/cometbft/cometbft-db"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
+	tmstore "github.com/tendermint/tendermint/store"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -146,6 +150,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.GenTxCmd(simapp.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(simapp.ModuleBasics),
+		genesisCommand(),
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
@@ -172,6 +177,22 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
 
+func genesisCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Genesis file subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		genutilcli.MigrateGenesisCmd(simapp.ModuleBasics),
+	)
+
+	return cmd
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -293,5 +314,32 @@ func (a appCreator) appExport(
 		simApp = simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
 	}
 
-	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
+	// A zero height export needs the time of the last block, which the application
+	// state does not record
+	var lastBlockTime time.Time
+	if forZeroHeight {
+		var err error
+		lastBlockTime, err = loadBlockTime(homePath, simApp.LastBlockHeight())
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+
+	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, lastBlockTime)
+}
+
+// loadBlockTime returns the time of the block at the given height from the node's
+// block store
+func loadBlockTime(homePath string, height int64) (time.Time, error) {
+	db, err := tmdb.NewGoLevelDB("blockstore", filepath.Join(homePath, "data"))
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer db.Close()
+
+	blockMeta := tmstore.NewBlockStore(db).LoadBlockMeta(height)
+	if blockMeta == nil {
+		return time.Time{}, fmt.Errorf("block %d not found in the block store", height)
+	}
+	return blockMeta.Header.Time, nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 )
@@ -332,6 +333,72 @@ Where proposal.json contains:
 	return cmd
 }
 
+// GetCmdSubmitUpdateParamsProposal implements the command to submit a proposal to update
+// the x/distribution params
+func GetCmdSubmitUpdateParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-distribution-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the distribution params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the distribution params along with an initial deposit.
+The proposal details must be supplied via a JSON file. All the params must be supplied.
+
+Example:
+$ %s tx gov submit-proposal update-distribution-params <path/to/proposal.json> --deposit=1000stake --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Update Distribution Params",
+  "description": "Raise the insurance fund tax",
+  "params": {
+    "community_tax": "0.02",
+    "base_proposer_reward": "0.01",
+    "bonus_proposer_reward": "0.04",
+    "withdraw_addr_enabled": true,
+    "insurance_fund_tax": "0.01"
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseUpdateParamsProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&proposal, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
 // WithdrawAllTokenizeShareRecordReward defines a method to withdraw reward for all owning TokenizeShareRecord
 func NewWithdrawAllTokenizeShareRecordRewardCmd() *cobra.Command {
 	cmd := &cobra.Command{
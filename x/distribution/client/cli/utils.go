@@ -22,3 +22,19 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONCodec, proposalFil
 
 	return proposal, nil
 }
+
+// ParseUpdateParamsProposal reads and parses an UpdateParamsProposal from a file.
+func ParseUpdateParamsProposal(cdc codec.JSONCodec, proposalFile string) (types.UpdateParamsProposal, error) {
+	proposal := types.UpdateParamsProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -1,13 +1,31 @@
 package client
 
 import (
-	"github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	distrrest "github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/client/cli"
 )
 
 // ProposalHandler is the community spend proposal handler.
 var (
 	// ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal)
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, distrrest.ProposalRESTHandler)
+	// UpdateParamsProposalHandler is the update distribution params proposal handler.
+	UpdateParamsProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateParamsProposal, updateParamsRESTHandler)
 )
+
+// updateParamsRESTHandler rejects the update params proposal, since it can only be
+// submitted through gRPC or the CLI
+func updateParamsRESTHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_distribution_params",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST routes are not supported for x/distribution update params proposals")
+		},
+	}
+}
@@ -44,12 +44,23 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	}
 }
 
+// NewCommunityPoolSpendProposalHandler creates a governance handler for the x/distribution proposals
+//
+// Deprecated: use NewProposalHandler, which this is an alias of
 func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return NewProposalHandler(k)
+}
+
+// NewProposalHandler creates a governance handler for the x/distribution proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case *types.UpdateParamsProposal:
+			return keeper.HandleUpdateParamsProposal(ctx, k, c)
+
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distr proposal content type: %T", c)
 		}
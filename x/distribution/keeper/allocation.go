@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // AllocateTokens handles distribution of the collected fees
@@ -95,6 +96,21 @@ func (k Keeper) AllocateTokens(
 		remaining = remaining.Sub(reward)
 	}
 
+	// send the insurance fund's share of the community tax to the slash insurance fund.
+	// Payouts are delegated, so only the bond denom is sent
+	if insuranceFundTax := k.GetInsuranceFundTax(ctx); insuranceFundTax.IsPositive() {
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+		insuranceAmount := feesCollected.AmountOf(bondDenom).Mul(communityTax).Mul(insuranceFundTax).TruncateInt()
+		if insuranceAmount.IsPositive() {
+			insurance := sdk.NewCoins(sdk.NewCoin(bondDenom, insuranceAmount))
+			err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.SlashInsuranceFundName, insurance)
+			if err != nil {
+				panic(err)
+			}
+			remaining = remaining.Sub(sdk.NewDecCoinsFromCoins(insurance...))
+		}
+	}
+
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	k.SetFeePool(ctx, feePool)
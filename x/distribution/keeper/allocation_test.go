@@ -119,6 +119,54 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(515, 1)}}, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[1]).Rewards)
 }
 
+func TestAllocateTokensToSlashInsuranceFund(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// reset fee pool and send half of the community tax to the slash insurance fund
+	app.DistrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+	params := app.DistrKeeper.GetParams(ctx)
+	params.InsuranceFundTax = sdk.NewDecWithPrec(5, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 0% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	// fund fee collector with fees in the bond denom and another denom
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin("otherdenom", sdk.NewInt(100)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NotNil(t, feeCollector)
+	require.NoError(t, simapp_test.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	app.DistrKeeper.AllocateTokens(ctx, 100, 100, valConsAddr1, votes)
+
+	// the validator's rewards are unaffected: 100 less 2 to the community tax
+	expectedRewards := sdk.DecCoins{
+		{Denom: "otherdenom", Amount: sdk.NewDec(98)},
+		{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(98)},
+	}
+	require.Equal(t, expectedRewards, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+
+	// half of the bond denom community tax goes to the slash insurance fund
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), app.StakingKeeper.GetSlashInsuranceFundBalance(ctx))
+	expectedCommunityPool := sdk.DecCoins{
+		{Denom: "otherdenom", Amount: sdk.NewDec(2)},
+		{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(1)},
+	}
+	require.Equal(t, expectedCommunityPool, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
 func TestAllocateTokensTruncation(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
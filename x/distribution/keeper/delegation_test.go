@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
 	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
@@ -161,19 +164,37 @@ func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
 	beforeBalance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[1]), sdk.DefaultBondDenom)
 
 	// withdraw rewards
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	coins, err = app.DistrKeeper.WithdrawAllTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddrs[1]))
 	require.Nil(t, err)
 
 	// check return value
 	require.Equal(t, coins.String(), "50000stake")
+
+	// check the typed event emitted for the record
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	withdrawEvents := []proto.Message{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventWithdrawTokenizeShareReward{}) {
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			withdrawEvents = append(withdrawEvents, typedEvent)
+		}
+	}
+	require.Equal(t, []proto.Message{&types.EventWithdrawTokenizeShareReward{
+		Owner:            sdk.AccAddress(valAddrs[1]).String(),
+		ShareRecordId:    1,
+		ValidatorAddress: valAddrs[0].String(),
+		ModuleAccount:    record.GetModuleAddress().String(),
+		Amount:           coins,
+	}}, withdrawEvents)
 	// check balance changes
 	midBalance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[1]), sdk.DefaultBondDenom)
 	require.Equal(t, beforeBalance.Amount.Add(coins.AmountOf(sdk.DefaultBondDenom)), midBalance.Amount)
 
 	// allocate more rewards manually on module account and try full redeem
-	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
-	require.NoError(t, err)
-
 	err = app.MintKeeper.MintCoins(ctx, coins)
 	require.NoError(t, err)
 	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, record.GetModuleAddress(), coins)
@@ -607,6 +628,94 @@ func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission)
 }
 
+func TestCalculateRewardsAfterSlashWithValidatorBondFirstLoss(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// half of each slash is absorbed by the validator bond delegations first
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFirstLossFraction = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// create validator with no commission, and a second delegation of the same size
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	valPower := int64(100)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, valPower, true)
+	tstaking.DelegateWithPower(sdk.AccAddress(valAddrs[1]), valAddrs[0], valPower)
+
+	// the self delegation is the validator bond
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: sdk.AccAddress(valAddrs[0]).String(),
+		ValidatorAddress: valAddrs[0].String(),
+	})
+	require.NoError(t, err)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 36))
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// fund the distribution module account for both allocations
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, simapp_test.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.MulInt64(2).TruncateInt()))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// slash the validator by 10%: of the 20 tokens burned, the validator bond absorbs
+	// 10 first, leaving it with 85 tokens and the other delegation with 95
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+	bondBalance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[0]), sdk.DefaultBondDenom)
+	app.StakingKeeper.Slash(ctx, valConsAddr1, ctx.BlockHeight(), 2*valPower, sdk.NewDecWithPrec(1, 1), 0)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+
+	// the rewards of the validator bond were withdrawn when its shares were reduced
+	withdrawn := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[0]), sdk.DefaultBondDenom).Sub(bondBalance)
+	require.Equal(t, initial.QuoInt64(2).TruncateInt(), withdrawn.Amount)
+
+	// allocate some more rewards
+	val = app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// end period
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+
+	requireRewardsAround := func(expected sdk.Dec, rewards sdk.DecCoins) {
+		t.Helper()
+		actual := rewards.AmountOf(sdk.DefaultBondDenom)
+		require.True(t, actual.Sub(expected).Abs().LTE(sdk.NewDec(1)), "expected %s, got %s", expected, actual)
+	}
+
+	// the validator bond earns on its 85 of the 180 tokens since the slash
+	del1 := app.StakingKeeper.Delegation(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
+	requireRewardsAround(initial.MulInt64(85).QuoInt64(180), rewards)
+
+	// the other delegation earned half before the slash, then on its 95 of the 180 tokens
+	del2 := app.StakingKeeper.Delegation(ctx, sdk.AccAddress(valAddrs[1]), valAddrs[0])
+	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
+	requireRewardsAround(initial.QuoInt64(2).Add(initial.MulInt64(95).QuoInt64(180)), rewards)
+
+	// both delegations can withdraw everything
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	require.NoError(t, err)
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddrs[1]), valAddrs[0])
+	require.NoError(t, err)
+
+	msg, broken := keeper.AllInvariants(app.DistrKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
 func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -78,6 +78,7 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 					WithdrawAddrEnabled: true,
+					InsuranceFundTax:    sdk.NewDecWithPrec(5, 1),
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -14,17 +14,23 @@ import (
 	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // Keeper of the distribution store
 type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
-	paramSpace    paramtypes.Subspace
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 
+	// legacySubspace is only read when migrating the params off x/params
+	legacySubspace paramtypes.Subspace
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
 
@@ -32,7 +38,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -44,10 +50,16 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	// ensure the authority is a valid address
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
 	return Keeper{
 		storeKey:         key,
 		cdc:              cdc,
-		paramSpace:       paramSpace,
+		legacySubspace:   paramSpace,
+		authority:        authority,
 		authKeeper:       ak,
 		bankKeeper:       bk,
 		stakingKeeper:    sk,
@@ -55,6 +67,11 @@ func NewKeeper(
 	}
 }
 
+// GetAuthority returns the x/distribution module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -225,10 +242,26 @@ func (k Keeper) WithdrawSingleShareRecordReward(ctx sdk.Context, recordID uint64
 				sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
 			),
 		)
+
+		if err := k.emitWithdrawTokenizeShareRewardEvent(ctx, record, balances); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// emitWithdrawTokenizeShareRewardEvent emits a typed event for the rewards of a single
+// tokenize share record that were sent to the record owner
+func (k Keeper) emitWithdrawTokenizeShareRewardEvent(ctx sdk.Context, record stakingtypes.TokenizeShareRecord, rewards sdk.Coins) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventWithdrawTokenizeShareReward{
+		Owner:            record.Owner,
+		ShareRecordId:    record.Id,
+		ValidatorAddress: record.Validator,
+		ModuleAccount:    record.GetModuleAddress().String(),
+		Amount:           rewards,
+	})
+}
+
 // withdraw reward for owning TokenizeShareRecord
 func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
 	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
@@ -278,6 +311,10 @@ func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk
 		),
 	)
 
+	if err := k.emitWithdrawTokenizeShareRewardEvent(ctx, record, rewards); err != nil {
+		return nil, err
+	}
+
 	return rewards, nil
 }
 
@@ -321,6 +358,10 @@ func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx sdk.Context, ownerAddr
 			}
 			write()
 			totalRewards = totalRewards.Add(balances...)
+
+			if err := k.emitWithdrawTokenizeShareRewardEvent(ctx, record, balances); err != nil {
+				return nil, err
+			}
 		}
 	}
 
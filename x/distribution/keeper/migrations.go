@@ -1 +1,47 @@
 package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates x/distribution state from consensus version 2 to 3.
+// It moves the params out of the x/params subspace into the module's own store
+// so that they can be updated with MsgUpdateParams
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	// The insurance fund tax was added after the params left the subspace
+	m.keeper.legacySubspace.Set(ctx, types.ParamStoreKeyInsuranceFundTax, types.DefaultInsuranceFundTax)
+
+	var params types.Params
+	m.keeper.legacySubspace.GetParamSet(ctx, &params)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate3to4 migrates x/distribution state from consensus version 3 to 4.
+// It sets the insurance fund tax param to its default, which leaves the whole
+// community tax in the community pool
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.InsuranceFundTax = types.DefaultInsuranceFundTax
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
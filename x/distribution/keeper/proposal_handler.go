@@ -28,3 +28,14 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 
 	return nil
 }
+
+// HandleUpdateParamsProposal is a handler for executing a passed update params proposal.
+// The proposal is executed as a MsgUpdateParams signed by the module authority, so that
+// it goes through the same checks as the message
+func HandleUpdateParamsProposal(ctx sdk.Context, k Keeper, p *types.UpdateParamsProposal) error {
+	_, err := NewMsgServerImpl(k).UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    p.Params,
+	})
+	return err
+}
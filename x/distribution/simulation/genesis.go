@@ -18,6 +18,7 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	InsuranceFundTax    = "insurance_fund_tax"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +41,15 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenInsuranceFundTax randomized InsuranceFundTax
+func GenInsuranceFundTax(r *rand.Rand) sdk.Dec {
+	// Half the time, the whole community tax goes to the community pool
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(50)), 2))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,6 +76,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var insuranceFundTax sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InsuranceFundTax, &insuranceFundTax, simState.Rand,
+		func(r *rand.Rand) { insuranceFundTax = GenInsuranceFundTax(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +89,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			InsuranceFundTax:    insuranceFundTax,
 		},
 	}
 
@@ -42,11 +42,13 @@ func TestRandomizedGenState(t *testing.T) {
 	dec1, _ := sdk.NewDecFromStr("0.170000000000000000")
 	dec2, _ := sdk.NewDecFromStr("0.010000000000000000")
 	dec3, _ := sdk.NewDecFromStr("0.210000000000000000")
+	dec4, _ := sdk.NewDecFromStr("0.130000000000000000")
 
 	require.Equal(t, dec1, distrGenesis.Params.BaseProposerReward)
 	require.Equal(t, dec2, distrGenesis.Params.BonusProposerReward)
 	require.Equal(t, dec3, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, dec4, distrGenesis.Params.InsuranceFundTax)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
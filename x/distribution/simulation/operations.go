@@ -15,17 +15,21 @@ import (
 	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 )
 
-const DefaultWeightMsgWithdrawAllTokenizeShareRecordReward int = 50
+const (
+	DefaultWeightMsgWithdrawTokenizeShareRecordReward    int = 50
+	DefaultWeightMsgWithdrawAllTokenizeShareRecordReward int = 50
+)
 
 // Simulation operation weights constants
 //
 //nolint:gosec // these are weights for simulation, not hard coded credentials
 const (
-	OpWeightMsgSetWithdrawAddress                = "op_weight_msg_set_withdraw_address"
-	OpWeightMsgWithdrawDelegationReward          = "op_weight_msg_withdraw_delegation_reward"
-	OpWeightMsgWithdrawValidatorCommission       = "op_weight_msg_withdraw_validator_commission"
-	OpWeightMsgFundCommunityPool                 = "op_weight_msg_fund_community_pool"
-	OpWeightMsgWithdrawTokenizeShareRecordReward = "op_weight_msg_withdraw_tokenize_share_record_reward"
+	OpWeightMsgSetWithdrawAddress                   = "op_weight_msg_set_withdraw_address"
+	OpWeightMsgWithdrawDelegationReward             = "op_weight_msg_withdraw_delegation_reward"
+	OpWeightMsgWithdrawValidatorCommission          = "op_weight_msg_withdraw_validator_commission"
+	OpWeightMsgFundCommunityPool                    = "op_weight_msg_fund_community_pool"
+	OpWeightMsgWithdrawTokenizeShareRecordReward    = "op_weight_msg_withdraw_tokenize_share_record_reward"
+	OpWeightMsgWithdrawAllTokenizeShareRecordReward = "op_weight_msg_withdraw_all_tokenize_share_record_reward"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -61,7 +65,14 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 	var weightMsgWithdrawTokenizeShareRecordReward int
 	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawTokenizeShareRecordReward, &weightMsgWithdrawTokenizeShareRecordReward, nil,
 		func(_ *rand.Rand) {
-			weightMsgWithdrawTokenizeShareRecordReward = DefaultWeightMsgWithdrawAllTokenizeShareRecordReward
+			weightMsgWithdrawTokenizeShareRecordReward = DefaultWeightMsgWithdrawTokenizeShareRecordReward
+		},
+	)
+
+	var weightMsgWithdrawAllTokenizeShareRecordReward int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawAllTokenizeShareRecordReward, &weightMsgWithdrawAllTokenizeShareRecordReward, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawAllTokenizeShareRecordReward = DefaultWeightMsgWithdrawAllTokenizeShareRecordReward
 		},
 	)
 
@@ -88,6 +99,10 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 			weightMsgWithdrawTokenizeShareRecordReward,
 			SimulateMsgWithdrawTokenizeShareRecordReward(ak, bk, k, stakeKeeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawAllTokenizeShareRecordReward,
+			SimulateMsgWithdrawAllTokenizeShareRecordReward(ak, bk, k, stakeKeeper),
+		),
 	}
 }
 
@@ -262,8 +277,54 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, _
 }
 
 // SimulateMsgWithdrawTokenizeShareRecordReward simulates MsgWithdrawTokenizeShareRecordReward execution where
-// a random account claim tokenize share record rewards.
+// the owner of a random tokenize share record claims the rewards of that record.
 func SimulateMsgWithdrawTokenizeShareRecordReward(ak types.AccountKeeper, bk types.BankKeeper, _ keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		records := sk.GetAllTokenizeShareRecords(ctx)
+		if len(records) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "no tokenize share records"), nil, nil
+		}
+
+		record := records[r.Intn(len(records))]
+		ownerAddr, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "invalid record owner"), nil, err
+		}
+
+		rewardOwner, found := simtypes.FindAccount(accs, ownerAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "record owner not found"), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawTokenizeShareRecordReward(rewardOwner.Address, record.Id)
+
+		account := ak.GetAccount(ctx, rewardOwner.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      rewardOwner,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgWithdrawAllTokenizeShareRecordReward simulates MsgWithdrawAllTokenizeShareRecordReward execution where
+// a random account claim tokenize share record rewards.
+func SimulateMsgWithdrawAllTokenizeShareRecordReward(ak types.AccountKeeper, bk types.BankKeeper, _ keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
@@ -282,7 +343,7 @@ func SimulateMsgWithdrawTokenizeShareRecordReward(ak types.AccountKeeper, bk typ
 
 		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
 		if rewardOwner.PrivKey == nil {
-			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward, "account private key is nil"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawAllTokenizeShareRecordReward, "account private key is nil"), nil, nil
 		}
 
 		msg := types.NewMsgWithdrawAllTokenizeShareRecordReward(rewardOwner.Address)
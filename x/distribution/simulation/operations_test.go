@@ -17,6 +17,7 @@ import (
 	simappparams "github.com/iqlusioninc/liquidity-staking-module/app/params"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/simulation"
 	"github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	stakingkeeper "github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -43,6 +44,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simappparams.DefaultWeightMsgWithdrawDelegationReward, types.ModuleName, types.TypeMsgWithdrawDelegatorReward},
 		{simappparams.DefaultWeightMsgWithdrawValidatorCommission, types.ModuleName, types.TypeMsgWithdrawValidatorCommission},
 		{simappparams.DefaultWeightMsgFundCommunityPool, types.ModuleName, types.TypeMsgFundCommunityPool},
+		{simulation.DefaultWeightMsgWithdrawTokenizeShareRecordReward, types.ModuleName, types.TypeMsgWithdrawTokenizeShareRecordReward},
 		{simulation.DefaultWeightMsgWithdrawAllTokenizeShareRecordReward, types.ModuleName, types.TypeMsgWithdrawAllTokenizeShareRecordReward},
 	}
 
@@ -217,6 +219,50 @@ func (suite *SimTestSuite) TestSimulateMsgFundCommunityPool() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+// TestSimulateMsgWithdrawTokenizeShareRecordReward tests the normal scenario of a valid message
+// of type TypeMsgWithdrawTokenizeShareRecordReward.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgWithdrawTokenizeShareRecordReward() {
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// delegate to the genesis validator and tokenize the delegation
+	valAddr := suite.genesisVals[0].GetOperator()
+	delegator, owner := accounts[1], accounts[2]
+	msgServer := stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper)
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 10))
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(suite.ctx), stakingtypes.NewMsgDelegate(delegator.Address, valAddr, amount))
+	suite.Require().NoError(err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(suite.ctx), &stakingtypes.MsgTokenizeShares{
+		DelegatorAddress:    delegator.Address.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              amount,
+		TokenizedShareOwner: owner.Address.String(),
+	})
+	suite.Require().NoError(err)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgWithdrawTokenizeShareRecordReward(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgWithdrawTokenizeShareRecordReward
+	err = legacy.Cdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(owner.Address.String(), msg.OwnerAddress)
+	suite.Require().Equal(uint64(1), msg.RecordId)
+	suite.Require().Equal(types.TypeMsgWithdrawTokenizeShareRecordReward, msg.Type())
+	suite.Require().Equal(types.ModuleName, msg.Route())
+	suite.Require().Len(futureOperations, 0)
+}
+
 type SimTestSuite struct {
 	suite.Suite
 
@@ -16,6 +16,7 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+	keyInsuranceFundTax    = "insurancefundtax"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -37,5 +38,10 @@ func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyInsuranceFundTax,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInsuranceFundTax(r))
+			},
+		),
 	}
 }
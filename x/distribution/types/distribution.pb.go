@@ -32,6 +32,9 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// fraction of the community tax, in the bond denom, that is sent to the staking
+	// module's slash insurance fund instead of the community pool
+	InsuranceFundTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=insurance_fund_tax,json=insuranceFundTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_tax"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -80,11 +83,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -464,6 +468,49 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// UpdateParamsProposal is a gov Content type that replaces the x/distribution
+// params once the proposal passes
+type UpdateParamsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// params defines the x/distribution parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3e6168184371676, []int{9}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
 // DelegatorStartingInfo represents the starting info for a delegator reward
 // period. It tracks the previous validator period, the delegation's amount of
 // staking token, and the creation height (to check later on if any slashes have
@@ -480,7 +527,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{9}
+	return fileDescriptor_c3e6168184371676, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +581,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{10}
+	return fileDescriptor_c3e6168184371676, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +620,7 @@ func (m *TokenizeShareRecordReward) Reset()         { *m = TokenizeShareRecordRe
 func (m *TokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
 func (*TokenizeShareRecordReward) ProtoMessage()    {}
 func (*TokenizeShareRecordReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{11}
+	return fileDescriptor_c3e6168184371676, []int{12}
 }
 func (m *TokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +663,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3e6168184371676, []int{12}
+	return fileDescriptor_c3e6168184371676, []int{13}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,6 +702,7 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "liquidstaking.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "liquidstaking.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "liquidstaking.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "liquidstaking.distribution.v1beta1.UpdateParamsProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "liquidstaking.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "liquidstaking.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*TokenizeShareRecordReward)(nil), "liquidstaking.distribution.v1beta1.TokenizeShareRecordReward")
@@ -666,70 +714,74 @@ func init() {
 }
 
 var fileDescriptor_c3e6168184371676 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xb4, 0x8e, 0x93, 0x4e, 0x69, 0x02, 0x13, 0x27, 0x75, 0x4c, 0x65, 0x47, 0x2b, 0xd1,
-	0x06, 0x2a, 0xdb, 0xb4, 0x3d, 0x20, 0x45, 0x5c, 0xf2, 0x0b, 0xd1, 0x13, 0xd1, 0xa6, 0x02, 0xc4,
-	0x65, 0x35, 0xde, 0x7d, 0xb1, 0x47, 0xd9, 0x9d, 0xd9, 0xcc, 0xcc, 0x3a, 0x09, 0xd7, 0x1e, 0xf8,
-	0x71, 0x02, 0x71, 0x41, 0x1c, 0x50, 0x8e, 0x08, 0x71, 0xcc, 0x3f, 0xc0, 0xad, 0xe2, 0x54, 0x7a,
-	0x29, 0xe2, 0x10, 0x50, 0x72, 0x41, 0xfc, 0x15, 0x68, 0x76, 0xc6, 0x6b, 0x17, 0x02, 0xf4, 0x90,
-	0xa8, 0xa7, 0xe4, 0xbd, 0xb7, 0xf3, 0xbe, 0xef, 0x7b, 0xf3, 0xde, 0x1b, 0xe3, 0x5b, 0x11, 0x53,
-	0x5a, 0xb2, 0x6e, 0xa6, 0x99, 0xe0, 0x9d, 0xc1, 0x9d, 0x2e, 0x68, 0x7a, 0xa7, 0x33, 0xee, 0x6c,
-	0xa7, 0x52, 0x68, 0x41, 0xbc, 0x98, 0xed, 0x66, 0x2c, 0x52, 0x9a, 0xee, 0x30, 0xde, 0x6b, 0x3f,
-	0xf3, 0x85, 0x3b, 0x56, 0xaf, 0xf6, 0x44, 0x4f, 0xe4, 0x9f, 0x77, 0xcc, 0x7f, 0xf6, 0x64, 0xbd,
-	0x11, 0x0a, 0x95, 0x08, 0xd5, 0xe9, 0x52, 0x05, 0x05, 0x42, 0x28, 0x98, 0xcb, 0x5c, 0x5f, 0xb0,
-	0xf1, 0xc0, 0x1e, 0xb4, 0x86, 0x0d, 0x79, 0x9f, 0x5c, 0xc6, 0x95, 0x4d, 0x2a, 0x69, 0xa2, 0x08,
-	0xc5, 0xd7, 0x42, 0x91, 0x24, 0x19, 0x67, 0xfa, 0x20, 0xd0, 0x74, 0xbf, 0x86, 0x16, 0xd1, 0xd2,
-	0x95, 0xd5, 0xb7, 0x1f, 0x1d, 0x37, 0x4b, 0xbf, 0x1e, 0x37, 0x6f, 0xf6, 0x98, 0xee, 0x67, 0xdd,
-	0x76, 0x28, 0x12, 0x97, 0xc2, 0xfd, 0x69, 0xa9, 0x68, 0xa7, 0xa3, 0x0f, 0x52, 0x50, 0xed, 0x75,
-	0x08, 0x9f, 0x1c, 0xb5, 0xb0, 0x43, 0x58, 0x87, 0xd0, 0x7f, 0xa9, 0x48, 0xf9, 0x80, 0xee, 0x13,
-	0x8e, 0xab, 0x86, 0xa3, 0x21, 0x92, 0x0a, 0x05, 0x32, 0x90, 0xb0, 0x47, 0x65, 0x54, 0xbb, 0x74,
-	0x0e, 0x48, 0xc4, 0x64, 0xde, 0x74, 0x89, 0xfd, 0x3c, 0x2f, 0x49, 0xf1, 0x5c, 0x57, 0xf0, 0x4c,
-	0xfd, 0x03, 0xf0, 0xf2, 0x39, 0x00, 0xce, 0xe6, 0xa9, 0xff, 0x86, 0x78, 0x17, 0xcf, 0xed, 0x31,
-	0xdd, 0x8f, 0x24, 0xdd, 0x0b, 0x68, 0x14, 0xc9, 0x00, 0x38, 0xed, 0xc6, 0x10, 0xd5, 0xca, 0x8b,
-	0x68, 0x69, 0xca, 0x9f, 0x1d, 0x06, 0x57, 0xa2, 0x48, 0x6e, 0xd8, 0xd0, 0x72, 0xf9, 0xeb, 0xc3,
-	0x66, 0xc9, 0xfb, 0x19, 0xe1, 0xfa, 0xfb, 0x34, 0x66, 0x11, 0xd5, 0x42, 0xbe, 0xcb, 0x94, 0x16,
-	0x92, 0x85, 0x34, 0xb6, 0x79, 0x15, 0xf9, 0x0c, 0xe1, 0xeb, 0x61, 0x96, 0x64, 0x31, 0xd5, 0x6c,
-	0x00, 0x4e, 0x47, 0x20, 0xa9, 0x66, 0xa2, 0x86, 0x16, 0x2f, 0x2f, 0x5d, 0xbd, 0x7b, 0xa3, 0xed,
-	0xc8, 0x99, 0x42, 0x0c, 0x3b, 0xc6, 0x30, 0x5d, 0x13, 0x8c, 0xaf, 0xde, 0x33, 0x5a, 0xbf, 0xff,
-	0xad, 0x79, 0xfb, 0xf9, 0xb4, 0x9a, 0x33, 0xca, 0x9f, 0x1b, 0x21, 0x5a, 0x1e, 0xbe, 0xc1, 0x23,
-	0xb7, 0xf0, 0x8c, 0x84, 0x6d, 0x90, 0xc0, 0x43, 0x08, 0x42, 0x91, 0x71, 0x9d, 0xdf, 0xe0, 0x35,
-	0x7f, 0xba, 0x70, 0xaf, 0x19, 0xaf, 0xf7, 0x2d, 0xc2, 0xd7, 0x0b, 0x4d, 0x6b, 0x99, 0x94, 0xc0,
-	0xf5, 0x50, 0xd0, 0x0e, 0x9e, 0xb4, 0x22, 0xd4, 0xc5, 0xf1, 0x1f, 0x22, 0x90, 0x79, 0x5c, 0x49,
-	0x41, 0x32, 0x61, 0x5b, 0xad, 0xec, 0x3b, 0xcb, 0xfb, 0x0a, 0xe1, 0x46, 0x41, 0x70, 0x25, 0x74,
-	0x72, 0x21, 0x5a, 0x13, 0x49, 0xc2, 0x94, 0x62, 0x82, 0x93, 0x5d, 0x8c, 0xc3, 0xc2, 0xba, 0x38,
-	0xaa, 0x63, 0x20, 0xde, 0xe7, 0x08, 0xbf, 0x5a, 0xb0, 0x7a, 0x2f, 0xd3, 0x4a, 0x53, 0x1e, 0x31,
-	0xde, 0x7b, 0x11, 0xa5, 0xf3, 0xbe, 0x41, 0x78, 0xb6, 0x20, 0xb3, 0x15, 0x53, 0xd5, 0xdf, 0x18,
-	0x00, 0xd7, 0xe4, 0x75, 0xfc, 0xf2, 0x60, 0xe8, 0x0e, 0x5c, 0x71, 0x51, 0x5e, 0xdc, 0x99, 0xc2,
-	0xbf, 0x99, 0xbb, 0xc9, 0x87, 0x78, 0x6a, 0x5b, 0xd2, 0xd0, 0x6c, 0xb2, 0x73, 0x19, 0xf5, 0x22,
-	0x9b, 0xf7, 0x25, 0xc2, 0xd5, 0x33, 0xc8, 0x29, 0xa2, 0xf0, 0xfc, 0x88, 0x9d, 0x32, 0x81, 0x00,
-	0xf2, 0x88, 0xab, 0xd8, 0x5b, 0xed, 0xff, 0xdf, 0xb6, 0xed, 0x33, 0x32, 0xaf, 0x96, 0x0d, 0x73,
-	0xbf, 0x3a, 0x38, 0x03, 0xd4, 0x0d, 0xf2, 0x43, 0x84, 0x27, 0xdf, 0x01, 0xd8, 0x14, 0x22, 0x26,
-	0xfb, 0x78, 0x7a, 0xb4, 0x53, 0x53, 0x21, 0xe2, 0x8b, 0xbb, 0xb0, 0xd1, 0xf2, 0x36, 0xc8, 0xde,
-	0xc3, 0x4b, 0xb8, 0xbe, 0x36, 0xee, 0xd9, 0x4a, 0x81, 0x47, 0x76, 0x5b, 0xd1, 0x98, 0x54, 0xf1,
-	0x84, 0x66, 0x3a, 0x06, 0xbb, 0xe4, 0x7d, 0x6b, 0x90, 0x45, 0x7c, 0x35, 0x02, 0x15, 0x4a, 0x96,
-	0x8e, 0xee, 0xca, 0x1f, 0x77, 0x91, 0x1b, 0xf8, 0x8a, 0x84, 0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0x5b,
-	0xd4, 0x1f, 0x39, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0xf7, 0x41, 0x39, 0x97, 0xb9, 0x70, 0xa6, 0xcc,
-	0x5c, 0xe3, 0x9b, 0x4e, 0xe3, 0xd2, 0x73, 0x68, 0xb4, 0x02, 0x5d, 0xea, 0xe5, 0x37, 0x3e, 0x3d,
-	0x6c, 0x96, 0x4c, 0xa5, 0xff, 0x38, 0x6c, 0x96, 0x7e, 0x3a, 0x6a, 0xd5, 0x1d, 0x46, 0x4f, 0x0c,
-	0xc6, 0x20, 0xb8, 0x06, 0xae, 0xbd, 0x1f, 0x11, 0x9e, 0x5b, 0x87, 0x18, 0x7a, 0xf9, 0x55, 0x69,
-	0x2a, 0x35, 0xe3, 0xbd, 0xfb, 0x7c, 0x3b, 0xdf, 0x61, 0xa9, 0x84, 0x01, 0x13, 0xe6, 0x75, 0x18,
-	0xef, 0xde, 0xe9, 0xa1, 0xdb, 0x35, 0xaf, 0x8f, 0x27, 0x4c, 0x93, 0xc0, 0xb9, 0x74, 0xae, 0x4d,
-	0x45, 0x6e, 0xe3, 0x4a, 0x1f, 0x58, 0xaf, 0x6f, 0x4b, 0x58, 0x5e, 0x9d, 0xfd, 0xf3, 0xb8, 0x39,
-	0x13, 0x4a, 0x30, 0xdb, 0x95, 0x07, 0x36, 0xe4, 0xbb, 0x4f, 0xbc, 0xa7, 0x08, 0x2f, 0x38, 0x0d,
-	0x4c, 0xf0, 0x42, 0x8d, 0x7b, 0x70, 0x36, 0xf0, 0x2b, 0xa3, 0x46, 0x37, 0x2f, 0x0e, 0x28, 0xe5,
-	0x5e, 0xee, 0xda, 0x93, 0xa3, 0x56, 0xd5, 0x81, 0xaf, 0xd8, 0xc8, 0x96, 0x96, 0x66, 0x8f, 0x8c,
-	0x26, 0xd7, 0xf9, 0x09, 0xc3, 0x95, 0xe2, 0x2d, 0xbe, 0xa0, 0x06, 0x75, 0x00, 0xcb, 0x53, 0xee,
-	0xfe, 0x90, 0xf7, 0x03, 0xc2, 0x0b, 0x0f, 0xc4, 0x0e, 0x70, 0xf6, 0x31, 0x6c, 0xf5, 0xa9, 0x04,
-	0x1f, 0x42, 0x21, 0x23, 0xa7, 0xac, 0x8e, 0xa7, 0x64, 0x6e, 0xdf, 0x1f, 0x5e, 0x4d, 0x61, 0xbf,
-	0x18, 0xba, 0x4f, 0x11, 0x7e, 0xed, 0xdf, 0x47, 0xea, 0x03, 0xa6, 0xfb, 0xeb, 0x90, 0x0a, 0xc5,
-	0xf4, 0x05, 0x4d, 0xd7, 0xfc, 0xd8, 0x74, 0x99, 0x90, 0xb3, 0x48, 0x0d, 0x4f, 0x46, 0x16, 0xb8,
-	0x36, 0x91, 0x07, 0x86, 0xe6, 0xf2, 0xcd, 0x21, 0xf7, 0xff, 0x1e, 0x93, 0xd5, 0xee, 0x77, 0x27,
-	0x0d, 0xf4, 0xe8, 0xa4, 0x81, 0x1e, 0x9f, 0x34, 0xd0, 0xef, 0x27, 0x0d, 0xf4, 0xc5, 0x69, 0xa3,
-	0xf4, 0xf8, 0xb4, 0x51, 0xfa, 0xe5, 0xb4, 0x51, 0xfa, 0x68, 0x7d, 0xac, 0x6c, 0x6c, 0x37, 0xce,
-	0xcc, 0x1b, 0xc5, 0x78, 0xd8, 0xb1, 0x1b, 0x94, 0xe9, 0x83, 0x96, 0xdb, 0xa2, 0xad, 0x44, 0x44,
-	0x59, 0x0c, 0x9d, 0xfd, 0x67, 0x7e, 0xde, 0xda, 0xc2, 0x76, 0x2b, 0xf9, 0x0f, 0xce, 0x7b, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x23, 0x17, 0x82, 0x10, 0x0b, 0x00, 0x00,
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xb4, 0x8e, 0x93, 0xbe, 0x7c, 0x9b, 0xf4, 0x3b, 0x71, 0x52, 0xc7, 0x54, 0x76, 0xb4,
+	0x12, 0x6d, 0x68, 0x65, 0x9b, 0xa6, 0x07, 0xa4, 0x88, 0x4b, 0x7e, 0x55, 0xed, 0x89, 0x68, 0x13,
+	0x7e, 0x88, 0x8b, 0x35, 0xde, 0x9d, 0xd8, 0x43, 0xd6, 0x33, 0x9b, 0x99, 0x59, 0x27, 0xe1, 0xda,
+	0x0b, 0x70, 0x02, 0x71, 0x41, 0x1c, 0x50, 0x8e, 0x08, 0x71, 0xcc, 0x3f, 0x80, 0xc4, 0xa1, 0xe2,
+	0x54, 0x7a, 0x29, 0xe2, 0x10, 0x50, 0x72, 0x41, 0x5c, 0xf9, 0x07, 0xd0, 0xec, 0x8c, 0xd7, 0x2e,
+	0x04, 0x08, 0x22, 0x51, 0x4f, 0xc9, 0x7b, 0x6f, 0xe7, 0x7d, 0x3e, 0x9f, 0xb7, 0xf3, 0xde, 0x5b,
+	0xc3, 0xad, 0x90, 0x29, 0x2d, 0x59, 0x2b, 0xd1, 0x4c, 0xf0, 0x46, 0xef, 0x6e, 0x8b, 0x6a, 0x72,
+	0xb7, 0x31, 0xec, 0xac, 0xc7, 0x52, 0x68, 0x81, 0xbd, 0x88, 0xed, 0x24, 0x2c, 0x54, 0x9a, 0x6c,
+	0x33, 0xde, 0xae, 0x3f, 0xf7, 0x84, 0x3b, 0x56, 0x2e, 0xb6, 0x45, 0x5b, 0xa4, 0x8f, 0x37, 0xcc,
+	0x7f, 0xf6, 0x64, 0xb9, 0x12, 0x08, 0xd5, 0x15, 0xaa, 0xd1, 0x22, 0x8a, 0x66, 0x08, 0x81, 0x60,
+	0x2e, 0x73, 0x79, 0xd6, 0xc6, 0x9b, 0xf6, 0xa0, 0x35, 0x6c, 0xc8, 0xfb, 0xed, 0x32, 0x14, 0xd6,
+	0x89, 0x24, 0x5d, 0x85, 0x09, 0x5c, 0x0d, 0x44, 0xb7, 0x9b, 0x70, 0xa6, 0xf7, 0x9b, 0x9a, 0xec,
+	0x95, 0xd0, 0x1c, 0x9a, 0xbf, 0xb2, 0xfc, 0xfa, 0xe3, 0xa3, 0x6a, 0xee, 0xc7, 0xa3, 0xea, 0xcd,
+	0x36, 0xd3, 0x9d, 0xa4, 0x55, 0x0f, 0x44, 0xd7, 0xa5, 0x70, 0x7f, 0x6a, 0x2a, 0xdc, 0x6e, 0xe8,
+	0xfd, 0x98, 0xaa, 0xfa, 0x2a, 0x0d, 0x9e, 0x1e, 0xd6, 0xc0, 0x21, 0xac, 0xd2, 0xc0, 0xff, 0x5f,
+	0x96, 0x72, 0x93, 0xec, 0x61, 0x0e, 0x45, 0xc3, 0xd1, 0x10, 0x89, 0x85, 0xa2, 0xb2, 0x29, 0xe9,
+	0x2e, 0x91, 0x61, 0xe9, 0xd2, 0x39, 0x20, 0x61, 0x93, 0x79, 0xdd, 0x25, 0xf6, 0xd3, 0xbc, 0x38,
+	0x86, 0xe9, 0x96, 0xe0, 0x89, 0xfa, 0x13, 0xe0, 0xe5, 0x73, 0x00, 0x9c, 0x4a, 0x53, 0xff, 0x01,
+	0x71, 0x01, 0xa6, 0x77, 0x99, 0xee, 0x84, 0x92, 0xec, 0x36, 0x49, 0x18, 0xca, 0x26, 0xe5, 0xa4,
+	0x15, 0xd1, 0xb0, 0x94, 0x9f, 0x43, 0xf3, 0x63, 0xfe, 0x54, 0x3f, 0xb8, 0x14, 0x86, 0x72, 0xcd,
+	0x86, 0xf0, 0x7b, 0x80, 0x19, 0x57, 0x89, 0x24, 0x3c, 0xa0, 0xcd, 0xad, 0x84, 0x87, 0x69, 0xf5,
+	0x47, 0xce, 0x81, 0xe2, 0xb5, 0x2c, 0xef, 0xfd, 0x84, 0x87, 0x9b, 0x64, 0x6f, 0x31, 0xff, 0xd9,
+	0x41, 0x35, 0xe7, 0x7d, 0x8f, 0xa0, 0xfc, 0x16, 0x89, 0x58, 0x48, 0xb4, 0x90, 0x0f, 0x98, 0xd2,
+	0x42, 0xb2, 0x80, 0x44, 0x56, 0x83, 0xc2, 0x1f, 0x22, 0xb8, 0x1e, 0x24, 0xdd, 0x24, 0x22, 0x9a,
+	0xf5, 0xa8, 0xab, 0x59, 0x53, 0x12, 0xcd, 0x44, 0x09, 0xcd, 0x5d, 0x9e, 0x1f, 0x5f, 0xb8, 0x51,
+	0x77, 0x28, 0xa6, 0xe8, 0xfd, 0xdb, 0x69, 0x20, 0x57, 0x04, 0xe3, 0xcb, 0xf7, 0x0c, 0xe9, 0xaf,
+	0x7e, 0xaa, 0xde, 0x39, 0x1b, 0x69, 0x73, 0x46, 0xf9, 0xd3, 0x03, 0x44, 0xcb, 0xc3, 0x37, 0x78,
+	0xf8, 0x16, 0x4c, 0x4a, 0xba, 0x45, 0x25, 0x35, 0xc5, 0x09, 0x44, 0xc2, 0x75, 0x7a, 0x5b, 0xae,
+	0xfa, 0x13, 0x99, 0x7b, 0xc5, 0x78, 0xbd, 0x2f, 0x10, 0x5c, 0xcf, 0x34, 0xad, 0x24, 0x52, 0x52,
+	0xae, 0xfb, 0x82, 0xb6, 0x61, 0xd4, 0x8a, 0x50, 0x17, 0xc7, 0xbf, 0x8f, 0x80, 0x67, 0xa0, 0x10,
+	0x53, 0xc9, 0x84, 0xbd, 0xd6, 0x79, 0xdf, 0x59, 0xde, 0xa7, 0x08, 0x2a, 0x19, 0xc1, 0xa5, 0xc0,
+	0xc9, 0xa5, 0xe1, 0x8a, 0xe8, 0x76, 0x99, 0x52, 0x4c, 0x70, 0xbc, 0x03, 0x10, 0x64, 0xd6, 0xc5,
+	0x51, 0x1d, 0x02, 0xf1, 0x3e, 0x42, 0xf0, 0x52, 0xc6, 0xea, 0x8d, 0x44, 0x2b, 0x4d, 0x78, 0xc8,
+	0x78, 0xfb, 0x45, 0x94, 0xce, 0xfb, 0x1c, 0xc1, 0x54, 0x46, 0x66, 0x23, 0x22, 0xaa, 0xb3, 0xd6,
+	0xa3, 0x5c, 0xe3, 0x57, 0xe0, 0x5a, 0xaf, 0xef, 0x6e, 0xba, 0xe2, 0xa2, 0xb4, 0xb8, 0x93, 0x99,
+	0x7f, 0x3d, 0x75, 0xe3, 0x77, 0x60, 0x6c, 0x4b, 0x92, 0xc0, 0x4c, 0xcd, 0x73, 0x19, 0x2b, 0x59,
+	0x36, 0xef, 0x13, 0x04, 0xc5, 0x53, 0xc8, 0x29, 0xac, 0x60, 0x66, 0xc0, 0x4e, 0x99, 0x40, 0x93,
+	0xa6, 0x11, 0x57, 0xb1, 0xd7, 0xea, 0xff, 0x3c, 0xd9, 0xeb, 0xa7, 0x64, 0x5e, 0xce, 0x1b, 0xe6,
+	0x7e, 0xb1, 0x77, 0x0a, 0xa8, 0x6b, 0xe4, 0x47, 0x08, 0x46, 0xef, 0x53, 0xba, 0x2e, 0x44, 0x84,
+	0xf7, 0x60, 0x62, 0x30, 0xbf, 0x63, 0x21, 0xa2, 0x8b, 0x7b, 0x61, 0x83, 0x45, 0x61, 0x90, 0xbd,
+	0x47, 0x97, 0xa0, 0xbc, 0x32, 0xec, 0xd9, 0x88, 0x29, 0x0f, 0xed, 0x64, 0x24, 0x11, 0x2e, 0xc2,
+	0x88, 0x66, 0x3a, 0xa2, 0x76, 0xa1, 0xf8, 0xd6, 0xc0, 0x73, 0x30, 0x1e, 0x52, 0x15, 0x48, 0x16,
+	0x0f, 0xde, 0x95, 0x3f, 0xec, 0xc2, 0x37, 0xe0, 0x8a, 0xa4, 0x01, 0x8b, 0x19, 0xe5, 0xda, 0x4e,
+	0x6c, 0x7f, 0xe0, 0xc0, 0x01, 0x14, 0x48, 0x37, 0x9d, 0x07, 0xf9, 0x54, 0xe6, 0xec, 0xa9, 0x32,
+	0x53, 0x8d, 0xaf, 0x3a, 0x8d, 0xf3, 0x67, 0xd0, 0x68, 0x05, 0xba, 0xd4, 0x8b, 0xb7, 0x3f, 0x38,
+	0xa8, 0xe6, 0x4c, 0xa5, 0x7f, 0x39, 0xa8, 0xe6, 0xbe, 0x3b, 0xac, 0x95, 0x1d, 0x46, 0x5b, 0xf4,
+	0x86, 0x20, 0xb8, 0xa6, 0x5c, 0x7b, 0xdf, 0x22, 0x28, 0xbe, 0x19, 0x87, 0x44, 0x53, 0xbb, 0x50,
+	0xff, 0xb3, 0xfe, 0x07, 0x50, 0x88, 0xd3, 0x4c, 0xa9, 0xf8, 0xf1, 0x85, 0xdb, 0x67, 0xb9, 0x47,
+	0x16, 0xdb, 0x5d, 0x1d, 0x77, 0xfe, 0x5f, 0xc9, 0xf8, 0x06, 0xc1, 0xf4, 0x2a, 0x8d, 0x68, 0x3b,
+	0xbd, 0x71, 0x9a, 0x48, 0xcd, 0x78, 0xfb, 0x21, 0xdf, 0x4a, 0x47, 0x71, 0x2c, 0x69, 0x8f, 0x09,
+	0xb3, 0x50, 0x87, 0x9b, 0x70, 0xa2, 0xef, 0x76, 0x3d, 0xe8, 0xc3, 0x88, 0xe1, 0x48, 0xcf, 0xa5,
+	0x01, 0x6d, 0x2a, 0x7c, 0x07, 0x0a, 0x1d, 0xca, 0xda, 0x1d, 0x7b, 0x13, 0xf2, 0xcb, 0x53, 0xbf,
+	0x1e, 0x55, 0x27, 0x03, 0x49, 0xcd, 0x92, 0xe0, 0x4d, 0x1b, 0xf2, 0xdd, 0x23, 0xde, 0x33, 0x04,
+	0xb3, 0x4e, 0x03, 0x13, 0x3c, 0x53, 0xe3, 0x76, 0xf4, 0x1a, 0xfc, 0x7f, 0xd0, 0xaf, 0x66, 0x49,
+	0x53, 0xa5, 0xdc, 0xc7, 0x4e, 0xe9, 0xe9, 0x61, 0xad, 0xe8, 0xc0, 0x97, 0x6c, 0x64, 0x43, 0x4b,
+	0x33, 0x0e, 0x07, 0x03, 0xc8, 0xf9, 0x31, 0x83, 0x42, 0xf6, 0xf9, 0x72, 0x41, 0x7d, 0xe6, 0x00,
+	0x16, 0xc7, 0xdc, 0xfb, 0x43, 0xde, 0xd7, 0x08, 0x66, 0x37, 0xc5, 0x36, 0xe5, 0xec, 0x7d, 0xba,
+	0xd1, 0x21, 0x92, 0xfa, 0x34, 0x10, 0x32, 0x74, 0xca, 0xca, 0x30, 0x26, 0x53, 0xfb, 0x61, 0xff,
+	0xd5, 0x64, 0xf6, 0x8b, 0xa1, 0xfb, 0x0c, 0xc1, 0xcb, 0x7f, 0x3d, 0x19, 0xde, 0x66, 0xba, 0xb3,
+	0x4a, 0x63, 0xa1, 0x98, 0xbe, 0xa0, 0x21, 0x31, 0x33, 0x34, 0x24, 0x4c, 0xc8, 0x59, 0xb8, 0x04,
+	0xa3, 0xa1, 0x05, 0xb6, 0xdf, 0x59, 0x7e, 0xdf, 0x5c, 0xbc, 0xd9, 0xe7, 0xfe, 0xf7, 0x6d, 0xb2,
+	0xdc, 0xfa, 0xf2, 0xb8, 0x82, 0x1e, 0x1f, 0x57, 0xd0, 0x93, 0xe3, 0x0a, 0xfa, 0xf9, 0xb8, 0x82,
+	0x3e, 0x3e, 0xa9, 0xe4, 0x9e, 0x9c, 0x54, 0x72, 0x3f, 0x9c, 0x54, 0x72, 0xef, 0xae, 0x0e, 0x95,
+	0x8d, 0xed, 0x44, 0x89, 0x59, 0xb5, 0x8c, 0x07, 0x0d, 0xdb, 0xc0, 0x4c, 0xef, 0xd7, 0x5c, 0x13,
+	0xd7, 0xba, 0x22, 0x4c, 0x22, 0xda, 0xd8, 0x7b, 0xee, 0x17, 0x81, 0x2d, 0x6c, 0xab, 0x90, 0x7e,
+	0xa3, 0xdf, 0xfb, 0x7d, 0x00, 0xdc, 0x14, 0x73, 0x17, 0x43, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -763,6 +815,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.InsuranceFundTax.Equal(that1.InsuranceFundTax) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1122,6 +1177,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.InsuranceFundTax.Size()
+		i -= size
+		if _, err := m.InsuranceFundTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1493,6 +1558,53 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1706,6 +1818,8 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.InsuranceFundTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1846,6 +1960,25 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
 func (m *DelegatorStartingInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2086,6 +2219,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2932,6 +3099,153 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
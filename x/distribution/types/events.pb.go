@@ -0,0 +1,540 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: distribution/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventWithdrawTokenizeShareReward is emitted when the rewards of a tokenize share
+// record are withdrawn to the record owner
+type EventWithdrawTokenizeShareReward struct {
+	// owner of the tokenize share record that received the rewards
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// id of the tokenize share record
+	ShareRecordId uint64 `protobuf:"varint,2,opt,name=share_record_id,json=shareRecordId,proto3" json:"share_record_id,omitempty"`
+	// validator the tokenize share record is delegated to
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// module account of the tokenize share record that accrued the rewards
+	ModuleAccount string `protobuf:"bytes,4,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// rewards sent to the owner
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventWithdrawTokenizeShareReward) Reset()         { *m = EventWithdrawTokenizeShareReward{} }
+func (m *EventWithdrawTokenizeShareReward) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawTokenizeShareReward) ProtoMessage()    {}
+func (*EventWithdrawTokenizeShareReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d12ef7a186f0d88f, []int{0}
+}
+func (m *EventWithdrawTokenizeShareReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawTokenizeShareReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawTokenizeShareReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawTokenizeShareReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawTokenizeShareReward.Merge(m, src)
+}
+func (m *EventWithdrawTokenizeShareReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawTokenizeShareReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawTokenizeShareReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawTokenizeShareReward proto.InternalMessageInfo
+
+func (m *EventWithdrawTokenizeShareReward) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventWithdrawTokenizeShareReward) GetShareRecordId() uint64 {
+	if m != nil {
+		return m.ShareRecordId
+	}
+	return 0
+}
+
+func (m *EventWithdrawTokenizeShareReward) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EventWithdrawTokenizeShareReward) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *EventWithdrawTokenizeShareReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventWithdrawTokenizeShareReward)(nil), "liquidstaking.distribution.v1beta1.EventWithdrawTokenizeShareReward")
+}
+
+func init() { proto.RegisterFile("distribution/v1beta1/events.proto", fileDescriptor_d12ef7a186f0d88f) }
+
+var fileDescriptor_d12ef7a186f0d88f = []byte{
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbd, 0x8e, 0xd4, 0x30,
+	0x10, 0xc7, 0x93, 0xfb, 0x92, 0x08, 0x3a, 0x3e, 0xa2, 0x2b, 0x72, 0x57, 0xe4, 0xc2, 0x15, 0x28,
+	0x4d, 0x62, 0x0e, 0x1e, 0x00, 0xdd, 0xc2, 0x15, 0xb4, 0x39, 0x24, 0x24, 0x0a, 0x22, 0xc7, 0xb6,
+	0xb2, 0xa3, 0x4d, 0x3c, 0x77, 0xb6, 0xb3, 0xcb, 0xf2, 0x14, 0x3c, 0x07, 0x35, 0x0f, 0xb1, 0xe5,
+	0x8a, 0x8a, 0x0a, 0xd0, 0x6e, 0xc1, 0x6b, 0xa0, 0xc4, 0x66, 0xb5, 0x57, 0x6d, 0x65, 0x7b, 0xe6,
+	0xff, 0xfb, 0xcf, 0x68, 0x3c, 0xc1, 0x33, 0x0e, 0xda, 0x28, 0xa8, 0x3a, 0x03, 0x28, 0xc9, 0xf4,
+	0xb2, 0x12, 0x86, 0x5e, 0x12, 0x31, 0x15, 0xd2, 0xe8, 0xfc, 0x56, 0xa1, 0xc1, 0xf0, 0xa2, 0x81,
+	0xbb, 0x0e, 0xb8, 0x36, 0x74, 0x02, 0xb2, 0xce, 0xb7, 0x81, 0xdc, 0x01, 0x67, 0x27, 0x35, 0xd6,
+	0x38, 0xc8, 0x49, 0x7f, 0xb3, 0xe4, 0x59, 0xcc, 0x50, 0xb7, 0xa8, 0x49, 0x45, 0xb5, 0xd8, 0x78,
+	0x33, 0x04, 0xe9, 0xf2, 0xa7, 0x36, 0x5f, 0x5a, 0xd0, 0x3e, 0x6c, 0xea, 0xe2, 0xef, 0x5e, 0x90,
+	0x5c, 0xf7, 0x5d, 0x7c, 0x00, 0x33, 0xe6, 0x8a, 0xce, 0xde, 0xe3, 0x44, 0x48, 0xf8, 0x22, 0x6e,
+	0xc6, 0x54, 0x89, 0x42, 0xcc, 0xa8, 0xe2, 0x61, 0x1e, 0x1c, 0xe2, 0x4c, 0x0a, 0x15, 0xf9, 0x89,
+	0x9f, 0x3e, 0x18, 0x45, 0x3f, 0xbe, 0x67, 0x27, 0xce, 0xe5, 0x8a, 0x73, 0x25, 0xb4, 0xbe, 0x31,
+	0x0a, 0x64, 0x5d, 0x58, 0x59, 0xf8, 0x3c, 0x78, 0xac, 0x7b, 0xbc, 0x54, 0x82, 0xa1, 0xe2, 0x25,
+	0xf0, 0x68, 0x2f, 0xf1, 0xd3, 0x83, 0xe2, 0x58, 0x5b, 0xd7, 0x3e, 0xfa, 0x8e, 0x87, 0xd7, 0xc1,
+	0xd3, 0x29, 0x6d, 0x80, 0x53, 0x83, 0xaa, 0xa4, 0xd6, 0x29, 0xda, 0xdf, 0x51, 0xe3, 0xc9, 0x06,
+	0x71, 0xf1, 0xf0, 0x75, 0xf0, 0xa8, 0x45, 0xde, 0x35, 0xa2, 0xa4, 0x8c, 0x61, 0x27, 0x4d, 0x74,
+	0xb0, 0xc3, 0xe3, 0xd8, 0xea, 0xaf, 0xac, 0x3c, 0x64, 0xc1, 0x11, 0x6d, 0x07, 0xf0, 0x30, 0xd9,
+	0x4f, 0x1f, 0xbe, 0x3c, 0xcd, 0x1d, 0xd5, 0x0f, 0xf4, 0xff, 0xec, 0xf3, 0x37, 0x08, 0x72, 0xf4,
+	0x62, 0xf1, 0xeb, 0xdc, 0xfb, 0xf6, 0xfb, 0x3c, 0xad, 0xc1, 0x8c, 0xbb, 0x2a, 0x67, 0xd8, 0xba,
+	0x81, 0xba, 0x23, 0xd3, 0x7c, 0x42, 0xcc, 0xfc, 0x56, 0xe8, 0x01, 0xd0, 0x85, 0xb3, 0x1e, 0x7d,
+	0x5a, 0xac, 0x62, 0x7f, 0xb9, 0x8a, 0xfd, 0x3f, 0xab, 0xd8, 0xff, 0xba, 0x8e, 0xbd, 0xe5, 0x3a,
+	0xf6, 0x7e, 0xae, 0x63, 0xef, 0xe3, 0xdb, 0x2d, 0x2f, 0xb8, 0x6b, 0x3a, 0x0d, 0x28, 0x41, 0x32,
+	0x62, 0xf7, 0x01, 0xcc, 0x3c, 0x73, 0x3b, 0x91, 0xd9, 0xb6, 0xc9, 0x67, 0x72, 0x6f, 0x9b, 0x86,
+	0x6a, 0xd5, 0xd1, 0xf0, 0xa1, 0xaf, 0xfe, 0x0d, 0x00, 0x63, 0x24, 0x67, 0x5f, 0x6a, 0x02, 0x00,
+	0x00,
+}
+
+func (m *EventWithdrawTokenizeShareReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawTokenizeShareReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawTokenizeShareReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShareRecordId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ShareRecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventWithdrawTokenizeShareReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ShareRecordId != 0 {
+		n += 1 + sovEvents(uint64(m.ShareRecordId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventWithdrawTokenizeShareReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawTokenizeShareReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawTokenizeShareReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareRecordId", wireType)
+			}
+			m.ShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -66,6 +66,7 @@ type StakingKeeper interface {
 		fn func(index int64, delegation sdkstaking.DelegationI) (stop bool))
 
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
+	BondDenom(ctx sdk.Context) string
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
@@ -16,6 +16,7 @@ const (
 	TypeMsgFundCommunityPool                    = "fund_community_pool"
 	TypeMsgWithdrawTokenizeShareRecordReward    = "withdraw_tokenize_share_record_reward"
 	TypeMsgWithdrawAllTokenizeShareRecordReward = "withdraw_all_tokenize_share_record_reward"
+	TypeMsgUpdateParams                         = "update_params"
 )
 
 // Verify interface at compile time
@@ -23,6 +24,7 @@ var (
 	_, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
 	_       sdk.Msg = &MsgWithdrawTokenizeShareRecordReward{}
 	_       sdk.Msg = &MsgWithdrawAllTokenizeShareRecordReward{}
+	_       sdk.Msg = &MsgUpdateParams{}
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -238,3 +240,29 @@ func (msg MsgWithdrawAllTokenizeShareRecordReward) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.ValidateBasic()
+}
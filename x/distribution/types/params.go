@@ -15,6 +15,7 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyInsuranceFundTax    = []byte("insurancefundtax")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,9 +30,13 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		InsuranceFundTax:    DefaultInsuranceFundTax,
 	}
 }
 
+// DefaultInsuranceFundTax leaves the whole community tax in the community pool
+var DefaultInsuranceFundTax = sdk.ZeroDec()
+
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -44,6 +49,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyInsuranceFundTax, &p.InsuranceFundTax, validateInsuranceFundTax),
 	}
 }
 
@@ -69,6 +75,11 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if p.InsuranceFundTax.IsNil() || p.InsuranceFundTax.IsNegative() || p.InsuranceFundTax.GT(sdk.OneDec()) {
+		return fmt.Errorf(
+			"insurance fund tax should be non-negative and less than one: %s", p.InsuranceFundTax,
+		)
+	}
 
 	return nil
 }
@@ -138,3 +149,22 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateInsuranceFundTax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("insurance fund tax must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("insurance fund tax must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("insurance fund tax too large: %s", v)
+	}
+
+	return nil
+}
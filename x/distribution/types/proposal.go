@@ -12,15 +12,22 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeUpdateParams defines the type for an UpdateParamsProposal
+	ProposalTypeUpdateParams = "UpdateDistributionParams"
 )
 
 // Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = &CommunityPoolSpendProposal{}
 
+// Assert UpdateParamsProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &UpdateParamsProposal{}
+
 func init() {
 	// already registered in cosmos
 	// govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	// govtypes.RegisterProposalTypeCodec(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "cosmos-sdk/x/distribution/UpdateParamsProposal")
 }
 
 // NewCommunityPoolSpendProposal creates a new community pool spend proposal.
@@ -69,3 +76,39 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// NewUpdateParamsProposal creates a new proposal to update the x/distribution params.
+func NewUpdateParamsProposal(title, description string, params Params) *UpdateParamsProposal {
+	return &UpdateParamsProposal{title, description, params}
+}
+
+// GetTitle returns the title of an update params proposal.
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update params proposal.
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update params proposal.
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update params proposal.
+func (p *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Params.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p UpdateParamsProposal) String() string {
+	return fmt.Sprintf(`Update Distribution Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:
+%s
+`, p.Title, p.Description, p.Params)
+}
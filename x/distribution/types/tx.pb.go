@@ -521,6 +521,101 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/distribution parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0452d52deb0ca76, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "liquidstaking.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -534,60 +629,68 @@ func init() {
 	proto.RegisterType((*MsgWithdrawAllTokenizeShareRecordRewardResponse)(nil), "liquidstaking.distribution.v1beta1.MsgWithdrawAllTokenizeShareRecordRewardResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "liquidstaking.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "liquidstaking.distribution.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "liquidstaking.distribution.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("distribution/v1beta1/tx.proto", fileDescriptor_f0452d52deb0ca76) }
 
 var fileDescriptor_f0452d52deb0ca76 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x58, 0x29, 0xed, 0xa8, 0xd8, 0x86, 0x68, 0xdb, 0xad, 0xdd, 0xd4, 0xa5, 0x68, 0x11,
-	0xb3, 0x6b, 0x2a, 0x88, 0x06, 0x14, 0x9b, 0xfe, 0xc0, 0x1f, 0x04, 0xca, 0x46, 0x14, 0xbc, 0x94,
-	0x4d, 0x66, 0xd8, 0x0e, 0xdd, 0xdd, 0x49, 0x77, 0x66, 0x9b, 0xc6, 0xa3, 0x17, 0x15, 0x2c, 0x8a,
-	0x7f, 0x41, 0xbd, 0x89, 0x20, 0x78, 0xf0, 0xee, 0xc1, 0x4b, 0xd1, 0x4b, 0xf1, 0xe4, 0xa9, 0x4a,
-	0x7a, 0xd0, 0xb3, 0x7f, 0x81, 0x64, 0x7f, 0x75, 0x43, 0x92, 0x26, 0x6d, 0x63, 0x4f, 0x9b, 0x9d,
-	0xf7, 0xbe, 0xef, 0x7d, 0xdf, 0xcc, 0x9b, 0x97, 0x85, 0x63, 0x88, 0x30, 0x6e, 0x93, 0x82, 0xc3,
-	0x09, 0xb5, 0x94, 0xd5, 0x74, 0x01, 0x73, 0x2d, 0xad, 0xf0, 0x35, 0xb9, 0x64, 0x53, 0x4e, 0xe3,
-	0x92, 0x41, 0x56, 0x1c, 0x82, 0x18, 0xd7, 0x96, 0x89, 0xa5, 0xcb, 0xd1, 0x64, 0xd9, 0x4f, 0x16,
-	0x12, 0x3a, 0xd5, 0xa9, 0x9b, 0xae, 0xd4, 0x7e, 0x79, 0x48, 0x41, 0x2c, 0x52, 0x66, 0x52, 0xa6,
-	0x14, 0x34, 0x86, 0x43, 0xde, 0x22, 0x25, 0x96, 0x1f, 0x1f, 0xf1, 0xe2, 0x8b, 0x1e, 0xd0, 0x7b,
-	0xf1, 0x43, 0x43, 0x3e, 0xd4, 0x64, 0xba, 0xb2, 0x9a, 0xae, 0x3d, 0xbc, 0x80, 0xf4, 0x05, 0xc0,
-	0x33, 0x39, 0xa6, 0xe7, 0x31, 0x7f, 0x44, 0xf8, 0x12, 0xb2, 0xb5, 0xf2, 0x34, 0x42, 0x36, 0x66,
-	0x2c, 0x3e, 0x07, 0x07, 0x11, 0x36, 0xb0, 0xae, 0x71, 0x6a, 0x2f, 0x6a, 0xde, 0xe2, 0x30, 0x18,
-	0x07, 0x93, 0xfd, 0xd9, 0xe1, 0xef, 0x9f, 0x52, 0x09, 0x9f, 0xdf, 0x4f, 0xcf, 0x73, 0x9b, 0x58,
-	0xba, 0x3a, 0x10, 0x42, 0x02, 0x9a, 0x19, 0x38, 0x50, 0xf6, 0x99, 0x43, 0x96, 0x63, 0x6d, 0x58,
-	0x4e, 0x97, 0xeb, 0xb5, 0x64, 0xc4, 0xe7, 0x1b, 0xc9, 0xd8, 0x9f, 0x8d, 0x64, 0xec, 0xe9, 0xef,
-	0x8f, 0x97, 0x1a, 0x65, 0x49, 0x49, 0x38, 0xd6, 0xd4, 0x84, 0x8a, 0x59, 0x89, 0x5a, 0x0c, 0x4b,
-	0x5f, 0x01, 0x14, 0x72, 0x4c, 0x0f, 0xc2, 0xb3, 0x01, 0x83, 0x8a, 0xcb, 0x9a, 0x8d, 0xba, 0xe5,
-	0x75, 0x0e, 0x0e, 0xae, 0x6a, 0x06, 0x41, 0x75, 0x34, 0xed, 0xcc, 0x0e, 0x84, 0x90, 0x4e, 0xdd,
-	0xbe, 0x00, 0x50, 0x6a, 0x6d, 0x26, 0xf0, 0x1c, 0x2f, 0xc2, 0x5e, 0xcd, 0xa4, 0x8e, 0xc5, 0x87,
-	0xc1, 0x78, 0xcf, 0xe4, 0x89, 0xa9, 0x11, 0xd9, 0xaf, 0x5f, 0xeb, 0x9f, 0xa0, 0xd5, 0xe4, 0x19,
-	0x4a, 0xac, 0xec, 0x95, 0xcd, 0xed, 0x64, 0xec, 0xfd, 0xcf, 0xe4, 0xa4, 0x4e, 0xf8, 0x92, 0x53,
-	0x90, 0x8b, 0xd4, 0xf4, 0xfb, 0xc7, 0x7f, 0xa4, 0x18, 0x5a, 0x56, 0x78, 0xa5, 0x84, 0x99, 0x0b,
-	0x60, 0xaa, 0x4f, 0x2d, 0x3d, 0x03, 0x50, 0x8c, 0x68, 0x79, 0x18, 0x78, 0x99, 0xa1, 0xa6, 0x49,
-	0x18, 0x23, 0xd4, 0x6a, 0xbe, 0x2b, 0xe0, 0x90, 0xbb, 0xd2, 0xc0, 0x28, 0xad, 0x03, 0x78, 0x61,
-	0x6f, 0x25, 0x47, 0xbb, 0x33, 0x2f, 0x01, 0x9c, 0x88, 0xe8, 0x79, 0x40, 0x97, 0xb1, 0x45, 0x9e,
-	0xe0, 0xfc, 0x92, 0x66, 0x63, 0x15, 0x17, 0x69, 0xed, 0xa4, 0xdc, 0xe6, 0xbb, 0x09, 0x4f, 0xd1,
-	0xb2, 0x85, 0x1b, 0xf6, 0xe6, 0xef, 0x76, 0x32, 0x51, 0xd1, 0x4c, 0x23, 0x23, 0xd5, 0x85, 0x25,
-	0xf5, 0xa4, 0xfb, 0x1e, 0x34, 0xdd, 0x28, 0xec, 0xb7, 0x5d, 0xba, 0x45, 0x82, 0xdc, 0x66, 0x3b,
-	0xae, 0xf6, 0x79, 0x0b, 0x77, 0x51, 0xa6, 0x2f, 0xd8, 0x34, 0x49, 0x86, 0x97, 0x3b, 0x51, 0x13,
-	0xde, 0x18, 0x1b, 0x5e, 0x8c, 0xe4, 0x4f, 0x1b, 0xc6, 0xff, 0x32, 0x10, 0xd1, 0x98, 0x86, 0x4a,
-	0x87, 0x35, 0x43, 0x99, 0xdf, 0x00, 0x4c, 0xe4, 0x98, 0x3e, 0xef, 0x58, 0xa8, 0x76, 0xd0, 0x8e,
-	0x45, 0x78, 0x65, 0x81, 0x52, 0xe3, 0x48, 0xce, 0x38, 0x7e, 0x0d, 0xf6, 0x23, 0x5c, 0xa2, 0x8c,
-	0x70, 0x6a, 0xb7, 0xbd, 0xe8, 0xbb, 0xa9, 0x99, 0xb3, 0xd1, 0x5e, 0xde, 0x5d, 0x97, 0x44, 0x78,
-	0xae, 0x99, 0x99, 0xc0, 0xed, 0xd4, 0x7a, 0x1f, 0xec, 0xc9, 0x31, 0x3d, 0xfe, 0x06, 0xc0, 0x78,
-	0x93, 0x91, 0x7d, 0x43, 0x6e, 0xff, 0xdf, 0x22, 0x37, 0x1d, 0x94, 0xc2, 0xf4, 0x81, 0xa1, 0xe1,
-	0xad, 0x7a, 0x0b, 0xe0, 0x50, 0xab, 0x01, 0x7b, 0xab, 0x43, 0xfa, 0x16, 0x78, 0x61, 0xfe, 0x70,
-	0xf8, 0x50, 0xe3, 0x07, 0x00, 0x47, 0xf7, 0x9a, 0x55, 0xd9, 0x7d, 0xd6, 0x69, 0xc2, 0x21, 0xdc,
-	0x3b, 0x3c, 0x47, 0xa8, 0xf7, 0x33, 0x80, 0xe7, 0xdb, 0x4f, 0x90, 0x3b, 0xfb, 0xac, 0xd8, 0x92,
-	0x49, 0x58, 0xe8, 0x16, 0x53, 0xe8, 0x60, 0x13, 0xc0, 0x89, 0x8e, 0xa6, 0xc8, 0xfd, 0x7d, 0x96,
-	0xde, 0x8b, 0x4c, 0xc8, 0x77, 0x91, 0x2c, 0xb4, 0xf2, 0x0a, 0xc0, 0xc1, 0xc6, 0x41, 0x73, 0xbd,
-	0xc3, 0x52, 0x0d, 0x48, 0xe1, 0xf6, 0x41, 0x91, 0x81, 0xa2, 0x6c, 0xe1, 0x5d, 0x55, 0x04, 0x9b,
-	0x55, 0x11, 0x6c, 0x55, 0x45, 0xf0, 0xab, 0x2a, 0x82, 0xd7, 0x3b, 0x62, 0x6c, 0x6b, 0x47, 0x8c,
-	0xfd, 0xd8, 0x11, 0x63, 0x8f, 0x67, 0x23, 0xf3, 0x8c, 0xac, 0x18, 0x4e, 0xad, 0xb3, 0x88, 0x55,
-	0x54, 0xbc, 0xaa, 0x84, 0x57, 0x52, 0x7e, 0xe5, 0x94, 0x49, 0x91, 0x63, 0x60, 0x65, 0x4d, 0xa9,
-	0xfb, 0x74, 0x75, 0x27, 0x5e, 0xa1, 0xd7, 0xfd, 0x50, 0xbc, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x7e, 0x76, 0x14, 0x5b, 0xd7, 0x0a, 0x00, 0x00,
+	// 853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0xeb, 0x44,
+	0x14, 0xce, 0xd0, 0xaa, 0x6a, 0xa6, 0x85, 0xb6, 0x56, 0xa0, 0xad, 0x4b, 0x9d, 0x62, 0x55, 0xb4,
+	0xaa, 0x88, 0x4d, 0x5a, 0x09, 0x41, 0x10, 0x88, 0xa6, 0x0f, 0x15, 0x50, 0xa4, 0xca, 0xe1, 0x21,
+	0xb1, 0xa9, 0x9c, 0xcc, 0xc8, 0x19, 0xd5, 0xf6, 0xa4, 0x9e, 0x71, 0xd3, 0xb0, 0x42, 0x6c, 0x00,
+	0x09, 0x44, 0xc5, 0x2f, 0x28, 0x62, 0x83, 0x90, 0x90, 0x58, 0xb0, 0x67, 0xc1, 0xa6, 0x82, 0x4d,
+	0xc5, 0x8a, 0x55, 0xef, 0x55, 0xba, 0xb8, 0x77, 0x7d, 0x7f, 0xc1, 0x55, 0xfc, 0xaa, 0x73, 0x93,
+	0x34, 0xee, 0xe3, 0x76, 0x95, 0xd8, 0xe7, 0x7c, 0xdf, 0xf9, 0xbe, 0xe3, 0xe3, 0x33, 0x86, 0xf3,
+	0x88, 0x30, 0xee, 0x90, 0x8a, 0xcb, 0x09, 0xb5, 0xd5, 0xc3, 0x7c, 0x05, 0x73, 0x3d, 0xaf, 0xf2,
+	0x23, 0xa5, 0xee, 0x50, 0x4e, 0x05, 0xd9, 0x24, 0x07, 0x2e, 0x41, 0x8c, 0xeb, 0xfb, 0xc4, 0x36,
+	0x94, 0x78, 0xb2, 0x12, 0x24, 0x8b, 0x19, 0x83, 0x1a, 0xd4, 0x4b, 0x57, 0xdb, 0xff, 0x7c, 0xa4,
+	0x28, 0x55, 0x29, 0xb3, 0x28, 0x53, 0x2b, 0x3a, 0xc3, 0x11, 0x6f, 0x95, 0x12, 0x3b, 0x88, 0xcf,
+	0xfa, 0xf1, 0x3d, 0x1f, 0xe8, 0x5f, 0x04, 0xa1, 0xe9, 0x00, 0x6a, 0x31, 0x43, 0x3d, 0xcc, 0xb7,
+	0x7f, 0x82, 0xc0, 0x52, 0x4f, 0xb1, 0x1d, 0xa2, 0xbc, 0x44, 0xf9, 0x6f, 0x00, 0x5f, 0x2e, 0x31,
+	0xa3, 0x8c, 0xf9, 0xe7, 0x84, 0xd7, 0x90, 0xa3, 0x37, 0xd6, 0x11, 0x72, 0x30, 0x63, 0xc2, 0x16,
+	0x9c, 0x42, 0xd8, 0xc4, 0x86, 0xce, 0xa9, 0xb3, 0xa7, 0xfb, 0x37, 0x67, 0xc0, 0x02, 0x58, 0x4e,
+	0x17, 0x67, 0xfe, 0xfb, 0x33, 0x97, 0x09, 0x84, 0x04, 0xe9, 0x65, 0xee, 0x10, 0xdb, 0xd0, 0x26,
+	0x23, 0x48, 0x48, 0xb3, 0x01, 0x27, 0x1b, 0x01, 0x73, 0xc4, 0xf2, 0xc2, 0x00, 0x96, 0x89, 0x46,
+	0xa7, 0x96, 0x82, 0xf4, 0xed, 0x49, 0x36, 0xf5, 0xf8, 0x24, 0x9b, 0xfa, 0xfa, 0xd1, 0x1f, 0x2b,
+	0xdd, 0xb2, 0xe4, 0x2c, 0x9c, 0xef, 0x69, 0x42, 0xc3, 0xac, 0x4e, 0x6d, 0x86, 0xe5, 0x7f, 0x00,
+	0x14, 0x4b, 0xcc, 0x08, 0xc3, 0x9b, 0x21, 0x83, 0x86, 0x1b, 0xba, 0x83, 0xee, 0xca, 0xeb, 0x16,
+	0x9c, 0x3a, 0xd4, 0x4d, 0x82, 0x3a, 0x68, 0x06, 0x99, 0x9d, 0x8c, 0x20, 0x49, 0xdd, 0x7e, 0x07,
+	0xa0, 0xdc, 0xdf, 0x4c, 0xe8, 0x59, 0xa8, 0xc2, 0x11, 0xdd, 0xa2, 0xae, 0xcd, 0x67, 0xc0, 0xc2,
+	0xd0, 0xf2, 0xd8, 0xea, 0xac, 0x12, 0xd4, 0x6f, 0x0f, 0x5a, 0x38, 0x93, 0xca, 0x06, 0x25, 0x76,
+	0xf1, 0xcd, 0xd3, 0xf3, 0x6c, 0xea, 0xb7, 0x07, 0xd9, 0x65, 0x83, 0xf0, 0x9a, 0x5b, 0x51, 0xaa,
+	0xd4, 0x0a, 0x06, 0x2d, 0xf8, 0xc9, 0x31, 0xb4, 0xaf, 0xf2, 0x66, 0x1d, 0x33, 0x0f, 0xc0, 0xb4,
+	0x80, 0x5a, 0xfe, 0x06, 0x40, 0x29, 0xa6, 0xe5, 0xb3, 0xd0, 0xcb, 0x06, 0xb5, 0x2c, 0xc2, 0x18,
+	0xa1, 0x76, 0xef, 0xae, 0x80, 0x5b, 0x76, 0xa5, 0x8b, 0x51, 0xfe, 0x01, 0xc0, 0xd7, 0xaf, 0x56,
+	0x72, 0xbf, 0x9d, 0xf9, 0x1e, 0xc0, 0xc5, 0x98, 0x9e, 0x4f, 0xe8, 0x3e, 0xb6, 0xc9, 0x97, 0xb8,
+	0x5c, 0xd3, 0x1d, 0xac, 0xe1, 0x2a, 0x75, 0x90, 0xff, 0xbc, 0x84, 0xf7, 0xe0, 0x8b, 0xb4, 0x61,
+	0xe3, 0xae, 0xde, 0x3c, 0x39, 0xcf, 0x66, 0x9a, 0xba, 0x65, 0x16, 0xe4, 0x8e, 0xb0, 0xac, 0x8d,
+	0x7b, 0xd7, 0xe1, 0xd0, 0xcd, 0xc1, 0xb4, 0xe3, 0xd1, 0xed, 0x11, 0xe4, 0x0d, 0xdb, 0xb0, 0x36,
+	0xea, 0xdf, 0xf8, 0x10, 0x15, 0x46, 0xc3, 0xa6, 0xc9, 0x0a, 0x7c, 0x23, 0x89, 0x9a, 0xe8, 0x8d,
+	0x71, 0xe0, 0x52, 0x2c, 0x7f, 0xdd, 0x34, 0x9f, 0x97, 0x81, 0x98, 0xc6, 0x3c, 0x54, 0x13, 0xd6,
+	0x8c, 0x64, 0xfe, 0x0b, 0x60, 0xa6, 0xc4, 0x8c, 0x6d, 0xd7, 0x46, 0xed, 0x07, 0xed, 0xda, 0x84,
+	0x37, 0x77, 0x29, 0x35, 0xef, 0xe5, 0x19, 0x0b, 0x6f, 0xc1, 0x34, 0xc2, 0x75, 0xca, 0x08, 0xa7,
+	0xce, 0xc0, 0x17, 0xfd, 0x32, 0xb5, 0xf0, 0x4a, 0x7c, 0x96, 0x2f, 0xef, 0xcb, 0x12, 0x7c, 0xb5,
+	0x97, 0x99, 0xc8, 0xed, 0x2f, 0x00, 0x4e, 0x94, 0x98, 0xf1, 0x69, 0x1d, 0xe9, 0x1c, 0xef, 0xea,
+	0x8e, 0x6e, 0xb1, 0xb6, 0x06, 0xdd, 0xe5, 0x35, 0xea, 0x10, 0xde, 0x1c, 0xf8, 0x5a, 0x5d, 0xa6,
+	0x0a, 0x3b, 0x70, 0xa4, 0xee, 0x31, 0x78, 0xc2, 0xc7, 0x56, 0x57, 0x94, 0xc1, 0x27, 0x98, 0xe2,
+	0xd7, 0x2c, 0x0e, 0xb7, 0x3b, 0xa6, 0x05, 0xf8, 0xc2, 0x4b, 0x9e, 0x8b, 0x88, 0x59, 0x9e, 0x85,
+	0xd3, 0xcf, 0x88, 0x0c, 0x0d, 0xac, 0x1e, 0xa7, 0xe1, 0x50, 0x89, 0x19, 0xc2, 0x4f, 0x00, 0x0a,
+	0x3d, 0xce, 0x9c, 0x77, 0x92, 0x68, 0xe8, 0xb9, 0xe9, 0xc5, 0xf5, 0x1b, 0x43, 0xa3, 0xb5, 0xf0,
+	0x33, 0x80, 0xd3, 0xfd, 0x4e, 0x88, 0xf7, 0x13, 0xd2, 0xf7, 0xc1, 0x8b, 0xdb, 0xb7, 0xc3, 0x47,
+	0x1a, 0x7f, 0x07, 0x70, 0xee, 0xaa, 0x65, 0x5b, 0xbc, 0x66, 0x9d, 0x1e, 0x1c, 0xe2, 0x47, 0xb7,
+	0xe7, 0x88, 0xf4, 0xfe, 0x05, 0xe0, 0x6b, 0x83, 0x57, 0xe0, 0xce, 0x35, 0x2b, 0xf6, 0x65, 0x12,
+	0x77, 0xef, 0x8a, 0x29, 0x72, 0x70, 0x0a, 0xe0, 0x62, 0xa2, 0x35, 0xf8, 0xf1, 0x35, 0x4b, 0x5f,
+	0x45, 0x26, 0x96, 0xef, 0x90, 0x2c, 0xb2, 0xf2, 0x23, 0x80, 0x53, 0xdd, 0x9b, 0xf2, 0xed, 0x84,
+	0xa5, 0xba, 0x90, 0xe2, 0x07, 0x37, 0x45, 0x46, 0x8a, 0xbe, 0x02, 0x70, 0xbc, 0x63, 0x9b, 0xad,
+	0x25, 0xa4, 0x8c, 0x83, 0xc4, 0x77, 0x6f, 0x00, 0x0a, 0x25, 0x14, 0x2b, 0xbf, 0xb6, 0x24, 0x70,
+	0xda, 0x92, 0xc0, 0x59, 0x4b, 0x02, 0x0f, 0x5b, 0x12, 0x38, 0xbe, 0x90, 0x52, 0x67, 0x17, 0x52,
+	0xea, 0xff, 0x0b, 0x29, 0xf5, 0xc5, 0x66, 0xec, 0x4c, 0x20, 0x07, 0xa6, 0xdb, 0x1e, 0x6e, 0x62,
+	0x57, 0x55, 0xbf, 0x20, 0xe1, 0xcd, 0x5c, 0x50, 0x34, 0x67, 0x51, 0xe4, 0x9a, 0x58, 0x3d, 0xea,
+	0xf8, 0xca, 0xf6, 0x4f, 0x8d, 0xca, 0x88, 0xf7, 0xb1, 0xbd, 0xf6, 0x74, 0x00, 0xbe, 0x87, 0x3e,
+	0x74, 0x44, 0x0c, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -732,6 +835,54 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -761,6 +912,9 @@ type MsgClient interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines an operation for updating the x/distribution module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -825,6 +979,15 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/liquidstaking.distribution.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -843,6 +1006,9 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines an operation for updating the x/distribution module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -867,6 +1033,9 @@ func (*UnimplementedMsgServer) WithdrawAllTokenizeShareRecordReward(ctx context.
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -980,6 +1149,24 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liquidstaking.distribution.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "liquidstaking.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1008,6 +1195,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "distribution/v1beta1/tx.proto",
@@ -1392,6 +1583,69 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1564,6 +1818,30 @@ func (m *MsgFundCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2547,6 +2825,171 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,6 +26,7 @@ import (
 	"github.com/iqlusioninc/liquidity-staking-module/x/genutil"
 	"github.com/iqlusioninc/liquidity-staking-module/x/genutil/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/client/cli"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
 // GenTxCmd builds the application's gentx command.
@@ -40,7 +41,9 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation,
 that is signed by the key in the Keyring referenced by a given name. A node ID and Bech32 consensus
 pubkey may optionally be provided. If they are omitted, they will be retrieved from the priv_validator.json
-file. The following default parameters are included:
+file. With --validator-bond, the self-delegation is also flagged as a validator bond, so that the
+validator can receive liquid delegations from genesis when the validator bond factor is enabled.
+The following default parameters are included:
     %s
 
 Example:
@@ -51,7 +54,8 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
     --commission-rate=0.07 \
     --details="..." \
     --security-contact="..." \
-    --website="..."
+    --website="..." \
+    --validator-bond
 `, defaultsDesc, version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -154,16 +158,30 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
+			msgs := []sdk.Msg{msg}
+			if validatorBond, _ := cmd.Flags().GetBool(cli.FlagValidatorBond); validatorBond {
+				createValidatorMsg := msg.(*stakingtypes.MsgCreateValidator)
+				msgs = append(msgs, &stakingtypes.MsgValidatorBond{
+					DelegatorAddress: createValidatorMsg.DelegatorAddress,
+					ValidatorAddress: createValidatorMsg.ValidatorAddress,
+				})
+
+				// The two messages take close to the default gas limit
+				if !cmd.Flags().Changed(flags.FlagGas) {
+					txBldr = txBldr.WithGas(2 * flags.DefaultGasLimit)
+				}
+			}
+
 			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
-				return txBldr.PrintUnsignedTx(clientCtx, msg)
+				return txBldr.PrintUnsignedTx(clientCtx, msgs...)
 			}
 
 			// write the unsigned transaction to the buffer
 			w := bytes.NewBuffer([]byte{})
 			clientCtx = clientCtx.WithOutput(w)
 
-			if err = txBldr.PrintUnsignedTx(clientCtx, msg); err != nil {
+			if err = txBldr.PrintUnsignedTx(clientCtx, msgs...); err != nil {
 				return errors.Wrap(err, "failed to print unsigned std tx")
 			}
 
@@ -204,6 +222,7 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().Bool(cli.FlagValidatorBond, false, "Flag the self delegation as a validator bond")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -1 +1,171 @@
 package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/genutil"
+	"github.com/iqlusioninc/liquidity-staking-module/x/genutil/migrations/lsm"
+	"github.com/iqlusioninc/liquidity-staking-module/x/genutil/types"
+)
+
+const (
+	flagDryRun                    = "dry-run"
+	flagValidatorBondFactor       = "validator-bond-factor"
+	flagGlobalLiquidStakingCap    = "global-liquid-staking-cap"
+	flagValidatorLiquidStakingCap = "validator-liquid-staking-cap"
+)
+
+// MigrateGenesisCmd returns the parent command for genesis migrations.
+func MigrateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "migrate",
+		Short:                      "Migrate a genesis file to this chain's format",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(MigrateLSMGenesisCmd(mbm))
+
+	return cmd
+}
+
+// MigrateLSMGenesisCmd returns a command that converts the genesis of a chain running the
+// cosmos-sdk v0.45 staking module into a genesis with the liquid staking module.
+func MigrateLSMGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lsm [genesis-file]",
+		Short: "Migrate a cosmos-sdk v0.45 staking genesis to the liquid staking module",
+		Long: fmt.Sprintf(`Migrate a genesis exported from a chain running the cosmos-sdk v0.45 staking module.
+
+The validators and delegations are converted, the liquid staking params are added, and
+delegations from liquid staking providers (module accounts such as ICA accounts) are counted
+towards each validator's total liquid shares and the global liquid staked total.
+
+The migrated genesis is validated, and a summary is printed to STDERR. The genesis is printed
+to STDOUT, or written to --output-document. With --dry-run, only the summary is printed.
+
+Example:
+$ %s genesis migrate lsm /path/to/genesis.json --global-liquid-staking-cap=0.25 --output-document=new_genesis.json
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			importGenesis := args[0]
+			genDoc, err := validateGenDoc(importGenesis)
+			if err != nil {
+				return err
+			}
+
+			var initialState types.AppMap
+			if err := json.Unmarshal(genDoc.AppState, &initialState); err != nil {
+				return errors.Wrap(err, "failed to JSON unmarshal initial genesis state")
+			}
+
+			var params lsm.Params
+			if params.ValidatorBondFactor, err = getDecFlag(cmd, flagValidatorBondFactor); err != nil {
+				return err
+			}
+			if params.GlobalLiquidStakingCap, err = getDecFlag(cmd, flagGlobalLiquidStakingCap); err != nil {
+				return err
+			}
+			if params.ValidatorLiquidStakingCap, err = getDecFlag(cmd, flagValidatorLiquidStakingCap); err != nil {
+				return err
+			}
+
+			newGenState, summary, err := lsm.Migrate(initialState, clientCtx.Codec, params)
+			if err != nil {
+				return errors.Wrap(err, "failed to migrate genesis state")
+			}
+
+			printSummary(cmd, summary)
+
+			if err := mbm.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, newGenState); err != nil {
+				return fmt.Errorf("migrated genesis is invalid: %s", err.Error())
+			}
+			if err := genutil.ValidateLiquidStakingGenesis(clientCtx.Codec, newGenState); err != nil {
+				return fmt.Errorf("migrated genesis has invalid liquid staking state: %s", err.Error())
+			}
+			cmd.PrintErrln("Migrated genesis is valid")
+
+			if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+				return nil
+			}
+
+			genDoc.AppState, err = json.Marshal(newGenState)
+			if err != nil {
+				return errors.Wrap(err, "failed to JSON marshal migrated genesis state")
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument != "" {
+				return genDoc.SaveAs(outputDocument)
+			}
+
+			bz, err := tmjson.Marshal(genDoc)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal genesis doc")
+			}
+
+			sortedBz, err := sdk.SortJSON(bz)
+			if err != nil {
+				return errors.Wrap(err, "failed to sort JSON genesis doc")
+			}
+
+			cmd.Println(string(sortedBz))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagDryRun, false, "validate the migration and print the summary without outputting the genesis")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "write the migrated genesis to this file instead of STDOUT")
+	cmd.Flags().String(flagValidatorBondFactor, "", "validator bond factor (default disabled)")
+	cmd.Flags().String(flagGlobalLiquidStakingCap, "", "global liquid staking cap (default disabled)")
+	cmd.Flags().String(flagValidatorLiquidStakingCap, "", "validator liquid staking cap (default disabled)")
+
+	return cmd
+}
+
+// getDecFlag returns the decimal value of a flag, or a nil Dec if it wasn't set
+func getDecFlag(cmd *cobra.Command, flag string) (sdk.Dec, error) {
+	value, _ := cmd.Flags().GetString(flag)
+	if value == "" {
+		return sdk.Dec{}, nil
+	}
+
+	dec, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return sdk.Dec{}, errors.Wrapf(err, "invalid --%s", flag)
+	}
+
+	return dec, nil
+}
+
+func printSummary(cmd *cobra.Command, summary lsm.Summary) {
+	cmd.PrintErrf("Validators: %d\n", summary.Validators)
+	cmd.PrintErrf("Delegations: %d\n", summary.Delegations)
+	cmd.PrintErrf("Liquid staking provider delegations: %d\n", summary.LiquidDelegations)
+	cmd.PrintErrf("Total liquid staked tokens: %s\n", summary.TotalLiquidStakedTokens)
+
+	validators := make([]string, 0, len(summary.ValidatorLiquidShares))
+	for validator := range summary.ValidatorLiquidShares {
+		validators = append(validators, validator)
+	}
+	sort.Strings(validators)
+	for _, validator := range validators {
+		cmd.PrintErrf("  %s: %s liquid shares\n", validator, summary.ValidatorLiquidShares[validator])
+	}
+}
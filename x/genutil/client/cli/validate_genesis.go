@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/iqlusioninc/liquidity-staking-module/x/genutil"
 )
 
 const chainUpgradeGuide = "https://docs.cosmos.network/master/migrations/chain-upgrade-guide-040.html"
@@ -48,6 +49,10 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
 			}
 
+			if err = genutil.ValidateLiquidStakingGenesis(cdc, genState); err != nil {
+				return fmt.Errorf("error validating liquid staking state in genesis file %s: %s", genesis, err.Error())
+			}
+
 			fmt.Printf("File at %s is a valid genesis file\n", genesis)
 			return nil
 		},
@@ -92,6 +92,51 @@ func (s *IntegrationTestSuite) TestGenTxCmd() {
 	s.Require().NoError(tx.ValidateBasic())
 }
 
+func (s *IntegrationTestSuite) TestGenTxCmdValidatorBond() {
+	val := s.network.Validators[0]
+	dir := s.T().TempDir()
+
+	cmd := cli.GenTxCmd(
+		simapp.ModuleBasics,
+		val.ClientCtx.TxConfig, banktypes.GenesisBalancesIterator{}, val.ClientCtx.HomeDir)
+
+	_, out := testutil.ApplyMockIO(cmd)
+	clientCtx := val.ClientCtx.WithOutput(out)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+
+	amount := sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(12))
+	genTxFile := filepath.Join(dir, "myTx")
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, s.network.Config.ChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, genTxFile),
+		fmt.Sprintf("--%s", stakingcli.FlagValidatorBond),
+		val.Moniker,
+		amount.String(),
+	})
+
+	err := cmd.ExecuteContext(ctx)
+	s.Require().NoError(err)
+
+	all, err := os.ReadFile(genTxFile)
+	s.Require().NoError(err)
+
+	tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(all)
+	s.Require().NoError(err)
+
+	// The self delegation of the created validator is flagged as a validator bond
+	msgs := tx.GetMsgs()
+	s.Require().Len(msgs, 2)
+	createValidatorMsg := msgs[0].(*types.MsgCreateValidator)
+	s.Require().Equal(&types.MsgValidatorBond{
+		DelegatorAddress: createValidatorMsg.DelegatorAddress,
+		ValidatorAddress: createValidatorMsg.ValidatorAddress,
+	}, msgs[1])
+	s.Require().Equal(2*flags.DefaultGasLimit, tx.(sdk.FeeTx).GetGas())
+	s.Require().NoError(tx.ValidateBasic())
+}
+
 func (s *IntegrationTestSuite) TestGenTxCmdPubkey() {
 	val := s.network.Validators[0]
 	dir := s.T().TempDir()
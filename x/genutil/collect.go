@@ -130,7 +130,8 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 			return appGenTxs, persistentPeers, fmt.Errorf("failed to find node's address and IP in %s", fo.Name())
 		}
 
-		// genesis transactions must be single-message
+		// genesis transactions start with the create validator message, optionally
+		// followed by a validator bond of the self delegation
 		msgs := genTx.GetMsgs()
 
 		// TODO abstract out staking message validation back to staking
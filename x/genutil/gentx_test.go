@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
@@ -282,6 +283,54 @@ func (suite *GenTxTestSuite) TestDeliverGenTxs() {
 	}
 }
 
+func (suite *GenTxTestSuite) TestDeliverGenTxsValidatorBond() {
+	_ = suite.setAccountBalance(addr1, 100)
+
+	valAddr := sdk.ValAddress(pk1.Address())
+	createValidator, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), desc,
+		stakingtypes.NewCommissionRates(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.01")),
+	)
+	suite.Require().NoError(err)
+	validatorBond := &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: addr1.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	tx, err := helpers.GenSignedMockTx(
+		r,
+		suite.encodingConfig.TxConfig,
+		[]sdk.Msg{createValidator, validatorBond},
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)},
+		helpers.DefaultGenTxGas,
+		suite.ctx.ChainID(),
+		[]uint64{7},
+		[]uint64{0},
+		priv1,
+	)
+	suite.Require().NoError(err)
+
+	genTx, err := suite.encodingConfig.TxConfig.TxJSONEncoder()(tx)
+	suite.Require().NoError(err)
+	_, err = types.ValidateAndGetGenTx(genTx, suite.encodingConfig.TxConfig.TxJSONDecoder())
+	suite.Require().NoError(err)
+
+	_, err = genutil.DeliverGenTxs(
+		suite.ctx, []json.RawMessage{genTx}, suite.app.StakingKeeper, suite.app.BaseApp.DeliverTx,
+		suite.encodingConfig.TxConfig,
+	)
+	suite.Require().NoError(err)
+
+	// The self delegation counts as the validator's bond from genesis
+	validator, found := suite.app.StakingKeeper.GetLiquidValidator(suite.ctx, valAddr)
+	suite.Require().True(found)
+	delegation, found := suite.app.StakingKeeper.GetLiquidDelegation(suite.ctx, addr1, valAddr)
+	suite.Require().True(found)
+	suite.Require().True(delegation.ValidatorBond)
+	suite.Require().Equal(delegation.Shares, validator.TotalValidatorBondShares)
+}
+
 func TestGenTxTestSuite(t *testing.T) {
 	suite.Run(t, new(GenTxTestSuite))
 }
@@ -0,0 +1,43 @@
+package genutil
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// ValidateLiquidStakingGenesis cross-checks the liquid staking state of the staking genesis
+// against the auth accounts and bank supply in the app genesis. The module genesis states
+// are expected to have been validated individually beforehand.
+func ValidateLiquidStakingGenesis(cdc codec.Codec, appGenesisState map[string]json.RawMessage) error {
+	if appGenesisState[stakingtypes.ModuleName] == nil {
+		return nil
+	}
+
+	var stakingGenesis stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appGenesisState[stakingtypes.ModuleName], &stakingGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err)
+	}
+
+	accounts, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(cdc, appGenesisState).Accounts)
+	if err != nil {
+		return err
+	}
+
+	// The bank module computes the supply from the balances if it is not set
+	bankGenesis := banktypes.GetGenesisStateFromAppState(cdc, appGenesisState)
+	supply := bankGenesis.Supply
+	if supply.Empty() {
+		for _, balance := range bankGenesis.Balances {
+			supply = supply.Add(balance.Coins...)
+		}
+	}
+
+	return staking.ValidateLiquidStakingGenesis(&stakingGenesis, accounts, sdk.NewCoins(supply...))
+}
@@ -0,0 +1,179 @@
+package lsm
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/genutil/types"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// Params are the liquid staking params written to the migrated genesis. A nil value
+// keeps the default, which leaves the corresponding cap disabled
+type Params struct {
+	ValidatorBondFactor       sdk.Dec
+	GlobalLiquidStakingCap    sdk.Dec
+	ValidatorLiquidStakingCap sdk.Dec
+}
+
+// Summary describes the liquid staking state computed by the migration
+type Summary struct {
+	Validators              int
+	Delegations             int
+	LiquidDelegations       int
+	TotalLiquidStakedTokens sdk.Int
+	// total liquid shares of each validator that has any, by operator address
+	ValidatorLiquidShares map[string]sdk.Dec
+}
+
+// Migrate converts the staking genesis of a chain running the cosmos-sdk v0.45 staking
+// module into this module's format:
+//   - validators, delegations, unbonding delegations and redelegations are converted,
+//     dropping the min self delegation and ICS unbonding fields
+//   - delegations from liquid staking providers (32-length module accounts in the auth
+//     genesis) are summed into each validator's total liquid shares and the global total
+//   - the params added since v0.45 are set, with the liquid staking params taken from lsmParams
+//   - the distribution params added since v0.45 (the insurance fund tax) are set to their defaults
+//
+// The slashing genesis format is unchanged and is left as is.
+func Migrate(appState types.AppMap, cdc codec.Codec, lsmParams Params) (types.AppMap, Summary, error) {
+	summary := Summary{
+		TotalLiquidStakedTokens: sdk.ZeroInt(),
+		ValidatorLiquidShares:   map[string]sdk.Dec{},
+	}
+
+	if appState[stakingtypes.ModuleName] == nil {
+		return nil, summary, fmt.Errorf("genesis has no %s state", stakingtypes.ModuleName)
+	}
+
+	var legacyGenesis sdkstaking.GenesisState
+	if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &legacyGenesis); err != nil {
+		return nil, summary, fmt.Errorf("failed to decode the cosmos-sdk v0.45 staking genesis: %w", err)
+	}
+
+	// Find the liquid staking provider accounts
+	accounts, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(cdc, appState).Accounts)
+	if err != nil {
+		return nil, summary, err
+	}
+	liquidStakingProviders := map[string]bool{}
+	for _, account := range accounts {
+		if stakingtypes.IsLiquidStakingProvider(account) {
+			liquidStakingProviders[account.GetAddress().String()] = true
+		}
+	}
+
+	params := stakingtypes.DefaultParams()
+	params.UnbondingTime = legacyGenesis.Params.UnbondingTime
+	params.MaxValidators = legacyGenesis.Params.MaxValidators
+	params.MaxEntries = legacyGenesis.Params.MaxEntries
+	params.HistoricalEntries = legacyGenesis.Params.HistoricalEntries
+	params.BondDenom = legacyGenesis.Params.BondDenom
+	if !lsmParams.ValidatorBondFactor.IsNil() {
+		params.ValidatorBondFactor = lsmParams.ValidatorBondFactor
+	}
+	if !lsmParams.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = lsmParams.GlobalLiquidStakingCap
+	}
+	if !lsmParams.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = lsmParams.ValidatorLiquidStakingCap
+	}
+
+	validators := make([]stakingtypes.Validator, len(legacyGenesis.Validators))
+	validatorIndex := make(map[string]int, len(legacyGenesis.Validators))
+	for i, validator := range legacyGenesis.Validators {
+		validators[i] = stakingtypes.ValidatorFromLegacy(validator)
+		validatorIndex[validator.OperatorAddress] = i
+	}
+
+	delegations := make([]stakingtypes.Delegation, len(legacyGenesis.Delegations))
+	for i, legacyDelegation := range legacyGenesis.Delegations {
+		delegation := stakingtypes.DelegationFromLegacy(legacyDelegation)
+		delegations[i] = delegation
+
+		if !liquidStakingProviders[delegation.DelegatorAddress] {
+			continue
+		}
+
+		index, found := validatorIndex[delegation.ValidatorAddress]
+		if !found {
+			return nil, summary, fmt.Errorf("delegation from %s is to unknown validator %s",
+				delegation.DelegatorAddress, delegation.ValidatorAddress)
+		}
+
+		// Same accounting as RefreshTotalLiquidStaked
+		validator := validators[index]
+		validator.TotalLiquidShares = validator.TotalLiquidShares.Add(delegation.Shares)
+		validators[index] = validator
+
+		summary.LiquidDelegations++
+		summary.TotalLiquidStakedTokens = summary.TotalLiquidStakedTokens.Add(
+			validator.TokensFromShares(delegation.Shares).TruncateInt())
+		summary.ValidatorLiquidShares[validator.OperatorAddress] = validator.TotalLiquidShares
+	}
+
+	unbondingDelegations := make([]stakingtypes.UnbondingDelegation, len(legacyGenesis.UnbondingDelegations))
+	for i, ubd := range legacyGenesis.UnbondingDelegations {
+		unbondingDelegations[i] = stakingtypes.UnbondingDelegationFromLegacy(ubd)
+	}
+
+	redelegations := make([]stakingtypes.Redelegation, len(legacyGenesis.Redelegations))
+	for i, red := range legacyGenesis.Redelegations {
+		redelegations[i] = stakingtypes.RedelegationFromLegacy(red)
+	}
+
+	lastValidatorPowers := make([]stakingtypes.LastValidatorPower, len(legacyGenesis.LastValidatorPowers))
+	for i, power := range legacyGenesis.LastValidatorPowers {
+		lastValidatorPowers[i] = stakingtypes.LastValidatorPower{Address: power.Address, Power: power.Power}
+	}
+
+	genesis := stakingtypes.GenesisState{
+		Params:                   params,
+		LastTotalPower:           legacyGenesis.LastTotalPower,
+		LastValidatorPowers:      lastValidatorPowers,
+		Validators:               validators,
+		Delegations:              delegations,
+		UnbondingDelegations:     unbondingDelegations,
+		Redelegations:            redelegations,
+		Exported:                 legacyGenesis.Exported,
+		TotalLiquidStakedTokens:  summary.TotalLiquidStakedTokens,
+		TotalLiquidStakedResidue: sdk.ZeroDec(),
+	}
+
+	bz, err := cdc.MarshalJSON(&genesis)
+	if err != nil {
+		return nil, summary, err
+	}
+
+	newAppState := make(types.AppMap, len(appState))
+	for module, state := range appState {
+		newAppState[module] = state
+	}
+	newAppState[stakingtypes.ModuleName] = bz
+
+	if appState[distrtypes.ModuleName] != nil {
+		var distrGenesis distrtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[distrtypes.ModuleName], &distrGenesis); err != nil {
+			return nil, summary, fmt.Errorf("failed to decode the cosmos-sdk v0.45 distribution genesis: %w", err)
+		}
+		if distrGenesis.Params.InsuranceFundTax.IsNil() {
+			distrGenesis.Params.InsuranceFundTax = distrtypes.DefaultInsuranceFundTax
+		}
+
+		bz, err := cdc.MarshalJSON(&distrGenesis)
+		if err != nil {
+			return nil, summary, err
+		}
+		newAppState[distrtypes.ModuleName] = bz
+	}
+
+	summary.Validators = len(validators)
+	summary.Delegations = len(delegations)
+
+	return newAppState, summary, nil
+}
@@ -0,0 +1,125 @@
+package lsm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkdistr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	sdkslashing "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	distrtypes "github.com/iqlusioninc/liquidity-staking-module/x/distribution/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/genutil/migrations/lsm"
+	genutiltypes "github.com/iqlusioninc/liquidity-staking-module/x/genutil/types"
+	slashingtypes "github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	stakingtypes "github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	appState := genutiltypes.AppMap(simapp.NewDefaultGenesisState(cdc))
+
+	// The distribution and slashing genesis of a stock v0.45 chain
+	appState[distrtypes.ModuleName] = cdc.MustMarshalJSON(sdkdistr.DefaultGenesisState())
+	appState[slashingtypes.ModuleName] = cdc.MustMarshalJSON(sdkslashing.DefaultGenesisState())
+
+	// A liquid staking provider (32 byte module account) and a regular account
+	icaAddress := sdk.AccAddress(address.Module("ica", []byte("ica")))
+	userAddress := sdk.AccAddress([]byte("user________________"))
+	accounts := authtypes.GenesisAccounts{
+		authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(icaAddress), "ica"),
+		authtypes.NewBaseAccountWithAddress(userAddress),
+	}
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), accounts)
+	appState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	// A stock staking genesis with two validators that have exchange rates of 1 and 0.5
+	pubKeys := simapp.CreateTestPubKeys(2)
+	valAddresses := []sdk.ValAddress{sdk.ValAddress(pubKeys[0].Address()), sdk.ValAddress(pubKeys[1].Address())}
+	validators := make([]sdkstaking.Validator, 2)
+	for i := range validators {
+		validator, err := sdkstaking.NewValidator(valAddresses[i], pubKeys[i], sdkstaking.Description{Moniker: "validator"})
+		require.NoError(t, err)
+		validators[i] = validator
+	}
+	validators[0].Tokens, validators[0].DelegatorShares = sdk.NewInt(100), sdk.NewDec(100)
+	validators[1].Tokens, validators[1].DelegatorShares = sdk.NewInt(100), sdk.NewDec(200)
+
+	legacyGenesis := sdkstaking.DefaultGenesisState()
+	legacyGenesis.Params.UnbondingTime = legacyGenesis.Params.UnbondingTime * 2
+	legacyGenesis.Validators = validators
+	legacyGenesis.Delegations = []sdkstaking.Delegation{
+		sdkstaking.NewDelegation(icaAddress, valAddresses[0], sdk.NewDec(40)),
+		sdkstaking.NewDelegation(userAddress, valAddresses[0], sdk.NewDec(60)),
+		sdkstaking.NewDelegation(icaAddress, valAddresses[1], sdk.NewDec(50)),
+		sdkstaking.NewDelegation(userAddress, valAddresses[1], sdk.NewDec(150)),
+	}
+	appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(legacyGenesis)
+
+	newAppState, summary, err := lsm.Migrate(appState, cdc, lsm.Params{
+		GlobalLiquidStakingCap: sdk.MustNewDecFromStr("0.25"),
+	})
+	require.NoError(t, err)
+
+	// 40 tokens from the first validator and 25 from the second
+	require.Equal(t, 2, summary.Validators)
+	require.Equal(t, 4, summary.Delegations)
+	require.Equal(t, 2, summary.LiquidDelegations)
+	require.Equal(t, sdk.NewInt(65), summary.TotalLiquidStakedTokens)
+	require.Equal(t, map[string]sdk.Dec{
+		valAddresses[0].String(): sdk.NewDec(40),
+		valAddresses[1].String(): sdk.NewDec(50),
+	}, summary.ValidatorLiquidShares)
+
+	var genesis stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(newAppState[stakingtypes.ModuleName], &genesis)
+	require.NoError(t, staking.ValidateGenesis(&genesis))
+
+	require.Equal(t, legacyGenesis.Params.UnbondingTime, genesis.Params.UnbondingTime)
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), genesis.Params.GlobalLiquidStakingCap)
+	require.Equal(t, stakingtypes.DefaultParams().ValidatorBondFactor, genesis.Params.ValidatorBondFactor)
+	require.Equal(t, stakingtypes.DefaultParams().ValidatorLiquidStakingCap, genesis.Params.ValidatorLiquidStakingCap)
+
+	require.Equal(t, sdk.NewInt(65), genesis.TotalLiquidStakedTokens)
+	require.Equal(t, sdk.NewDec(40), genesis.Validators[0].TotalLiquidShares)
+	require.Equal(t, sdk.NewDec(50), genesis.Validators[1].TotalLiquidShares)
+	for _, validator := range genesis.Validators {
+		require.Equal(t, sdk.ZeroDec(), validator.TotalValidatorBondShares)
+	}
+	require.Len(t, genesis.Delegations, 4)
+
+	// The distribution params added since v0.45 take their defaults
+	var distrGenesis distrtypes.GenesisState
+	cdc.MustUnmarshalJSON(newAppState[distrtypes.ModuleName], &distrGenesis)
+	require.NoError(t, distrtypes.ValidateGenesis(&distrGenesis))
+	require.Equal(t, distrtypes.DefaultInsuranceFundTax, distrGenesis.Params.InsuranceFundTax)
+	require.Equal(t, sdkdistr.DefaultParams().CommunityTax, distrGenesis.Params.CommunityTax)
+
+	// The slashing genesis is valid as is
+	var slashingGenesis slashingtypes.GenesisState
+	cdc.MustUnmarshalJSON(newAppState[slashingtypes.ModuleName], &slashingGenesis)
+	require.NoError(t, slashingtypes.ValidateGenesis(slashingGenesis))
+
+	// The other modules are unchanged
+	require.Equal(t, appState[authtypes.ModuleName], newAppState[authtypes.ModuleName])
+	require.Equal(t, appState[slashingtypes.ModuleName], newAppState[slashingtypes.ModuleName])
+}
+
+func TestMigrateInvalidGenesis(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	appState := genutiltypes.AppMap(simapp.NewDefaultGenesisState(cdc))
+
+	delete(appState, stakingtypes.ModuleName)
+	_, _, err := lsm.Migrate(appState, cdc, lsm.Params{})
+	require.Error(t, err)
+
+	appState[stakingtypes.ModuleName] = []byte(`{"validators":"not a list"}`)
+	_, _, err = lsm.Migrate(appState, cdc, lsm.Params{})
+	require.Error(t, err)
+}
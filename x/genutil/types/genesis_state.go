@@ -111,18 +111,34 @@ func ValidateAndGetGenTx(genTx json.RawMessage, txJSONDecoder sdk.TxDecoder) (sd
 		return tx, fmt.Errorf("failed to decode gentx: %s, error: %s", genTx, err)
 	}
 
+	// A GenTx creates a validator, and may also flag the self delegation as a validator bond
 	msgs := tx.GetMsgs()
-	if len(msgs) != 1 {
-		return tx, fmt.Errorf("unexpected number of GenTx messages; got: %d, expected: 1", len(msgs))
+	if len(msgs) != 1 && len(msgs) != 2 {
+		return tx, fmt.Errorf("unexpected number of GenTx messages; got: %d, expected: 1 or 2", len(msgs))
 	}
 
 	// TODO: abstract back to staking
-	if _, ok := msgs[0].(*stakingtypes.MsgCreateValidator); !ok {
+	createValidatorMsg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+	if !ok {
 		return tx, fmt.Errorf("unexpected GenTx message type; expected: MsgCreateValidator, got: %T", msgs[0])
 	}
 
-	if err := msgs[0].ValidateBasic(); err != nil {
-		return tx, fmt.Errorf("invalid GenTx '%s': %s", msgs[0], err)
+	if len(msgs) == 2 {
+		validatorBondMsg, ok := msgs[1].(*stakingtypes.MsgValidatorBond)
+		if !ok {
+			return tx, fmt.Errorf("unexpected GenTx message type; expected: MsgValidatorBond, got: %T", msgs[1])
+		}
+
+		if validatorBondMsg.DelegatorAddress != createValidatorMsg.DelegatorAddress ||
+			validatorBondMsg.ValidatorAddress != createValidatorMsg.ValidatorAddress {
+			return tx, fmt.Errorf("invalid GenTx: MsgValidatorBond must be for the self delegation of the created validator")
+		}
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return tx, fmt.Errorf("invalid GenTx '%s': %s", msg, err)
+		}
 	}
 
 	return tx, nil
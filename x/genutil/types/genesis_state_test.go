@@ -70,3 +70,43 @@ func TestValidateGenesisBadMessage(t *testing.T) {
 	err = types.ValidateGenesis(genesisState, simapp.MakeTestEncodingConfig().TxConfig.TxJSONDecoder())
 	require.Error(t, err)
 }
+
+func TestValidateGenesisValidatorBond(t *testing.T) {
+	desc := stakingtypes.NewDescription("testname", "", "", "", "")
+	comm := stakingtypes.NewCommissionRates(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.01"))
+
+	createValidator, err := stakingtypes.NewMsgCreateValidator(sdk.ValAddress(pk1.Address()), pk1,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), desc, comm)
+	require.NoError(t, err)
+
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	validate := func(msgs ...sdk.Msg) error {
+		txBuilder := txGen.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		genesisState := types.NewGenesisStateFromTx(txGen.TxJSONEncoder(), []sdk.Tx{txBuilder.GetTx()})
+		return types.ValidateGenesis(genesisState, txGen.TxJSONDecoder())
+	}
+
+	// The create validator message may be followed by a validator bond of the self delegation
+	require.NoError(t, validate(createValidator))
+	require.NoError(t, validate(createValidator, &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: sdk.AccAddress(pk1.Address()).String(),
+		ValidatorAddress: sdk.ValAddress(pk1.Address()).String(),
+	}))
+
+	// The validator bond must be for the created validator's self delegation
+	require.Error(t, validate(createValidator, &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: sdk.AccAddress(pk2.Address()).String(),
+		ValidatorAddress: sdk.ValAddress(pk1.Address()).String(),
+	}))
+	require.Error(t, validate(createValidator, &stakingtypes.MsgValidatorBond{
+		DelegatorAddress: sdk.AccAddress(pk1.Address()).String(),
+		ValidatorAddress: sdk.ValAddress(pk2.Address()).String(),
+	}))
+
+	// The validator bond must come after the create validator message
+	require.Error(t, validate(&stakingtypes.MsgValidatorBond{
+		DelegatorAddress: sdk.AccAddress(pk1.Address()).String(),
+		ValidatorAddress: sdk.ValAddress(pk1.Address()).String(),
+	}, createValidator))
+}
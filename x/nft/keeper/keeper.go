@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	nft "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
+)
+
+// Keeper of the nft store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	bk       nft.BankKeeper
+	hooks    nft.NFTHooks
+}
+
+// NewKeeper creates a new nft Keeper instance
+func NewKeeper(key storetypes.StoreKey,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+) Keeper {
+	// ensure nft module account is set
+	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
+		panic("the nft module account has not been set")
+	}
+
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+		bk:       bk,
+	}
+}
+
+// SetHooks sets the nft hooks
+func (k *Keeper) SetHooks(nh nft.NFTHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+
+	k.hooks = nh
+
+	return k
+}
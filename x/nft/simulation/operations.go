@@ -0,0 +1,136 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/iqlusioninc/liquidity-staking-module/x/nft/keeper"
+	nft "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
+)
+
+const (
+	// OpWeightMsgSend Simulation operation weights constants
+	OpWeightMsgSend = "op_weight_msg_send" //nolint:gosec
+)
+
+const (
+	// WeightSend nft operations weights
+	WeightSend = 100
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSend int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = WeightSend
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSend generates a MsgSend with random values.
+func SimulateMsgSend(
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		if sender.Address.Equals(receiver.Address) {
+			return simtypes.NoOpMsg(nft.ModuleName, nft.TypeMsgSend, "sender and receiver are same"), nil, nil
+		}
+
+		n, err := randNFT(ctx, r, k, sender.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, nft.TypeMsgSend, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgSend{
+			ClassId:  n.ClassId,
+			Id:       n.Id,
+			Sender:   sender.Address.String(),
+			Receiver: receiver.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         nft.TypeMsgSend,
+			Context:         ctx,
+			SimAccount:      sender,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      nft.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
+	c, err := randClass(ctx, r, k)
+	if err != nil {
+		return nft.NFT{}, err
+	}
+	ns := k.GetNFTsOfClassByOwner(ctx, c.Id, minter)
+	if len(ns) > 0 {
+		return ns[r.Intn(len(ns))], nil
+	}
+
+	n := nft.NFT{
+		ClassId: c.Id,
+		Id:      simtypes.RandStringOfLength(r, 10),
+		Uri:     simtypes.RandStringOfLength(r, 10),
+	}
+	err = k.Mint(ctx, n, minter)
+	if err != nil {
+		return nft.NFT{}, err
+	}
+	return n, nil
+}
+
+func randClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) (nft.Class, error) {
+	classes := k.GetClasses(ctx)
+	if len(classes) == 0 {
+		c := nft.Class{
+			Id:          simtypes.RandStringOfLength(r, 10),
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			Uri:         simtypes.RandStringOfLength(r, 10),
+		}
+		err := k.SaveClass(ctx, c)
+		if err != nil {
+			return nft.Class{}, err
+		}
+		return c, nil
+	}
+	return *classes[r.Intn(len(classes))], nil
+}
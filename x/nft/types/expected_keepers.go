@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper defines the contract needed to be fulfilled for banking and supply
+// dependencies.
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// NFTHooks defines the hooks that other modules can register to follow the nfts they
+// have minted, e.g. to keep the ownership of what an nft represents in sync with it.
+type NFTHooks interface {
+	// AfterNFTTransferred must be called when an nft changes owner. Returning an error
+	// rejects the transfer
+	AfterNFTTransferred(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName module name
+	ModuleName = "nft"
+
+	// StoreKey is the default store key for nft
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nft
+	RouterKey = ModuleName
+)
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// NewSubmitUpdateParamsProposalCmd returns a CLI command handler for submitting a proposal
+// to update the x/slashing params
+func NewSubmitUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-slashing-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the slashing params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the slashing params along with an initial deposit.
+The proposal details must be supplied via a JSON file. All the params must be supplied.
+
+Example:
+$ %s tx gov submit-proposal update-slashing-params <path/to/proposal.json> --deposit=1000stake --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Update Slashing Params",
+  "description": "Lower the downtime slash fraction",
+  "params": {
+    "signed_blocks_window": "10000",
+    "min_signed_per_window": "0.05",
+    "downtime_jail_duration": "600s",
+    "slash_fraction_double_sign": "0.05",
+    "slash_fraction_downtime": "0.0001"
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := &types.UpdateParamsProposal{}
+			if err := parseProposalFile(clientCtx.Codec, args[0], proposal); err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// parseProposalFile reads and parses the proposal content from a JSON file
+func parseProposalFile(cdc codec.JSONCodec, proposalFile string, content proto.Message) error {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(contents, content)
+}
+
+// submitProposal generates or broadcasts a MsgSubmitProposal for the proposal content,
+// with the deposit of the deposit flag
+func submitProposal(clientCtx client.Context, cmd *cobra.Command, content govtypes.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
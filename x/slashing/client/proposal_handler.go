@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/client/cli"
+)
+
+// UpdateParamsProposalHandler is the update slashing params proposal handler.
+var UpdateParamsProposalHandler = govclient.NewProposalHandler(cli.NewSubmitUpdateParamsProposalCmd, unsupportedRESTHandler("update_slashing_params"))
+
+// unsupportedRESTHandler returns a REST handler that rejects the proposal, since the
+// x/slashing proposals can only be submitted through gRPC or the CLI
+func unsupportedRESTHandler(subRoute string) govclient.RESTHandlerFn {
+	return func(client.Context) govrest.ProposalRESTHandler {
+		return govrest.ProposalRESTHandler{
+			SubRoute: subRoute,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST routes are not supported for x/slashing proposals")
+			},
+		}
+	}
+}
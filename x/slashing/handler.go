@@ -0,0 +1,24 @@
+package slashing
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// NewProposalHandler creates a governance handler for the x/slashing proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateParamsProposal:
+			return keeper.HandleUpdateParamsProposal(ctx, k, c)
+
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -18,3 +19,17 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(_ sdk.Context) error {
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// It moves the params out of the x/params subspace into the module's own store
+// so that they can be updated with MsgUpdateParams
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var params types.Params
+	m.keeper.legacySubspace.GetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+)
+
+// HandleUpdateParamsProposal is a handler for executing a passed update params proposal.
+// The proposal is executed as a MsgUpdateParams signed by the module authority, so that
+// it goes through the same checks and side effects as the message
+func HandleUpdateParamsProposal(ctx sdk.Context, k Keeper, p *types.UpdateParamsProposal) error {
+	_, err := NewMsgServerImpl(k).UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    p.Params,
+	})
+	return err
+}
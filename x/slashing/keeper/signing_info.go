@@ -114,7 +114,8 @@ func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime ti
 }
 
 // Tombstone attempts to tombstone a validator. It will panic if signing info for
-// the given validator does not exist.
+// the given validator does not exist. A tombstoned validator can never be unjailed,
+// so the tokenize share records delegated to it are released.
 func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !ok {
@@ -127,6 +128,8 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 
 	signInfo.Tombstoned = true
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	k.sk.ReleaseTokenizeShareRecords(ctx, consAddr)
 }
 
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
@@ -10,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/slashing/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
 )
 
 func TestGetSetValidatorSigningInfo(t *testing.T) {
@@ -72,6 +74,34 @@ func TestTombstoned(t *testing.T) {
 	require.Panics(t, func() { app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[0])) })
 }
 
+// Test that the tokenize share records of a tombstoned validator are released
+func TestTombstoneReleasesTokenizeShareRecords(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	amt := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tstaking.Delegate(addrDels[1], valAddrs[0], amt)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	tstaking.TokenizeShares(addrDels[1], valAddrs[0], sdk.NewCoin(bondDenom, amt), addrDels[1], true)
+	records := app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddrs[0])
+	require.Len(t, records, 1)
+
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	app.SlashingKeeper.Tombstone(ctx, consAddr)
+
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddrs[0]))
+	claim, found := app.StakingKeeper.GetTokenizeShareRecordClaim(ctx, records[0].GetShareTokenDenom())
+	require.True(t, found)
+	require.Equal(t, amt, claim.Tokens)
+}
+
 func TestJailUntil(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -46,6 +46,9 @@ type StakingKeeper interface {
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
 	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
 
+	// release the tokenize share records of a tombstoned validator
+	ReleaseTokenizeShareRecords(sdk.Context, sdk.ConsAddress)
+
 	// Delegation allows for getting a particular delegation for a given validator
 	// and delegator outside the scope of the staking module.
 	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) sdkstaking.DelegationI
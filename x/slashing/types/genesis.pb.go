@@ -1,11 +1,11 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/genesis.proto
+// source: slashing/v1beta1/genesis.proto
 
 package types
 
 import (
 	fmt "fmt"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -39,7 +39,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{0}
+	return fileDescriptor_1d12eeaa856153e6, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -101,7 +101,7 @@ func (m *SigningInfo) Reset()         { *m = SigningInfo{} }
 func (m *SigningInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningInfo) ProtoMessage()    {}
 func (*SigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{1}
+	return fileDescriptor_1d12eeaa856153e6, []int{1}
 }
 func (m *SigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +157,7 @@ func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
 func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
 func (*ValidatorMissedBlocks) ProtoMessage()    {}
 func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{2}
+	return fileDescriptor_1d12eeaa856153e6, []int{2}
 }
 func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +212,7 @@ func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
 func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
 func (*MissedBlock) ProtoMessage()    {}
 func (*MissedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1923b9188b635394, []int{3}
+	return fileDescriptor_1d12eeaa856153e6, []int{3}
 }
 func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,39 +262,38 @@ func init() {
 	proto.RegisterType((*MissedBlock)(nil), "liquidstaking.slashing.v1beta1.MissedBlock")
 }
 
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/genesis.proto", fileDescriptor_1923b9188b635394)
-}
+func init() { proto.RegisterFile("slashing/v1beta1/genesis.proto", fileDescriptor_1d12eeaa856153e6) }
 
-var fileDescriptor_1923b9188b635394 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x8e, 0xd3, 0x40,
-	0x14, 0xf6, 0x24, 0x10, 0x60, 0x9c, 0x6d, 0x46, 0x66, 0xb1, 0x56, 0xe0, 0xac, 0x2c, 0x16, 0x6d,
-	0x13, 0x5b, 0xbb, 0x74, 0x20, 0x1a, 0x37, 0x2b, 0x0a, 0x24, 0xe4, 0x95, 0x28, 0x68, 0xa2, 0x71,
-	0x3c, 0x3b, 0x19, 0xc5, 0xf6, 0x04, 0x3f, 0x13, 0x25, 0x57, 0xa0, 0xa2, 0xe6, 0x06, 0xf4, 0x1c,
-	0x22, 0x65, 0x4a, 0xaa, 0x08, 0x25, 0x37, 0xe0, 0x04, 0x28, 0x33, 0x0e, 0x71, 0xa2, 0x98, 0x88,
-	0xca, 0x7e, 0xd2, 0xf7, 0xf7, 0xbe, 0xd1, 0xc3, 0x17, 0x7d, 0x09, 0xa9, 0x04, 0x1f, 0x12, 0x0a,
-	0x03, 0x91, 0x71, 0x7f, 0x7c, 0x15, 0xb1, 0x82, 0x5e, 0xf9, 0x9c, 0x65, 0x0c, 0x04, 0x78, 0xa3,
-	0x5c, 0x16, 0x92, 0x3c, 0xd1, 0x30, 0x6f, 0x03, 0xf3, 0x4a, 0xd8, 0x99, 0xc5, 0x25, 0x97, 0x0a,
-	0xe3, 0xaf, 0xff, 0x34, 0xfc, 0xec, 0x45, 0x9d, 0xea, 0x5f, 0xbe, 0xc2, 0xb9, 0xdf, 0x1b, 0xb8,
-	0x7d, 0xa3, 0x8d, 0x6e, 0x0b, 0x5a, 0x30, 0xf2, 0x06, 0xb7, 0x46, 0x34, 0xa7, 0x29, 0xd8, 0xe8,
-	0x1c, 0x5d, 0x9a, 0xd7, 0x1d, 0xaf, 0xc6, 0xd8, 0x7b, 0xaf, 0x60, 0xc1, 0xbd, 0xd9, 0xa2, 0x63,
-	0x84, 0x25, 0x89, 0x70, 0x7c, 0x02, 0x82, 0x67, 0x22, 0xe3, 0x3d, 0x91, 0xdd, 0x49, 0xb0, 0x1b,
-	0xe7, 0xcd, 0x4b, 0xf3, 0xfa, 0x79, 0xad, 0xca, 0xad, 0x46, 0xbf, 0xcd, 0xee, 0x64, 0xf0, 0x74,
-	0x2d, 0xf5, 0x7b, 0xd1, 0xb1, 0xa6, 0x34, 0x4d, 0x5e, 0xb9, 0x3b, 0x42, 0x6e, 0xd8, 0x86, 0x2d,
-	0x14, 0xc8, 0x27, 0x7c, 0x92, 0x0a, 0x00, 0x16, 0xf7, 0xa2, 0x44, 0xf6, 0x87, 0x60, 0x37, 0x95,
-	0x91, 0x57, 0x6b, 0xf4, 0x81, 0x26, 0x22, 0xa6, 0x85, 0xcc, 0xdf, 0x29, 0x5a, 0xa0, 0x58, 0xfb,
-	0x96, 0x3b, 0x92, 0x6e, 0xd8, 0x4e, 0x2b, 0x58, 0xf7, 0x07, 0xc2, 0x66, 0x25, 0x2e, 0xb1, 0xf1,
-	0x03, 0x1a, 0xc7, 0x39, 0x03, 0xdd, 0xd5, 0xa3, 0x70, 0x33, 0x92, 0x2f, 0x08, 0x9f, 0x8e, 0x37,
-	0x7e, 0xbd, 0xea, 0x1e, 0x76, 0x43, 0xb5, 0xda, 0x3d, 0x1e, 0xb3, 0x5a, 0xcc, 0x45, 0x99, 0xf2,
-	0x99, 0x4e, 0x79, 0x58, 0xda, 0x0d, 0xad, 0xf1, 0x01, 0xb2, 0xfb, 0x0d, 0xe1, 0xc7, 0x07, 0x97,
-	0xff, 0xc7, 0x02, 0x7c, 0xbf, 0xdd, 0x63, 0xcf, 0x58, 0xd1, 0xfd, 0xaf, 0x4e, 0x5f, 0x63, 0xb3,
-	0x42, 0x25, 0x16, 0xbe, 0x2f, 0xb2, 0x98, 0x4d, 0x54, 0x9e, 0x66, 0xa8, 0x07, 0x72, 0x8a, 0x5b,
-	0x9a, 0xa4, 0xda, 0x7b, 0x18, 0x96, 0x53, 0x70, 0x33, 0x5b, 0x3a, 0x68, 0xbe, 0x74, 0xd0, 0xaf,
-	0xa5, 0x83, 0xbe, 0xae, 0x1c, 0x63, 0xbe, 0x72, 0x8c, 0x9f, 0x2b, 0xc7, 0xf8, 0xd8, 0xe5, 0xa2,
-	0x18, 0x7c, 0x8e, 0xbc, 0xbe, 0x4c, 0xfd, 0xf2, 0x12, 0xf4, 0xa7, 0x0b, 0xf1, 0xd0, 0x9f, 0x6c,
-	0xcf, 0xa2, 0x98, 0x8e, 0x18, 0x44, 0x2d, 0x75, 0x0c, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x3c, 0xa8, 0xf6, 0x8c, 0x03, 0x00, 0x00,
+var fileDescriptor_1d12eeaa856153e6 = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0x33, 0x89, 0x46, 0x9d, 0xa4, 0x97, 0x21, 0x96, 0xa5, 0xe8, 0xa4, 0x2c, 0x28, 0x05,
+	0xe9, 0x2e, 0xad, 0x7a, 0xd1, 0x8b, 0x04, 0x41, 0x3c, 0x08, 0xb2, 0x05, 0x0f, 0xbd, 0x84, 0x49,
+	0x76, 0x3a, 0x7d, 0xe9, 0xee, 0x4c, 0x9a, 0x77, 0x36, 0x24, 0xdf, 0x42, 0x3f, 0x82, 0x9f, 0x43,
+	0xf0, 0xdc, 0x63, 0x8f, 0x9e, 0x8a, 0x24, 0xdf, 0xc0, 0x4f, 0x20, 0x9d, 0xd9, 0xd4, 0x6d, 0x0d,
+	0xfd, 0x73, 0xdb, 0x17, 0x9e, 0xdf, 0xf3, 0xbc, 0xef, 0xb3, 0x0c, 0xe5, 0x98, 0x09, 0x3c, 0x04,
+	0xad, 0xe2, 0xc9, 0xce, 0x40, 0x5a, 0xb1, 0x13, 0x2b, 0xa9, 0x25, 0x02, 0x46, 0xa3, 0xb1, 0xb1,
+	0x86, 0xf1, 0x0c, 0x8e, 0x0b, 0x48, 0xd1, 0x8a, 0x23, 0xd0, 0x2a, 0x5a, 0xaa, 0xa3, 0x52, 0xbd,
+	0xd1, 0x51, 0x46, 0x19, 0x27, 0x8d, 0xcf, 0xbf, 0x3c, 0xb5, 0xd1, 0xfd, 0xcf, 0xf5, 0x02, 0x74,
+	0x82, 0xf0, 0x67, 0x9d, 0xb6, 0x3f, 0xf8, 0xa0, 0x3d, 0x2b, 0xac, 0x64, 0xef, 0x69, 0x73, 0x24,
+	0xc6, 0x22, 0xc7, 0x80, 0x6c, 0x92, 0xad, 0xd6, 0xee, 0xf3, 0xe8, 0xfa, 0xe0, 0xe8, 0xb3, 0x53,
+	0xf7, 0xee, 0x9d, 0x9c, 0x75, 0x6b, 0x49, 0xc9, 0x32, 0x4d, 0xd7, 0x10, 0x94, 0x06, 0xad, 0xfa,
+	0xa0, 0x0f, 0x0c, 0x06, 0xf5, 0xcd, 0xc6, 0x56, 0x6b, 0xf7, 0xc5, 0x4d, 0x66, 0x7b, 0x1e, 0xfa,
+	0xa8, 0x0f, 0x4c, 0xef, 0xc9, 0xb9, 0xe3, 0x9f, 0xb3, 0x6e, 0x67, 0x26, 0xf2, 0xec, 0x4d, 0x78,
+	0xc9, 0x2f, 0x4c, 0xda, 0xf8, 0x4f, 0x8a, 0x6c, 0x4a, 0xd7, 0x72, 0x40, 0x94, 0x69, 0x7f, 0x90,
+	0x99, 0xe1, 0x11, 0x06, 0x0d, 0x97, 0xf7, 0xfa, 0xa6, 0xbc, 0x2f, 0x22, 0x83, 0x54, 0x58, 0x33,
+	0xfe, 0xe4, 0xe8, 0x9e, 0x83, 0xaf, 0x26, 0x5f, 0x72, 0x0e, 0x93, 0x76, 0x5e, 0xd1, 0x86, 0x3f,
+	0x08, 0x6d, 0x55, 0xb6, 0x66, 0x01, 0x7d, 0x20, 0xd2, 0x74, 0x2c, 0xd1, 0x17, 0xf8, 0x28, 0x59,
+	0x8e, 0xec, 0x1b, 0xa1, 0xeb, 0x93, 0x65, 0x5e, 0xbf, 0x7a, 0x4e, 0x50, 0x77, 0x55, 0xbf, 0xba,
+	0xf5, 0xb6, 0xd5, 0x9a, 0x9e, 0x95, 0xcb, 0x3e, 0xf5, 0xcb, 0xae, 0x4e, 0x08, 0x93, 0xce, 0x64,
+	0x05, 0x1c, 0x7e, 0x27, 0xf4, 0xf1, 0xca, 0x0e, 0xae, 0xb9, 0x43, 0x5f, 0xed, 0xfa, 0x96, 0xff,
+	0xb6, 0x62, 0x7f, 0xa7, 0x86, 0xdf, 0xd2, 0x56, 0x05, 0x65, 0x1d, 0x7a, 0x1f, 0x74, 0x2a, 0xa7,
+	0x6e, 0xad, 0x46, 0xe2, 0x07, 0xb6, 0x4e, 0x9b, 0x1e, 0x72, 0x5d, 0x3e, 0x4c, 0xca, 0xa9, 0xb7,
+	0x7f, 0x32, 0xe7, 0xe4, 0x74, 0xce, 0xc9, 0xef, 0x39, 0x27, 0x5f, 0x17, 0xbc, 0x76, 0xba, 0xe0,
+	0xb5, 0x5f, 0x0b, 0x5e, 0xdb, 0x7f, 0xa7, 0xc0, 0x1e, 0x16, 0x83, 0x68, 0x68, 0xf2, 0x18, 0x8e,
+	0xb3, 0x02, 0xc1, 0x68, 0xd0, 0xc3, 0xd8, 0x5f, 0x01, 0x76, 0xb6, 0x5d, 0x5e, 0xb2, 0x9d, 0x9b,
+	0xb4, 0xc8, 0x64, 0x3c, 0xbd, 0x78, 0x3b, 0xb1, 0x9d, 0x8d, 0x24, 0x0e, 0x9a, 0xee, 0x09, 0xbd,
+	0xfc, 0x3b, 0x00, 0xc1, 0x7c, 0x6b, 0xba, 0xbb, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateParams defines the type for an UpdateParamsProposal
+	ProposalTypeUpdateParams = "UpdateSlashingParams"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var _ govtypes.Content = &UpdateParamsProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "cosmos-sdk/x/slashing/UpdateParamsProposal")
+}
+
+// NewUpdateParamsProposal creates a new proposal to update the x/slashing params.
+func NewUpdateParamsProposal(title, description string, params Params) *UpdateParamsProposal {
+	return &UpdateParamsProposal{title, description, params}
+}
+
+// GetTitle returns the title of an update params proposal.
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update params proposal.
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update params proposal.
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update params proposal.
+func (p *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Params.Validate()
+}
+
+// String implements the Stringer interface.
+func (p UpdateParamsProposal) String() string {
+	return fmt.Sprintf(`Update Slashing Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:
+%s
+`, p.Title, p.Description, p.Params.String())
+}
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/query.proto
+// source: slashing/v1beta1/query.proto
 
 package types
 
@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -38,7 +38,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{0}
+	return fileDescriptor_edfe1dd4e275002d, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +76,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{1}
+	return fileDescriptor_edfe1dd4e275002d, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +123,7 @@ func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest
 func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoRequest) ProtoMessage()    {}
 func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{2}
+	return fileDescriptor_edfe1dd4e275002d, []int{2}
 }
 func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,7 +170,7 @@ func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoRespon
 func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoResponse) ProtoMessage()    {}
 func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{3}
+	return fileDescriptor_edfe1dd4e275002d, []int{3}
 }
 func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +216,7 @@ func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosReque
 func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosRequest) ProtoMessage()    {}
 func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{4}
+	return fileDescriptor_edfe1dd4e275002d, []int{4}
 }
 func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +264,7 @@ func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResp
 func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosResponse) ProtoMessage()    {}
 func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_791b11d41a861ed0, []int{5}
+	return fileDescriptor_edfe1dd4e275002d, []int{5}
 }
 func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,45 +316,45 @@ func init() {
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "liquidstaking.slashing.v1beta1.QuerySigningInfosResponse")
 }
 
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/query.proto", fileDescriptor_791b11d41a861ed0)
-}
-
-var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x0d, 0x38, 0x29, 0x22, 0x63, 0xa1, 0x35, 0xc8, 0xc6, 0xae, 0x90, 0x16,
-	0x35, 0x3b, 0x26, 0x22, 0x5e, 0xec, 0xc1, 0x1e, 0x0c, 0xde, 0x34, 0x8a, 0x07, 0x41, 0xc2, 0x6c,
-	0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0xfb, 0x36, 0xc1, 0x20, 0x5e, 0x3c, 0x7b, 0x10, 0xfc, 0x0c,
-	0x1e, 0x3d, 0xf8, 0x2d, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x09, 0xfc, 0x04, 0x92, 0x99,
-	0x49, 0xb2, 0x25, 0xae, 0xa6, 0x3d, 0xed, 0xf0, 0xe6, 0xfd, 0xdf, 0xff, 0xf7, 0xe6, 0x3d, 0x16,
-	0xdf, 0xe8, 0x68, 0xe8, 0x69, 0xa0, 0x10, 0x31, 0x38, 0x94, 0x4a, 0xd0, 0x41, 0x3d, 0xe4, 0x29,
-	0xab, 0xd3, 0xa3, 0x3e, 0x4f, 0x86, 0x41, 0x9c, 0xe8, 0x54, 0x93, 0x4d, 0x9b, 0x14, 0x4c, 0x93,
-	0x02, 0x97, 0x54, 0xbe, 0xe9, 0xd4, 0x21, 0x03, 0x6e, 0x15, 0x33, 0x7d, 0xcc, 0x84, 0x54, 0x2c,
-	0x95, 0x5a, 0xd9, 0x22, 0xe5, 0x0d, 0xa1, 0x85, 0x36, 0x47, 0x3a, 0x39, 0xb9, 0xe8, 0x35, 0xa1,
-	0xb5, 0x88, 0x38, 0x65, 0xb1, 0xa4, 0x4c, 0x29, 0x9d, 0x1a, 0x09, 0xb8, 0xdb, 0x6a, 0x1e, 0xdd,
-	0x8c, 0xc4, 0xe4, 0xf9, 0x1b, 0x98, 0x3c, 0x9d, 0xb8, 0x3f, 0x61, 0x09, 0xeb, 0x41, 0x8b, 0x1f,
-	0xf5, 0x39, 0xa4, 0xfe, 0x73, 0x7c, 0xe5, 0x54, 0x14, 0x62, 0xad, 0x80, 0x93, 0x3d, 0x5c, 0x8c,
-	0x4d, 0x64, 0x0b, 0x5d, 0x47, 0xbb, 0xa5, 0x46, 0x25, 0xc8, 0x69, 0x2f, 0xb0, 0xc2, 0xfd, 0xb5,
-	0xe3, 0x1f, 0x95, 0x42, 0xcb, 0x89, 0xfc, 0x07, 0x78, 0xd3, 0x54, 0x7d, 0x26, 0x85, 0x92, 0x4a,
-	0x3c, 0x56, 0x07, 0xda, 0x19, 0x92, 0x6d, 0xbc, 0xde, 0xd1, 0x0a, 0xda, 0xac, 0xdb, 0x4d, 0x38,
-	0xd8, 0xfa, 0x17, 0x5b, 0xa5, 0x49, 0xec, 0xa1, 0x0d, 0xf9, 0x43, 0xbc, 0xb5, 0xa8, 0x76, 0x60,
-	0xaf, 0xf0, 0xe5, 0x01, 0x8b, 0xda, 0x60, 0xaf, 0xda, 0x52, 0x1d, 0x68, 0x87, 0x58, 0xcb, 0x45,
-	0x7c, 0xc1, 0x22, 0xd9, 0x65, 0xa9, 0x4e, 0x32, 0x05, 0x1d, 0xf0, 0xa5, 0x01, 0x8b, 0x32, 0x51,
-	0x3f, 0x5c, 0xb4, 0x9e, 0x3e, 0x15, 0x79, 0x84, 0xf1, 0x7c, 0x60, 0xce, 0xb4, 0x3a, 0x35, 0x9d,
-	0x4c, 0x37, 0xb0, 0xfb, 0x30, 0x7f, 0x19, 0xc1, 0x9d, 0xb6, 0x95, 0x51, 0xfa, 0x5f, 0x10, 0xbe,
-	0xfa, 0x17, 0x13, 0xd7, 0x60, 0x13, 0xaf, 0xb9, 0xa6, 0x56, 0xcf, 0xdb, 0x94, 0x29, 0x40, 0x9a,
-	0xa7, 0x70, 0x57, 0x0c, 0xee, 0xce, 0x7f, 0x71, 0x2d, 0x45, 0x96, 0xb7, 0xf1, 0x7b, 0x15, 0x5f,
-	0x30, 0xbc, 0xe4, 0x03, 0xc2, 0x45, 0x3b, 0x6f, 0x72, 0x2b, 0x17, 0x6c, 0x71, 0xc9, 0xca, 0xb7,
-	0x97, 0x4b, 0xb6, 0xde, 0xfe, 0xce, 0xfb, 0x6f, 0xbf, 0x3e, 0xad, 0x6c, 0x93, 0x0a, 0xcd, 0xdb,
-	0x6c, 0xbb, 0x65, 0xe4, 0x2b, 0xc2, 0xa5, 0x4c, 0xf7, 0xe4, 0xce, 0xbf, 0x6d, 0x16, 0x97, 0xb1,
-	0x5c, 0x3f, 0x83, 0xc2, 0xd1, 0xed, 0x19, 0xba, 0xfb, 0xe4, 0x5e, 0x2e, 0x5d, 0x76, 0x37, 0x81,
-	0xbe, 0xcd, 0x6e, 0xfb, 0x3b, 0xf2, 0x19, 0xe1, 0xf5, 0xec, 0xdc, 0xc9, 0xf2, 0x08, 0xb3, 0xe7,
-	0x6c, 0x9c, 0x45, 0xe2, 0xb0, 0x03, 0x83, 0xbd, 0x4b, 0xaa, 0xcb, 0x61, 0xef, 0x37, 0x8f, 0x47,
-	0x1e, 0x3a, 0x19, 0x79, 0xe8, 0xe7, 0xc8, 0x43, 0x1f, 0xc7, 0x5e, 0xe1, 0x64, 0xec, 0x15, 0xbe,
-	0x8f, 0xbd, 0xc2, 0xcb, 0x9a, 0x90, 0xe9, 0x61, 0x3f, 0x0c, 0x3a, 0xba, 0x37, 0xad, 0x65, 0x3f,
-	0x35, 0xe8, 0xbe, 0xa6, 0x6f, 0xe6, 0x85, 0xd3, 0x61, 0xcc, 0x21, 0x2c, 0x9a, 0xbf, 0xcf, 0xdd,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x0f, 0xc0, 0xe6, 0x45, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("slashing/v1beta1/query.proto", fileDescriptor_edfe1dd4e275002d) }
+
+var fileDescriptor_edfe1dd4e275002d = []byte{
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x35, 0x06, 0x9c, 0x14, 0x91, 0xb1, 0x60, 0x0d, 0x65, 0x63, 0xf7, 0xd0, 0x16,
+	0xa1, 0x33, 0x34, 0x55, 0xaa, 0xa0, 0xa0, 0x45, 0x14, 0x2f, 0xa2, 0x11, 0x3c, 0xd4, 0x43, 0x98,
+	0x64, 0xa7, 0xd3, 0xc1, 0xcd, 0xcc, 0x66, 0x67, 0x36, 0x18, 0x44, 0x10, 0x3f, 0x81, 0xe0, 0x4d,
+	0xfc, 0x16, 0x7a, 0xf7, 0xda, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0xc4, 0x0f, 0x22, 0x99, 0x99, 0x26,
+	0x5b, 0x56, 0x4d, 0xa3, 0xb7, 0xe5, 0xcd, 0xfc, 0xdf, 0xfb, 0xbd, 0xf7, 0xfe, 0xb3, 0x70, 0x45,
+	0xc7, 0x54, 0x1f, 0x08, 0xc9, 0x49, 0x7f, 0xab, 0xcd, 0x0c, 0xdd, 0x22, 0xbd, 0x8c, 0xa5, 0x03,
+	0x9c, 0xa4, 0xca, 0x28, 0x14, 0xc4, 0xa2, 0x97, 0x89, 0x48, 0x1b, 0xfa, 0x42, 0x48, 0x8e, 0x8f,
+	0xef, 0x62, 0x7f, 0xb7, 0x76, 0xb5, 0xa3, 0x74, 0x57, 0x69, 0xd2, 0xa6, 0x9a, 0x39, 0xe1, 0x24,
+	0x4d, 0x42, 0xb9, 0x90, 0xd4, 0x08, 0x25, 0x5d, 0xae, 0xda, 0x12, 0x57, 0x5c, 0xd9, 0x4f, 0x32,
+	0xfe, 0xf2, 0xd1, 0x15, 0xae, 0x14, 0x8f, 0x19, 0xa1, 0x89, 0x20, 0x54, 0x4a, 0x65, 0xac, 0x44,
+	0xfb, 0xd3, 0x7a, 0x81, 0x6e, 0x82, 0x60, 0x2f, 0x84, 0x4b, 0x10, 0x3d, 0x19, 0x97, 0x7d, 0x4c,
+	0x53, 0xda, 0xd5, 0x4d, 0xd6, 0xcb, 0x98, 0x36, 0xe1, 0x73, 0x78, 0xf1, 0x44, 0x54, 0x27, 0x4a,
+	0x6a, 0x86, 0xee, 0xc1, 0x4a, 0x62, 0x23, 0xcb, 0xe0, 0x0a, 0xd8, 0xa8, 0x36, 0xd6, 0xf0, 0xdf,
+	0xdb, 0xc3, 0x4e, 0xbf, 0x5b, 0x3e, 0xfc, 0x5e, 0x2f, 0x35, 0xbd, 0x36, 0xbc, 0x05, 0x2f, 0xd9,
+	0xe4, 0x4f, 0x05, 0x97, 0x42, 0xf2, 0x87, 0x72, 0x5f, 0xf9, 0xba, 0x68, 0x15, 0x2e, 0x76, 0x94,
+	0xd4, 0x2d, 0x1a, 0x45, 0x29, 0xd3, 0xae, 0xcc, 0xb9, 0x66, 0x75, 0x1c, 0xbb, 0xeb, 0x42, 0xe1,
+	0x1b, 0x00, 0x97, 0x8b, 0x72, 0x0f, 0x18, 0xc1, 0x0b, 0x7d, 0x1a, 0xb7, 0xb4, 0x3b, 0x6a, 0x09,
+	0xb9, 0xaf, 0x3c, 0xea, 0xb5, 0x59, 0xa8, 0xcf, 0x68, 0x2c, 0x22, 0x6a, 0x54, 0x9a, 0xcb, 0xeb,
+	0xc1, 0xcf, 0xf7, 0x69, 0x9c, 0x8b, 0x86, 0xed, 0x22, 0xc1, 0xf1, 0xe4, 0xd0, 0x7d, 0x08, 0xa7,
+	0x8b, 0x9b, 0x8c, 0xc9, 0x6d, 0x19, 0x8f, 0xb7, 0x8c, 0x9d, 0x3d, 0xa6, 0x13, 0xe2, 0xcc, 0x6b,
+	0x9b, 0x39, 0x65, 0xf8, 0x19, 0xc0, 0xcb, 0xbf, 0x29, 0xe2, 0xfb, 0x7c, 0x04, 0xcb, 0xbe, 0xb7,
+	0x33, 0xff, 0xd9, 0x9b, 0xcd, 0x83, 0x1e, 0x9c, 0xa0, 0x5e, 0xb0, 0xd4, 0xeb, 0x33, 0xa9, 0x1d,
+	0x4c, 0x1e, 0xbb, 0xf1, 0xb1, 0x0c, 0xcf, 0x5a, 0x6c, 0xf4, 0x01, 0xc0, 0x8a, 0x5b, 0x3f, 0x6a,
+	0xcc, 0xe2, 0x2b, 0x3a, 0xb0, 0xb6, 0x3d, 0x97, 0xc6, 0x91, 0x84, 0xeb, 0x6f, 0xbf, 0xfe, 0x7c,
+	0xbf, 0xb0, 0x8a, 0xea, 0xc4, 0x3f, 0xab, 0x82, 0xfb, 0x9d, 0x05, 0xd1, 0x17, 0x00, 0xab, 0xb9,
+	0x59, 0xa0, 0x9d, 0x53, 0x55, 0x2b, 0x1a, 0xb6, 0x76, 0x63, 0x7e, 0xa1, 0x67, 0xbd, 0x6d, 0x59,
+	0x77, 0xd0, 0xf5, 0x3f, 0xb2, 0xe6, 0x5d, 0xac, 0xc9, 0xab, 0xfc, 0xc3, 0x78, 0x8d, 0x3e, 0x01,
+	0xb8, 0x98, 0xb7, 0x06, 0x9a, 0x9b, 0x64, 0x32, 0xea, 0x9b, 0xff, 0xa0, 0xf4, 0x4d, 0x60, 0xdb,
+	0xc4, 0x06, 0x5a, 0x3b, 0x5d, 0x13, 0xbb, 0x7b, 0x87, 0xc3, 0x00, 0x1c, 0x0d, 0x03, 0xf0, 0x63,
+	0x18, 0x80, 0x77, 0xa3, 0xa0, 0x74, 0x34, 0x0a, 0x4a, 0xdf, 0x46, 0x41, 0x69, 0xef, 0x0e, 0x17,
+	0xe6, 0x20, 0x6b, 0xe3, 0x8e, 0xea, 0x12, 0xd1, 0x8b, 0x33, 0x2d, 0x94, 0x14, 0xb2, 0x43, 0x1c,
+	0x9a, 0x30, 0x83, 0x4d, 0x8f, 0xb7, 0xd9, 0x55, 0x51, 0x16, 0x33, 0xf2, 0x72, 0x5a, 0xcb, 0x0c,
+	0x12, 0xa6, 0xdb, 0x15, 0xfb, 0x43, 0xdb, 0xfe, 0x35, 0x00, 0xcf, 0xd8, 0xb3, 0xf3, 0x91, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -387,7 +387,7 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/Params", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/liquidstaking.slashing.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -396,7 +396,7 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 
 func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
 	out := new(QuerySigningInfoResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/SigningInfo", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/liquidstaking.slashing.v1beta1.Query/SigningInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -405,7 +405,7 @@ func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoReque
 
 func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
 	out := new(QuerySigningInfosResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/SigningInfos", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/liquidstaking.slashing.v1beta1.Query/SigningInfos", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -450,7 +450,7 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.slashing.v1beta1.Query/Params",
+		FullMethod: "/liquidstaking.slashing.v1beta1.Query/Params",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
@@ -468,7 +468,7 @@ func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.slashing.v1beta1.Query/SigningInfo",
+		FullMethod: "/liquidstaking.slashing.v1beta1.Query/SigningInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
@@ -486,7 +486,7 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.slashing.v1beta1.Query/SigningInfos",
+		FullMethod: "/liquidstaking.slashing.v1beta1.Query/SigningInfos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
@@ -495,7 +495,7 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 }
 
 var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.slashing.v1beta1.Query",
+	ServiceName: "liquidstaking.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -512,7 +512,7 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/slashing/v1beta1/query.proto",
+	Metadata: "slashing/v1beta1/query.proto",
 }
 
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
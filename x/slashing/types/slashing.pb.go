@@ -1,16 +1,16 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/slashing/v1beta1/slashing.proto
+// source: slashing/v1beta1/slashing.proto
 
 package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/gogo/protobuf/gogoproto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -52,7 +52,7 @@ type ValidatorSigningInfo struct {
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
 func (*ValidatorSigningInfo) ProtoMessage() {}
 func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{0}
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{0}
 }
 func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -136,7 +136,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,57 +179,107 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+// UpdateParamsProposal is a gov Content type that replaces the x/slashing params
+// once the proposal passes
+type UpdateParamsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// params defines the x/slashing parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ddc33c0f1f4d4ab, []int{2}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "liquidstaking.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "liquidstaking.slashing.v1beta1.Params")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "liquidstaking.slashing.v1beta1.UpdateParamsProposal")
 }
 
-func init() {
-	proto.RegisterFile("cosmos/slashing/v1beta1/slashing.proto", fileDescriptor_1078e5d96a74cc52)
-}
-
-var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("slashing/v1beta1/slashing.proto", fileDescriptor_2ddc33c0f1f4d4ab) }
+
+var fileDescriptor_2ddc33c0f1f4d4ab = []byte{
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x73, 0xeb, 0x44,
+	0x14, 0xb5, 0x9e, 0xf3, 0xcc, 0x63, 0xed, 0x4a, 0xcf, 0x8f, 0xf8, 0x19, 0x90, 0x8c, 0x8a, 0x8c,
+	0x61, 0xc6, 0xd2, 0x24, 0x74, 0xae, 0x18, 0xc5, 0xc3, 0x00, 0x05, 0x18, 0x25, 0x81, 0x19, 0x8a,
+	0x68, 0xd6, 0xda, 0xb5, 0xbc, 0x44, 0xda, 0x55, 0xb4, 0xab, 0x7c, 0xd0, 0xd1, 0xa5, 0x4c, 0x99,
+	0x32, 0x25, 0x3f, 0x80, 0x1f, 0x40, 0x99, 0xa1, 0x4a, 0xc9, 0x50, 0x18, 0xc6, 0x69, 0xa8, 0xd3,
+	0xd1, 0x31, 0xda, 0x5d, 0x25, 0x9e, 0xc4, 0x61, 0x26, 0x95, 0x7d, 0xcf, 0xb9, 0xf7, 0xee, 0xb9,
+	0x1f, 0xba, 0xc0, 0xe6, 0x09, 0xe4, 0x33, 0x42, 0x63, 0xef, 0x68, 0x73, 0x82, 0x05, 0xdc, 0xf4,
+	0x2a, 0xc0, 0xcd, 0x72, 0x26, 0x98, 0x69, 0x25, 0xe4, 0xb0, 0x20, 0x88, 0x0b, 0x78, 0x50, 0x82,
+	0x77, 0xac, 0x76, 0xef, 0xb6, 0x63, 0x16, 0x33, 0xe9, 0xea, 0x95, 0xff, 0x54, 0x54, 0xf7, 0x6d,
+	0xc4, 0x78, 0xca, 0x78, 0xa8, 0x08, 0x65, 0x68, 0xca, 0x8a, 0x19, 0x8b, 0x13, 0xec, 0x49, 0x6b,
+	0x52, 0x4c, 0x3d, 0x54, 0xe4, 0x50, 0x10, 0x46, 0x35, 0x6f, 0x3f, 0xe4, 0x05, 0x49, 0x31, 0x17,
+	0x30, 0xcd, 0x94, 0x83, 0x73, 0x56, 0x07, 0xed, 0xef, 0x60, 0x42, 0x10, 0x14, 0x2c, 0xdf, 0x21,
+	0x31, 0x25, 0x34, 0xfe, 0x92, 0x4e, 0x99, 0xd9, 0x01, 0xef, 0x40, 0x84, 0x72, 0xcc, 0x79, 0xc7,
+	0xe8, 0x19, 0xfd, 0x77, 0x83, 0xca, 0x34, 0x87, 0xa0, 0xc5, 0x05, 0xcc, 0x45, 0x38, 0xc3, 0x24,
+	0x9e, 0x89, 0xce, 0x8b, 0x9e, 0xd1, 0xaf, 0xfb, 0xeb, 0xb7, 0x73, 0xfb, 0xf5, 0x29, 0x4c, 0x93,
+	0xa1, 0xb3, 0xcc, 0x3a, 0x41, 0x53, 0x9a, 0x5f, 0x48, 0xab, 0x8c, 0x25, 0x14, 0xe1, 0x93, 0x90,
+	0x4d, 0xa7, 0x1c, 0x8b, 0x4e, 0xfd, 0x61, 0xec, 0x32, 0xeb, 0x04, 0x4d, 0x69, 0x7e, 0x23, 0x2d,
+	0x73, 0x1f, 0xb4, 0x7e, 0x84, 0x24, 0xc1, 0x28, 0x2c, 0xa8, 0x20, 0x49, 0x67, 0xad, 0x67, 0xf4,
+	0x9b, 0x5b, 0x5d, 0x57, 0x95, 0xe8, 0x56, 0x25, 0xba, 0xbb, 0x55, 0x89, 0xbe, 0x7d, 0x35, 0xb7,
+	0x6b, 0xf7, 0xb9, 0x97, 0xa3, 0x9d, 0xf3, 0xbf, 0x6c, 0x23, 0x68, 0x2a, 0x68, 0xaf, 0x44, 0x4c,
+	0x0b, 0x00, 0xc1, 0xd2, 0x09, 0x17, 0x8c, 0x62, 0xd4, 0x79, 0xd9, 0x33, 0xfa, 0xaf, 0x82, 0x25,
+	0xc4, 0xdc, 0x05, 0x6f, 0x52, 0xc2, 0x39, 0x46, 0xe1, 0x24, 0x61, 0xd1, 0x01, 0x0f, 0x23, 0x56,
+	0x50, 0x81, 0xf3, 0x4e, 0x43, 0x16, 0xd1, 0xbb, 0x9d, 0xdb, 0x1f, 0xa8, 0x87, 0x56, 0xba, 0x39,
+	0xc1, 0x6b, 0x85, 0xfb, 0x12, 0xde, 0x56, 0xe8, 0xf0, 0xd5, 0xc5, 0xa5, 0x5d, 0xfb, 0xe7, 0xd2,
+	0x36, 0x9c, 0x7f, 0xd7, 0x40, 0x63, 0x0c, 0x73, 0x98, 0x72, 0xf3, 0x5b, 0xd0, 0xe6, 0x24, 0xa6,
+	0xf7, 0x39, 0x8e, 0x09, 0x45, 0xec, 0x58, 0x4e, 0xa2, 0xee, 0xdb, 0xb7, 0x73, 0xfb, 0x7d, 0xdd,
+	0xea, 0x15, 0x5e, 0x4e, 0x60, 0x2a, 0x58, 0x3d, 0xf4, 0xbd, 0x04, 0xcd, 0x9f, 0x8d, 0x52, 0x3e,
+	0x0d, 0x75, 0x44, 0x86, 0xf3, 0x2a, 0x69, 0x39, 0xbf, 0x96, 0xff, 0x75, 0xd9, 0xab, 0x3f, 0xe7,
+	0xf6, 0x46, 0x4c, 0xc4, 0xac, 0x98, 0xb8, 0x11, 0x4b, 0xf5, 0xaa, 0xe9, 0x9f, 0x01, 0x47, 0x07,
+	0x9e, 0x38, 0xcd, 0x30, 0x77, 0x47, 0x38, 0x5a, 0x2e, 0x76, 0x45, 0x52, 0x27, 0x30, 0x53, 0x42,
+	0x77, 0x24, 0x3c, 0xc6, 0xb9, 0xd6, 0xf0, 0x13, 0x78, 0x0f, 0xb1, 0x63, 0x5a, 0xee, 0x60, 0x58,
+	0x76, 0x3e, 0xac, 0xb6, 0x55, 0xee, 0x41, 0x73, 0xeb, 0xed, 0xa3, 0x59, 0x8e, 0xb4, 0x83, 0xff,
+	0xb1, 0x1e, 0xe5, 0x87, 0xea, 0xd1, 0xd5, 0x69, 0x9c, 0x8b, 0x72, 0xa8, 0xed, 0x8a, 0xfc, 0x0a,
+	0x92, 0xa4, 0x4a, 0x60, 0x9e, 0x1b, 0xa0, 0x2b, 0xbf, 0xb7, 0x70, 0x9a, 0xc3, 0xa8, 0x84, 0x42,
+	0xc4, 0x8a, 0x49, 0x82, 0xa5, 0x78, 0xb9, 0x4c, 0x2d, 0x7f, 0xe7, 0xd9, 0x4d, 0xf8, 0x48, 0xcf,
+	0xe1, 0xc9, 0xcc, 0x4e, 0xb0, 0x2e, 0xc9, 0xcf, 0x35, 0x37, 0x92, 0x54, 0xd9, 0x19, 0xf3, 0xcc,
+	0x00, 0xeb, 0x8f, 0x02, 0x95, 0x74, 0xb9, 0x7e, 0x2d, 0x7f, 0xfc, 0x6c, 0x3d, 0xd6, 0x13, 0x7a,
+	0x54, 0x5a, 0x27, 0x78, 0xf3, 0x40, 0x8c, 0xc6, 0x7f, 0x33, 0x40, 0x7b, 0x2f, 0x43, 0x50, 0x60,
+	0xb5, 0x81, 0xe3, 0x9c, 0x65, 0x8c, 0xc3, 0xc4, 0x6c, 0x83, 0x97, 0x82, 0x88, 0x04, 0xeb, 0x23,
+	0xa0, 0x0c, 0xb3, 0x07, 0x9a, 0x08, 0xf3, 0x28, 0x27, 0x99, 0x9c, 0xde, 0x0b, 0xc9, 0x2d, 0x43,
+	0xe6, 0x08, 0x34, 0x32, 0x99, 0x49, 0x8f, 0x76, 0xc3, 0xfd, 0xff, 0xd3, 0xe7, 0xaa, 0x77, 0xfd,
+	0xb5, 0xb2, 0xe2, 0x40, 0xc7, 0x0e, 0x3f, 0x39, 0xbb, 0xb4, 0x6b, 0xfa, 0x03, 0xa9, 0xfd, 0xfe,
+	0xeb, 0xa0, 0xab, 0x8f, 0x5f, 0xcc, 0x8e, 0xee, 0x02, 0xb7, 0x19, 0x15, 0x98, 0x0a, 0x7f, 0xff,
+	0x97, 0x85, 0x65, 0x5c, 0x2d, 0x2c, 0xe3, 0x7a, 0x61, 0x19, 0x7f, 0x2f, 0x2c, 0xe3, 0xfc, 0xc6,
+	0xaa, 0x5d, 0xdf, 0x58, 0xb5, 0x3f, 0x6e, 0xac, 0xda, 0x0f, 0x9f, 0x2d, 0x75, 0x90, 0x1c, 0x26,
+	0x05, 0x27, 0x8c, 0x12, 0x1a, 0x79, 0x4a, 0x15, 0x11, 0xa7, 0x03, 0xad, 0x6c, 0x90, 0x32, 0x54,
+	0x24, 0xd8, 0x3b, 0xb9, 0xbb, 0xdd, 0xaa, 0xbf, 0x93, 0x86, 0x5c, 0xca, 0x4f, 0xff, 0x1b, 0x00,
+	0x36, 0xcc, 0x1a, 0x15, 0xe5, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -436,6 +486,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -494,6 +591,25 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
 func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -893,6 +1009,153 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"fmt"
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -11,18 +12,62 @@ import (
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
+// totalLiquidStakedRefreshBatchSize is the maximum number of delegations added to the
+// liquid staked totals in a single block while a refresh is in progress
+const totalLiquidStakedRefreshBatchSize = 10_000
+
 // BeginBlocker will persist the current header and validator set as a historical entry
 // and prune the oldest entry based on the HistoricalEntries parameter
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.TrackHistoricalInfo(ctx)
-	k.RemoveExpiredTokenizeShareLocks(ctx, ctx.BlockTime())
+
+	unlockedAddresses := k.RemoveExpiredTokenizeShareLocks(ctx, ctx.BlockTime())
+	for _, address := range unlockedAddresses {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteTokenizeSharesUnlock,
+				sdk.NewAttribute(types.AttributeKeyDelegator, address),
+			),
+		)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventCompleteTokenizeSharesUnlock{
+			DelegatorAddress: address,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	expiredTransfers := k.RemoveExpiredTokenizeShareRecordTransfers(ctx, ctx.BlockTime())
+	for _, transfer := range expiredTransfers {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireTokenizeShareRecordTransfer,
+				sdk.NewAttribute(types.AttributeKeyShareRecordID, fmt.Sprintf("%d", transfer.TokenizeShareRecordId)),
+				sdk.NewAttribute(types.AttributeKeyShareOwner, transfer.Owner),
+				sdk.NewAttribute(types.AttributeKeyNewOwner, transfer.NewOwner),
+			),
+		)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelTokenizeShareRecordTransfer{
+			ShareRecordId: transfer.TokenizeShareRecordId,
+			Owner:         transfer.Owner,
+			NewOwner:      transfer.NewOwner,
+			Expired:       true,
+		}); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // Called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	if _, err := k.ProcessTotalLiquidStakedRefresh(ctx, totalLiquidStakedRefreshBatchSize); err != nil {
+		panic(err)
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
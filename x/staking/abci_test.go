@@ -0,0 +1,133 @@
+package staking_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func TestBeginBlockerCompleteTokenizeSharesUnlockEvents(t *testing.T) {
+	_, app, ctx := getBaseSimappWithCustomKeeper(t)
+	addrDels, _ := generateAddresses(app, ctx, 3, sdk.NewInt(1))
+
+	unbondingPeriod := time.Hour * 24
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = unbondingPeriod
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// Queue an unlock for the first two addresses, and leave the third locked
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(startTime)
+	for _, address := range addrDels {
+		app.StakingKeeper.AddTokenizeSharesLock(ctx, address)
+	}
+	app.StakingKeeper.QueueTokenizeSharesAuthorization(ctx, addrDels[0])
+	app.StakingKeeper.QueueTokenizeSharesAuthorization(ctx, addrDels[1])
+
+	// No events should be emitted before the unbonding period has elapsed
+	ctx = ctx.WithBlockTime(startTime.Add(unbondingPeriod).Add(-time.Second)).WithEventManager(sdk.NewEventManager())
+	staking.BeginBlocker(ctx, app.StakingKeeper)
+	require.Empty(t, ctx.EventManager().Events(), "no unlock events before completion")
+
+	// Once the unlock completes, an event should be emitted for each address
+	ctx = ctx.WithBlockTime(startTime.Add(unbondingPeriod)).WithEventManager(sdk.NewEventManager())
+	staking.BeginBlocker(ctx, app.StakingKeeper)
+
+	unlockedAddresses := []string{}
+	typedUnlockedAddresses := []string{}
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeCompleteTokenizeSharesUnlock:
+			require.Len(t, event.Attributes, 1, "number of attributes")
+			require.Equal(t, types.AttributeKeyDelegator, string(event.Attributes[0].Key), "attribute key")
+			unlockedAddresses = append(unlockedAddresses, string(event.Attributes[0].Value))
+
+		case proto.MessageName(&types.EventCompleteTokenizeSharesUnlock{}):
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			typedUnlockedAddresses = append(typedUnlockedAddresses,
+				typedEvent.(*types.EventCompleteTokenizeSharesUnlock).DelegatorAddress)
+
+		default:
+			t.Fatalf("unexpected event type %s", event.Type)
+		}
+	}
+	expectedAddresses := []string{addrDels[0].String(), addrDels[1].String()}
+	require.Equal(t, expectedAddresses, unlockedAddresses, "unlocked addresses")
+	require.Equal(t, expectedAddresses, typedUnlockedAddresses, "unlocked addresses from typed events")
+
+	status, _ := app.StakingKeeper.GetTokenizeSharesLock(ctx, addrDels[2])
+	require.Equal(t, types.TokenizeShareLockStatus_LOCKED, status, "third address still locked")
+}
+
+func TestBeginBlockerExpireTokenizeShareRecordTransfers(t *testing.T) {
+	_, app, ctx := getBaseSimappWithCustomKeeper(t)
+	addrDels, addrVals := generateAddresses(app, ctx, 3, sdk.NewInt(1))
+	owner, newOwner := addrDels[0], addrDels[1]
+
+	unbondingPeriod := time.Hour * 24
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = unbondingPeriod
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// Propose transfers of two records at different times
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, proposalTime := range []time.Time{startTime, startTime.Add(time.Hour)} {
+		recordID := uint64(i + 1)
+		err := app.StakingKeeper.AddTokenizeShareRecord(ctx, types.TokenizeShareRecord{
+			Id:            recordID,
+			Owner:         owner.String(),
+			ModuleAccount: fmt.Sprintf("%s%d", types.TokenizeShareModuleAccountPrefix, recordID),
+			Validator:     addrVals[2].String(),
+		})
+		require.NoError(t, err)
+
+		app.StakingKeeper.SetPendingTokenizeShareRecordTransfer(ctx, types.PendingTokenizeShareRecordTransfer{
+			TokenizeShareRecordId: recordID,
+			Owner:                 owner.String(),
+			NewOwner:              newOwner.String(),
+			ExpirationTime:        proposalTime.Add(unbondingPeriod),
+		})
+	}
+
+	// No transfer should expire before the unbonding period has elapsed
+	ctx = ctx.WithBlockTime(startTime.Add(unbondingPeriod).Add(-time.Second)).WithEventManager(sdk.NewEventManager())
+	staking.BeginBlocker(ctx, app.StakingKeeper)
+	require.Empty(t, ctx.EventManager().Events(), "no expiration events before expiration")
+	require.Len(t, app.StakingKeeper.GetAllPendingTokenizeShareRecordTransfers(ctx), 2, "pending transfers")
+
+	// Only the first transfer should expire
+	ctx = ctx.WithBlockTime(startTime.Add(unbondingPeriod)).WithEventManager(sdk.NewEventManager())
+	staking.BeginBlocker(ctx, app.StakingKeeper)
+
+	expiredEvents := []sdk.Event{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExpireTokenizeShareRecordTransfer {
+			expiredEvents = append(expiredEvents, sdk.Event(event))
+		}
+	}
+	require.Equal(t, []sdk.Event{sdk.NewEvent(
+		types.EventTypeExpireTokenizeShareRecordTransfer,
+		sdk.NewAttribute(types.AttributeKeyShareRecordID, "1"),
+		sdk.NewAttribute(types.AttributeKeyShareOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+	)}, expiredEvents, "expiration events")
+
+	_, found := app.StakingKeeper.GetPendingTokenizeShareRecordTransfer(ctx, 1)
+	require.False(t, found, "first transfer should have expired")
+	_, found = app.StakingKeeper.GetPendingTokenizeShareRecordTransfer(ctx, 2)
+	require.True(t, found, "second transfer should still be pending")
+
+	// The record ownership is unchanged
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, owner.String(), record.Owner, "record owner")
+}
@@ -2,13 +2,16 @@ package staking_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
@@ -121,3 +124,92 @@ func TestStakingMsgs(t *testing.T) {
 	// balance should be the same because bonding not yet complete
 	simapp.CheckBalance(t, app, addr2, sdk.Coins{genCoin.Sub(bondCoin)})
 }
+
+func TestAuthzLSMMsgs(t *testing.T) {
+	genTokens := sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction)
+	bondTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, genTokens)
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, bondTokens)
+
+	acc1 := &authtypes.BaseAccount{Address: addr1.String()}
+	acc2 := &authtypes.BaseAccount{Address: addr2.String()}
+	accs := authtypes.GenesisAccounts{acc1, acc2}
+	balances := []banktypes.Balance{
+		{
+			Address: addr1.String(),
+			Coins:   sdk.Coins{genCoin},
+		},
+		{
+			Address: addr2.String(),
+			Coins:   sdk.Coins{genCoin},
+		},
+	}
+
+	app := simapp.SetupWithGenesisAccounts(t, accs, balances...)
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	deliver := func(priv cryptotypes.PrivKey, accNum, accSeq uint64, msg sdk.Msg) {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{msg}, "", []uint64{accNum}, []uint64{accSeq}, true, true, priv)
+		require.NoError(t, err)
+	}
+
+	// addr2 tokenizes a delegation to the validator of addr1
+	description := types.NewDescription("foo_moniker", "", "", "", "")
+	createValidatorMsg, err := types.NewMsgCreateValidator(
+		sdk.ValAddress(addr1), valKey.PubKey(), bondCoin, description, commissionRates,
+	)
+	require.NoError(t, err)
+	deliver(priv1, 0, 0, createValidatorMsg)
+	deliver(priv2, 1, 0, types.NewMsgDelegate(addr2, sdk.ValAddress(addr1), bondCoin))
+	deliver(priv2, 1, 1, &types.MsgTokenizeShares{
+		DelegatorAddress:    addr2.String(),
+		ValidatorAddress:    sdk.ValAddress(addr1).String(),
+		Amount:              bondCoin,
+		TokenizedShareOwner: addr2.String(),
+	})
+
+	ctxCheck := app.BaseApp.NewContext(true, tmproto.Header{})
+	records := app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctxCheck, addr2)
+	require.Len(t, records, 1)
+	record := records[0]
+
+	// addr2 lets addr1 redeem its share tokens and transfer the record to addr1
+	expiration := time.Now().AddDate(1, 0, 0)
+	redeemAuth, err := types.NewStakeAuthorization([]sdk.ValAddress{sdk.ValAddress(addr1)}, nil,
+		types.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS, &bondCoin)
+	require.NoError(t, err)
+	grantMsg, err := authz.NewMsgGrant(addr2, addr1, redeemAuth, expiration)
+	require.NoError(t, err)
+	deliver(priv2, 1, 2, grantMsg)
+
+	transferAuth, err := types.NewTransferTokenizeShareRecordAuthorization([]sdk.AccAddress{addr1})
+	require.NoError(t, err)
+	grantMsg, err = authz.NewMsgGrant(addr2, addr1, transferAuth, expiration)
+	require.NoError(t, err)
+	deliver(priv2, 1, 3, grantMsg)
+
+	// The redeemed share tokens are counted against the limit at the bond tokens they were minted for
+	halfShares := sdk.NewCoin(record.GetShareTokenDenom(), bondTokens.QuoRaw(2))
+	execMsg := authz.NewMsgExec(addr1, []sdk.Msg{&types.MsgRedeemTokensforShares{
+		DelegatorAddress: addr2.String(),
+		Amount:           halfShares,
+	}})
+	deliver(priv1, 0, 1, &execMsg)
+
+	ctxCheck = app.BaseApp.NewContext(true, tmproto.Header{})
+	updated, _ := app.AuthzKeeper.GetCleanAuthorization(ctxCheck, addr1, addr2, sdk.MsgTypeURL(&types.MsgRedeemTokensforShares{}))
+	require.NotNil(t, updated)
+	require.Equal(t, bondCoin.SubAmount(bondTokens.QuoRaw(2)), *updated.(*types.StakeAuthorization).MaxTokens)
+
+	execMsg = authz.NewMsgExec(addr1, []sdk.Msg{&types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                addr2.String(),
+		NewOwner:              addr1.String(),
+	}})
+	deliver(priv1, 0, 2, &execMsg)
+
+	ctxCheck = app.BaseApp.NewContext(true, tmproto.Header{})
+	record, err = app.StakingKeeper.GetTokenizeShareRecord(ctxCheck, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, addr1.String(), record.Owner)
+}
@@ -1,11 +1,16 @@
 package staking_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
@@ -46,6 +51,75 @@ func benchmarkValidateGenesis(b *testing.B, n int) {
 	}
 }
 
+func BenchmarkRefreshTotalLiquidStaked1000Delegations(b *testing.B) {
+	benchmarkRefreshTotalLiquidStaked(b, 1000)
+}
+
+func BenchmarkRefreshTotalLiquidStaked10000Delegations(b *testing.B) {
+	benchmarkRefreshTotalLiquidStaked(b, 10000)
+}
+
+func BenchmarkBuildLiquidDelegationIndex1000Delegations(b *testing.B) {
+	benchmarkBuildLiquidDelegationIndex(b, 1000)
+}
+
+func BenchmarkBuildLiquidDelegationIndex10000Delegations(b *testing.B) {
+	benchmarkBuildLiquidDelegationIndex(b, 10000)
+}
+
+// benchmarkRefreshTotalLiquidStaked measures the refresh, which only visits the
+// delegations in the liquid delegation index
+func benchmarkRefreshTotalLiquidStaked(b *testing.B, n int) {
+	b.ReportAllocs()
+	app, ctx := setupLiquidDelegations(b, n)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := app.StakingKeeper.RefreshTotalLiquidStaked(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchmarkBuildLiquidDelegationIndex measures the migration that builds the index,
+// which has to visit every delegation
+func benchmarkBuildLiquidDelegationIndex(b *testing.B, n int) {
+	b.ReportAllocs()
+	app, ctx := setupLiquidDelegations(b, n)
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := migrator.Migrate6to7(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// setupLiquidDelegations creates a validator with n delegations, one percent of which
+// are held by liquid staking providers
+func setupLiquidDelegations(b *testing.B, n int) (*simapp.SimApp, sdk.Context) {
+	_, app, ctx := getBaseSimappWithCustomKeeper(&testing.T{})
+
+	valAddr := sdk.ValAddress(PKs[0].Address())
+	validator := teststaking.NewValidator(b, valAddr, PKs[0])
+	validator.Tokens = sdk.NewInt(int64(n))
+	validator.DelegatorShares = sdk.NewDec(int64(n))
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("delegator-%d", i)
+		var account authtypes.AccountI = authtypes.NewBaseAccountWithAddress(sdk.AccAddress(name))
+		if i%100 == 0 {
+			account = authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(address.Module(name, []byte(name))), name)
+		}
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, account))
+		app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(account.GetAddress(), valAddr, sdk.OneDec(), false))
+	}
+
+	return app, ctx
+}
+
 func makeRandomAddressesAndPublicKeys(n int) (accL []sdk.ValAddress, pkL []*ed25519.PubKey) {
 	for i := 0; i < n; i++ {
 		pk := ed25519.GenPrivKey().PubKey().(*ed25519.PubKey)
@@ -26,9 +26,19 @@ const (
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 
+	FlagLiquidStakingCap              = "liquid-staking-cap"
+	FlagAllowedLiquidStakingProviders = "allowed-liquid-staking-providers"
+	FlagDisableTokenization           = "disable-tokenization"
+
+	FlagMaxTokens         = "max-tokens"
+	FlagRewardOwner       = "reward-owner"
+	FlagAllowedRecipients = "allowed-recipients"
+	FlagExpiration        = "expiration"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
+	FlagValidatorBond = "validator-bond"
 )
 
 // common flagsets to add to various functions
@@ -91,6 +101,16 @@ func flagSetCommissionUpdate() *flag.FlagSet {
 	return fs
 }
 
+func flagSetLiquidStakingPolicy() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagLiquidStakingCap, "", "The (optional) fraction of the validator's shares that can be liquid, if lower than the validator liquid staking cap param")
+	fs.StringSlice(FlagAllowedLiquidStakingProviders, []string{}, "The (optional) liquid staking providers that can delegate to the validator, any provider if empty")
+	fs.Bool(FlagDisableTokenization, false, "Disable the tokenization of delegations to the validator")
+
+	return fs
+}
+
 func flagSetDescriptionCreate() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
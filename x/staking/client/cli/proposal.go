@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// NewSubmitUpdateParamsProposalCmd returns a CLI command handler for submitting a proposal
+// to update the x/staking params
+func NewSubmitUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-staking-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the staking params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the staking params along with an initial deposit.
+The proposal details must be supplied via a JSON file. All the params must be supplied.
+
+Example:
+$ %s tx gov submit-proposal update-staking-params <path/to/proposal.json> --deposit=1000stake --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Update Staking Params",
+  "description": "Raise the global liquid staking cap",
+  "params": {
+    "unbonding_time": "1814400s",
+    "max_validators": 100,
+    "max_entries": 7,
+    "historical_entries": 10000,
+    "bond_denom": "stake",
+    "min_commission_rate": "0.0",
+    "validator_bond_factor": "250.0",
+    "global_liquid_staking_cap": "0.5",
+    "validator_liquid_staking_cap": "0.5",
+    "validator_bond_first_loss_fraction": "0.0"
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := &types.UpdateParamsProposal{}
+			if err := parseProposalFile(clientCtx.Codec, args[0], proposal); err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// NewSubmitUpdateSlashInsuranceCoverageProposalCmd returns a CLI command handler for submitting
+// a proposal to set the coverage of the slash insurance fund
+func NewSubmitUpdateSlashInsuranceCoverageProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-slash-insurance-coverage [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the coverage of the slash insurance fund",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set the coverage of the slash insurance fund along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-slash-insurance-coverage <path/to/proposal.json> --deposit=1000stake --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Slash Insurance Coverage",
+  "description": "Reimburse half of what share token holders lose to a slash",
+  "coverage": {
+    "coverage_fraction": "0.5",
+    "max_payout_per_slash": "1000000000"
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := &types.UpdateSlashInsuranceCoverageProposal{}
+			if err := parseProposalFile(clientCtx.Codec, args[0], proposal); err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// NewSubmitPauseTokenizationProposalCmd returns a CLI command handler for submitting
+// a proposal to pause tokenization
+func NewSubmitPauseTokenizationProposalCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "pause-tokenization [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to pause tokenization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause tokenization along with an initial deposit.
+The proposal details must be supplied via a JSON file. An empty validator address pauses
+tokenization against every validator.
+
+Example:
+$ %s tx gov submit-proposal pause-tokenization <path/to/proposal.json> --deposit=1000stake --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Pause Tokenization",
+  "description": "Stop minting share tokens of a compromised validator",
+  "pause": {
+    "validator_address": "%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm",
+    "pause_redemptions": false
+  }
+}
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := &types.PauseTokenizationProposal{}
+			if err := parseProposalFile(clientCtx.Codec, args[0], proposal); err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// NewSubmitUnpauseTokenizationProposalCmd returns a CLI command handler for submitting
+// a proposal to lift a tokenization pause
+func NewSubmitUnpauseTokenizationProposalCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unpause-tokenization [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to lift a tokenization pause",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to lift a tokenization pause along with an initial deposit.
+The proposal details must be supplied via a JSON file. An empty validator address lifts
+the global pause.
+
+Example:
+$ %s tx gov submit-proposal unpause-tokenization <path/to/proposal.json> --deposit=1000stake --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Unpause Tokenization",
+  "description": "Resume minting share tokens of the validator",
+  "validator_address": "%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm"
+}
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := &types.UnpauseTokenizationProposal{}
+			if err := parseProposalFile(clientCtx.Codec, args[0], proposal); err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// parseProposalFile reads and parses the proposal content from a JSON file
+func parseProposalFile(cdc codec.JSONCodec, proposalFile string, content proto.Message) error {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(contents, content)
+}
+
+// submitProposal generates or broadcasts a MsgSubmitProposal for the proposal content,
+// with the deposit of the deposit flag
+func submitProposal(clientCtx client.Context, cmd *cobra.Command, content govtypes.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
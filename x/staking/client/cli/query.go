@@ -46,7 +46,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryLastTokenizeShareRecordID(),
 		GetCmdQueryTotalTokenizeSharedAssets(),
 		GetCmdQueryTokenizeShareLockInfo(),
+		GetCmdQueryPendingTokenizeShareRecordTransfer(),
+		GetCmdQueryPendingTokenizeShareRecordTransfers(),
 		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryTotalLiquidStakedRefreshStatus(),
+		GetCmdQueryLiquidDelegations(),
+		GetCmdQueryTokenizeShareRecordsBySlash(),
+		GetCmdQuerySlashInsuranceFund(),
+		GetCmdQuerySlashInsurancePayouts(),
+		GetCmdQueryTokenizeShareRecordClaimByDenom(),
+		GetCmdQueryAllTokenizeShareRecordClaims(),
+		GetCmdQueryTokenizationPauseStatus(),
+		GetCmdQueryTokenizationPauses(),
 	)
 
 	return stakingQueryCmd
@@ -1022,6 +1033,92 @@ $ %s query staking total-liquid-staked
 	return cmd
 }
 
+// GetCmdQueryTotalLiquidStakedRefreshStatus implements the query for the progress
+// of a recalculation of the liquid staked totals
+func GetCmdQueryTotalLiquidStakedRefreshStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked-refresh-status",
+		Args:  cobra.NoArgs,
+		Short: "Query the progress of a recalculation of the liquid staked totals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the progress of a recalculation of the liquid staked totals.
+The totals are recalculated over several blocks whenever a liquid staking cap is
+re-enabled, and liquid staking messages are rejected until the refresh completes.
+Example:
+$ %s query staking total-liquid-staked-refresh-status
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStakedRefreshStatus(
+				cmd.Context(),
+				&types.QueryTotalLiquidStakedRefreshStatusRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLiquidDelegations implements the query for the delegations held by liquid
+// staking providers
+func GetCmdQueryLiquidDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-delegations",
+		Args:  cobra.NoArgs,
+		Short: "Query all delegations held by liquid staking providers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all delegations held by liquid staking providers.
+
+Example:
+$ %s query staking liquid-delegations
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LiquidDelegations(cmd.Context(), &types.QueryLiquidDelegationsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "liquid delegations")
+
+	return cmd
+}
+
 // GetCmdQueryTokenizeShareLockInfo returns the tokenize share lock status for a user
 func GetCmdQueryTokenizeShareLockInfo() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -1065,3 +1162,437 @@ $ %s query staking tokenize-share-lock-info %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldm
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPendingTokenizeShareRecordTransfer implements the query for the pending
+// ownership transfer of a tokenize share record
+func GetCmdQueryPendingTokenizeShareRecordTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-tokenize-share-record-transfer [record-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending ownership transfer of a tokenize share record",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending ownership transfer of a tokenize share record.
+
+Example:
+$ %s query staking pending-tokenize-share-record-transfer 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingTokenizeShareRecordTransfer(
+				cmd.Context(),
+				&types.QueryPendingTokenizeShareRecordTransferRequest{TokenizeShareRecordId: recordID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingTokenizeShareRecordTransfers implements the query for all pending
+// tokenize share record transfers, optionally filtered by the proposed new owner
+func GetCmdQueryPendingTokenizeShareRecordTransfers() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "pending-tokenize-share-record-transfers [new-owner]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query all pending tokenize share record ownership transfers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all pending tokenize share record ownership transfers,
+optionally only those proposed to the given new owner.
+
+Example:
+$ %s query staking pending-tokenize-share-record-transfers
+$ %s query staking pending-tokenize-share-record-transfers %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			newOwner := ""
+			if len(args) == 1 {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+				newOwner = args[0]
+			}
+
+			res, err := queryClient.PendingTokenizeShareRecordTransfers(
+				cmd.Context(),
+				&types.QueryPendingTokenizeShareRecordTransfersRequest{NewOwner: newOwner},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsBySlash implements the query for the tokenize share records
+// affected by a slash, with their token value before and after the slash
+func GetCmdQueryTokenizeShareRecordsBySlash() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-by-slash [validator-addr] [slash-record-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the tokenize share records affected by a slash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records delegated to a slashed validator, with the token
+value of each record before and after the slash. The slash record id is emitted in the slash event.
+
+Example:
+$ %s query staking tokenize-share-records-by-slash %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			slashRecordID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsBySlash(cmd.Context(), &types.QueryTokenizeShareRecordsBySlashRequest{
+				ValidatorAddress: valAddr.String(),
+				SlashRecordId:    slashRecordID,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
+
+	return cmd
+}
+
+// GetCmdQuerySlashInsuranceFund implements the query for the balance and coverage of the
+// slash insurance fund
+func GetCmdQuerySlashInsuranceFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-insurance-fund",
+		Args:  cobra.NoArgs,
+		Short: "Query the balance and coverage of the slash insurance fund",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance and coverage of the slash insurance fund.
+
+Example:
+$ %s query staking slash-insurance-fund
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashInsuranceFund(cmd.Context(), &types.QuerySlashInsuranceFundRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySlashInsurancePayouts implements the query for the payouts the slash insurance
+// fund made for the slashes of a validator
+func GetCmdQuerySlashInsurancePayouts() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "slash-insurance-payouts [validator-addr] [slash-record-id]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the slash insurance payouts made for the slashes of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the payouts the slash insurance fund made to tokenize share records after
+a validator was slashed, optionally only those for the given slash record.
+
+Example:
+$ %s query staking slash-insurance-payouts %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query staking slash-insurance-payouts %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1
+`,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			slashRecordID := uint64(0)
+			if len(args) == 2 {
+				slashRecordID, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashInsurancePayouts(cmd.Context(), &types.QuerySlashInsurancePayoutsRequest{
+				ValidatorAddress: valAddr.String(),
+				SlashRecordId:    slashRecordID,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash insurance payouts")
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordClaimByDenom implements the query for the claim on a released
+// tokenize share record by share denom
+func GetCmdQueryTokenizeShareRecordClaimByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-claim [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the claim on a released tokenize share record by share denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the claim on the unbonded tokens of a released tokenize share record
+by the denom of its share tokens, along with the tokens currently held for the claim.
+
+Example:
+$ %s query staking tokenize-share-record-claim cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordClaimByDenom(cmd.Context(), &types.QueryTokenizeShareRecordClaimByDenomRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllTokenizeShareRecordClaims implements the query for the claims on all released
+// tokenize share records
+func GetCmdQueryAllTokenizeShareRecordClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-tokenize-share-record-claims",
+		Args:  cobra.NoArgs,
+		Short: "Query the claims on all released tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the claims on the unbonded tokens of all released tokenize share records.
+
+Example:
+$ %s query staking all-tokenize-share-record-claims
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllTokenizeShareRecordClaims(cmd.Context(), &types.QueryAllTokenizeShareRecordClaimsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share record claims")
+
+	return cmd
+}
+
+// GetCmdQueryTokenizationPauseStatus implements the query for whether tokenization and
+// redemptions are paused for a validator
+func GetCmdQueryTokenizationPauseStatus() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenization-pause-status [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether tokenization and redemptions are paused for a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether share tokens can be minted against a validator or redeemed, and
+whether that is because of the global pause, a pause of the validator or the validator being jailed.
+
+Example:
+$ %s query staking tokenization-pause-status %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizationPauseStatus(cmd.Context(), &types.QueryTokenizationPauseStatusRequest{
+				ValidatorAddress: valAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizationPauses implements the query for all tokenization pauses set by governance
+func GetCmdQueryTokenizationPauses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenization-pauses",
+		Args:  cobra.NoArgs,
+		Short: "Query all tokenization pauses set by governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the global tokenization pause, if set, and the pauses of single validators.
+
+Example:
+$ %s query staking tokenization-pauses
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizationPauses(cmd.Context(), &types.QueryTokenizationPausesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenization pauses")
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -15,6 +16,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -47,9 +49,16 @@ func NewTxCmd() *cobra.Command {
 		NewTokenizeSharesCmd(),
 		NewRedeemTokensCmd(),
 		NewTransferTokenizeShareRecordCmd(),
+		NewProposeTokenizeShareRecordTransferCmd(),
+		NewAcceptTokenizeShareRecordTransferCmd(),
+		NewCancelTokenizeShareRecordTransferCmd(),
 		NewDisableTokenizeShares(),
 		NewEnableTokenizeShares(),
+		NewCancelEnableTokenizeShares(),
 		NewValidatorBondCmd(),
+		NewGrantTokenizeSharesCmd(),
+		NewFundSlashInsuranceCmd(),
+		NewClaimTokenizeShareRecordCmd(),
 	)
 
 	return stakingTxCmd
@@ -80,6 +89,7 @@ func NewCreateValidatorCmd() *cobra.Command {
 	cmd.Flags().AddFlagSet(FlagSetAmount())
 	cmd.Flags().AddFlagSet(flagSetDescriptionCreate())
 	cmd.Flags().AddFlagSet(FlagSetCommissionCreate())
+	cmd.Flags().AddFlagSet(flagSetLiquidStakingPolicy())
 
 	cmd.Flags().String(FlagIP, "", fmt.Sprintf("The node's public IP. It takes effect only when used in combination with --%s", flags.FlagGenerateOnly))
 	cmd.Flags().String(FlagNodeID, "", "The node's ID")
@@ -124,12 +134,25 @@ func NewEditValidatorCmd() *cobra.Command {
 
 			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate)
 
+			// the policy is replaced as a whole, so it is only sent if one of its flags is set
+			for _, flagName := range []string{FlagLiquidStakingCap, FlagAllowedLiquidStakingProviders, FlagDisableTokenization} {
+				if cmd.Flags().Changed(flagName) {
+					policy, err := buildLiquidStakingPolicy(cmd.Flags())
+					if err != nil {
+						return err
+					}
+					msg.LiquidStakingPolicy = &policy
+					break
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().AddFlagSet(flagSetDescriptionEdit())
 	cmd.Flags().AddFlagSet(flagSetCommissionUpdate())
+	cmd.Flags().AddFlagSet(flagSetLiquidStakingPolicy())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -346,6 +369,11 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	if err != nil {
 		return txf, nil, err
 	}
+
+	msg.LiquidStakingPolicy, err = buildLiquidStakingPolicy(fs)
+	if err != nil {
+		return txf, nil, err
+	}
 	if err := msg.ValidateBasic(); err != nil {
 		return txf, nil, err
 	}
@@ -682,6 +710,138 @@ $ %s tx staking transfer-tokenize-share-record 1 %s1gghjut3ccd8ay0zduzj64hwre2fx
 	return cmd
 }
 
+// NewProposeTokenizeShareRecordTransferCmd defines a command to propose a transfer of ownership of TokenizeShareRecord
+func NewProposeTokenizeShareRecordTransferCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "propose-tokenize-share-record-transfer [record-id] [new-owner]",
+		Short: "Propose a transfer of ownership of TokenizeShareRecord",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Propose a transfer of ownership of TokenizeShareRecord.
+The new owner must accept the transfer before it expires after one unbonding period.
+Proposing a new transfer replaces any transfer already pending for the record.
+
+Example:
+$ %s tx staking propose-tokenize-share-record-transfer 1 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			ownerAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProposeTokenizeShareRecordTransfer{
+				Sender:                clientCtx.GetFromAddress().String(),
+				TokenizeShareRecordId: recordID,
+				NewOwner:              ownerAddr.String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAcceptTokenizeShareRecordTransferCmd defines a command to accept a pending transfer of ownership of TokenizeShareRecord
+func NewAcceptTokenizeShareRecordTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-tokenize-share-record-transfer [record-id]",
+		Short: "Accept a pending transfer of ownership of TokenizeShareRecord",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept a pending transfer of ownership of TokenizeShareRecord.
+The transaction must be signed by the proposed new owner.
+
+Example:
+$ %s tx staking accept-tokenize-share-record-transfer 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcceptTokenizeShareRecordTransfer{
+				NewOwner:              clientCtx.GetFromAddress().String(),
+				TokenizeShareRecordId: recordID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelTokenizeShareRecordTransferCmd defines a command to cancel a pending transfer of ownership of TokenizeShareRecord
+func NewCancelTokenizeShareRecordTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-tokenize-share-record-transfer [record-id]",
+		Short: "Cancel a pending transfer of ownership of TokenizeShareRecord",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a pending transfer of ownership of TokenizeShareRecord.
+The transaction must be signed by the current owner.
+
+Example:
+$ %s tx staking cancel-tokenize-share-record-transfer 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelTokenizeShareRecordTransfer{
+				Sender:                clientCtx.GetFromAddress().String(),
+				TokenizeShareRecordId: recordID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewDisableTokenizeShares defines a command to disable tokenization for an address
 func NewDisableTokenizeShares() *cobra.Command {
 	cmd := &cobra.Command{
@@ -751,6 +911,41 @@ $ %s tx staking enable-tokenize-shares --from mykey
 	return cmd
 }
 
+// NewCancelEnableTokenizeShares defines a command to cancel a pending re-enablement
+// of tokenization for an address
+func NewCancelEnableTokenizeShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-enable-tokenize-shares",
+		Short: "Cancel a pending enablement of tokenization of shares",
+		Args:  cobra.ExactArgs(0),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancels a pending re-enablement of tokenization for an address.
+The address remains locked and must send a new enable-tokenize-shares transaction
+(and wait another unbonding period) if they later wish to tokenize.
+
+Example:
+$ %s tx staking cancel-enable-tokenize-shares --from mykey
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelEnableTokenizeShares{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewValidatorBondCmd defines a command to mark a delegation as a validator self bond
 func NewValidatorBondCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -785,3 +980,197 @@ $ %s tx staking validator-bond cosmosvaloper13h5xdxhsdaugwdrkusf8lkgu406h8t62jkq
 
 	return cmd
 }
+
+// NewFundSlashInsuranceCmd defines a command to deposit tokens into the slash insurance fund
+func NewFundSlashInsuranceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-slash-insurance [amount]",
+		Short: "Deposit tokens into the slash insurance fund",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit tokens into the slash insurance fund, which reimburses tokenize share
+records for what they lose when their validator is slashed. Only the bond denom is accepted.
+
+Example:
+$ %s tx staking fund-slash-insurance 1000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundSlashInsurance(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimTokenizeShareRecordCmd defines a command to burn the share tokens of a released
+// tokenize share record for their part of the record's unbonded tokens
+func NewClaimTokenizeShareRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-tokenize-share-record [amount]",
+		Short: "Burn share tokens of a released tokenize share record for its unbonded tokens",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn share tokens of a tokenize share record that was released after its validator
+was tombstoned or left without tokens, in exchange for their part of the record's unbonded tokens.
+The tokens can be claimed once the unbonding of the record completes.
+
+Example:
+$ %s tx staking claim-tokenize-share-record 1000cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimTokenizeShareRecord(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewGrantTokenizeSharesCmd defines a command to grant a TokenizeShareAuthorization
+func NewGrantTokenizeSharesCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "grant-tokenize-shares [grantee] [validator-addr[=expiration]]...",
+		Short: "Grant an authorization to tokenize delegations on behalf of the granter",
+		Args:  cobra.MinimumNArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an authorization to tokenize delegations to the given validators on behalf of the granter.
+Each validator may be followed by an RFC3339 expiration after which it is no longer allowed.
+The tokenized share owner must be the --reward-owner if one is set, and otherwise either the
+granter or one of the --allowed-recipients.
+
+Example:
+$ %s tx staking grant-tokenize-shares %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj=2030-01-01T00:00:00Z --max-tokens 1000stake --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			validators := []types.TokenizeShareAuthorization_AllowedValidator{}
+			for _, arg := range args[1:] {
+				validatorArg, expirationArg, hasExpiration := strings.Cut(arg, "=")
+				valAddr, err := sdk.ValAddressFromBech32(validatorArg)
+				if err != nil {
+					return err
+				}
+
+				validator := types.TokenizeShareAuthorization_AllowedValidator{ValidatorAddress: valAddr.String()}
+				if hasExpiration {
+					expiration, err := time.Parse(time.RFC3339, expirationArg)
+					if err != nil {
+						return err
+					}
+					validator.Expiration = &expiration
+				}
+				validators = append(validators, validator)
+			}
+
+			var maxTokens *sdk.Coin
+			maxTokensStr, err := cmd.Flags().GetString(FlagMaxTokens)
+			if err != nil {
+				return err
+			}
+			if maxTokensStr != "" {
+				coin, err := sdk.ParseCoinNormalized(maxTokensStr)
+				if err != nil {
+					return err
+				}
+				maxTokens = &coin
+			}
+
+			var rewardOwner sdk.AccAddress
+			rewardOwnerStr, err := cmd.Flags().GetString(FlagRewardOwner)
+			if err != nil {
+				return err
+			}
+			if rewardOwnerStr != "" {
+				rewardOwner, err = sdk.AccAddressFromBech32(rewardOwnerStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			recipientStrs, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return err
+			}
+			recipients := make([]sdk.AccAddress, len(recipientStrs))
+			for i, recipientStr := range recipientStrs {
+				recipients[i], err = sdk.AccAddressFromBech32(recipientStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			authorization, err := types.NewTokenizeShareAuthorization(validators, maxTokens, rewardOwner, recipients)
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMaxTokens, "", "The maximum cumulative amount of tokens that can be tokenized")
+	cmd.Flags().String(FlagRewardOwner, "", "The only address that may be set as the tokenized share owner")
+	cmd.Flags().StringSlice(FlagAllowedRecipients, []string{}, "Addresses, besides the granter, that may be set as the tokenized share owner")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp at which the grant expires. Default is one year.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
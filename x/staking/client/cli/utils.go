@@ -2,8 +2,11 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	flag "github.com/spf13/pflag"
+
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -31,3 +34,19 @@ func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commiss
 
 	return commission, nil
 }
+
+func buildLiquidStakingPolicy(fs *flag.FlagSet) (policy types.ValidatorLiquidStakingPolicy, err error) {
+	capStr, _ := fs.GetString(FlagLiquidStakingCap)
+	if capStr != "" {
+		liquidStakingCap, err := sdk.NewDecFromStr(capStr)
+		if err != nil {
+			return policy, fmt.Errorf("invalid liquid staking cap: %w", err)
+		}
+		policy.LiquidStakingCap = &liquidStakingCap
+	}
+
+	policy.AllowedLiquidStakingProviders, _ = fs.GetStringSlice(FlagAllowedLiquidStakingProviders)
+	policy.TokenizationDisabled, _ = fs.GetBool(FlagDisableTokenization)
+
+	return policy, nil
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/client/cli"
+)
+
+var (
+	// UpdateParamsProposalHandler is the update staking params proposal handler.
+	UpdateParamsProposalHandler = govclient.NewProposalHandler(cli.NewSubmitUpdateParamsProposalCmd, unsupportedRESTHandler("update_staking_params"))
+	// UpdateSlashInsuranceCoverageProposalHandler is the update slash insurance coverage proposal handler.
+	UpdateSlashInsuranceCoverageProposalHandler = govclient.NewProposalHandler(cli.NewSubmitUpdateSlashInsuranceCoverageProposalCmd, unsupportedRESTHandler("update_slash_insurance_coverage"))
+	// PauseTokenizationProposalHandler is the pause tokenization proposal handler.
+	PauseTokenizationProposalHandler = govclient.NewProposalHandler(cli.NewSubmitPauseTokenizationProposalCmd, unsupportedRESTHandler("pause_tokenization"))
+	// UnpauseTokenizationProposalHandler is the unpause tokenization proposal handler.
+	UnpauseTokenizationProposalHandler = govclient.NewProposalHandler(cli.NewSubmitUnpauseTokenizationProposalCmd, unsupportedRESTHandler("unpause_tokenization"))
+)
+
+// unsupportedRESTHandler returns a REST handler that rejects the proposal, since the
+// x/staking proposals can only be submitted through gRPC or the CLI
+func unsupportedRESTHandler(subRoute string) govclient.RESTHandlerFn {
+	return func(client.Context) govrest.ProposalRESTHandler {
+		return govrest.ProposalRESTHandler{
+			SubRoute: subRoute,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST routes are not supported for x/staking proposals")
+			},
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -38,15 +39,121 @@ func WriteValidators(ctx sdk.Context, keeper keeper.Keeper) (vals []tmtypes.Gene
 }
 
 // ValidateGenesis validates the provided staking genesis state to ensure the
-// expected invariants holds. (i.e. params in correct bounds, no duplicate validators)
+// expected invariants holds. (i.e. params in correct bounds, no duplicate validators,
+// consistent tokenize share records and validator bond shares)
 func ValidateGenesis(data *types.GenesisState) error {
 	if err := validateGenesisStateValidators(data.Validators); err != nil {
 		return err
 	}
 
+	if err := validateGenesisStateDelegations(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizeShareRecords(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateSlashRecords(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateSlashInsurance(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizeShareRecordClaims(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizationPauses(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStatePendingTokenizeShareRecordTransfers(data); err != nil {
+		return err
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("total liquid staked tokens cannot be negative: %s", data.TotalLiquidStakedTokens)
+	}
+
+	if !data.TotalLiquidStakedResidue.IsNil() &&
+		(data.TotalLiquidStakedResidue.IsNegative() || data.TotalLiquidStakedResidue.GTE(sdk.OneDec())) {
+		return fmt.Errorf("total liquid staked residue must be in [0, 1): %s", data.TotalLiquidStakedResidue)
+	}
+
 	return data.Params.Validate()
 }
 
+// ValidateLiquidStakingGenesis cross-checks the liquid staking state against the genesis
+// accounts and bank supply, which are only available from the full app genesis:
+//   - each validator's total liquid shares must match the delegations from liquid staking
+//     providers and tokenize share record module accounts
+//   - each tokenize share record must have share tokens in supply, and every share token
+//     in supply must have a record, or a claim if its record was released
+//
+// It assumes the staking genesis has already passed ValidateGenesis.
+func ValidateLiquidStakingGenesis(data *types.GenesisState, accounts authtypes.GenesisAccounts, supply sdk.Coins) error {
+	liquidDelegators := make(map[string]bool)
+	for _, account := range accounts {
+		if types.IsLiquidStakingProvider(account) {
+			liquidDelegators[account.GetAddress().String()] = true
+		}
+	}
+
+	shareTokenRecords := make(map[string]uint64, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		liquidDelegators[record.GetModuleAddress().String()] = true
+		shareTokenRecords[record.GetShareTokenDenom()] = record.Id
+	}
+	// The share tokens of a released record stay in supply until they are claimed
+	for _, claim := range data.TokenizeShareRecordClaims {
+		shareTokenRecords[claim.Record.GetShareTokenDenom()] = claim.Record.Id
+	}
+
+	liquidShares := make(map[string]sdk.Dec, len(data.Validators))
+	for _, delegation := range data.Delegations {
+		if !liquidDelegators[delegation.DelegatorAddress] {
+			continue
+		}
+		shares, ok := liquidShares[delegation.ValidatorAddress]
+		if !ok {
+			shares = sdk.ZeroDec()
+		}
+		liquidShares[delegation.ValidatorAddress] = shares.Add(delegation.Shares)
+	}
+
+	for _, validator := range data.Validators {
+		expected, ok := liquidShares[validator.OperatorAddress]
+		if !ok {
+			expected = sdk.ZeroDec()
+		}
+		if !validator.TotalLiquidShares.Equal(expected) {
+			return fmt.Errorf("validator %s has total liquid shares %s, but its liquid delegations have %s shares",
+				validator.OperatorAddress, validator.TotalLiquidShares, expected)
+		}
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		if !supply.AmountOf(record.GetShareTokenDenom()).IsPositive() {
+			return fmt.Errorf("tokenize share record %d has no %s share tokens in supply",
+				record.Id, record.GetShareTokenDenom())
+		}
+	}
+
+	for _, coin := range supply {
+		if _, _, err := types.ParseShareTokenDenom(coin.Denom); err != nil {
+			continue
+		}
+		if _, found := shareTokenRecords[coin.Denom]; !found {
+			return fmt.Errorf("share token %s in supply has no tokenize share record or claim", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -79,8 +186,282 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 			return fmt.Errorf("bonded/unbonded genesis validator cannot have zero delegator shares, validator: %v", val)
 		}
 
+		if err := val.LiquidStakingPolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid liquid staking policy of validator %s: %w", val.OperatorAddress, err)
+		}
+
 		addrMap[strKey] = true
 	}
 
 	return nil
 }
+
+// validateGenesisStateDelegations checks that every delegation is to a genesis validator,
+// and that each validator's validator bond shares match its validator bond delegations
+func validateGenesisStateDelegations(data *types.GenesisState) error {
+	validatorBondShares := make(map[string]sdk.Dec, len(data.Validators))
+	for _, validator := range data.Validators {
+		validatorBondShares[validator.OperatorAddress] = sdk.ZeroDec()
+	}
+
+	for _, delegation := range data.Delegations {
+		shares, found := validatorBondShares[delegation.ValidatorAddress]
+		if !found {
+			return fmt.Errorf("delegation from %s is to unknown validator %s",
+				delegation.DelegatorAddress, delegation.ValidatorAddress)
+		}
+		if delegation.ValidatorBond {
+			validatorBondShares[delegation.ValidatorAddress] = shares.Add(delegation.Shares)
+		}
+	}
+
+	for _, validator := range data.Validators {
+		expected := validatorBondShares[validator.OperatorAddress]
+		if !validator.TotalValidatorBondShares.Equal(expected) {
+			return fmt.Errorf("validator %s has total validator bond shares %s, but its validator bond delegations have %s shares",
+				validator.OperatorAddress, validator.TotalValidatorBondShares, expected)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisStateTokenizeShareRecords checks that each tokenize share record has a
+// unique id no greater than the last record id, and is backed by a delegation from its
+// module account to a genesis validator. Each validator's total tokenized shares must
+// match the delegations of its records
+func validateGenesisStateTokenizeShareRecords(data *types.GenesisState) error {
+	validators := make(map[string]bool, len(data.Validators))
+	for _, validator := range data.Validators {
+		validators[validator.OperatorAddress] = true
+	}
+
+	delegations := make(map[string]sdk.Dec, len(data.Delegations))
+	for _, delegation := range data.Delegations {
+		delegations[delegation.DelegatorAddress+"/"+delegation.ValidatorAddress] = delegation.Shares
+	}
+
+	tokenizedShares := make(map[string]sdk.Dec, len(data.Validators))
+	for _, validator := range data.Validators {
+		tokenizedShares[validator.OperatorAddress] = sdk.ZeroDec()
+	}
+
+	ids := make(map[uint64]bool, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record id %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.Id == 0 || record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("tokenize share record id %d must be between 1 and the last tokenize share record id %d",
+				record.Id, data.LastTokenizeShareRecordId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("tokenize share record %d has an invalid owner: %w", record.Id, err)
+		}
+
+		if expected := fmt.Sprintf("%s%d", types.TokenizeShareModuleAccountPrefix, record.Id); record.ModuleAccount != expected {
+			return fmt.Errorf("tokenize share record %d has module account %s, expected %s",
+				record.Id, record.ModuleAccount, expected)
+		}
+
+		if !validators[record.Validator] {
+			return fmt.Errorf("tokenize share record %d is for unknown validator %s", record.Id, record.Validator)
+		}
+
+		shares, found := delegations[record.GetModuleAddress().String()+"/"+record.Validator]
+		if !found {
+			return fmt.Errorf("tokenize share record %d has no delegation from its module account to %s",
+				record.Id, record.Validator)
+		}
+		tokenizedShares[record.Validator] = tokenizedShares[record.Validator].Add(shares)
+	}
+
+	// genesis files created before the running total was exported leave it unset,
+	// in which case it is computed by InitGenesis
+	for _, validator := range data.Validators {
+		if validator.TotalTokenizedShares.IsNil() {
+			continue
+		}
+		expected := tokenizedShares[validator.OperatorAddress]
+		if !validator.TotalTokenizedShares.Equal(expected) {
+			return fmt.Errorf("validator %s has total tokenized shares %s, but its tokenize share record delegations have %s shares",
+				validator.OperatorAddress, validator.TotalTokenizedShares, expected)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisStateSlashRecords checks that the slash records have unique ids up to the
+// last slash record id and belong to known validators
+func validateGenesisStateSlashRecords(data *types.GenesisState) error {
+	validators := make(map[string]bool, len(data.Validators))
+	for _, validator := range data.Validators {
+		validators[validator.OperatorAddress] = true
+	}
+
+	ids := make(map[uint64]bool, len(data.SlashRecords))
+	for _, record := range data.SlashRecords {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate slash record id %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.Id == 0 || record.Id > data.LastSlashRecordId {
+			return fmt.Errorf("slash record id %d must be between 1 and the last slash record id %d",
+				record.Id, data.LastSlashRecordId)
+		}
+
+		if !validators[record.ValidatorAddress] {
+			return fmt.Errorf("slash record %d is for unknown validator %s", record.Id, record.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateSlashInsurance(data *types.GenesisState) error {
+	// The coverage is left unset by genesis files from before the slash insurance fund
+	coverage := data.SlashInsuranceCoverage
+	if !coverage.CoverageFraction.IsNil() || !coverage.MaxPayoutPerSlash.IsNil() {
+		if err := coverage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	slashRecordValidators := make(map[uint64]string, len(data.SlashRecords))
+	for _, record := range data.SlashRecords {
+		slashRecordValidators[record.Id] = record.ValidatorAddress
+	}
+
+	type payoutID struct{ slashRecordID, tokenizeShareRecordID uint64 }
+	payouts := make(map[payoutID]bool, len(data.SlashInsurancePayouts))
+	for _, payout := range data.SlashInsurancePayouts {
+		id := payoutID{payout.SlashRecordId, payout.TokenizeShareRecordId}
+		if payouts[id] {
+			return fmt.Errorf("duplicate slash insurance payout for slash record %d and tokenize share record %d",
+				payout.SlashRecordId, payout.TokenizeShareRecordId)
+		}
+		payouts[id] = true
+
+		validatorAddress, found := slashRecordValidators[payout.SlashRecordId]
+		if !found || validatorAddress != payout.ValidatorAddress {
+			return fmt.Errorf("slash insurance payout is for unknown slash record %d of validator %s",
+				payout.SlashRecordId, payout.ValidatorAddress)
+		}
+
+		if payout.TokenizeShareRecordId == 0 || payout.TokenizeShareRecordId > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("slash insurance payout tokenize share record id %d must be between 1 and the last tokenize share record id %d",
+				payout.TokenizeShareRecordId, data.LastTokenizeShareRecordId)
+		}
+
+		if payout.Amount.IsNil() || !payout.Amount.IsPositive() {
+			return fmt.Errorf("slash insurance payout for slash record %d must have a positive amount", payout.SlashRecordId)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisStateTokenizeShareRecordClaims checks that each claim is on a released
+// record, which is no longer a tokenize share record. The validator of a released record
+// may have been removed, so it is not checked
+func validateGenesisStateTokenizeShareRecordClaims(data *types.GenesisState) error {
+	records := make(map[uint64]bool, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		records[record.Id] = true
+	}
+
+	claims := make(map[uint64]bool, len(data.TokenizeShareRecordClaims))
+	for _, claim := range data.TokenizeShareRecordClaims {
+		record := claim.Record
+		if claims[record.Id] {
+			return fmt.Errorf("duplicate claim on tokenize share record %d", record.Id)
+		}
+		claims[record.Id] = true
+
+		if records[record.Id] {
+			return fmt.Errorf("claim on tokenize share record %d that has not been released", record.Id)
+		}
+
+		if record.Id == 0 || record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("claim tokenize share record id %d must be between 1 and the last tokenize share record id %d",
+				record.Id, data.LastTokenizeShareRecordId)
+		}
+
+		if expected := fmt.Sprintf("%s%d", types.TokenizeShareModuleAccountPrefix, record.Id); record.ModuleAccount != expected {
+			return fmt.Errorf("claim on tokenize share record %d has module account %s, expected %s",
+				record.Id, record.ModuleAccount, expected)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(record.Validator); err != nil {
+			return fmt.Errorf("claim on tokenize share record %d has an invalid validator: %w", record.Id, err)
+		}
+
+		if claim.Shares.IsNil() || claim.Shares.IsNegative() || claim.Tokens.IsNil() || claim.Tokens.IsNegative() {
+			return fmt.Errorf("claim on tokenize share record %d must have non-negative shares and tokens", record.Id)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisStateTokenizationPauses checks that there is at most one pause per validator
+// and one global pause. A paused validator may have been removed, so it is not checked
+func validateGenesisStateTokenizationPauses(data *types.GenesisState) error {
+	pauses := make(map[string]bool, len(data.TokenizationPauses))
+	for _, pause := range data.TokenizationPauses {
+		if err := pause.Validate(); err != nil {
+			return fmt.Errorf("invalid tokenization pause validator address %s: %w", pause.ValidatorAddress, err)
+		}
+
+		if pauses[pause.ValidatorAddress] {
+			if pause.IsGlobal() {
+				return fmt.Errorf("duplicate global tokenization pause")
+			}
+			return fmt.Errorf("duplicate tokenization pause for validator %s", pause.ValidatorAddress)
+		}
+		pauses[pause.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+// validateGenesisStatePendingTokenizeShareRecordTransfers checks that each pending transfer
+// is proposed by the owner of an existing tokenize share record, with at most one per record
+func validateGenesisStatePendingTokenizeShareRecordTransfers(data *types.GenesisState) error {
+	owners := make(map[uint64]string, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		owners[record.Id] = record.Owner
+	}
+
+	transfers := make(map[uint64]bool, len(data.PendingTokenizeShareRecordTransfers))
+	for _, transfer := range data.PendingTokenizeShareRecordTransfers {
+		recordID := transfer.TokenizeShareRecordId
+		if transfers[recordID] {
+			return fmt.Errorf("duplicate pending transfer of tokenize share record %d", recordID)
+		}
+		transfers[recordID] = true
+
+		owner, found := owners[recordID]
+		if !found {
+			return fmt.Errorf("pending transfer of tokenize share record %d that does not exist", recordID)
+		}
+		if transfer.Owner != owner {
+			return fmt.Errorf("pending transfer of tokenize share record %d is from %s, but the record is owned by %s",
+				recordID, transfer.Owner, owner)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(transfer.NewOwner); err != nil {
+			return fmt.Errorf("pending transfer of tokenize share record %d has an invalid new owner: %w", recordID, err)
+		}
+		if transfer.NewOwner == transfer.Owner {
+			return fmt.Errorf("pending transfer of tokenize share record %d is to its owner", recordID)
+		}
+	}
+
+	return nil
+}
@@ -20,6 +20,53 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	// copied, since the cases below mutate genValidators1
+	genValidator := genValidators1[0]
+	valAddr := genValidator.OperatorAddress
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         sdk.AccAddress(pk.Address()).String(),
+		ModuleAccount: types.TokenizeShareModuleAccountPrefix + "1",
+		Validator:     valAddr,
+	}
+	recordDelegation := types.NewDelegation(record.GetModuleAddress(), genValidator.GetOperator(), sdk.OneDec(), false)
+	withRecord := func(data *types.GenesisState) {
+		data.Validators = []types.Validator{genValidator}
+		data.Validators[0].TotalTokenizedShares = sdk.OneDec()
+		data.Delegations = []types.Delegation{recordDelegation}
+		data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+		data.LastTokenizeShareRecordId = 1
+	}
+	newOwner := sdk.AccAddress(pk.Address()[1:]).String()
+	withTransfer := func(data *types.GenesisState) {
+		withRecord(data)
+		data.PendingTokenizeShareRecordTransfers = []types.PendingTokenizeShareRecordTransfer{{
+			TokenizeShareRecordId: record.Id,
+			Owner:                 record.Owner,
+			NewOwner:              newOwner,
+		}}
+	}
+	withClaim := func(data *types.GenesisState) {
+		data.TokenizeShareRecordClaims = []types.TokenizeShareRecordClaim{{
+			Record: record,
+			Shares: sdk.OneDec(),
+			Tokens: sdk.OneInt(),
+		}}
+		data.LastTokenizeShareRecordId = 1
+	}
+	withPayout := func(data *types.GenesisState) {
+		withRecord(data)
+		data.SlashRecords = []types.SlashRecord{{Id: 1, ValidatorAddress: valAddr}}
+		data.LastSlashRecordId = 1
+		data.SlashInsurancePayouts = []types.SlashInsurancePayout{{
+			SlashRecordId:         1,
+			ValidatorAddress:      valAddr,
+			TokenizeShareRecordId: 1,
+			Amount:                sdk.OneInt(),
+			Shares:                sdk.OneDec(),
+		}}
+	}
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -29,7 +76,7 @@ func TestValidateGenesis(t *testing.T) {
 		// validate genesis validators
 		{"duplicate validator", func(data *types.GenesisState) {
 			data.Validators = genValidators1
-			data.Validators = append(data.Validators, genValidators1[0])
+			data.Validators = append(data.Validators, genValidator)
 		}, true},
 		{"no delegator shares", func(data *types.GenesisState) {
 			data.Validators = genValidators1
@@ -40,6 +87,176 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdkstaking.Bonded
 		}, true},
+		{"validator liquid staking policy", func(data *types.GenesisState) {
+			liquidStakingCap := sdk.MustNewDecFromStr("0.1")
+			data.Validators = []types.Validator{genValidator}
+			data.Validators[0].LiquidStakingPolicy = types.ValidatorLiquidStakingPolicy{
+				LiquidStakingCap:              &liquidStakingCap,
+				AllowedLiquidStakingProviders: []string{record.Owner},
+				TokenizationDisabled:          true,
+			}
+		}, false},
+		{"validator liquid staking cap above one", func(data *types.GenesisState) {
+			liquidStakingCap := sdk.MustNewDecFromStr("1.1")
+			data.Validators = []types.Validator{genValidator}
+			data.Validators[0].LiquidStakingPolicy.LiquidStakingCap = &liquidStakingCap
+		}, true},
+		{"invalid allowed liquid staking provider", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.Validators[0].LiquidStakingPolicy.AllowedLiquidStakingProviders = []string{valAddr}
+		}, true},
+		// validate delegations
+		{"delegation to unknown validator", func(data *types.GenesisState) {
+			data.Delegations = []types.Delegation{recordDelegation}
+		}, true},
+		{"validator bond shares match", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.Validators[0].TotalValidatorBondShares = sdk.OneDec()
+			data.Delegations = []types.Delegation{types.NewDelegation(sdk.AccAddress(pk.Address()), genValidator.GetOperator(), sdk.OneDec(), true)}
+		}, false},
+		{"validator bond shares mismatch", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.Validators[0].TotalValidatorBondShares = sdk.NewDec(2)
+			data.Delegations = []types.Delegation{types.NewDelegation(sdk.AccAddress(pk.Address()), genValidator.GetOperator(), sdk.OneDec(), true)}
+		}, true},
+		{"negative total liquid staked tokens", func(data *types.GenesisState) {
+			data.TotalLiquidStakedTokens = sdk.NewInt(-1)
+		}, true},
+		{"total liquid staked residue below one token", func(data *types.GenesisState) {
+			data.TotalLiquidStakedResidue = sdk.MustNewDecFromStr("0.5")
+		}, false},
+		{"total liquid staked residue of a whole token", func(data *types.GenesisState) {
+			data.TotalLiquidStakedResidue = sdk.OneDec()
+		}, true},
+		// validate slash records
+		{"slash record", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.SlashRecords = []types.SlashRecord{{Id: 1, ValidatorAddress: valAddr}}
+			data.LastSlashRecordId = 1
+		}, false},
+		{"slash record id above last id", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator}
+			data.SlashRecords = []types.SlashRecord{{Id: 2, ValidatorAddress: valAddr}}
+			data.LastSlashRecordId = 1
+		}, true},
+		{"slash record for unknown validator", func(data *types.GenesisState) {
+			data.SlashRecords = []types.SlashRecord{{Id: 1, ValidatorAddress: valAddr}}
+			data.LastSlashRecordId = 1
+		}, true},
+		// validate slash insurance
+		{"slash insurance coverage", func(data *types.GenesisState) {
+			data.SlashInsuranceCoverage = types.SlashInsuranceCoverage{
+				CoverageFraction:  sdk.NewDecWithPrec(5, 1),
+				MaxPayoutPerSlash: sdk.NewInt(100),
+			}
+		}, false},
+		{"slash insurance coverage unset", func(data *types.GenesisState) {
+			data.SlashInsuranceCoverage = types.SlashInsuranceCoverage{}
+		}, false},
+		{"slash insurance coverage above one", func(data *types.GenesisState) {
+			data.SlashInsuranceCoverage.CoverageFraction = sdk.NewDecWithPrec(15, 1)
+		}, true},
+		{"slash insurance payout", withPayout, false},
+		{"duplicate slash insurance payout", func(data *types.GenesisState) {
+			withPayout(data)
+			data.SlashInsurancePayouts = append(data.SlashInsurancePayouts, data.SlashInsurancePayouts[0])
+		}, true},
+		{"slash insurance payout for unknown slash record", func(data *types.GenesisState) {
+			withPayout(data)
+			data.SlashRecords = nil
+			data.LastSlashRecordId = 0
+		}, true},
+		{"slash insurance payout for unknown tokenize share record", func(data *types.GenesisState) {
+			withPayout(data)
+			data.SlashInsurancePayouts[0].TokenizeShareRecordId = 2
+		}, true},
+		{"slash insurance payout without amount", func(data *types.GenesisState) {
+			withPayout(data)
+			data.SlashInsurancePayouts[0].Amount = sdk.ZeroInt()
+		}, true},
+		// validate tokenize share record claims
+		{"tokenize share record claim", withClaim, false},
+		{"duplicate tokenize share record claim", func(data *types.GenesisState) {
+			withClaim(data)
+			data.TokenizeShareRecordClaims = append(data.TokenizeShareRecordClaims, data.TokenizeShareRecordClaims[0])
+		}, true},
+		{"claim on a tokenize share record that was not released", func(data *types.GenesisState) {
+			withRecord(data)
+			withClaim(data)
+		}, true},
+		{"claim tokenize share record id above last id", func(data *types.GenesisState) {
+			withClaim(data)
+			data.LastTokenizeShareRecordId = 0
+		}, true},
+		{"claim with negative tokens", func(data *types.GenesisState) {
+			withClaim(data)
+			data.TokenizeShareRecordClaims[0].Tokens = sdk.NewInt(-1)
+		}, true},
+		// validate tokenization pauses
+		{"tokenization pauses", func(data *types.GenesisState) {
+			data.TokenizationPauses = []types.TokenizationPause{{}, {ValidatorAddress: valAddr, PauseRedemptions: true}}
+		}, false},
+		{"duplicate global tokenization pause", func(data *types.GenesisState) {
+			data.TokenizationPauses = []types.TokenizationPause{{}, {PauseRedemptions: true}}
+		}, true},
+		{"duplicate validator tokenization pause", func(data *types.GenesisState) {
+			data.TokenizationPauses = []types.TokenizationPause{{ValidatorAddress: valAddr}, {ValidatorAddress: valAddr}}
+		}, true},
+		{"tokenization pause with invalid validator address", func(data *types.GenesisState) {
+			data.TokenizationPauses = []types.TokenizationPause{{ValidatorAddress: "invalid"}}
+		}, true},
+		// validate pending tokenize share record transfers
+		{"pending tokenize share record transfer", withTransfer, false},
+		{"duplicate pending tokenize share record transfer", func(data *types.GenesisState) {
+			withTransfer(data)
+			data.PendingTokenizeShareRecordTransfers = append(data.PendingTokenizeShareRecordTransfers, data.PendingTokenizeShareRecordTransfers[0])
+		}, true},
+		{"pending transfer of unknown tokenize share record", func(data *types.GenesisState) {
+			withTransfer(data)
+			data.PendingTokenizeShareRecordTransfers[0].TokenizeShareRecordId = 2
+		}, true},
+		{"pending tokenize share record transfer not from the owner", func(data *types.GenesisState) {
+			withTransfer(data)
+			data.PendingTokenizeShareRecordTransfers[0].Owner = sdk.AccAddress(pk.Address()[2:]).String()
+		}, true},
+		{"pending tokenize share record transfer with invalid new owner", func(data *types.GenesisState) {
+			withTransfer(data)
+			data.PendingTokenizeShareRecordTransfers[0].NewOwner = valAddr
+		}, true},
+		{"pending tokenize share record transfer to the owner", func(data *types.GenesisState) {
+			withTransfer(data)
+			data.PendingTokenizeShareRecordTransfers[0].NewOwner = record.Owner
+		}, true},
+		// validate tokenize share records
+		{"tokenize share record", withRecord, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			withRecord(data)
+			data.TokenizeShareRecords = append(data.TokenizeShareRecords, record)
+		}, true},
+		{"tokenize share record id above last id", func(data *types.GenesisState) {
+			withRecord(data)
+			data.LastTokenizeShareRecordId = 0
+		}, true},
+		{"tokenize share record with wrong module account", func(data *types.GenesisState) {
+			withRecord(data)
+			data.TokenizeShareRecords[0].ModuleAccount = types.TokenizeShareModuleAccountPrefix + "2"
+		}, true},
+		{"tokenize share record for unknown validator", func(data *types.GenesisState) {
+			withRecord(data)
+			data.TokenizeShareRecords[0].Validator = sdk.ValAddress(pk.Address()[1:]).String()
+		}, true},
+		{"tokenize share record without delegation", func(data *types.GenesisState) {
+			withRecord(data)
+			data.Delegations = nil
+		}, true},
+		{"tokenized shares mismatch", func(data *types.GenesisState) {
+			withRecord(data)
+			data.Validators[0].TotalTokenizedShares = sdk.ZeroDec()
+		}, true},
+		{"tokenized shares unset", func(data *types.GenesisState) {
+			withRecord(data)
+			data.Validators[0].TotalTokenizedShares = sdk.Dec{}
+		}, false},
 	}
 
 	for _, tt := range tests {
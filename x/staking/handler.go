@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
@@ -52,6 +53,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgProposeTokenizeShareRecordTransfer:
+			res, err := msgServer.ProposeTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAcceptTokenizeShareRecordTransfer:
+			res, err := msgServer.AcceptTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelTokenizeShareRecordTransfer:
+			res, err := msgServer.CancelTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgDisableTokenizeShares:
 			res, err := msgServer.DisableTokenizeShares(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -60,8 +73,42 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.EnableTokenizeShares(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCancelEnableTokenizeShares:
+			res, err := msgServer.CancelEnableTokenizeShares(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFundSlashInsurance:
+			res, err := msgServer.FundSlashInsurance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgClaimTokenizeShareRecord:
+			res, err := msgServer.ClaimTokenizeShareRecord(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewProposalHandler creates a governance handler for the x/staking proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateParamsProposal:
+			return keeper.HandleUpdateParamsProposal(ctx, k, c)
+
+		case *types.UpdateSlashInsuranceCoverageProposal:
+			return keeper.HandleUpdateSlashInsuranceCoverageProposal(ctx, k, c)
+
+		case *types.PauseTokenizationProposal:
+			return keeper.HandlePauseTokenizationProposal(ctx, k, c)
+
+		case *types.UnpauseTokenizationProposal:
+			return keeper.HandleUnpauseTokenizationProposal(ctx, k, c)
+
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
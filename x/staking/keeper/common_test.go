@@ -6,11 +6,14 @@ import (
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -31,7 +34,9 @@ func createTestInput(t *testing.T) (*codec.LegacyAmino, *simapp.SimApp, sdk.Cont
 		app.GetKey(types.StoreKey),
 		app.AccountKeeper,
 		app.BankKeeper,
+		&app.NFTKeeper,
 		app.GetSubspace(types.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 	return app.LegacyAmino(), app, ctx
 }
@@ -57,3 +62,36 @@ func delegateCoinsFromAccount(ctx sdk.Context, app *simapp.SimApp, addr sdk.AccA
 
 	return err
 }
+
+// createDelegatedValidators creates a validator with the given status for each of the operator
+// addresses, using the test public keys in order as consensus keys, and delegates operatorTokens
+// from its operator, if positive, and delegatorTokens from each of the delegators
+func createDelegatedValidators(
+	t *testing.T, ctx sdk.Context, app *simapp.SimApp, valAddrs []sdk.ValAddress, status sdkstaking.BondStatus,
+	operatorTokens sdk.Int, delegatorTokens sdk.Int, delegators ...sdk.AccAddress,
+) {
+	for i, valAddr := range valAddrs {
+		validator := teststaking.NewValidator(t, valAddr, PKs[i])
+		validator.Status = status
+		app.StakingKeeper.SetValidator(ctx, validator)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+		require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+
+		delegate := func(addr sdk.AccAddress, amount sdk.Int) {
+			validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+			require.NoError(t, delegateCoinsFromAccount(ctx, app, addr, amount, validator))
+		}
+		if operatorTokens.IsPositive() {
+			delegate(sdk.AccAddress(valAddr), operatorTokens)
+		}
+		for _, addr := range delegators {
+			delegate(addr, delegatorTokens)
+		}
+	}
+}
+
+// requireInvariants checks that none of the staking invariants are broken
+func requireInvariants(t *testing.T, ctx sdk.Context, app *simapp.SimApp) {
+	msg, broken := keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken, msg)
+}
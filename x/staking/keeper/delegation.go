@@ -68,6 +68,63 @@ func (k Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegati
 	return delegations
 }
 
+// IterateLiquidDelegations iterates through the delegations held by liquid staking
+// providers, using the liquid delegation index.
+func (k Keeper) IterateLiquidDelegations(ctx sdk.Context, cb func(delegation types.Delegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.LiquidDelegationIndexKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := k.mustGetDelegationFromLiquidDelegationIndexKey(store, iterator.Key())
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetAllLiquidDelegations returns all delegations held by liquid staking providers.
+func (k Keeper) GetAllLiquidDelegations(ctx sdk.Context) (delegations []types.Delegation) {
+	k.IterateLiquidDelegations(ctx, func(delegation types.Delegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+
+	return delegations
+}
+
+// mustGetDelegationFromLiquidDelegationIndexKey returns the delegation that a liquid
+// delegation index key points to. The index is kept in sync with the delegations by
+// SetDelegation and RemoveDelegation, so a missing delegation is a bug
+func (k Keeper) mustGetDelegationFromLiquidDelegationIndexKey(store sdk.KVStore, indexKey []byte) types.Delegation {
+	value := store.Get(types.GetDelegationKeyFromLiquidDelegationIndexKey(indexKey))
+	if value == nil {
+		panic(fmt.Sprintf("liquid delegation index entry %X has no delegation", indexKey))
+	}
+
+	return types.MustUnmarshalDelegation(k.cdc, value)
+}
+
+// GetValidatorBondDelegations returns the validator bond delegations to a validator, using
+// the validator bond delegation index.
+func (k Keeper) GetValidatorBondDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorBondDelegationsIndexKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		value := store.Get(types.GetDelegationKeyFromValidatorBondDelegationIndexKey(iterator.Key()))
+		if value == nil {
+			panic(fmt.Sprintf("validator bond delegation index entry %X has no delegation", iterator.Key()))
+		}
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, value))
+	}
+
+	return delegations
+}
+
 // GetValidatorDelegations returns all delegations to a specific validator.
 // Useful for querier.
 func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []types.Delegation) { //nolint:interfacer
@@ -113,6 +170,20 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetLiquidDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+
+	// delegations held by liquid staking providers are indexed so that the liquid
+	// staked totals can be recalculated without iterating every delegation
+	if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+		store.Set(types.GetLiquidDelegationIndexKey(delegatorAddress, delegation.GetValidatorAddr()), []byte{})
+	}
+
+	// validator bond delegations are indexed by validator so that a slash can find them
+	validatorBondIndexKey := types.GetValidatorBondDelegationIndexKey(delegatorAddress, delegation.GetValidatorAddr())
+	if delegation.ValidatorBond {
+		store.Set(validatorBondIndexKey, []byte{})
+	} else {
+		store.Delete(validatorBondIndexKey)
+	}
 }
 
 // RemoveDelegation removes a delegation
@@ -126,6 +197,8 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetLiquidDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	store.Delete(types.GetLiquidDelegationIndexKey(delegatorAddress, delegation.GetValidatorAddr()))
+	store.Delete(types.GetValidatorBondDelegationIndexKey(delegatorAddress, delegation.GetValidatorAddr()))
 	return nil
 }
 
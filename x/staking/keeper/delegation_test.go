@@ -143,6 +143,72 @@ func TestDelegation(t *testing.T) {
 	require.Equal(t, 0, len(resBonds))
 }
 
+// tests that SetDelegation and RemoveDelegation maintain the liquid delegation index
+func TestLiquidDelegationIndex(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	valAddrs := simapp.ConvertAddrsToValAddrs(simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000)))
+	providerAddress := createICAAccount(app, ctx, "provider")
+	regularAddress := createBaseAccount(app, ctx, "regular")
+
+	providerDelegationA := types.NewDelegation(providerAddress, valAddrs[0], sdk.NewDec(100), false)
+	providerDelegationB := types.NewDelegation(providerAddress, valAddrs[1], sdk.NewDec(200), false)
+	regularDelegation := types.NewDelegation(regularAddress, valAddrs[0], sdk.NewDec(300), false)
+	for _, delegation := range []types.Delegation{providerDelegationA, providerDelegationB, regularDelegation} {
+		app.StakingKeeper.SetDelegation(ctx, delegation)
+	}
+
+	// only the delegations from the liquid staking provider are indexed
+	require.ElementsMatch(t, []types.Delegation{providerDelegationA, providerDelegationB}, app.StakingKeeper.GetAllLiquidDelegations(ctx))
+
+	// updating a delegation keeps a single index entry that points to the new shares
+	providerDelegationA.Shares = sdk.NewDec(150)
+	app.StakingKeeper.SetDelegation(ctx, providerDelegationA)
+	require.ElementsMatch(t, []types.Delegation{providerDelegationA, providerDelegationB}, app.StakingKeeper.GetAllLiquidDelegations(ctx))
+
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, providerDelegationB))
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, regularDelegation))
+	require.Equal(t, []types.Delegation{providerDelegationA}, app.StakingKeeper.GetAllLiquidDelegations(ctx))
+
+	_, broken := keeper.LiquidDelegationIndexInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+}
+
+// tests that SetDelegation and RemoveDelegation maintain the validator bond delegation index
+func TestValidatorBondDelegationIndex(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:2])
+
+	bondDelegationA := types.NewDelegation(addrs[2], valAddrs[0], sdk.NewDec(100), true)
+	bondDelegationB := types.NewDelegation(addrs[3], valAddrs[0], sdk.NewDec(200), true)
+	bondDelegationOther := types.NewDelegation(addrs[2], valAddrs[1], sdk.NewDec(300), true)
+	regularDelegation := types.NewDelegation(addrs[3], valAddrs[1], sdk.NewDec(400), false)
+	for _, delegation := range []types.Delegation{bondDelegationA, bondDelegationB, bondDelegationOther, regularDelegation} {
+		app.StakingKeeper.SetDelegation(ctx, delegation)
+	}
+
+	// only the validator bond delegations are indexed, by validator
+	require.ElementsMatch(t, []types.Delegation{bondDelegationA, bondDelegationB}, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddrs[0]))
+	require.Equal(t, []types.Delegation{bondDelegationOther}, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddrs[1]))
+
+	// clearing the flag drops the index entry
+	bondDelegationB.ValidatorBond = false
+	app.StakingKeeper.SetDelegation(ctx, bondDelegationB)
+	require.Equal(t, []types.Delegation{bondDelegationA}, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddrs[0]))
+
+	// setting the flag adds it
+	regularDelegation.ValidatorBond = true
+	app.StakingKeeper.SetDelegation(ctx, regularDelegation)
+	require.ElementsMatch(t, []types.Delegation{bondDelegationOther, regularDelegation}, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddrs[1]))
+
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, bondDelegationA))
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, regularDelegation))
+	require.Empty(t, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddrs[0]))
+	require.Equal(t, []types.Delegation{bondDelegationOther}, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddrs[1]))
+}
+
 // tests Get/Set/Remove UnbondingDelegation
 func TestUnbondingDelegation(t *testing.T) {
 	_, app, ctx := createTestInput(t)
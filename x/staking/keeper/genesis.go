@@ -29,7 +29,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	k.SetParams(ctx, data.Params)
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
+	// genesis files created before the total was exported leave it unset
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	}
+	if !data.TotalLiquidStakedResidue.IsNil() {
+		k.SetTotalLiquidStakedResidue(ctx, data.TotalLiquidStakedResidue)
+	}
+
+	tokenizedShares := genesisTokenizedShares(data)
 	for _, validator := range data.Validators {
+		// genesis files created before the running total was exported leave it unset,
+		// so it is computed from the tokenize share record delegations
+		if validator.TotalTokenizedShares.IsNil() {
+			validator.TotalTokenizedShares = sdk.ZeroDec()
+			if shares, found := tokenizedShares[validator.OperatorAddress]; found {
+				validator.TotalTokenizedShares = shares
+			}
+		}
+
 		k.SetValidator(ctx, validator)
 
 		// Manually set indices for the first time
@@ -101,6 +119,40 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, record := range data.SlashRecords {
+		k.SetSlashRecord(ctx, record)
+	}
+	k.SetLastSlashRecordID(ctx, data.LastSlashRecordId)
+
+	coverage := data.SlashInsuranceCoverage
+	if coverage.CoverageFraction.IsNil() || coverage.MaxPayoutPerSlash.IsNil() {
+		coverage = types.DefaultSlashInsuranceCoverage()
+	}
+	k.SetSlashInsuranceCoverage(ctx, coverage)
+
+	for _, payout := range data.SlashInsurancePayouts {
+		k.SetSlashInsurancePayout(ctx, payout)
+	}
+
+	for _, claim := range data.TokenizeShareRecordClaims {
+		k.SetTokenizeShareRecordClaim(ctx, claim)
+	}
+
+	for _, pause := range data.TokenizationPauses {
+		k.SetTokenizationPause(ctx, pause)
+	}
+
+	for _, transfer := range data.PendingTokenizeShareRecordTransfers {
+		k.SetPendingTokenizeShareRecordTransfer(ctx, transfer)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -168,6 +220,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	return res
 }
 
+// genesisTokenizedShares returns the shares delegated to each validator by the
+// tokenize share record module accounts in the genesis state
+func genesisTokenizedShares(data *types.GenesisState) map[string]sdk.Dec {
+	recordAccounts := make(map[string]bool, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		recordAccounts[record.GetModuleAddress().String()] = true
+	}
+
+	tokenizedShares := make(map[string]sdk.Dec)
+	for _, delegation := range data.Delegations {
+		if !recordAccounts[delegation.DelegatorAddress] {
+			continue
+		}
+		shares, found := tokenizedShares[delegation.ValidatorAddress]
+		if !found {
+			shares = sdk.ZeroDec()
+		}
+		tokenizedShares[delegation.ValidatorAddress] = shares.Add(delegation.Shares)
+	}
+
+	return tokenizedShares
+}
+
 // ExportGenesis returns a GenesisState for a given context and keeper. The
 // GenesisState will contain the pool, params, validators, and bonds found in
 // the keeper.
@@ -194,13 +269,24 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                              k.GetParams(ctx),
+		LastTotalPower:                      k.GetLastTotalPower(ctx),
+		LastValidatorPowers:                 lastValidatorPowers,
+		Validators:                          k.GetAllValidators(ctx),
+		Delegations:                         k.GetAllDelegations(ctx),
+		UnbondingDelegations:                unbondingDelegations,
+		Redelegations:                       redelegations,
+		Exported:                            true,
+		TokenizeShareRecords:                k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId:           k.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:             k.GetTotalLiquidStakedTokens(ctx),
+		TotalLiquidStakedResidue:            k.GetTotalLiquidStakedResidue(ctx),
+		SlashRecords:                        k.GetAllSlashRecords(ctx),
+		LastSlashRecordId:                   k.GetLastSlashRecordID(ctx),
+		SlashInsuranceCoverage:              k.GetSlashInsuranceCoverage(ctx),
+		SlashInsurancePayouts:               k.GetAllSlashInsurancePayouts(ctx),
+		TokenizeShareRecordClaims:           k.GetAllTokenizeShareRecordClaims(ctx),
+		TokenizationPauses:                  k.GetAllTokenizationPauses(ctx),
+		PendingTokenizeShareRecordTransfers: k.GetAllPendingTokenizeShareRecordTransfers(ctx),
 	}
 }
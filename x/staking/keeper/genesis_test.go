@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -11,9 +12,12 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -218,3 +222,110 @@ func TestInitGenesisLargeValidatorSet(t *testing.T) {
 	vals = vals[:100]
 	require.Equal(t, abcivals, vals)
 }
+
+// Tokenizes shares of a slashed validator, from a regular account and a liquid staking
+// provider, and checks that the exported genesis passes the liquid staking validation
+func TestExportGenesisLiquidStaking(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, power(100))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	validator := teststaking.NewValidator(t, valAddr, PKs[0])
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrs[0], power(30), validator))
+	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrs[1], power(30), validator))
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: addrs[0].String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+
+	// Slash so that the validator's exchange rate is no longer 1
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 60, sdk.MustNewDecFromStr("0.1"), sdkstaking.InfractionEmpty)
+
+	icaAddr := createICAAccount(app, ctx, "ica")
+	require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, icaAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, power(20)))))
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(icaAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, power(20))))
+	require.NoError(t, err)
+
+	for _, delegator := range []sdk.AccAddress{addrs[1], icaAddr} {
+		_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delegator.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3_333_333)),
+			TokenizedShareOwner: addrs[1].String(),
+		})
+		require.NoError(t, err)
+	}
+
+	genesis := app.StakingKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.TokenizeShareRecords, 2)
+	require.Equal(t, uint64(2), genesis.LastTokenizeShareRecordId)
+
+	var accounts authtypes.GenesisAccounts
+	for _, account := range app.AccountKeeper.GetAllAccounts(ctx) {
+		accounts = append(accounts, account.(authtypes.GenesisAccount))
+	}
+	supply := sdk.NewCoins()
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = supply.Add(coin)
+		return false
+	})
+
+	require.NoError(t, staking.ValidateGenesis(genesis))
+	require.NoError(t, staking.ValidateLiquidStakingGenesis(genesis, accounts, supply))
+
+	// Without the share tokens in supply, the records are unbacked
+	require.Error(t, staking.ValidateLiquidStakingGenesis(genesis, accounts, sdk.NewCoins()))
+
+	// A validator's liquid shares must match its liquid delegations
+	genesis.Validators[1].TotalLiquidShares = genesis.Validators[1].TotalLiquidShares.Add(sdk.OneDec())
+	require.Error(t, staking.ValidateLiquidStakingGenesis(genesis, accounts, supply))
+}
+
+// Imports a pending tokenize share record transfer and checks that it is exported and
+// that it expires from the rebuilt queue
+func TestInitGenesisPendingTokenizeShareRecordTransfer(t *testing.T) {
+	app, ctx, addrs := bootstrapGenesisTest(t, 2)
+	owner, newOwner := addrs[0], addrs[1]
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner.String(),
+		ModuleAccount: types.TokenizeShareModuleAccountPrefix + "1",
+		Validator:     valAddr.String(),
+	}
+	transfer := types.PendingTokenizeShareRecordTransfer{
+		TokenizeShareRecordId: record.Id,
+		Owner:                 owner.String(),
+		NewOwner:              newOwner.String(),
+		ExpirationTime:        time.Unix(1000, 0).UTC(),
+	}
+
+	genesisState := app.StakingKeeper.ExportGenesis(ctx)
+	genesisState.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+	genesisState.LastTokenizeShareRecordId = record.Id
+	genesisState.PendingTokenizeShareRecordTransfers = []types.PendingTokenizeShareRecordTransfer{transfer}
+	app.StakingKeeper.InitGenesis(ctx, genesisState)
+
+	actualGenesis := app.StakingKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.PendingTokenizeShareRecordTransfer{transfer}, actualGenesis.PendingTokenizeShareRecordTransfers)
+
+	// The transfer is queued to expire at its expiration time
+	require.Empty(t, app.StakingKeeper.RemoveExpiredTokenizeShareRecordTransfers(ctx, transfer.ExpirationTime.Add(-time.Second)))
+	require.Equal(t, []types.PendingTokenizeShareRecordTransfer{transfer},
+		app.StakingKeeper.RemoveExpiredTokenizeShareRecordTransfers(ctx, transfer.ExpirationTime))
+	require.Empty(t, app.StakingKeeper.ExportGenesis(ctx).PendingTokenizeShareRecordTransfers)
+}
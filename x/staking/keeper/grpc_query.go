@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -617,36 +618,34 @@ func (k Querier) LastTokenizeShareRecordId(c context.Context, req *types.QueryLa
 }
 
 // Query for total tokenized staked assets
+// The value is computed from each validator's running total of tokenized shares,
+// so the query does not need to iterate every tokenize share record
 func (k Querier) TotalTokenizeSharedAssets(c context.Context, req *types.QueryTotalTokenizeSharedAssetsRequest) (*types.QueryTotalTokenizeSharedAssetsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	records := k.GetAllTokenizeShareRecords(ctx)
+	bondDenom := k.BondDenom(ctx)
 	totalTokenizeShared := sdk.ZeroInt()
+	validators := []types.ValidatorTokenizedAssets{}
 
-	for _, record := range records {
-		moduleAcc := record.GetModuleAddress()
-		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
-		if err != nil {
-			return nil, err
-		}
-
-		validator, found := k.GetLiquidValidator(ctx, valAddr)
-		if !found {
-			return nil, sdkstaking.ErrNoValidatorFound
+	for _, validator := range k.GetAllValidators(ctx) {
+		if !validator.TotalTokenizedShares.IsPositive() {
+			continue
 		}
 
-		delegation, found := k.GetLiquidDelegation(ctx, moduleAcc, valAddr)
-		if !found {
-			return nil, sdkstaking.ErrNoDelegation
-		}
-
-		tokens := validator.TokensFromShares(delegation.Shares)
-		totalTokenizeShared = totalTokenizeShared.Add(tokens.RoundInt())
+		tokens := validator.TokensFromShares(validator.TotalTokenizedShares).RoundInt()
+		totalTokenizeShared = totalTokenizeShared.Add(tokens)
+		validators = append(validators, types.ValidatorTokenizedAssets{
+			ValidatorAddress: validator.OperatorAddress,
+			TokenizedShares:  validator.TotalTokenizedShares,
+			Value:            sdk.NewCoin(bondDenom, tokens),
+		})
 	}
+
 	return &types.QueryTotalTokenizeSharedAssetsResponse{
-		Value: sdk.NewCoin(k.BondDenom(ctx), totalTokenizeShared),
+		Value:      sdk.NewCoin(bondDenom, totalTokenizeShared),
+		Validators: validators,
 	}, nil
 }
 
@@ -664,6 +663,59 @@ func (k Querier) TotalLiquidStaked(c context.Context, req *types.QueryTotalLiqui
 	}, nil
 }
 
+// Query the progress of a recalculation of the liquid staked totals
+func (k Querier) TotalLiquidStakedRefreshStatus(c context.Context, req *types.QueryTotalLiquidStakedRefreshStatusRequest) (*types.QueryTotalLiquidStakedRefreshStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	refresh, found := k.GetTotalLiquidStakedRefresh(ctx)
+	return &types.QueryTotalLiquidStakedRefreshStatusResponse{
+		InProgress:           found,
+		StartHeight:          refresh.StartHeight,
+		DelegationsProcessed: refresh.DelegationsProcessed,
+	}, nil
+}
+
+// Query for the delegations held by liquid staking providers
+func (k Querier) LiquidDelegations(c context.Context, req *types.QueryLiquidDelegationsRequest) (*types.QueryLiquidDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var delegations []types.Delegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.LiquidDelegationIndexKey)
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		value := store.Get(append(types.DelegationKey, key...))
+		if value == nil {
+			return fmt.Errorf("liquid delegation index entry %X has no delegation", key)
+		}
+
+		delegation, err := types.UnmarshalDelegation(k.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delResponses, err := DelegationsToDelegationResponses(ctx, k.Keeper, delegations)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidDelegationsResponse{
+		DelegationResponses: delResponses, Pagination: pageRes,
+	}, nil
+}
+
 // Query status of an account's tokenize share lock
 func (k Querier) TokenizeShareLockInfo(c context.Context, req *types.QueryTokenizeShareLockInfo) (*types.QueryTokenizeShareLockInfoResponse, error) {
 	if req == nil {
@@ -684,3 +736,284 @@ func (k Querier) TokenizeShareLockInfo(c context.Context, req *types.QueryTokeni
 		ExpirationTime: timeString,
 	}, nil
 }
+
+// Query for the pending ownership transfer of a tokenize share record
+func (k Querier) PendingTokenizeShareRecordTransfer(c context.Context, req *types.QueryPendingTokenizeShareRecordTransferRequest) (*types.QueryPendingTokenizeShareRecordTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	transfer, found := k.GetPendingTokenizeShareRecordTransfer(ctx, req.TokenizeShareRecordId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no pending transfer for tokenize share record %d", req.TokenizeShareRecordId)
+	}
+
+	return &types.QueryPendingTokenizeShareRecordTransferResponse{
+		Transfer: transfer,
+	}, nil
+}
+
+// Query for all pending tokenize share record transfers, optionally filtered by new owner
+func (k Querier) PendingTokenizeShareRecordTransfers(c context.Context, req *types.QueryPendingTokenizeShareRecordTransfersRequest) (*types.QueryPendingTokenizeShareRecordTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.NewOwner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.NewOwner); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	transfers := []types.PendingTokenizeShareRecordTransfer{}
+	for _, transfer := range k.GetAllPendingTokenizeShareRecordTransfers(ctx) {
+		if req.NewOwner == "" || transfer.NewOwner == req.NewOwner {
+			transfers = append(transfers, transfer)
+		}
+	}
+
+	return &types.QueryPendingTokenizeShareRecordTransfersResponse{
+		Transfers: transfers,
+	}, nil
+}
+
+// TokenizeShareRecordsBySlash queries the tokenize share records delegated to a slashed
+// validator, with the token value of each record before and after the slash
+func (k Querier) TokenizeShareRecordsBySlash(c context.Context, req *types.QueryTokenizeShareRecordsBySlashRequest) (*types.QueryTokenizeShareRecordsBySlashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	slashRecord, found := k.GetSlashRecord(ctx, valAddr, req.SlashRecordId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "slash record %d of validator %s not found", req.SlashRecordId, req.ValidatorAddress)
+	}
+
+	// The only shares removed by the slash are those of the validator bond delegations,
+	// which never belong to a tokenize share record
+	tokensAfter := slashRecord.TokensBefore.Sub(slashRecord.TokensBurned)
+	sharesAfter := slashRecord.DelegatorShares.Sub(slashRecord.ValidatorBondSharesRemoved)
+	tokensFromShares := func(shares sdk.Dec, tokens sdk.Int, totalShares sdk.Dec) sdk.Dec {
+		if !totalShares.IsPositive() {
+			return sdk.ZeroDec()
+		}
+		return shares.MulInt(tokens).Quo(totalShares)
+	}
+
+	var records []types.SlashedTokenizeShareRecord
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.GetTokenizeShareRecordIDsByValidatorPrefix(valAddr))
+	pageRes, err := query.FilteredPaginate(recordStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return false, nil
+		}
+
+		delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, types.SlashedTokenizeShareRecord{
+				Record:       record,
+				Shares:       delegation.Shares,
+				TokensBefore: tokensFromShares(delegation.Shares, slashRecord.TokensBefore, slashRecord.DelegatorShares),
+				TokensAfter:  tokensFromShares(delegation.Shares, tokensAfter, sharesAfter),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsBySlashResponse{
+		SlashRecord: slashRecord,
+		Records:     records,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// SlashInsuranceFund queries the balance and coverage of the slash insurance fund
+func (k Querier) SlashInsuranceFund(c context.Context, req *types.QuerySlashInsuranceFundRequest) (*types.QuerySlashInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySlashInsuranceFundResponse{
+		Balance:  k.GetSlashInsuranceFundBalance(ctx),
+		Coverage: k.GetSlashInsuranceCoverage(ctx),
+	}, nil
+}
+
+// SlashInsurancePayouts queries the payouts the slash insurance fund made for the slashes
+// of a validator, or for one of its slashes if a slash record id is given
+func (k Querier) SlashInsurancePayouts(c context.Context, req *types.QuerySlashInsurancePayoutsRequest) (*types.QuerySlashInsurancePayoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prefixKey := types.GetSlashInsurancePayoutsByValidatorKey(valAddr)
+	if req.SlashRecordId != 0 {
+		prefixKey = types.GetSlashInsurancePayoutsBySlashKey(valAddr, req.SlashRecordId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var payouts []types.SlashInsurancePayout
+	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(payoutStore, req.Pagination, func(key []byte, value []byte) error {
+		var payout types.SlashInsurancePayout
+		if err := k.cdc.Unmarshal(value, &payout); err != nil {
+			return err
+		}
+
+		payouts = append(payouts, payout)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashInsurancePayoutsResponse{
+		Payouts:    payouts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenizeShareRecordClaimByDenom queries the claim on a released tokenize share record by share denom
+func (k Querier) TokenizeShareRecordClaimByDenom(
+	c context.Context, req *types.QueryTokenizeShareRecordClaimByDenomRequest,
+) (*types.QueryTokenizeShareRecordClaimByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	claim, found := k.GetTokenizeShareRecordClaim(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no claim for share denom %s", req.Denom)
+	}
+
+	return &types.QueryTokenizeShareRecordClaimByDenomResponse{
+		Claim:   claim,
+		Balance: k.GetTokenizeShareRecordClaimBalance(ctx, claim),
+	}, nil
+}
+
+// AllTokenizeShareRecordClaims queries the claims on all released tokenize share records
+func (k Querier) AllTokenizeShareRecordClaims(
+	c context.Context, req *types.QueryAllTokenizeShareRecordClaimsRequest,
+) (*types.QueryAllTokenizeShareRecordClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var claims []types.TokenizeShareRecordClaim
+	claimStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenizeShareRecordClaimPrefix)
+	pageRes, err := query.Paginate(claimStore, req.Pagination, func(key []byte, value []byte) error {
+		var claim types.TokenizeShareRecordClaim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return err
+		}
+
+		claims = append(claims, claim)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenizeShareRecordClaimsResponse{
+		Claims:     claims,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenizationPauseStatus queries whether tokenization and redemptions are paused for a
+// validator, and which pauses apply
+func (k Querier) TokenizationPauseStatus(
+	c context.Context, req *types.QueryTokenizationPauseStatusRequest,
+) (*types.QueryTokenizationPauseStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	}
+
+	globalPause, pausedGlobally := k.GetGlobalTokenizationPause(ctx)
+	validatorPause, pausedForValidator := k.GetValidatorTokenizationPause(ctx, valAddr)
+
+	return &types.QueryTokenizationPauseStatusResponse{
+		TokenizationPaused: k.CheckTokenizationAllowed(ctx, validator) != nil,
+		RedemptionsPaused:  globalPause.PauseRedemptions || validatorPause.PauseRedemptions,
+		PausedGlobally:     pausedGlobally,
+		PausedForValidator: pausedForValidator,
+		PausedByJailing:    validator.IsJailed(),
+	}, nil
+}
+
+// TokenizationPauses queries the global tokenization pause and the pauses of single validators
+func (k Querier) TokenizationPauses(
+	c context.Context, req *types.QueryTokenizationPausesRequest,
+) (*types.QueryTokenizationPausesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var globalPause *types.TokenizationPause
+	if pause, found := k.GetGlobalTokenizationPause(ctx); found {
+		globalPause = &pause
+	}
+
+	var pauses []types.TokenizationPause
+	pauseStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorTokenizationPausePrefix)
+	pageRes, err := query.Paginate(pauseStore, req.Pagination, func(key []byte, value []byte) error {
+		var pause types.TokenizationPause
+		if err := k.cdc.Unmarshal(value, &pause); err != nil {
+			return err
+		}
+
+		pauses = append(pauses, pause)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizationPausesResponse{
+		GlobalPause:     globalPause,
+		ValidatorPauses: pauses,
+		Pagination:      pageRes,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -789,6 +790,260 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidatorUnbondingDelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryLiquidDelegations() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+
+	// the delegations from the regular accounts created in the setup are not included
+	res, err := queryClient.LiquidDelegations(gocontext.Background(), &types.QueryLiquidDelegationsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DelegationResponses)
+
+	providerA := createICAAccount(app, ctx, "provider-a")
+	providerB := createICAAccount(app, ctx, "provider-b")
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(providerA, vals[0].GetOperator(), sdk.NewDec(100), false))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(providerA, vals[1].GetOperator(), sdk.NewDec(200), false))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(providerB, vals[0].GetOperator(), sdk.NewDec(300), false))
+
+	res, err = queryClient.LiquidDelegations(gocontext.Background(), &types.QueryLiquidDelegationsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DelegationResponses, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	nextRes, err := queryClient.LiquidDelegations(gocontext.Background(), &types.QueryLiquidDelegationsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(nextRes.DelegationResponses, 1)
+
+	shares := []sdk.Dec{}
+	for _, response := range append(res.DelegationResponses, nextRes.DelegationResponses...) {
+		shares = append(shares, response.Delegation.Shares)
+		suite.Require().Equal(app.StakingKeeper.BondDenom(ctx), response.Balance.Denom)
+	}
+	suite.Require().ElementsMatch([]sdk.Dec{sdk.NewDec(100), sdk.NewDec(200), sdk.NewDec(300)}, shares)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecordsBySlash() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+
+	// two records are delegated to the slashed validator and one to another validator
+	for i, validator := range []types.Validator{vals[0], vals[0], vals[1]} {
+		record := types.TokenizeShareRecord{
+			Id:            uint64(i + 1),
+			Owner:         suite.addrs[0].String(),
+			ModuleAccount: fmt.Sprintf("%s%d", types.TokenizeShareModuleAccountPrefix, i+1),
+			Validator:     validator.OperatorAddress,
+		}
+		suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+		app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(record.GetModuleAddress(), validator.GetOperator(), sdk.NewDec(int64(100*(i+1))), false))
+	}
+
+	slashRecord := app.StakingKeeper.AddSlashRecord(ctx, types.SlashRecord{
+		ValidatorAddress:     vals[0].OperatorAddress,
+		Height:               ctx.BlockHeight(),
+		InfractionHeight:     ctx.BlockHeight(),
+		InfractionType:       sdkstaking.DoubleSign.String(),
+		SlashFactor:          sdk.NewDecWithPrec(1, 1),
+		EffectiveFraction:    sdk.NewDecWithPrec(1, 1),
+		TokensBurned:         sdk.NewInt(100),
+		LiquidTokensDeducted: sdk.NewDec(30),
+		TokensBefore:         sdk.NewInt(1000),
+		DelegatorShares:      sdk.NewDec(1000),
+		// the validator bond delegations absorbed 60 of the burned tokens by giving
+		// up 1000 * 60 / 960 shares, so the other shares lose 4%
+		ValidatorBondFirstLoss:     sdk.NewInt(60),
+		ValidatorBondSharesRemoved: sdk.NewDec(62500).QuoInt64(1000),
+		InsurancePayout:            sdk.ZeroInt(),
+	})
+
+	_, err := queryClient.TokenizeShareRecordsBySlash(gocontext.Background(), &types.QueryTokenizeShareRecordsBySlashRequest{
+		ValidatorAddress: vals[1].OperatorAddress,
+		SlashRecordId:    slashRecord.Id,
+	})
+	suite.Require().Error(err, "slash record of another validator")
+
+	res, err := queryClient.TokenizeShareRecordsBySlash(gocontext.Background(), &types.QueryTokenizeShareRecordsBySlashRequest{
+		ValidatorAddress: vals[0].OperatorAddress,
+		SlashRecordId:    slashRecord.Id,
+		Pagination:       &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(slashRecord, res.SlashRecord)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().Len(res.Records, 2)
+
+	for i, record := range res.Records {
+		shares := sdk.NewDec(int64(100 * (i + 1)))
+		suite.Require().Equal(uint64(i+1), record.Record.Id)
+		suite.Require().Equal(shares, record.Shares)
+		suite.Require().Equal(shares, record.TokensBefore)
+		suite.Require().Equal(shares.Mul(sdk.NewDecWithPrec(96, 2)), record.TokensAfter)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySlashInsurance() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	coverage := types.SlashInsuranceCoverage{
+		CoverageFraction:  sdk.NewDecWithPrec(5, 1),
+		MaxPayoutPerSlash: sdk.NewInt(1000),
+	}
+	app.StakingKeeper.SetSlashInsuranceCoverage(ctx, coverage)
+
+	fundRes, err := queryClient.SlashInsuranceFund(gocontext.Background(), &types.QuerySlashInsuranceFundRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 0), fundRes.Balance)
+	suite.Require().Equal(coverage, fundRes.Coverage)
+
+	// two slashes of the first validator paid out to two records, and one slash of the
+	// second validator paid out to a third record
+	payouts := []types.SlashInsurancePayout{
+		{SlashRecordId: 1, ValidatorAddress: vals[0].OperatorAddress, TokenizeShareRecordId: 1, Amount: sdk.NewInt(10), Shares: sdk.NewDec(10)},
+		{SlashRecordId: 1, ValidatorAddress: vals[0].OperatorAddress, TokenizeShareRecordId: 2, Amount: sdk.NewInt(20), Shares: sdk.NewDec(20)},
+		{SlashRecordId: 2, ValidatorAddress: vals[0].OperatorAddress, TokenizeShareRecordId: 1, Amount: sdk.NewInt(30), Shares: sdk.NewDec(30)},
+		{SlashRecordId: 3, ValidatorAddress: vals[1].OperatorAddress, TokenizeShareRecordId: 3, Amount: sdk.NewInt(40), Shares: sdk.NewDec(40)},
+	}
+	for _, payout := range payouts {
+		app.StakingKeeper.SetSlashInsurancePayout(ctx, payout)
+	}
+
+	_, err = queryClient.SlashInsurancePayouts(gocontext.Background(), &types.QuerySlashInsurancePayoutsRequest{})
+	suite.Require().Error(err, "empty validator address")
+
+	res, err := queryClient.SlashInsurancePayouts(gocontext.Background(), &types.QuerySlashInsurancePayoutsRequest{
+		ValidatorAddress: vals[0].OperatorAddress,
+		Pagination:       &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(payouts[:3], res.Payouts)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.SlashInsurancePayouts(gocontext.Background(), &types.QuerySlashInsurancePayoutsRequest{
+		ValidatorAddress: vals[0].OperatorAddress,
+		SlashRecordId:    1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(payouts[:2], res.Payouts)
+
+	res, err = queryClient.SlashInsurancePayouts(gocontext.Background(), &types.QuerySlashInsurancePayoutsRequest{
+		ValidatorAddress: vals[1].OperatorAddress,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(payouts[3:], res.Payouts)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecordClaims() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	claims := []types.TokenizeShareRecordClaim{}
+	for i, validator := range []types.Validator{vals[0], vals[1]} {
+		record := types.TokenizeShareRecord{
+			Id:            uint64(i + 1),
+			Owner:         suite.addrs[0].String(),
+			ModuleAccount: fmt.Sprintf("%s%d", types.TokenizeShareModuleAccountPrefix, i+1),
+			Validator:     validator.OperatorAddress,
+		}
+		claim := types.TokenizeShareRecordClaim{
+			Record:         record,
+			Shares:         sdk.NewDec(int64(100 * (i + 1))),
+			Tokens:         sdk.NewInt(int64(100 * (i + 1))),
+			CompletionTime: ctx.BlockTime().UTC(),
+		}
+		app.StakingKeeper.SetTokenizeShareRecordClaim(ctx, claim)
+		claims = append(claims, claim)
+	}
+
+	// the unbonded tokens of the first claim have been sent to its module account
+	unbonded := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	suite.Require().NoError(simapp_test.FundAccount(app.BankKeeper, ctx, claims[0].Record.GetModuleAddress(), unbonded))
+
+	_, err := queryClient.TokenizeShareRecordClaimByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordClaimByDenomRequest{})
+	suite.Require().Error(err, "empty denom")
+
+	_, err = queryClient.TokenizeShareRecordClaimByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordClaimByDenomRequest{
+		Denom: "unknown",
+	})
+	suite.Require().Error(err, "unknown denom")
+
+	res, err := queryClient.TokenizeShareRecordClaimByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordClaimByDenomRequest{
+		Denom: claims[0].Record.GetShareTokenDenom(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(claims[0], res.Claim)
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 100), res.Balance)
+
+	allRes, err := queryClient.AllTokenizeShareRecordClaims(gocontext.Background(), &types.QueryAllTokenizeShareRecordClaimsRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(claims, allRes.Claims)
+	suite.Require().Equal(uint64(2), allRes.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizationPauses() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+
+	res, err := queryClient.TokenizationPauses(gocontext.Background(), &types.QueryTokenizationPausesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.GlobalPause)
+	suite.Require().Empty(res.ValidatorPauses)
+
+	pause := types.TokenizationPause{ValidatorAddress: vals[0].OperatorAddress, PauseRedemptions: true}
+	app.StakingKeeper.SetTokenizationPause(ctx, pause)
+	app.StakingKeeper.SetTokenizationPause(ctx, types.TokenizationPause{})
+
+	res, err = queryClient.TokenizationPauses(gocontext.Background(), &types.QueryTokenizationPausesRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.TokenizationPause{}, res.GlobalPause)
+	suite.Require().Equal([]types.TokenizationPause{pause}, res.ValidatorPauses)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+
+	_, err = queryClient.TokenizationPauseStatus(gocontext.Background(), &types.QueryTokenizationPauseStatusRequest{})
+	suite.Require().Error(err, "empty validator address")
+
+	// the first validator is paused by governance and jailed, the second only by the global pause
+	consAddr, err := vals[0].GetConsAddr()
+	suite.Require().NoError(err)
+	app.StakingKeeper.Jail(ctx, consAddr)
+	testCases := []struct {
+		validator types.Validator
+		expRes    types.QueryTokenizationPauseStatusResponse
+	}{
+		{vals[0], types.QueryTokenizationPauseStatusResponse{
+			TokenizationPaused: true,
+			RedemptionsPaused:  true,
+			PausedGlobally:     true,
+			PausedForValidator: true,
+			PausedByJailing:    true,
+		}},
+		{vals[1], types.QueryTokenizationPauseStatusResponse{
+			TokenizationPaused: true,
+			PausedGlobally:     true,
+		}},
+	}
+	for _, tc := range testCases {
+		res, err := queryClient.TokenizationPauseStatus(gocontext.Background(), &types.QueryTokenizationPauseStatusRequest{
+			ValidatorAddress: tc.validator.OperatorAddress,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.expRes, *res)
+	}
+
+	app.StakingKeeper.RemoveTokenizationPause(ctx, "")
+	statusRes, err := queryClient.TokenizationPauseStatus(gocontext.Background(), &types.QueryTokenizationPauseStatusRequest{
+		ValidatorAddress: vals[1].OperatorAddress,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(statusRes.TokenizationPaused)
+}
+
 func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sdk.AccAddress, []sdk.ValAddress, []types.Validator) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, app.StakingKeeper.TokensFromConsensusPower(ctx, 300))
 	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
@@ -799,7 +1054,9 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 		app.GetKey(types.StoreKey),
 		app.AccountKeeper,
 		app.BankKeeper,
+		&app.NFTKeeper,
 		app.GetSubspace(types.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 
 	val1 := teststaking.NewValidator(t, valAddrs[0], pks[0])
@@ -32,6 +32,7 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress,
 	return nil
 }
 
+// BeforeTokenizeShareRecordRemoved - call hook if registered
 func (k Keeper) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error {
 	if k.hooks != nil {
 		return k.hooks.BeforeTokenizeShareRecordRemoved(ctx, recordID)
@@ -39,6 +40,38 @@ func (k Keeper) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint6
 	return nil
 }
 
+// AfterTokenizeShareRecordCreated - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordCreated(ctx sdk.Context, recordID uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordCreated(ctx, recordID)
+	}
+	return nil
+}
+
+// AfterTokenizeSharesRedeemed - call hook if registered
+func (k Keeper) AfterTokenizeSharesRedeemed(ctx sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeSharesRedeemed(ctx, recordID, delAddr, valAddr, shares)
+	}
+	return nil
+}
+
+// AfterTokenizeShareRecordTransferred - call hook if registered
+func (k Keeper) AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTokenizeShareRecordTransferred(ctx, recordID, prevOwner, newOwner)
+	}
+	return nil
+}
+
+// AfterValidatorBondChanged - call hook if registered
+func (k Keeper) AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, validatorBond bool) error {
+	if k.hooks != nil {
+		return k.hooks.AfterValidatorBondChanged(ctx, delAddr, valAddr, validatorBond)
+	}
+	return nil
+}
+
 // AfterValidatorBonded - call hook if registered
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	if k.hooks != nil {
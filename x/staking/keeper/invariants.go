@@ -19,6 +19,14 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquid-shares",
+		LiquidSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-bond-shares",
+		ValidatorBondSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tokenized-shares",
+		TokenizedSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquid-delegation-index",
+		LiquidDelegationIndexInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -39,7 +47,34 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return LiquidStakingInvariants(k)(ctx)
+	}
+}
+
+// LiquidStakingInvariants runs the liquid staking invariants of the staking module.
+func LiquidStakingInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := LiquidSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ValidatorBondSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = TokenizedSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return LiquidDelegationIndexInvariant(k)(ctx)
 	}
 }
 
@@ -195,3 +230,195 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// LiquidSharesInvariant checks that the total liquid shares of each validator equal the
+// shares delegated to it by liquid staking providers and tokenize share record module
+// accounts. The check is skipped while the liquid staked totals are being refreshed.
+func LiquidSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+			return sdk.FormatInvariant(types.ModuleName, "liquid shares", msg), broken
+		}
+
+		validators := k.GetAllValidators(ctx)
+		validatorsLiquidShares := map[string]sdk.Dec{}
+		for _, validator := range validators {
+			validatorsLiquidShares[validator.GetOperator().String()] = sdk.ZeroDec()
+		}
+
+		liquidDelegations := append(k.GetAllLiquidDelegations(ctx), k.getTokenizeShareRecordDelegations(ctx)...)
+		for _, delegation := range liquidDelegations {
+			delegationValidatorAddr := delegation.GetValidatorAddr().String()
+			validatorsLiquidShares[delegationValidatorAddr] = validatorsLiquidShares[delegationValidatorAddr].Add(delegation.Shares)
+		}
+
+		for _, validator := range validators {
+			calculatedLiquidShares := validatorsLiquidShares[validator.GetOperator().String()]
+			if !calculatedLiquidShares.Equal(validator.TotalLiquidShares) {
+				broken = true
+				msg += fmt.Sprintf("broken liquid shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.TotalLiquidShares: %v\n"+
+					"\tsum of liquid Delegation.Shares: %v\n",
+					validator.OperatorAddress, validator.TotalLiquidShares, calculatedLiquidShares)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "liquid shares", msg), broken
+	}
+}
+
+// ValidatorBondSharesInvariant checks that the total validator bond shares of each
+// validator equal the shares of its delegations that are validator bonds, and that
+// exactly those delegations are in the validator bond delegation index.
+func ValidatorBondSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		validatorsBondShares := map[string]sdk.Dec{}
+		for _, validator := range validators {
+			validatorsBondShares[validator.GetOperator().String()] = sdk.ZeroDec()
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		for _, delegation := range k.GetAllDelegations(ctx) {
+			indexed := store.Has(types.GetValidatorBondDelegationIndexKey(delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()))
+			if indexed != delegation.ValidatorBond {
+				broken = true
+				msg += fmt.Sprintf("broken validator bond delegation index invariance:\n"+
+					"\tdelegation from %s to %s is indexed: %t\n",
+					delegation.DelegatorAddress, delegation.ValidatorAddress, indexed)
+			}
+
+			if !delegation.ValidatorBond {
+				continue
+			}
+			delegationValidatorAddr := delegation.GetValidatorAddr().String()
+			validatorsBondShares[delegationValidatorAddr] = validatorsBondShares[delegationValidatorAddr].Add(delegation.Shares)
+		}
+
+		for _, validator := range validators {
+			calculatedBondShares := validatorsBondShares[validator.GetOperator().String()]
+			if !calculatedBondShares.Equal(validator.TotalValidatorBondShares) {
+				broken = true
+				msg += fmt.Sprintf("broken validator bond shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.TotalValidatorBondShares: %v\n"+
+					"\tsum of validator bond Delegation.Shares: %v\n",
+					validator.OperatorAddress, validator.TotalValidatorBondShares, calculatedBondShares)
+			}
+		}
+
+		iterator := sdk.KVStorePrefixIterator(store, types.ValidatorBondDelegationIndexKey)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			if !store.Has(types.GetDelegationKeyFromValidatorBondDelegationIndexKey(iterator.Key())) {
+				broken = true
+				msg += fmt.Sprintf("broken validator bond delegation index invariance:\n"+
+					"\tindex entry %X has no delegation\n", iterator.Key())
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator bond shares", msg), broken
+	}
+}
+
+// TokenizedSharesInvariant checks that the total tokenized shares of each validator
+// equal the shares delegated to it by tokenize share record module accounts.
+func TokenizedSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validators := k.GetAllValidators(ctx)
+		validatorsTokenizedShares := map[string]sdk.Dec{}
+		for _, validator := range validators {
+			validatorsTokenizedShares[validator.GetOperator().String()] = sdk.ZeroDec()
+		}
+
+		for _, delegation := range k.getTokenizeShareRecordDelegations(ctx) {
+			delegationValidatorAddr := delegation.GetValidatorAddr().String()
+			validatorsTokenizedShares[delegationValidatorAddr] = validatorsTokenizedShares[delegationValidatorAddr].Add(delegation.Shares)
+		}
+
+		for _, validator := range validators {
+			calculatedTokenizedShares := validatorsTokenizedShares[validator.GetOperator().String()]
+			if !calculatedTokenizedShares.Equal(validator.TotalTokenizedShares) {
+				broken = true
+				msg += fmt.Sprintf("broken tokenized shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.TotalTokenizedShares: %v\n"+
+					"\tsum of tokenize share record Delegation.Shares: %v\n",
+					validator.OperatorAddress, validator.TotalTokenizedShares, calculatedTokenizedShares)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tokenized shares", msg), broken
+	}
+}
+
+// LiquidDelegationIndexInvariant checks that the liquid delegation index contains
+// exactly the delegations held by liquid staking providers.
+func LiquidDelegationIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+			indexed := store.Has(types.GetLiquidDelegationIndexKey(delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()))
+			if indexed != k.AccountIsLiquidStakingProvider(ctx, delegation.GetDelegatorAddr()) {
+				broken = true
+				msg += fmt.Sprintf("broken liquid delegation index invariance:\n"+
+					"\tdelegation from %s to %s is indexed: %t\n",
+					delegation.DelegatorAddress, delegation.ValidatorAddress, indexed)
+			}
+			return false
+		})
+
+		iterator := sdk.KVStorePrefixIterator(store, types.LiquidDelegationIndexKey)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			if !store.Has(types.GetDelegationKeyFromLiquidDelegationIndexKey(iterator.Key())) {
+				broken = true
+				msg += fmt.Sprintf("broken liquid delegation index invariance:\n"+
+					"\tindex entry %X has no delegation\n", iterator.Key())
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "liquid delegation index", msg), broken
+	}
+}
+
+// getTokenizeShareRecordDelegations returns the delegation of each tokenize share record's
+// module account to the record's validator
+func (k Keeper) getTokenizeShareRecordDelegations(ctx sdk.Context) (delegations []types.Delegation) {
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if found {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations
+}
@@ -4,7 +4,6 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
@@ -43,8 +42,7 @@ func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
 // This is determined by checking if the account is a 32-length module account
 func (k Keeper) AccountIsLiquidStakingProvider(ctx sdk.Context, address sdk.AccAddress) bool {
 	account := k.authKeeper.GetAccount(ctx, address)
-	_, isModuleAccount := account.(*authtypes.ModuleAccount)
-	return isModuleAccount && len(address) == 32
+	return account != nil && types.IsLiquidStakingProvider(account)
 }
 
 // CheckExceedsGlobalLiquidStakingCap checks if a liquid delegation would cause the
@@ -91,6 +89,8 @@ func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, validator types.Va
 // CheckExceedsValidatorLiquidStakingCap checks if a liquid delegation could cause the
 // total liuquid shares to exceed the liquid staking cap
 // A liquid delegation is defined as either tokenized shares, or a delegation from an ICA Account
+// The cap is the validator liquid staking cap param, or the cap of the validator's liquid
+// staking policy if it is lower
 // Returns true if the cap is exceeded
 func (k Keeper) CheckExceedsValidatorLiquidStakingCap(ctx sdk.Context, validator types.Validator, shares sdk.Dec) bool {
 	updatedLiquidShares := validator.TotalLiquidShares.Add(shares)
@@ -98,10 +98,23 @@ func (k Keeper) CheckExceedsValidatorLiquidStakingCap(ctx sdk.Context, validator
 
 	liquidStakePercent := updatedLiquidShares.Quo(updatedTotalShares)
 	liquidStakingCap := k.ValidatorLiquidStakingCap(ctx)
+	if policyCap := validator.LiquidStakingPolicy.LiquidStakingCap; policyCap != nil && policyCap.LT(liquidStakingCap) {
+		liquidStakingCap = *policyCap
+	}
 
 	return liquidStakePercent.GT(liquidStakingCap)
 }
 
+// CheckLiquidStakingProviderAllowed returns an error if the validator's liquid staking policy
+// does not allow a liquid staking provider to delegate to it
+func (k Keeper) CheckLiquidStakingProviderAllowed(ctx sdk.Context, validator types.Validator, address sdk.AccAddress) error {
+	if !validator.LiquidStakingPolicy.AllowsLiquidStakingProvider(address) {
+		return types.ErrLiquidStakingProviderNotAllowed.Wrapf("%s cannot delegate to validator %s", address, validator.OperatorAddress)
+	}
+
+	return nil
+}
+
 // SafelyIncreaseTotalLiquidStakedTokens increments the total liquid staked tokens
 // if the global cap is not surpassed by this delegation
 func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, amount sdk.Int, tokenizingShares bool) error {
@@ -109,13 +122,72 @@ func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, amount sd
 		return types.ErrGlobalLiquidStakingCapExceeded
 	}
 
-	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(amount))
+	k.IncreaseTotalLiquidStakedTokens(ctx, amount)
+
 	return nil
 }
 
+// IncreaseTotalLiquidStakedTokens increments the total liquid staked tokens
+// without checking the global cap
+func (k Keeper) IncreaseTotalLiquidStakedTokens(ctx sdk.Context, amount sdk.Int) {
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(amount))
+	k.emitLiquidCapChangedEvent(ctx, "", sdk.ZeroDec(), sdk.ZeroDec(), amount)
+}
+
 // DecreaseTotalLiquidStakedTokens decrements the total liquid staked tokens
 func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, amount sdk.Int) {
 	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Sub(amount))
+	k.emitLiquidCapChangedEvent(ctx, "", sdk.ZeroDec(), sdk.ZeroDec(), amount.Neg())
+}
+
+// SetTotalLiquidStakedResidue stores the fraction of a token that has been slashed from
+// liquid stake but not yet deducted from the total liquid staked tokens
+func (k Keeper) SetTotalLiquidStakedResidue(ctx sdk.Context, residue sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	residueBz, err := residue.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.TotalLiquidStakedResidueKey, residueBz)
+}
+
+// GetTotalLiquidStakedResidue returns the fraction of a token that has been slashed from
+// liquid stake but not yet deducted from the total liquid staked tokens
+// Returns zero if the residue has not been initialized
+func (k Keeper) GetTotalLiquidStakedResidue(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	residueBz := store.Get(types.TotalLiquidStakedResidueKey)
+
+	if residueBz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var residue sdk.Dec
+	if err := residue.Unmarshal(residueBz); err != nil {
+		panic(err)
+	}
+
+	return residue
+}
+
+// DecreaseTotalLiquidStakedTokensBySlash decrements the total liquid staked tokens by the
+// exact amount of liquid tokens burned by a slash
+// The total is an integer, so the whole tokens are deducted and the fractional remainder is
+// carried in the residue until it adds up to a whole token. This keeps the total from
+// drifting upwards over repeated slashes, as it would if each deduction were truncated
+// Returns the tokens deducted from the total
+func (k Keeper) DecreaseTotalLiquidStakedTokensBySlash(ctx sdk.Context, slashedLiquidTokens sdk.Dec) sdk.Int {
+	residue := k.GetTotalLiquidStakedResidue(ctx).Add(slashedLiquidTokens)
+	deductedTokens := residue.TruncateInt()
+
+	k.SetTotalLiquidStakedResidue(ctx, residue.Sub(sdk.NewDecFromInt(deductedTokens)))
+	if deductedTokens.IsPositive() {
+		k.DecreaseTotalLiquidStakedTokens(ctx, deductedTokens)
+	}
+
+	return deductedTokens
 }
 
 // SafelyIncreaseValidatorTotalLiquidShares increments the total liquid shares on a validator, if:
@@ -129,17 +201,63 @@ func (k Keeper) SafelyIncreaseValidatorTotalLiquidShares(ctx sdk.Context, valida
 		return types.ErrValidatorLiquidStakingCapExceeded
 	}
 
-	// Increment the validator's total liquid shares
-	validator.TotalLiquidShares = validator.TotalLiquidShares.Add(shares)
-	k.SetValidator(ctx, validator)
+	k.IncreaseValidatorTotalLiquidShares(ctx, validator, shares)
 
 	return nil
 }
 
+// IncreaseValidatorTotalLiquidShares increments the total liquid shares on a validator
+// without checking the caps
+func (k Keeper) IncreaseValidatorTotalLiquidShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	validator.TotalLiquidShares = validator.TotalLiquidShares.Add(shares)
+	k.SetValidator(ctx, validator)
+	k.emitLiquidCapChangedEvent(ctx, validator.OperatorAddress, shares, validator.TotalLiquidShares, sdk.ZeroInt())
+}
+
 // DecreaseValidatorTotalLiquidShares decrements the total liquid shares on a validator
 func (k Keeper) DecreaseValidatorTotalLiquidShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
 	validator.TotalLiquidShares = validator.TotalLiquidShares.Sub(shares)
 	k.SetValidator(ctx, validator)
+	k.emitLiquidCapChangedEvent(ctx, validator.OperatorAddress, shares.Neg(), validator.TotalLiquidShares, sdk.ZeroInt())
+}
+
+// correctValidatorTotalLiquidShares replaces liquid shares that were counted on a validator
+// before a delegation was made with the shares that the delegation actually issued
+// The two can differ due to rounding when the exchange rate is not one
+func (k Keeper) correctValidatorTotalLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, countedShares, issuedShares sdk.Dec) error {
+	if countedShares.Equal(issuedShares) {
+		return nil
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return sdkstaking.ErrNoValidatorFound
+	}
+	k.IncreaseValidatorTotalLiquidShares(ctx, validator, issuedShares.Sub(countedShares))
+
+	return nil
+}
+
+// emitLiquidCapChangedEvent emits a typed event recording a change to the stake that is
+// counted against the global or validator liquid staking caps
+// The validator address is empty if only the global total changed
+func (k Keeper) emitLiquidCapChangedEvent(
+	ctx sdk.Context,
+	validatorAddress string,
+	sharesDelta sdk.Dec,
+	validatorTotalLiquidShares sdk.Dec,
+	tokensDelta sdk.Int,
+) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventLiquidCapChanged{
+		ValidatorAddress:           validatorAddress,
+		SharesDelta:                sharesDelta,
+		ValidatorTotalLiquidShares: validatorTotalLiquidShares,
+		TokensDelta:                tokensDelta,
+		TotalLiquidStakedTokens:    k.GetTotalLiquidStakedTokens(ctx),
+	})
+	if err != nil {
+		panic(err)
+	}
 }
 
 // SafelyDecreaseValidatorBond decrements the total validator's self bond
@@ -147,15 +265,60 @@ func (k Keeper) DecreaseValidatorTotalLiquidShares(ctx sdk.Context, validator ty
 // set by validator bond factor
 func (k Keeper) SafelyDecreaseValidatorBond(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
 	// Check if the decreased self bond will cause the validator bond threshold to be exceeded
+	// There is no threshold while the validator bond factor is disabled
 	validatorBondFactor := k.ValidatorBondFactor(ctx)
-	maxValTotalShare := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
-	if validator.TotalLiquidShares.GT(maxValTotalShare) {
-		return types.ErrInsufficientValidatorBondShares
+	if !validatorBondFactor.Equal(sdk.NewDec(-1)) {
+		maxValTotalShare := validator.TotalValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
+		if validator.TotalLiquidShares.GT(maxValTotalShare) {
+			return types.ErrInsufficientValidatorBondShares
+		}
 	}
 
-	// Decrement the validator's total self bond
+	k.DecreaseValidatorBondShares(ctx, validator, shares)
+
+	return nil
+}
+
+// IncreaseValidatorBondShares increments the total validator bond shares of a validator
+func (k Keeper) IncreaseValidatorBondShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(shares)
+	k.SetValidator(ctx, validator)
+}
+
+// DecreaseValidatorBondShares decrements the total validator bond shares of a validator
+// without checking the validator bond factor
+func (k Keeper) DecreaseValidatorBondShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
 	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(shares)
 	k.SetValidator(ctx, validator)
+}
+
+// IncreaseValidatorTokenizedShares increments the shares of a validator that are
+// delegated by tokenize share record module accounts
+func (k Keeper) IncreaseValidatorTokenizedShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	validator.TotalTokenizedShares = validator.TotalTokenizedShares.Add(shares)
+	k.SetValidator(ctx, validator)
+}
+
+// DecreaseValidatorTokenizedShares decrements the shares of a validator that are
+// delegated by tokenize share record module accounts
+func (k Keeper) DecreaseValidatorTokenizedShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	validator.TotalTokenizedShares = validator.TotalTokenizedShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+}
+
+// increaseValidatorBondSharesIfBond adds shares that were delegated to the validator's total
+// validator bond shares if the delegation they were added to is a validator bond
+func (k Keeper) increaseValidatorBondSharesIfBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	delegation, found := k.GetLiquidDelegation(ctx, delAddr, valAddr)
+	if !found || !delegation.ValidatorBond {
+		return nil
+	}
+
+	validator, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return sdkstaking.ErrNoValidatorFound
+	}
+	k.IncreaseValidatorBondShares(ctx, validator, shares)
 
 	return nil
 }
@@ -245,6 +408,30 @@ func (k Keeper) QueueTokenizeSharesAuthorization(ctx sdk.Context, address sdk.Ac
 	return completionTime
 }
 
+// Removes an address from the queue of pending tokenize share unlocks at the given
+// completion time. This is used when the unlock is cancelled before it matures so that
+// the queue entry does not remove a lock that was set afterwards
+func (k Keeper) CancelTokenizeShareLockExpiration(ctx sdk.Context, address sdk.AccAddress, completionTime time.Time) {
+	authorizations := k.GetPendingTokenizeShareAuthorizations(ctx, completionTime)
+
+	updatedAddresses := []string{}
+	for _, addressString := range authorizations.Addresses {
+		if address.String() != addressString {
+			updatedAddresses = append(updatedAddresses, addressString)
+		}
+	}
+
+	// If there are no more addresses unlocking at this time, remove the time slice entirely
+	if len(updatedAddresses) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetTokenizeShareAuthorizationTimeKey(completionTime))
+		return
+	}
+
+	authorizations.Addresses = updatedAddresses
+	k.SetPendingTokenizeShareAuthorizations(ctx, completionTime, authorizations)
+}
+
 // Unlocks all queued tokenize share authorizations that have matured
 // (i.e. have waited the full unbonding period)
 func (k Keeper) RemoveExpiredTokenizeShareLocks(ctx sdk.Context, blockTime time.Time) (unlockedAddresses []string) {
@@ -271,50 +458,175 @@ func (k Keeper) RemoveExpiredTokenizeShareLocks(ctx sdk.Context, blockTime time.
 }
 
 // Calculates and sets the global liquid staked tokens and total liquid shares by validator
-// The totals are determined by looping each delegation record and summing the stake
-// if the delegator is a module account. Checking for a module account will capture
-// ICA accounts, as well as tokenized delegationswhich are owned by module accounts
-// under the hood
-// This function must be called in the upgrade handler which onboards LSM, as
-// well as any time the liquid staking cap is re-enabled
+// Delegations from tokenize share record module accounts are counted from each validator's
+// total tokenized shares, and the delegations held by liquid staking providers (e.g. ICA
+// accounts) are summed from the liquid delegation index, so the remaining delegations
+// never need to be iterated
+// This function must be called in the upgrade handler which onboards LSM. When a cap
+// is re-enabled through a params update, the same recalculation is instead spread
+// over several blocks (see StartTotalLiquidStakedRefresh)
 func (k Keeper) RefreshTotalLiquidStaked(ctx sdk.Context) error {
-	// First reset each validator's liquid shares to 0
+	// Any incremental refresh that is in progress is superseded
+	k.deleteTotalLiquidStakedRefresh(ctx)
+	k.resetTotalLiquidStaked(ctx)
+
+	var err error
+	k.IterateLiquidDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		err = k.addLiquidDelegationToTotals(ctx, delegation)
+		return err != nil
+	})
+
+	return err
+}
+
+// resetTotalLiquidStaked sets the global liquid staked tokens and each validator's total
+// liquid shares to the tokenized shares alone, before the liquid staking provider
+// delegations are added back. Any slashing residue is discarded, since the recalculated
+// total already reflects the slashed exchange rates
+func (k Keeper) resetTotalLiquidStaked(ctx sdk.Context) {
+	totalLiquidStakedTokens := sdk.ZeroInt()
 	for _, validator := range k.GetAllValidators(ctx) {
-		validator.TotalLiquidShares = sdk.ZeroDec()
+		validator.TotalLiquidShares = validator.TotalTokenizedShares
 		k.SetValidator(ctx, validator)
+
+		tokenizedTokens := validator.TokensFromShares(validator.TotalTokenizedShares).TruncateInt()
+		totalLiquidStakedTokens = totalLiquidStakedTokens.Add(tokenizedTokens)
 	}
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStakedTokens)
+	k.SetTotalLiquidStakedResidue(ctx, sdk.ZeroDec())
+}
 
-	// Sum up the total liquid tokens and increment each validator's total liquid shares
-	totalLiquidStakedTokens := sdk.ZeroInt()
-	for _, delegation := range k.GetAllDelegations(ctx) {
-		delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
-		if err != nil {
-			return err
-		}
-		validatorAddress, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
-		if err != nil {
-			return err
-		}
+// addLiquidDelegationToTotals increments the global liquid staked tokens and the
+// validator's total liquid shares by a delegation held by a liquid staking provider
+func (k Keeper) addLiquidDelegationToTotals(ctx sdk.Context, delegation types.Delegation) error {
+	validatorAddress, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+	if err != nil {
+		return err
+	}
 
-		validator, found := k.GetLiquidValidator(ctx, validatorAddress)
-		if !found {
-			return sdkstaking.ErrNoValidatorFound
-		}
+	validator, found := k.GetLiquidValidator(ctx, validatorAddress)
+	if !found {
+		return sdkstaking.ErrNoValidatorFound
+	}
+
+	liquidShares := delegation.Shares
+	liquidTokens := validator.TokensFromShares(liquidShares).TruncateInt()
+
+	validator.TotalLiquidShares = validator.TotalLiquidShares.Add(liquidShares)
+	k.SetValidator(ctx, validator)
+
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(liquidTokens))
+
+	return nil
+}
+
+// GetTotalLiquidStakedRefresh returns the progress of the liquid staked totals refresh,
+// and false if no refresh is in progress
+func (k Keeper) GetTotalLiquidStakedRefresh(ctx sdk.Context) (refresh types.TotalLiquidStakedRefresh, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedRefreshKey)
+	if bz == nil {
+		return refresh, false
+	}
 
-		// If the account is a liquid staking provider, increment the global number
-		// of liquid staked tokens, and the total liquid shares on the validator
-		if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
-			liquidShares := delegation.Shares
-			liquidTokens := validator.TokensFromShares(liquidShares).TruncateInt()
+	k.cdc.MustUnmarshal(bz, &refresh)
+	return refresh, true
+}
+
+// setTotalLiquidStakedRefresh stores the progress of the liquid staked totals refresh
+func (k Keeper) setTotalLiquidStakedRefresh(ctx sdk.Context, refresh types.TotalLiquidStakedRefresh) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TotalLiquidStakedRefreshKey, k.cdc.MustMarshal(&refresh))
+}
 
-			validator.TotalLiquidShares = validator.TotalLiquidShares.Add(liquidShares)
-			k.SetValidator(ctx, validator)
+// deleteTotalLiquidStakedRefresh marks the liquid staked totals refresh as complete
+func (k Keeper) deleteTotalLiquidStakedRefresh(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TotalLiquidStakedRefreshKey)
+}
+
+// IsTotalLiquidStakedRefreshInProgress returns true while the liquid staked totals
+// are being recalculated. Messages that would change the totals are rejected in the meantime
+func (k Keeper) IsTotalLiquidStakedRefreshInProgress(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.TotalLiquidStakedRefreshKey)
+}
+
+// StartTotalLiquidStakedRefresh resets the liquid staked totals and begins recalculating
+// them from the liquid delegation index. The delegations are processed in batches at the
+// end of each block by ProcessTotalLiquidStakedRefresh
+// This is called whenever a params update re-enables one of the liquid staking caps,
+// since the totals may have drifted while the cap was not enforced
+func (k Keeper) StartTotalLiquidStakedRefresh(ctx sdk.Context) {
+	k.resetTotalLiquidStaked(ctx)
+	k.setTotalLiquidStakedRefresh(ctx, types.TotalLiquidStakedRefresh{
+		StartHeight: ctx.BlockHeight(),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventStartTotalLiquidStakedRefresh{
+		StartHeight: ctx.BlockHeight(),
+	}); err != nil {
+		panic(err)
+	}
+}
 
-			totalLiquidStakedTokens = totalLiquidStakedTokens.Add(liquidTokens)
+// ProcessTotalLiquidStakedRefresh adds up to maxDelegations liquid staking provider
+// delegations to the liquid staked totals, continuing from where the previous batch left off
+// Returns true once every delegation has been processed and the refresh is complete
+func (k Keeper) ProcessTotalLiquidStakedRefresh(ctx sdk.Context, maxDelegations uint64) (done bool, err error) {
+	refresh, found := k.GetTotalLiquidStakedRefresh(ctx)
+	if !found {
+		return true, nil
+	}
+
+	start := refresh.NextDelegationKey
+	if len(start) == 0 {
+		start = types.LiquidDelegationIndexKey
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.LiquidDelegationIndexKey))
+	defer iterator.Close()
+
+	processed := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		if processed == maxDelegations {
+			refresh.NextDelegationKey = iterator.Key()
+			refresh.DelegationsProcessed += processed
+			k.setTotalLiquidStakedRefresh(ctx, refresh)
+			return false, nil
 		}
+
+		delegation := k.mustGetDelegationFromLiquidDelegationIndexKey(store, iterator.Key())
+		if err := k.addLiquidDelegationToTotals(ctx, delegation); err != nil {
+			return false, err
+		}
+		processed++
 	}
 
-	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStakedTokens)
+	k.deleteTotalLiquidStakedRefresh(ctx)
 
-	return nil
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCompleteTotalLiquidStakedRefresh{
+		StartHeight:             refresh.StartHeight,
+		DelegationsProcessed:    refresh.DelegationsProcessed + processed,
+		TotalLiquidStakedTokens: k.GetTotalLiquidStakedTokens(ctx),
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// LiquidStakingCapsReEnabled returns true if a params update moves any of the liquid
+// staking caps from a disabled to an enforced state, i.e. the validator bond factor
+// moving off -1, or the global or validator liquid staking cap moving below 100%
+func LiquidStakingCapsReEnabled(oldParams, newParams types.Params) bool {
+	disabledBondFactor := sdk.NewDec(-1)
+	bondFactorEnabled := oldParams.ValidatorBondFactor.Equal(disabledBondFactor) &&
+		!newParams.ValidatorBondFactor.Equal(disabledBondFactor)
+	globalCapEnabled := oldParams.GlobalLiquidStakingCap.GTE(sdk.OneDec()) &&
+		newParams.GlobalLiquidStakingCap.LT(sdk.OneDec())
+	validatorCapEnabled := oldParams.ValidatorLiquidStakingCap.GTE(sdk.OneDec()) &&
+		newParams.ValidatorLiquidStakingCap.LT(sdk.OneDec())
+
+	return bondFactorEnabled || globalCapEnabled || validatorCapEnabled
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -326,6 +328,29 @@ func TestDecreaseTotalLiquidStakedTokens(t *testing.T) {
 	require.Equal(t, intitialTotalLiquidStaked.Sub(decreaseAmount), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
 }
 
+// Tests DecreaseTotalLiquidStakedTokensBySlash
+func TestDecreaseTotalLiquidStakedTokensBySlash(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewInt(100))
+
+	// Only whole tokens are deducted, the remainder is carried in the residue
+	deducted := app.StakingKeeper.DecreaseTotalLiquidStakedTokensBySlash(ctx, sdk.MustNewDecFromStr("10.6"))
+	require.Equal(t, sdk.NewInt(10), deducted)
+	require.Equal(t, sdk.NewInt(90), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), app.StakingKeeper.GetTotalLiquidStakedResidue(ctx))
+
+	// Once the residue adds up to a whole token, it is deducted from the total
+	deducted = app.StakingKeeper.DecreaseTotalLiquidStakedTokensBySlash(ctx, sdk.MustNewDecFromStr("0.5"))
+	require.Equal(t, sdk.NewInt(1), deducted)
+	require.Equal(t, sdk.NewInt(89), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), app.StakingKeeper.GetTotalLiquidStakedResidue(ctx))
+
+	// Recalculating the totals discards the residue
+	require.NoError(t, app.StakingKeeper.RefreshTotalLiquidStaked(ctx))
+	require.Equal(t, sdk.ZeroDec(), app.StakingKeeper.GetTotalLiquidStakedResidue(ctx))
+}
+
 // Tests CheckExceedsValidatorBondCap
 func TestCheckExceedsValidatorBondCap(t *testing.T) {
 	_, app, ctx := createTestInput(t)
@@ -472,10 +497,12 @@ func TestCheckExceedsValidatorBondCap(t *testing.T) {
 // Tests TestCheckExceedsValidatorLiquidStakingCap
 func TestCheckExceedsValidatorLiquidStakingCap(t *testing.T) {
 	_, app, ctx := createTestInput(t)
+	decPtr := func(d sdk.Dec) *sdk.Dec { return &d }
 
 	testCases := []struct {
 		name                  string
 		validatorLiquidCap    sdk.Dec
+		validatorPolicyCap    *sdk.Dec
 		validatorLiquidShares sdk.Dec
 		validatorTotalShares  sdk.Dec
 		newLiquidShares       sdk.Dec
@@ -565,6 +592,40 @@ func TestCheckExceedsValidatorLiquidStakingCap(t *testing.T) {
 			newLiquidShares:       sdk.NewDec(1),
 			expectedExceeds:       false,
 		},
+		{
+			// Cap: 20%, Validator Policy Cap: 10% - Delegation Exceeds Policy Cap
+			// Liquid Shares: 5, Total Shares: 95, New Liquid Shares: 6
+			// => Liquid Shares: 5+6=11, Total Shares: 95+6=101 => 11/101 = 11% > 10% policy cap
+			name:                  "policy cap below param _ delegation exceeds policy cap",
+			validatorLiquidCap:    sdk.MustNewDecFromStr("0.2"),
+			validatorPolicyCap:    decPtr(sdk.MustNewDecFromStr("0.1")),
+			validatorLiquidShares: sdk.NewDec(5),
+			validatorTotalShares:  sdk.NewDec(95),
+			newLiquidShares:       sdk.NewDec(6),
+			expectedExceeds:       true,
+		},
+		{
+			// Cap: 10%, Validator Policy Cap: 20% - Delegation Exceeds Param Cap
+			// Liquid Shares: 5, Total Shares: 95, New Liquid Shares: 6
+			// => Liquid Shares: 5+6=11, Total Shares: 95+6=101 => 11/101 = 11% > 10% cap
+			name:                  "policy cap above param _ delegation exceeds param cap",
+			validatorLiquidCap:    sdk.MustNewDecFromStr("0.1"),
+			validatorPolicyCap:    decPtr(sdk.MustNewDecFromStr("0.2")),
+			validatorLiquidShares: sdk.NewDec(5),
+			validatorTotalShares:  sdk.NewDec(95),
+			newLiquidShares:       sdk.NewDec(6),
+			expectedExceeds:       true,
+		},
+		{
+			// Cap: 100%, Validator Policy Cap: 0% - everything should exceed
+			name:                  "0 percent policy cap",
+			validatorLiquidCap:    sdk.OneDec(),
+			validatorPolicyCap:    decPtr(sdk.ZeroDec()),
+			validatorLiquidShares: sdk.NewDec(0),
+			validatorTotalShares:  sdk.NewDec(1_000_000),
+			newLiquidShares:       sdk.NewDec(1),
+			expectedExceeds:       true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -578,6 +639,9 @@ func TestCheckExceedsValidatorLiquidStakingCap(t *testing.T) {
 			validator := types.Validator{
 				TotalLiquidShares: tc.validatorLiquidShares,
 				DelegatorShares:   tc.validatorTotalShares,
+				LiquidStakingPolicy: types.ValidatorLiquidStakingPolicy{
+					LiquidStakingCap: tc.validatorPolicyCap,
+				},
 			}
 
 			// Check whether the cap is exceeded
@@ -873,6 +937,34 @@ func TestTokenizeShareAuthorizationQueue(t *testing.T) {
 	require.Equal(t, expectedUnlockedAddresses["10"], actualAddresses, "addresses unlocked from time 10")
 }
 
+// Test CancelTokenizeShareLockExpiration
+func TestCancelTokenizeShareLockExpiration(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1))
+	addressA, addressB := addresses[0], addresses[1]
+
+	completionTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	app.StakingKeeper.SetPendingTokenizeShareAuthorizations(ctx, completionTime, types.PendingTokenizeShareAuthorizations{
+		Addresses: []string{addressA.String(), addressB.String()},
+	})
+
+	// Cancel the first address, only the second should remain
+	app.StakingKeeper.CancelTokenizeShareLockExpiration(ctx, addressA, completionTime)
+	authorizations := app.StakingKeeper.GetPendingTokenizeShareAuthorizations(ctx, completionTime)
+	require.Equal(t, []string{addressB.String()}, authorizations.Addresses, "addressB remains in queue")
+
+	// Cancelling an address that is not in the queue should have no effect
+	app.StakingKeeper.CancelTokenizeShareLockExpiration(ctx, addressA, completionTime)
+	authorizations = app.StakingKeeper.GetPendingTokenizeShareAuthorizations(ctx, completionTime)
+	require.Equal(t, []string{addressB.String()}, authorizations.Addresses, "addressB still remains in queue")
+
+	// Cancel the second address, the time slice should be removed from the queue
+	app.StakingKeeper.CancelTokenizeShareLockExpiration(ctx, addressB, completionTime)
+	unlocked := app.StakingKeeper.RemoveExpiredTokenizeShareLocks(ctx, completionTime)
+	require.Empty(t, unlocked, "no addresses left in queue")
+}
+
 // Test CalculateTotalLiquidStaked
 func TestCalculateTotalLiquidStaked(t *testing.T) {
 	_, app, ctx := createTestInput(t)
@@ -884,10 +976,11 @@ func TestCalculateTotalLiquidStaked(t *testing.T) {
 	validators := []types.Validator{
 		{
 			// Exchange rate of 1
-			OperatorAddress:   "valA",
-			Tokens:            sdk.NewInt(100),
-			DelegatorShares:   sdk.NewDec(100),
-			TotalLiquidShares: sdk.NewDec(100), // should be overwritten
+			OperatorAddress:      "valA",
+			Tokens:               sdk.NewInt(100),
+			DelegatorShares:      sdk.NewDec(100),
+			TotalLiquidShares:    sdk.NewDec(100), // should be overwritten
+			TotalTokenizedShares: sdk.NewDec(50),  // from the tokenize share record below
 		},
 		{
 			// Exchange rate of 0.9
@@ -906,14 +999,14 @@ func TestCalculateTotalLiquidStaked(t *testing.T) {
 	}
 
 	// Add various delegations across the above validator's
-	// Total Liquid Staked: 1,849 + 922 = 2,771
+	// Total Liquid Staked: 1,849 + 922 + 50 = 2,821
 	// Total Liquid Shares:
-	//   ValA: 400 + 325 = 725
+	//   ValA: 400 + 325 + 50 = 775
 	//   ValB: 860 + 580 = 1,440
 	//   ValC: 900 + 100 = 1,000
-	expectedTotalLiquidStaked := int64(2771)
+	expectedTotalLiquidStaked := int64(2821)
 	expectedValidatorLiquidShares := map[string]sdk.Dec{
-		"valA": sdk.NewDec(725),
+		"valA": sdk.NewDec(775),
 		"valB": sdk.NewDec(1440),
 		"valC": sdk.NewDec(1000),
 	}
@@ -1035,8 +1128,20 @@ func TestCalculateTotalLiquidStaked(t *testing.T) {
 		app.StakingKeeper.SetDelegation(ctx, delegation)
 	}
 
+	// Delegation from a tokenize share record module account, tokens included in total
+	// Shares: 50 shares, Exchange Rate: 1.0, Tokens: 50
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         addresses[3].String(),
+		ModuleAccount: types.TokenizeShareModuleAccountPrefix + "1",
+		Validator:     validatorAddresses["valA"].String(),
+	}
+	err := app.StakingKeeper.AddTokenizeShareRecord(ctx, record)
+	require.NoError(t, err)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(record.GetModuleAddress(), validatorAddresses["valA"], sdk.NewDec(50), false))
+
 	// Refresh the total liquid staked and validator liquid shares
-	err := app.StakingKeeper.RefreshTotalLiquidStaked(ctx)
+	err = app.StakingKeeper.RefreshTotalLiquidStaked(ctx)
 	require.NoError(t, err, "no error expected when refreshing total liquid staked")
 
 	// Check the total liquid staked and liquid shares by validator
@@ -1055,3 +1160,166 @@ func TestCalculateTotalLiquidStaked(t *testing.T) {
 			"liquid staked shares for validator %s", moniker)
 	}
 }
+
+func TestTotalLiquidStakedRefreshInBatches(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	// Create a validator with an exchange rate of 0.9 and stale liquid totals
+	addresses := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 300))
+	validatorAddress := sdk.ValAddress(addresses[0])
+	app.StakingKeeper.SetValidator(ctx, types.Validator{
+		OperatorAddress:   validatorAddress.String(),
+		Tokens:            sdk.NewInt(90),
+		DelegatorShares:   sdk.NewDec(100),
+		TotalLiquidShares: sdk.NewDec(999),
+	})
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewInt(999))
+
+	// Add three delegations from liquid staking providers and one from a regular account
+	// Total liquid shares: 100 + 200 + 300 = 600, Total liquid staked: 90 + 180 + 270 = 540
+	for i, shares := range []int64{100, 200, 300} {
+		delegatorAddress := createICAAccount(app, ctx, fmt.Sprintf("ica-%d", i))
+		app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delegatorAddress, validatorAddress, sdk.NewDec(shares), false))
+	}
+	regularAddress := createBaseAccount(app, ctx, "regular")
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(regularAddress, validatorAddress, sdk.NewDec(400), false))
+
+	// Only the indexed liquid staking provider delegations are processed
+	numDelegations := uint64(len(app.StakingKeeper.GetAllLiquidDelegations(ctx)))
+	require.Equal(t, uint64(3), numDelegations)
+
+	// Starting the refresh should zero out the totals
+	require.False(t, app.StakingKeeper.IsTotalLiquidStakedRefreshInProgress(ctx))
+	app.StakingKeeper.StartTotalLiquidStakedRefresh(ctx)
+	require.True(t, app.StakingKeeper.IsTotalLiquidStakedRefreshInProgress(ctx))
+	require.Equal(t, int64(0), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).Int64())
+
+	// Process two delegations at a time until the refresh completes
+	batches := uint64(0)
+	for {
+		done, err := app.StakingKeeper.ProcessTotalLiquidStakedRefresh(ctx, 2)
+		require.NoError(t, err)
+		batches++
+		if done {
+			break
+		}
+
+		refresh, found := app.StakingKeeper.GetTotalLiquidStakedRefresh(ctx)
+		require.True(t, found, "refresh should be in progress after batch %d", batches)
+		require.Equal(t, batches*2, refresh.DelegationsProcessed)
+	}
+	require.Equal(t, (numDelegations+1)/2, batches, "number of batches")
+
+	require.False(t, app.StakingKeeper.IsTotalLiquidStakedRefreshInProgress(ctx))
+	require.Equal(t, int64(540), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).Int64())
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, validatorAddress)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(600), validator.TotalLiquidShares)
+
+	// Processing again is a no-op once the refresh is complete
+	done, err := app.StakingKeeper.ProcessTotalLiquidStakedRefresh(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, done)
+}
+
+func TestLiquidStakingCapsReEnabled(t *testing.T) {
+	disabled := types.DefaultParams()
+
+	enabledBondFactor := disabled
+	enabledBondFactor.ValidatorBondFactor = sdk.NewDec(250)
+
+	enabledGlobalCap := disabled
+	enabledGlobalCap.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.25")
+
+	enabledValidatorCap := disabled
+	enabledValidatorCap.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.5")
+
+	loweredGlobalCap := enabledGlobalCap
+	loweredGlobalCap.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.1")
+
+	testCases := []struct {
+		name      string
+		oldParams types.Params
+		newParams types.Params
+		expected  bool
+	}{
+		{"no change", disabled, disabled, false},
+		{"bond factor enabled", disabled, enabledBondFactor, true},
+		{"global cap enabled", disabled, enabledGlobalCap, true},
+		{"validator cap enabled", disabled, enabledValidatorCap, true},
+		{"already enforced cap lowered", enabledGlobalCap, loweredGlobalCap, false},
+		{"bond factor disabled", enabledBondFactor, disabled, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, keeper.LiquidStakingCapsReEnabled(tc.oldParams, tc.newParams))
+		})
+	}
+}
+
+// Tests SafelyDecreaseValidatorBond
+func TestSafelyDecreaseValidatorBond(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	testCases := []struct {
+		name                string
+		validatorBondFactor sdk.Dec
+		validatorBondShares sdk.Dec
+		liquidShares        sdk.Dec
+		decreasedShares     sdk.Dec
+		expectedErr         error
+	}{
+		{
+			// Bond Shares: 100-10=90, Factor: 2 => 180 Max Liquid Shares, Liquid Shares: 150
+			name:                "factor 2 - below cap",
+			validatorBondFactor: sdk.NewDec(2),
+			validatorBondShares: sdk.NewDec(100),
+			liquidShares:        sdk.NewDec(150),
+			decreasedShares:     sdk.NewDec(10),
+		},
+		{
+			// Bond Shares: 100-30=70, Factor: 2 => 140 Max Liquid Shares, Liquid Shares: 150
+			name:                "factor 2 - above cap",
+			validatorBondFactor: sdk.NewDec(2),
+			validatorBondShares: sdk.NewDec(100),
+			liquidShares:        sdk.NewDec(150),
+			decreasedShares:     sdk.NewDec(30),
+			expectedErr:         types.ErrInsufficientValidatorBondShares,
+		},
+		{
+			// Disabled factor - the whole validator bond can be removed
+			name:                "factor disabled",
+			validatorBondFactor: sdk.NewDec(-1),
+			validatorBondShares: sdk.NewDec(100),
+			liquidShares:        sdk.NewDec(150),
+			decreasedShares:     sdk.NewDec(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := app.StakingKeeper.GetParams(ctx)
+			params.ValidatorBondFactor = tc.validatorBondFactor
+			app.StakingKeeper.SetParams(ctx, params)
+
+			validator := types.Validator{
+				OperatorAddress:          sdk.ValAddress("val").String(),
+				TotalValidatorBondShares: tc.validatorBondShares,
+				TotalLiquidShares:        tc.liquidShares,
+			}
+			err := app.StakingKeeper.SafelyDecreaseValidatorBond(ctx, validator, tc.decreasedShares)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			validator, found := app.StakingKeeper.GetLiquidValidator(ctx, validator.GetOperator())
+			require.True(t, found)
+			require.True(t, tc.validatorBondShares.Sub(tc.decreasedShares).Equal(validator.TotalValidatorBondShares),
+				"validator bond shares: %s", validator.TotalValidatorBondShares)
+		})
+	}
+}
@@ -1 +1,362 @@
 package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates x/staking state from consensus version 2 to 3.
+// Version 2 is the store of the cosmos-sdk v0.45 staking module, so this migration
+// onboards a chain running the stock module onto this one:
+//   - validators and historical info are re-encoded, dropping the min self delegation
+//     and ICS unbonding fields and initializing the validator bond and liquid shares to zero
+//   - the ICS unbonding operation indexes and validator updates are removed. Unbondings
+//     that were put on hold by a consumer chain will complete at their completion time
+//   - the params that were added since v0.45 are set to their defaults in the subspace
+//
+// Delegations are encoded the same way in both modules and do not need to be rewritten,
+// a missing validator bond flag decodes as false.
+// The liquid staked totals are not computed here; the upgrade handler must call
+// RefreshTotalLiquidStaked once the liquid staking params have been set
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := m.keeper.migrateLegacyValidators(ctx); err != nil {
+		return err
+	}
+	if err := m.keeper.migrateLegacyHistoricalInfo(ctx); err != nil {
+		return err
+	}
+	m.keeper.removeLegacyUnbondingState(ctx)
+
+	m.keeper.legacySubspace.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	m.keeper.legacySubspace.Set(ctx, types.KeyValidatorBondFactor, types.DefaultValidatorBondFactor)
+	m.keeper.legacySubspace.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	m.keeper.legacySubspace.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	m.keeper.legacySubspace.Set(ctx, types.KeyValidatorBondFirstLossFraction, types.DefaultValidatorBondFirstLossFraction)
+
+	return nil
+}
+
+// Migrate3to4 migrates x/staking state from consensus version 3 to 4.
+// It removes stale entries from the tokenize share unlock queue that were left
+// behind when tokenization was disabled again while an unlock was in progress
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.keeper.removeStaleTokenizeShareUnlocks(ctx)
+}
+
+// Migrate4to5 migrates x/staking state from consensus version 4 to 5.
+// It mints the x/nft token that represents the ownership of each tokenize share record
+// to the record owner
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	for _, record := range m.keeper.GetAllTokenizeShareRecords(ctx) {
+		owner, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return err
+		}
+		if err := m.keeper.mintTokenizeShareRecordNFT(ctx, record, owner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6.
+// It moves the params out of the x/params subspace into the module's own store
+// so that they can be updated with MsgUpdateParams
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	// The validator bond first loss fraction was added after the params left the subspace
+	m.keeper.legacySubspace.Set(ctx, types.KeyValidatorBondFirstLossFraction, types.DefaultValidatorBondFirstLossFraction)
+
+	var params types.Params
+	m.keeper.legacySubspace.GetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+// It initializes each validator's running total of tokenized shares from the
+// delegations of the tokenize share record module accounts
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return m.keeper.initializeValidatorTokenizedShares(ctx)
+}
+
+// Migrate7to8 migrates x/staking state from consensus version 7 to 8.
+// It builds the index of the delegations held by liquid staking providers. A liquid
+// staked totals refresh that is in progress was iterating the delegations themselves,
+// so it is restarted from the beginning of the index
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.keeper.buildLiquidDelegationIndex(ctx)
+
+	if m.keeper.IsTotalLiquidStakedRefreshInProgress(ctx) {
+		m.keeper.StartTotalLiquidStakedRefresh(ctx)
+	}
+
+	return nil
+}
+
+// Migrate8to9 migrates x/staking state from consensus version 8 to 9.
+// It builds the index of the validator bond delegations by validator, and sets the
+// validator bond first loss fraction param to its default, which leaves it disabled
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	m.keeper.buildValidatorBondDelegationIndex(ctx)
+
+	params := m.keeper.GetParams(ctx)
+	params.ValidatorBondFirstLossFraction = types.DefaultValidatorBondFirstLossFraction
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate9to10 migrates x/staking state from consensus version 9 to 10.
+// It builds the index of the tokenize share records by validator, which the slash
+// insurance fund uses to find the records affected by a slash. The fund's coverage
+// is left unset, which disables its payouts
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return m.keeper.buildTokenizeShareRecordValidatorIndex(ctx)
+}
+
+// buildTokenizeShareRecordValidatorIndex adds an index entry for every tokenize share record
+// under the validator it is delegated to
+func (k Keeper) buildTokenizeShareRecordValidatorIndex(ctx sdk.Context) error {
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return err
+		}
+		k.setTokenizeShareRecordWithValidator(ctx, valAddr, record.Id)
+	}
+
+	return nil
+}
+
+// buildValidatorBondDelegationIndex adds an index entry for every validator bond delegation
+func (k Keeper) buildValidatorBondDelegationIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the keys first so the store is not modified while iterating
+	indexKeys := [][]byte{}
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		if delegation.ValidatorBond {
+			indexKeys = append(indexKeys, types.GetValidatorBondDelegationIndexKey(delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()))
+		}
+		return false
+	})
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+}
+
+// buildLiquidDelegationIndex adds an index entry for every delegation that is held by a
+// liquid staking provider
+func (k Keeper) buildLiquidDelegationIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the keys first so the store is not modified while iterating
+	indexKeys := [][]byte{}
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		delegatorAddress := delegation.GetDelegatorAddr()
+		if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+			indexKeys = append(indexKeys, types.GetLiquidDelegationIndexKey(delegatorAddress, delegation.GetValidatorAddr()))
+		}
+		return false
+	})
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+}
+
+// initializeValidatorTokenizedShares sets the total tokenized shares of every validator
+// to the sum of the shares delegated to it by tokenize share records
+func (k Keeper) initializeValidatorTokenizedShares(ctx sdk.Context) error {
+	tokenizedShares := map[string]sdk.Dec{}
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return err
+		}
+
+		delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			continue
+		}
+
+		shares, found := tokenizedShares[record.Validator]
+		if !found {
+			shares = sdk.ZeroDec()
+		}
+		tokenizedShares[record.Validator] = shares.Add(delegation.Shares)
+	}
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		validator.TotalTokenizedShares = sdk.ZeroDec()
+		if shares, found := tokenizedShares[validator.OperatorAddress]; found {
+			validator.TotalTokenizedShares = shares
+		}
+		k.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
+
+// removeStaleTokenizeShareUnlocks iterates the tokenize share unlock queue and drops
+// every address whose lock is no longer expiring at the time of the queue entry
+// (i.e. the lock was re-added, removed, or rescheduled after the entry was queued)
+func (k Keeper) removeStaleTokenizeShareUnlocks(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeSharesUnlockQueueKey)
+	defer iterator.Close()
+
+	// Collect the updates first so the store is not modified while iterating
+	updatedKeys := [][]byte{}
+	updatedQueue := []types.PendingTokenizeShareAuthorizations{}
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(types.TokenizeSharesUnlockQueueKey):])
+		if err != nil {
+			return err
+		}
+
+		authorizations := types.PendingTokenizeShareAuthorizations{}
+		k.cdc.MustUnmarshal(iterator.Value(), &authorizations)
+
+		validAddresses := []string{}
+		for _, addressString := range authorizations.Addresses {
+			address, err := sdk.AccAddressFromBech32(addressString)
+			if err != nil {
+				return err
+			}
+
+			lockStatus, unlockTime := k.GetTokenizeSharesLock(ctx, address)
+			if lockStatus == types.TokenizeShareLockStatus_LOCK_EXPIRING && unlockTime.Equal(completionTime) {
+				validAddresses = append(validAddresses, addressString)
+				continue
+			}
+
+			k.Logger(ctx).Info("removing stale tokenize share unlock queue entry",
+				"address", addressString, "completion_time", completionTime)
+		}
+
+		if len(validAddresses) != len(authorizations.Addresses) {
+			updatedKeys = append(updatedKeys, iterator.Key())
+			updatedQueue = append(updatedQueue, types.PendingTokenizeShareAuthorizations{Addresses: validAddresses})
+		}
+	}
+
+	for i, key := range updatedKeys {
+		authorizations := updatedQueue[i]
+		if len(authorizations.Addresses) == 0 {
+			store.Delete(key)
+			continue
+		}
+		store.Set(key, k.cdc.MustMarshal(&authorizations))
+	}
+
+	return nil
+}
+
+// migrateLegacyValidators re-encodes every validator that was stored by the
+// cosmos-sdk v0.45 staking module
+func (k Keeper) migrateLegacyValidators(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	// Collect the validators first so the store is not modified while iterating
+	validators := []types.Validator{}
+	for ; iterator.Valid(); iterator.Next() {
+		var legacy sdkstaking.Validator
+		if err := k.cdc.Unmarshal(iterator.Value(), &legacy); err != nil {
+			return err
+		}
+
+		if legacy.UnbondingOnHoldRefCount > 0 {
+			k.Logger(ctx).Info("releasing validator unbonding that was on hold",
+				"validator", legacy.OperatorAddress, "ref_count", legacy.UnbondingOnHoldRefCount)
+		}
+
+		validators = append(validators, types.ValidatorFromLegacy(legacy))
+	}
+
+	for _, validator := range validators {
+		k.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
+
+// migrateLegacyHistoricalInfo re-encodes the validator sets of the historical info
+// entries that were stored by the cosmos-sdk v0.45 staking module
+func (k Keeper) migrateLegacyHistoricalInfo(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	infos := []types.HistoricalInfo{}
+	for ; iterator.Valid(); iterator.Next() {
+		var legacy sdkstaking.HistoricalInfo
+		if err := k.cdc.Unmarshal(iterator.Value(), &legacy); err != nil {
+			return err
+		}
+
+		valset := make([]types.Validator, len(legacy.Valset))
+		for i, validator := range legacy.Valset {
+			valset[i] = types.ValidatorFromLegacy(validator)
+		}
+
+		keys = append(keys, iterator.Key())
+		infos = append(infos, types.HistoricalInfo{Header: legacy.Header, Valset: valset})
+	}
+
+	for i, key := range keys {
+		store.Set(key, k.cdc.MustMarshal(&infos[i]))
+	}
+
+	return nil
+}
+
+// removeLegacyUnbondingState deletes the unbonding operation indexes and pending
+// validator updates written by the ICS fork of the cosmos-sdk v0.45 staking module
+// The validator updates key must be removed since it falls under the tokenize share
+// record prefix
+func (k Keeper) removeLegacyUnbondingState(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(sdkstaking.UnbondingIdKey)
+	store.Delete(sdkstaking.ValidatorUpdatesKey)
+
+	for _, prefix := range [][]byte{sdkstaking.UnbondingIndexKey, sdkstaking.UnbondingTypeKey} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/teststaking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1))
+	lockedAddress, expiringAddress, unlockedAddress, rescheduledAddress := addresses[0], addresses[1], addresses[2], addresses[3]
+
+	timeA := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeB := timeA.Add(time.Hour)
+
+	// Build a queue where only the expiring address has a valid entry
+	//   lockedAddress: tokenization was disabled again during the unlock
+	//   expiringAddress: valid unlock at timeA
+	//   unlockedAddress: lock no longer exists
+	//   rescheduledAddress: lock expires at timeB, but is queued at both times
+	app.StakingKeeper.AddTokenizeSharesLock(ctx, lockedAddress)
+	app.StakingKeeper.SetTokenizeSharesUnlockTime(ctx, expiringAddress, timeA)
+	app.StakingKeeper.SetTokenizeSharesUnlockTime(ctx, rescheduledAddress, timeB)
+
+	app.StakingKeeper.SetPendingTokenizeShareAuthorizations(ctx, timeA, types.PendingTokenizeShareAuthorizations{
+		Addresses: []string{
+			lockedAddress.String(),
+			expiringAddress.String(),
+			unlockedAddress.String(),
+			rescheduledAddress.String(),
+		},
+	})
+	app.StakingKeeper.SetPendingTokenizeShareAuthorizations(ctx, timeB, types.PendingTokenizeShareAuthorizations{
+		Addresses: []string{rescheduledAddress.String()},
+	})
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate3to4(ctx))
+
+	authorizationsA := app.StakingKeeper.GetPendingTokenizeShareAuthorizations(ctx, timeA)
+	require.Equal(t, []string{expiringAddress.String()}, authorizationsA.Addresses, "addresses at timeA")
+
+	authorizationsB := app.StakingKeeper.GetPendingTokenizeShareAuthorizations(ctx, timeB)
+	require.Equal(t, []string{rescheduledAddress.String()}, authorizationsB.Addresses, "addresses at timeB")
+
+	// Removing the expired locks at timeA should leave the locked address locked
+	unlocked := app.StakingKeeper.RemoveExpiredTokenizeShareLocks(ctx, timeA)
+	require.Equal(t, []string{expiringAddress.String()}, unlocked, "unlocked addresses at timeA")
+
+	status, _ := app.StakingKeeper.GetTokenizeSharesLock(ctx, lockedAddress)
+	require.Equal(t, types.TokenizeShareLockStatus_LOCKED, status, "locked address still locked")
+
+	status, _ = app.StakingKeeper.GetTokenizeSharesLock(ctx, rescheduledAddress)
+	require.Equal(t, types.TokenizeShareLockStatus_LOCK_EXPIRING, status, "rescheduled address still expiring")
+}
+
+func TestMigrate4to5(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	records := []types.TokenizeShareRecord{
+		{Id: 1, Owner: addrs[0].String(), ModuleAccount: types.TokenizeShareModuleAccountPrefix + "1", Validator: valAddrs[0].String()},
+		{Id: 2, Owner: addrs[1].String(), ModuleAccount: types.TokenizeShareModuleAccountPrefix + "2", Validator: valAddrs[1].String()},
+	}
+	for _, record := range records {
+		require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+
+		// Burn the nft to mimic a store written before the records were represented as nfts
+		require.NoError(t, app.NFTKeeper.Burn(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID()))
+	}
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate4to5(ctx))
+
+	for i, record := range records {
+		require.Equal(t, addrs[i], app.NFTKeeper.GetOwner(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID()))
+	}
+}
+
+func TestMigrate5to6(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	// Seed the legacy subspace with the keys it held at consensus version 5, some of
+	// them non-default, and clear the module store
+	legacyParams := types.DefaultParams()
+	legacyParams.MaxValidators = 42
+	legacyParams.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.25")
+
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyUnbondingTime, legacyParams.UnbondingTime)
+	subspace.Set(ctx, types.KeyMaxValidators, legacyParams.MaxValidators)
+	subspace.Set(ctx, types.KeyMaxEntries, legacyParams.MaxEntries)
+	subspace.Set(ctx, types.KeyHistoricalEntries, legacyParams.HistoricalEntries)
+	subspace.Set(ctx, types.KeyBondDenom, legacyParams.BondDenom)
+	subspace.Set(ctx, types.KeyMinCommissionRate, legacyParams.MinCommissionRate)
+	subspace.Set(ctx, types.KeyValidatorBondFactor, legacyParams.ValidatorBondFactor)
+	subspace.Set(ctx, types.KeyGlobalLiquidStakingCap, legacyParams.GlobalLiquidStakingCap)
+	subspace.Set(ctx, types.KeyValidatorLiquidStakingCap, legacyParams.ValidatorLiquidStakingCap)
+	require.False(t, subspace.Has(ctx, types.KeyValidatorBondFirstLossFraction))
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate5to6(ctx))
+
+	// The first loss fraction was not in the subspace and takes its default
+	require.Equal(t, types.DefaultValidatorBondFirstLossFraction, legacyParams.ValidatorBondFirstLossFraction)
+	require.Equal(t, legacyParams, app.StakingKeeper.GetParams(ctx))
+}
+
+func TestMigrate6to7(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, power(100))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	validator := teststaking.NewValidator(t, valAddr, PKs[0])
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrs[0], power(30), validator))
+	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.NoError(t, delegateCoinsFromAccount(ctx, app, addrs[1], power(30), validator))
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: addrs[0].String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    addrs[1].String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(sdk.DefaultBondDenom, power(10)),
+			TokenizedShareOwner: addrs[1].String(),
+		})
+		require.NoError(t, err)
+	}
+
+	// Validators stored before the running total existed decode without it
+	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	expectedShares := validator.TotalTokenizedShares
+	require.Equal(t, sdk.NewDecFromInt(power(20)), expectedShares)
+	validator.TotalTokenizedShares = sdk.ZeroDec()
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate6to7(ctx))
+
+	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.Equal(t, expectedShares, validator.TotalTokenizedShares)
+
+	_, broken := keeper.TokenizedSharesInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	valAddr := sdk.ValAddress(simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))[0])
+	validator := teststaking.NewValidator(t, valAddr, PKs[0])
+	validator.Tokens = sdk.NewInt(300)
+	validator.DelegatorShares = sdk.NewDec(300)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	providerAddress := createICAAccount(app, ctx, "provider")
+	regularAddress := createBaseAccount(app, ctx, "regular")
+	providerDelegation := types.NewDelegation(providerAddress, valAddr, sdk.NewDec(100), false)
+	app.StakingKeeper.SetDelegation(ctx, providerDelegation)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(regularAddress, valAddr, sdk.NewDec(200), false))
+
+	// Remove the index entry to mimic a store written before the index existed, with
+	// a refresh in progress that was iterating the delegations themselves
+	store.Delete(types.GetLiquidDelegationIndexKey(providerAddress, valAddr))
+	require.Empty(t, app.StakingKeeper.GetAllLiquidDelegations(ctx))
+	store.Set(types.TotalLiquidStakedRefreshKey, app.AppCodec().MustMarshal(&types.TotalLiquidStakedRefresh{
+		NextDelegationKey:    types.GetLiquidDelegationKey(regularAddress, valAddr),
+		DelegationsProcessed: 1,
+	}))
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate7to8(ctx))
+
+	require.Equal(t, []types.Delegation{providerDelegation}, app.StakingKeeper.GetAllLiquidDelegations(ctx))
+	_, broken := keeper.LiquidDelegationIndexInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	// The refresh restarts from the beginning of the index
+	refresh, found := app.StakingKeeper.GetTotalLiquidStakedRefresh(ctx)
+	require.True(t, found)
+	require.Empty(t, refresh.NextDelegationKey)
+	require.Zero(t, refresh.DelegationsProcessed)
+
+	done, err := app.StakingKeeper.ProcessTotalLiquidStakedRefresh(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestMigrate8to9(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddr := sdk.ValAddress(addrs[0])
+	bondDelegation := types.NewDelegation(addrs[0], valAddr, sdk.NewDec(100), true)
+	app.StakingKeeper.SetDelegation(ctx, bondDelegation)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrs[1], valAddr, sdk.NewDec(200), false))
+
+	// Remove the index entry and the new param to mimic a store written before they existed
+	store.Delete(types.GetValidatorBondDelegationIndexKey(addrs[0], valAddr))
+	require.Empty(t, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddr))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFirstLossFraction = sdk.Dec{}
+	app.StakingKeeper.SetParams(ctx, params)
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate8to9(ctx))
+
+	require.Equal(t, []types.Delegation{bondDelegation}, app.StakingKeeper.GetValidatorBondDelegations(ctx, valAddr))
+	require.Equal(t, types.DefaultParams(), app.StakingKeeper.GetParams(ctx))
+}
+
+func TestMigrate9to10(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	records := []types.TokenizeShareRecord{
+		{Id: 1, Owner: addrs[0].String(), ModuleAccount: types.TokenizeShareModuleAccountPrefix + "1", Validator: valAddrs[0].String()},
+		{Id: 2, Owner: addrs[1].String(), ModuleAccount: types.TokenizeShareModuleAccountPrefix + "2", Validator: valAddrs[1].String()},
+		{Id: 3, Owner: addrs[1].String(), ModuleAccount: types.TokenizeShareModuleAccountPrefix + "3", Validator: valAddrs[0].String()},
+	}
+	for _, record := range records {
+		require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	}
+
+	// Remove the index entries to mimic a store written before they existed
+	store.Delete(types.GetTokenizeShareRecordIDByValidatorKey(valAddrs[0], 1))
+	store.Delete(types.GetTokenizeShareRecordIDByValidatorKey(valAddrs[1], 2))
+	store.Delete(types.GetTokenizeShareRecordIDByValidatorKey(valAddrs[0], 3))
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddrs[0]))
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate9to10(ctx))
+
+	require.Equal(t, []types.TokenizeShareRecord{records[0], records[2]}, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddrs[0]))
+	require.Equal(t, []types.TokenizeShareRecord{records[1]}, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddrs[1]))
+	require.Equal(t, types.DefaultSlashInsuranceCoverage(), app.StakingKeeper.GetSlashInsuranceCoverage(ctx))
+}
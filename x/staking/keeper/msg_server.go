@@ -106,6 +106,16 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
+	validator.LiquidStakingPolicy = msg.LiquidStakingPolicy
+	if !msg.LiquidStakingPolicy.Equal(types.ValidatorLiquidStakingPolicy{}) {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateValidatorLiquidStakingPolicy{
+			ValidatorAddress: validator.OperatorAddress,
+			Policy:           validator.LiquidStakingPolicy,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, err
@@ -182,6 +192,19 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		validator.Commission = commission
 	}
 
+	// the policy only restricts liquid stake added from now on, so it can be tighter than
+	// the validator's current liquid shares
+	if msg.LiquidStakingPolicy != nil {
+		validator.LiquidStakingPolicy = *msg.LiquidStakingPolicy
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateValidatorLiquidStakingPolicy{
+			ValidatorAddress: validator.OperatorAddress,
+			Policy:           validator.LiquidStakingPolicy,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -232,13 +255,26 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 
 	// if this delegation is from a liquid staking provider, it cannot exceed
 	// the global or validator bond cap
-	if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	if isLiquidStakingProvider {
+		if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+			return nil, types.ErrTotalLiquidStakedRefreshInProgress
+		}
+		if err := k.CheckLiquidStakingProviderAllowed(ctx, validator, delegatorAddress); err != nil {
+			return nil, err
+		}
 		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens, false); err != nil {
 			return nil, err
 		}
 		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, validator, shares); err != nil {
 			return nil, err
 		}
+
+		// Reload the validator so that the delegation doesn't overwrite the updated liquid shares
+		validator, found = k.GetLiquidValidator(ctx, valAddr)
+		if !found {
+			return nil, sdkstaking.ErrNoValidatorFound
+		}
 	}
 
 	// NOTE: source funds are always unbonded
@@ -247,6 +283,15 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		return nil, err
 	}
 
+	if isLiquidStakingProvider {
+		if err := k.correctValidatorTotalLiquidShares(ctx, valAddr, shares, newShares); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.increaseValidatorBondSharesIfBond(ctx, delegatorAddress, valAddr, newShares); err != nil {
+		return nil, err
+	}
+
 	if tokens.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "delegate")
@@ -320,6 +365,9 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 
 	// if this is a validator self-bond, the new liquid delegation cannot fall below the self-bond * bond factor
 	if delegation.ValidatorBond {
+		if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+			return nil, types.ErrTotalLiquidStakedRefreshInProgress
+		}
 		if err := k.SafelyDecreaseValidatorBond(ctx, srcValidator, shares); err != nil {
 			return nil, err
 		}
@@ -328,13 +376,35 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 	// If this delegation is from a liquid staking provider, the delegation on the new validator
 	// cannot exceed that validator's self-bond cap
 	// The liquid shares from the source validator should get moved to the destination validator
-	if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
-		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, dstValidator, shares); err != nil {
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	dstShares := sdk.ZeroDec()
+	if isLiquidStakingProvider {
+		if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+			return nil, types.ErrTotalLiquidStakedRefreshInProgress
+		}
+		if err := k.CheckLiquidStakingProviderAllowed(ctx, dstValidator, delegatorAddress); err != nil {
+			return nil, err
+		}
+		dstShares, err = dstValidator.SharesFromTokens(srcValidator.TokensFromShares(shares).TruncateInt())
+		if err != nil {
 			return nil, err
 		}
+		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, dstValidator, dstShares); err != nil {
+			return nil, err
+		}
+		srcValidator, found = k.GetLiquidValidator(ctx, valSrcAddr)
+		if !found {
+			return nil, sdkstaking.ErrNoValidatorFound
+		}
 		k.DecreaseValidatorTotalLiquidShares(ctx, srcValidator, shares)
 	}
 
+	// The shares issued by the destination validator are the difference in the delegation
+	dstSharesBefore := sdk.ZeroDec()
+	if dstDelegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr); found {
+		dstSharesBefore = dstDelegation.Shares
+	}
+
 	bondDenom := k.BondDenom(ctx)
 	if msg.Amount.Denom != bondDenom {
 		return nil, errorsmod.Wrapf(
@@ -349,6 +419,29 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	dstDelegation, found := k.GetLiquidDelegation(ctx, delegatorAddress, valDstAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoDelegation
+	}
+	issuedDstShares := dstDelegation.Shares.Sub(dstSharesBefore)
+	if isLiquidStakingProvider {
+		if err := k.correctValidatorTotalLiquidShares(ctx, valDstAddr, dstShares, issuedDstShares); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.increaseValidatorBondSharesIfBond(ctx, delegatorAddress, valDstAddr, issuedDstShares); err != nil {
+		return nil, err
+	}
+
+	// if the full validator bond was moved away, the delegation is no longer a validator bond
+	if delegation.ValidatorBond {
+		if _, found := k.GetLiquidDelegation(ctx, delegatorAddress, valSrcAddr); !found {
+			if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, valSrcAddr, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "redelegate")
@@ -417,6 +510,9 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 
 	// if this is a validator self-bond, the new liquid delegation cannot fall below the self-bond * bond factor
 	if delegation.ValidatorBond {
+		if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+			return nil, types.ErrTotalLiquidStakedRefreshInProgress
+		}
 		if err := k.SafelyDecreaseValidatorBond(ctx, validator, shares); err != nil {
 			return nil, err
 		}
@@ -425,6 +521,9 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 	// if this undelegation is from a liquid staking provider, the global and validator
 	// liquid counts should be decremented
 	if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+		if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+			return nil, types.ErrTotalLiquidStakedRefreshInProgress
+		}
 		k.DecreaseTotalLiquidStakedTokens(ctx, tokens)
 		k.DecreaseValidatorTotalLiquidShares(ctx, validator, shares)
 	}
@@ -441,6 +540,15 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		return nil, err
 	}
 
+	// if the full validator bond was undelegated, the delegation is no longer a validator bond
+	if delegation.ValidatorBond {
+		if _, found := k.GetLiquidDelegation(ctx, delegatorAddress, addr); !found {
+			if err := k.AfterValidatorBondChanged(ctx, delegatorAddress, addr, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	if tokens.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "undelegate")
@@ -542,12 +650,50 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
 	}
 
+	// if this delegation is from a liquid staking provider, the tokens become liquid
+	// again and cannot exceed the global or validator bond cap
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	shares := sdk.ZeroDec()
+	if isLiquidStakingProvider {
+		if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+			return nil, types.ErrTotalLiquidStakedRefreshInProgress
+		}
+		if err := k.CheckLiquidStakingProviderAllowed(ctx, validator, delegatorAddress); err != nil {
+			return nil, err
+		}
+		shares, err = validator.SharesFromTokens(msg.Amount.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, msg.Amount.Amount, false); err != nil {
+			return nil, err
+		}
+		if err := k.SafelyIncreaseValidatorTotalLiquidShares(ctx, validator, shares); err != nil {
+			return nil, err
+		}
+
+		// Reload the validator so that the delegation doesn't overwrite the updated liquid shares
+		validator, found = k.GetLiquidValidator(ctx, valAddr)
+		if !found {
+			return nil, sdkstaking.ErrNoValidatorFound
+		}
+	}
+
 	// delegate back the unbonding delegation amount to the validator
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, sdkstaking.Unbonding, validator, false)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, sdkstaking.Unbonding, validator, false)
 	if err != nil {
 		return nil, err
 	}
 
+	if isLiquidStakingProvider {
+		if err := k.correctValidatorTotalLiquidShares(ctx, valAddr, shares, newShares); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.increaseValidatorBondSharesIfBond(ctx, delegatorAddress, valAddr, newShares); err != nil {
+		return nil, err
+	}
+
 	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
 	if amount.IsZero() {
 		ubd.RemoveEntry(unbondEntryIndex)
@@ -600,6 +746,12 @@ func (k msgServer) UnbondValidator(goCtx context.Context, msg *types.MsgUnbondVa
 func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The tokenize share module accounts are liquid staking providers, so their delegations
+	// cannot change while the liquid staked totals are being recalculated
+	if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+		return nil, types.ErrTotalLiquidStakedRefreshInProgress
+	}
+
 	valAddr, valErr := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if valErr != nil {
 		return nil, valErr
@@ -609,6 +761,13 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, sdkstaking.ErrNoValidatorFound
 	}
 
+	if err := k.CheckTokenizationAllowed(ctx, validator); err != nil {
+		return nil, err
+	}
+	if validator.LiquidStakingPolicy.TokenizationDisabled {
+		return nil, types.ErrTokenizationDisabledByValidator
+	}
+
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, err
@@ -707,8 +866,11 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		k.bondedTokensToNotBonded(ctx, returnAmount)
 	}
 
+	// the unbonded tokens can be truncated below msg.Amount, so only the returned amount is moved
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+
 	// Note: UndelegateCoinsFromModuleToAccount is internally calling TrackUndelegation for vesting account
-	err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, sdk.Coins{msg.Amount})
+	err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, sdk.Coins{returnCoin})
 	if err != nil {
 		return nil, err
 	}
@@ -719,7 +881,7 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		return nil, err
 	}
 	// send coins to module account
-	err = k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), sdk.Coins{msg.Amount})
+	err = k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), sdk.Coins{returnCoin})
 	if err != nil {
 		return nil, err
 	}
@@ -731,11 +893,27 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 	}
 
 	// delegate from module account
-	_, err = k.Keeper.Delegate(ctx, record.GetModuleAddress(), msg.Amount.Amount, sdkstaking.Unbonded, validator, true)
+	recordShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), returnAmount, sdkstaking.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
 
+	// The liquid shares are now held by the record, whose delegation can have slightly
+	// different shares than were taken from the delegator
+	if err := k.correctValidatorTotalLiquidShares(ctx, valAddr, shares, recordShares); err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+	k.IncreaseValidatorTokenizedShares(ctx, validator, recordShares)
+
+	if err := k.AfterTokenizeShareRecordCreated(ctx, record.Id); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTokenizeShares,
@@ -747,6 +925,19 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTokenizeShares{
+		DelegatorAddress: msg.DelegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+		ShareOwner:       msg.TokenizedShareOwner,
+		ShareRecordId:    record.Id,
+		ModuleAccount:    record.GetModuleAddress().String(),
+		Shares:           shares,
+		Tokens:           returnCoin,
+		ShareTokens:      shareToken,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgTokenizeSharesResponse{
 		Amount: shareToken,
 	}, nil
@@ -755,6 +946,12 @@ func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeS
 func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemTokensforShares) (*types.MsgRedeemTokensforSharesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The tokenize share module accounts are liquid staking providers, so their delegations
+	// cannot change while the liquid staked totals are being recalculated
+	if k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+		return nil, types.ErrTotalLiquidStakedRefreshInProgress
+	}
+
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, err
@@ -780,6 +977,10 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 		return nil, sdkstaking.ErrNoValidatorFound
 	}
 
+	if err := k.CheckRedemptionAllowed(ctx, validator); err != nil {
+		return nil, err
+	}
+
 	// calculate the ratio between shares and redeem amount
 	// moduleAccountTotalDelegation * redeemAmount / totalIssue
 	delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
@@ -793,11 +994,18 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 	// If this redemption is NOT from a liquid staking provider, decrement the total liquid staked
 	// If the redemption was from a liquid staking provider, the shares are still considered
 	// liquid, even in their non-tokenized form (since they are owned by a liquid staking provider)
-	if !k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+	isLiquidStakingProvider := k.AccountIsLiquidStakingProvider(ctx, delegatorAddress)
+	if !isLiquidStakingProvider {
 		k.DecreaseTotalLiquidStakedTokens(ctx, tokens)
 		k.DecreaseValidatorTotalLiquidShares(ctx, validator, shares)
 	}
 
+	validator, found = k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkstaking.ErrNoValidatorFound
+	}
+	k.DecreaseValidatorTokenizedShares(ctx, validator, shares)
+
 	returnAmount, err := k.Unbond(ctx, record.GetModuleAddress(), valAddr, shares)
 	if err != nil {
 		return nil, err
@@ -809,9 +1017,10 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 
 	// Note: since delegation object has been changed from unbond call, it gets latest delegation
 	_, found = k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	recordRemoved := !found
 	if !found {
-		if k.hooks != nil {
-			return nil, k.hooks.BeforeTokenizeShareRecordRemoved(ctx, record.Id)
+		if err := k.BeforeTokenizeShareRecordRemoved(ctx, record.Id); err != nil {
+			return nil, err
 		}
 
 		err = k.DeleteTokenizeShareRecord(ctx, record.Id)
@@ -845,11 +1054,24 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 
 	// convert the share tokens to delegated status
 	// Note: Delegate(substractAccount => true) -> DelegateCoinsFromAccountToModule -> TrackDelegation for vesting account
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, sdkstaking.Unbonded, validator, true)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, sdkstaking.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
 
+	if isLiquidStakingProvider {
+		if err := k.correctValidatorTotalLiquidShares(ctx, valAddr, shares, newShares); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.increaseValidatorBondSharesIfBond(ctx, delegatorAddress, valAddr, newShares); err != nil {
+		return nil, err
+	}
+
+	if err := k.AfterTokenizeSharesRedeemed(ctx, record.Id, delegatorAddress, valAddr, shares); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRedeemShares,
@@ -859,6 +1081,19 @@ func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemToken
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedeemShares{
+		DelegatorAddress: msg.DelegatorAddress,
+		ValidatorAddress: validator.OperatorAddress,
+		ShareOwner:       record.Owner,
+		ShareRecordId:    record.Id,
+		Shares:           shares,
+		ShareTokens:      msg.Amount,
+		Tokens:           returnCoin,
+		RecordRemoved:    recordRemoved,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgRedeemTokensforSharesResponse{
 		Amount: returnCoin,
 	}, nil
@@ -876,22 +1111,15 @@ func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types
 		return nil, types.ErrNotTokenizeShareRecordOwner
 	}
 
-	// Remove old account reference
-	oldOwner, err := sdk.AccAddressFromBech32(record.Owner)
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress
 	}
-	k.deleteTokenizeShareRecordWithOwner(ctx, oldOwner, record.Id)
-
-	record.Owner = msg.NewOwner
-	k.setTokenizeShareRecord(ctx, record)
 
-	// Set new account reference
-	newOwner, err := sdk.AccAddressFromBech32(record.Owner)
+	oldOwner, err := k.TransferTokenizeShareRecordOwnership(ctx, record, newOwner)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress
+		return nil, err
 	}
-	k.setTokenizeShareRecordWithOwner(ctx, newOwner, record.Id)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -902,9 +1130,165 @@ func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransferTokenizeShareRecord{
+		ShareRecordId:    record.Id,
+		ValidatorAddress: record.Validator,
+		PreviousOwner:    oldOwner.String(),
+		NewOwner:         msg.NewOwner,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
 }
 
+// ProposeTokenizeShareRecordTransfer proposes a transfer of the ownership of a tokenize
+// share record, which must be accepted by the new owner before the unbonding period elapses
+func (k msgServer) ProposeTokenizeShareRecordTransfer(
+	goCtx context.Context,
+	msg *types.MsgProposeTokenizeShareRecordTransfer,
+) (*types.MsgProposeTokenizeShareRecordTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if err != nil {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	if record.Owner != msg.Sender {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+	if record.Owner == msg.NewOwner {
+		return nil, types.ErrTokenizeShareRecordTransferToSelf
+	}
+
+	// Note: a new proposal replaces any transfer already pending for the record
+	expirationTime := ctx.BlockTime().Add(k.UnbondingTime(ctx))
+	k.SetPendingTokenizeShareRecordTransfer(ctx, types.PendingTokenizeShareRecordTransfer{
+		TokenizeShareRecordId: record.Id,
+		Owner:                 record.Owner,
+		NewOwner:              msg.NewOwner,
+		ExpirationTime:        expirationTime,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposeTokenizeShareRecordTransfer,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, expirationTime.Format(time.RFC3339)),
+		),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventProposeTokenizeShareRecordTransfer{
+		ShareRecordId:  record.Id,
+		Owner:          record.Owner,
+		NewOwner:       msg.NewOwner,
+		ExpirationTime: expirationTime,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeTokenizeShareRecordTransferResponse{
+		ExpirationTime: expirationTime,
+	}, nil
+}
+
+// AcceptTokenizeShareRecordTransfer completes a pending transfer of the ownership of
+// a tokenize share record, and must be signed by the proposed new owner
+func (k msgServer) AcceptTokenizeShareRecordTransfer(
+	goCtx context.Context,
+	msg *types.MsgAcceptTokenizeShareRecordTransfer,
+) (*types.MsgAcceptTokenizeShareRecordTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	transfer, found := k.GetPendingTokenizeShareRecordTransfer(ctx, msg.TokenizeShareRecordId)
+	if !found {
+		return nil, types.ErrNoPendingTokenizeShareRecordTransfer
+	}
+	if transfer.NewOwner != msg.NewOwner {
+		return nil, types.ErrNotPendingTokenizeShareRecordNewOwner
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if err != nil {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+	if record.Owner != transfer.Owner {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	oldOwner, err := k.TransferTokenizeShareRecordOwnership(ctx, record, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(sdk.AttributeKeySender, transfer.Owner),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+		),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransferTokenizeShareRecord{
+		ShareRecordId:    record.Id,
+		ValidatorAddress: record.Validator,
+		PreviousOwner:    oldOwner.String(),
+		NewOwner:         msg.NewOwner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptTokenizeShareRecordTransferResponse{}, nil
+}
+
+// CancelTokenizeShareRecordTransfer cancels a pending transfer of the ownership of
+// a tokenize share record, and must be signed by the current owner
+func (k msgServer) CancelTokenizeShareRecordTransfer(
+	goCtx context.Context,
+	msg *types.MsgCancelTokenizeShareRecordTransfer,
+) (*types.MsgCancelTokenizeShareRecordTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	transfer, found := k.GetPendingTokenizeShareRecordTransfer(ctx, msg.TokenizeShareRecordId)
+	if !found {
+		return nil, types.ErrNoPendingTokenizeShareRecordTransfer
+	}
+	if transfer.Owner != msg.Sender {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	k.RemovePendingTokenizeShareRecordTransfer(ctx, msg.TokenizeShareRecordId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelTokenizeShareRecordTransfer,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, fmt.Sprintf("%d", transfer.TokenizeShareRecordId)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, transfer.NewOwner),
+		),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelTokenizeShareRecordTransfer{
+		ShareRecordId: transfer.TokenizeShareRecordId,
+		Owner:         transfer.Owner,
+		NewOwner:      transfer.NewOwner,
+		Expired:       false,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelTokenizeShareRecordTransferResponse{}, nil
+}
+
 // DisableTokenizeShares prevents an address from tokenizing any of their delegations
 func (k msgServer) DisableTokenizeShares(goCtx context.Context, msg *types.MsgDisableTokenizeShares) (*types.MsgDisableTokenizeSharesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -912,15 +1296,34 @@ func (k msgServer) DisableTokenizeShares(goCtx context.Context, msg *types.MsgDi
 	delegator := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
 
 	// If tokenized shares is already disabled, alert the user
-	lockStatus, _ := k.GetTokenizeSharesLock(ctx, delegator)
+	lockStatus, unlockTime := k.GetTokenizeSharesLock(ctx, delegator)
 	if lockStatus == types.TokenizeShareLockStatus_LOCKED {
 		return nil, types.ErrTokenizeSharesAlreadyDisabledForAccount
 	}
 
-	// Otherwise, create a new tokenization lock for the user
+	// If there is a lock expiration in progress, remove the address from the unlock queue
+	// so the pending expiration does not remove the new lock when it matures
+	if lockStatus == types.TokenizeShareLockStatus_LOCK_EXPIRING {
+		k.CancelTokenizeShareLockExpiration(ctx, delegator, unlockTime)
+	}
+
+	// Create a new tokenization lock for the user
 	// Note: if there is a lock expiration in progress, this will override the expiration
 	k.AddTokenizeSharesLock(ctx, delegator)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddTokenizeSharesLock,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddTokenizeSharesLock{
+		DelegatorAddress: msg.DelegatorAddress,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgDisableTokenizeSharesResponse{}, nil
 }
 
@@ -944,9 +1347,57 @@ func (k msgServer) EnableTokenizeShares(goCtx context.Context, msg *types.MsgEna
 	// Otherwise queue the unlock
 	completionTime := k.QueueTokenizeSharesAuthorization(ctx, delegator)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueTokenizeSharesUnlock,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventQueueTokenizeSharesUnlock{
+		DelegatorAddress: msg.DelegatorAddress,
+		CompletionTime:   completionTime,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgEnableTokenizeSharesResponse{CompletionTime: completionTime}, nil
 }
 
+// CancelEnableTokenizeShares cancels a pending re-enablement of tokenization, leaving
+// the sender address locked
+func (k msgServer) CancelEnableTokenizeShares(goCtx context.Context, msg *types.MsgCancelEnableTokenizeShares) (*types.MsgCancelEnableTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
+
+	// The cancellation is only valid if there is an unlock in progress
+	lockStatus, unlockTime := k.GetTokenizeSharesLock(ctx, delegator)
+	if lockStatus != types.TokenizeShareLockStatus_LOCK_EXPIRING {
+		return nil, types.ErrNoTokenizeSharesUnlockInProgress
+	}
+
+	// Remove the address from the unlock queue and restore the lock
+	k.CancelTokenizeShareLockExpiration(ctx, delegator, unlockTime)
+	k.AddTokenizeSharesLock(ctx, delegator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddTokenizeSharesLock,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddTokenizeSharesLock{
+		DelegatorAddress: msg.DelegatorAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelEnableTokenizeSharesResponse{}, nil
+}
+
 func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -981,6 +1432,10 @@ func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorB
 		validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Add(delegation.Shares)
 		k.SetValidator(ctx, validator)
 
+		if err := k.AfterValidatorBondChanged(ctx, delAddr, valAddr, true); err != nil {
+			return nil, err
+		}
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeValidatorBondDelegation,
@@ -988,7 +1443,165 @@ func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorB
 				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 			),
 		)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorBond{
+			DelegatorAddress:         msg.DelegatorAddress,
+			ValidatorAddress:         msg.ValidatorAddress,
+			Shares:                   delegation.Shares,
+			TotalValidatorBondShares: validator.TotalValidatorBondShares,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgValidatorBondResponse{}, nil
 }
+
+// UpdateParams defines a method to perform updation of params exist in x/staking module.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ValidateParamsUpdate(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	// The liquid totals are not guaranteed to be accurate while a cap is disabled,
+	// so they are recalculated whenever one is re-enabled
+	oldParams := k.GetParams(ctx)
+	k.SetParams(ctx, msg.Params)
+
+	if LiquidStakingCapsReEnabled(oldParams, msg.Params) && !k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+		k.StartTotalLiquidStakedRefresh(ctx)
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// FundSlashInsurance deposits tokens into the slash insurance fund
+func (k msgServer) FundSlashInsurance(goCtx context.Context, msg *types.MsgFundSlashInsurance) (*types.MsgFundSlashInsuranceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Payouts are delegated, so only the bond denom can be used
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return nil, types.ErrOnlyBondDenomAllowedForSlashInsurance
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.SlashInsuranceFundName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFundSlashInsurance{
+		Depositor: msg.Depositor,
+		Amount:    msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundSlashInsuranceResponse{}, nil
+}
+
+// UpdateSlashInsuranceCoverage sets the coverage of the slash insurance fund
+func (k msgServer) UpdateSlashInsuranceCoverage(goCtx context.Context, msg *types.MsgUpdateSlashInsuranceCoverage) (*types.MsgUpdateSlashInsuranceCoverageResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Coverage.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetSlashInsuranceCoverage(ctx, msg.Coverage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateSlashInsuranceCoverage{
+		Coverage: msg.Coverage,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateSlashInsuranceCoverageResponse{}, nil
+}
+
+// ClaimTokenizeShareRecord burns share tokens of a released tokenize share record for their
+// part of the record's unbonded tokens
+func (k msgServer) ClaimTokenizeShareRecord(goCtx context.Context, msg *types.MsgClaimTokenizeShareRecord) (*types.MsgClaimTokenizeShareRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := k.Keeper.ClaimTokenizeShareRecord(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimTokenizeShareRecordResponse{
+		Amount: tokens,
+	}, nil
+}
+
+// PauseTokenization pauses tokenization globally or for a single validator
+func (k msgServer) PauseTokenization(goCtx context.Context, msg *types.MsgPauseTokenization) (*types.MsgPauseTokenizationResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Pause.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetTokenizationPause(ctx, msg.Pause)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPauseTokenization{
+		ValidatorAddress: msg.Pause.ValidatorAddress,
+		PauseRedemptions: msg.Pause.PauseRedemptions,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseTokenizationResponse{}, nil
+}
+
+// UnpauseTokenization lifts a global or validator tokenization pause
+func (k msgServer) UnpauseTokenization(goCtx context.Context, msg *types.MsgUnpauseTokenization) (*types.MsgUnpauseTokenizationResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	found := false
+	if msg.ValidatorAddress == "" {
+		_, found = k.GetGlobalTokenizationPause(ctx)
+	} else {
+		valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		_, found = k.GetValidatorTokenizationPause(ctx, valAddr)
+	}
+	if !found {
+		return nil, types.ErrTokenizationNotPaused
+	}
+
+	k.RemoveTokenizationPause(ctx, msg.ValidatorAddress)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUnpauseTokenization{
+		ValidatorAddress: msg.ValidatorAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseTokenizationResponse{}, nil
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
@@ -613,7 +615,7 @@ func TestTransferTokenizeShareRecord(t *testing.T) {
 		Id:            1,
 		Owner:         addrAcc1.String(),
 		ModuleAccount: "module_account",
-		Validator:     val.String(),
+		Validator:     val.OperatorAddress,
 	})
 	require.NoError(t, err)
 
@@ -871,6 +873,541 @@ func TestEnableDisableTokenizeShares(t *testing.T) {
 	require.NoError(t, err, "no error expected when tokenizing after lock has expired")
 }
 
+func TestDisableTokenizeSharesDuringUnlock(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	delegatorAddress := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+
+	// Fix block time and set unbonding period to 1 day
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	unbondingPeriod := time.Hour * 24
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = unbondingPeriod
+	app.StakingKeeper.SetParams(ctx, params)
+
+	disableMsg := types.MsgDisableTokenizeShares{DelegatorAddress: delegatorAddress.String()}
+	enableMsg := types.MsgEnableTokenizeShares{DelegatorAddress: delegatorAddress.String()}
+	cancelMsg := types.MsgCancelEnableTokenizeShares{DelegatorAddress: delegatorAddress.String()}
+
+	// Cancelling when there is no lock in place should error
+	_, err := msgServer.CancelEnableTokenizeShares(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrNoTokenizeSharesUnlockInProgress)
+
+	// Lock the account and begin the unlock
+	_, err = msgServer.DisableTokenizeShares(sdk.WrapSDKContext(ctx), &disableMsg)
+	require.NoError(t, err, "no error expected when disabling tokenization")
+
+	// Cancelling while the lock is in place (with no unlock in progress) should also error
+	_, err = msgServer.CancelEnableTokenizeShares(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrNoTokenizeSharesUnlockInProgress)
+
+	res, err := msgServer.EnableTokenizeShares(sdk.WrapSDKContext(ctx), &enableMsg)
+	require.NoError(t, err, "no error expected when enabling tokenization")
+	firstCompletionTime := res.CompletionTime
+
+	pending := app.StakingKeeper.GetPendingTokenizeShareAuthorizations(ctx, firstCompletionTime)
+	require.Equal(t, []string{delegatorAddress.String()}, pending.Addresses, "address queued for unlock")
+
+	// Disable again halfway through the unlock, the address should be removed from the queue
+	ctx = ctx.WithBlockTime(blockTime.Add(unbondingPeriod / 2))
+	_, err = msgServer.DisableTokenizeShares(sdk.WrapSDKContext(ctx), &disableMsg)
+	require.NoError(t, err, "no error expected when disabling tokenization during unlock")
+
+	pending = app.StakingKeeper.GetPendingTokenizeShareAuthorizations(ctx, firstCompletionTime)
+	require.Empty(t, pending.Addresses, "address removed from unlock queue")
+
+	// Once the original completion time has passed, the lock should still be in place
+	ctx = ctx.WithBlockTime(firstCompletionTime)
+	unlocked := app.StakingKeeper.RemoveExpiredTokenizeShareLocks(ctx, ctx.BlockTime())
+	require.Empty(t, unlocked, "no addresses unlocked at original completion time")
+
+	status, _ := app.StakingKeeper.GetTokenizeSharesLock(ctx, delegatorAddress)
+	require.Equal(t, types.TokenizeShareLockStatus_LOCKED, status, "lock remains after original completion time")
+
+	// Start another unlock and then explicitly cancel it
+	res, err = msgServer.EnableTokenizeShares(sdk.WrapSDKContext(ctx), &enableMsg)
+	require.NoError(t, err, "no error expected when enabling tokenization")
+	secondCompletionTime := res.CompletionTime
+
+	_, err = msgServer.CancelEnableTokenizeShares(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.NoError(t, err, "no error expected when cancelling the unlock")
+
+	status, _ = app.StakingKeeper.GetTokenizeSharesLock(ctx, delegatorAddress)
+	require.Equal(t, types.TokenizeShareLockStatus_LOCKED, status, "lock restored after cancellation")
+
+	pending = app.StakingKeeper.GetPendingTokenizeShareAuthorizations(ctx, secondCompletionTime)
+	require.Empty(t, pending.Addresses, "address removed from unlock queue after cancellation")
+
+	ctx = ctx.WithBlockTime(secondCompletionTime)
+	unlocked = app.StakingKeeper.RemoveExpiredTokenizeShareLocks(ctx, ctx.BlockTime())
+	require.Empty(t, unlocked, "no addresses unlocked after cancellation")
+
+	status, _ = app.StakingKeeper.GetTokenizeSharesLock(ctx, delegatorAddress)
+	require.Equal(t, types.TokenizeShareLockStatus_LOCKED, status, "lock remains after cancelled completion time")
+}
+
+func TestTokenizeSharesLockEvents(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	delegatorAddress := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// requireEvent checks that the event manager holds exactly one event of the given type
+	// with the expected attributes, and then resets the event manager
+	requireEvent := func(eventType string, expectedAttributes map[string]string) {
+		matching := []sdk.Event{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				matching = append(matching, event)
+			}
+		}
+		require.Len(t, matching, 1, "number of %s events", eventType)
+
+		actualAttributes := map[string]string{}
+		for _, attribute := range matching[0].Attributes {
+			actualAttributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		require.Equal(t, expectedAttributes, actualAttributes, "%s event attributes", eventType)
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+	}
+
+	// Disabling should emit the lock added event
+	_, err := msgServer.DisableTokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgDisableTokenizeShares{
+		DelegatorAddress: delegatorAddress.String(),
+	})
+	require.NoError(t, err)
+	requireEvent(types.EventTypeAddTokenizeSharesLock, map[string]string{
+		types.AttributeKeyDelegator: delegatorAddress.String(),
+	})
+
+	// Enabling should emit the unlock queued event with the completion time
+	res, err := msgServer.EnableTokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgEnableTokenizeShares{
+		DelegatorAddress: delegatorAddress.String(),
+	})
+	require.NoError(t, err)
+	requireEvent(types.EventTypeQueueTokenizeSharesUnlock, map[string]string{
+		types.AttributeKeyDelegator:      delegatorAddress.String(),
+		types.AttributeKeyCompletionTime: res.CompletionTime.Format(time.RFC3339),
+	})
+
+	// Cancelling the unlock should emit the lock added event
+	_, err = msgServer.CancelEnableTokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgCancelEnableTokenizeShares{
+		DelegatorAddress: delegatorAddress.String(),
+	})
+	require.NoError(t, err)
+	requireEvent(types.EventTypeAddTokenizeSharesLock, map[string]string{
+		types.AttributeKeyDelegator: delegatorAddress.String(),
+	})
+}
+
+// Helper function to parse all typed events of the given type from the event manager
+func getTypedEvents(t *testing.T, ctx sdk.Context, eventType proto.Message) []proto.Message {
+	typedEvents := []proto.Message{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(eventType) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err, "no error expected when parsing typed event")
+		typedEvents = append(typedEvents, typedEvent)
+	}
+	return typedEvents
+}
+
+func TestTypedLiquidStakingEvents(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	stakeAmount := sdk.NewInt(1000)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 3, stakeAmount)
+	delegatorAddress, validatorAccount, newOwnerAddress := addresses[0], addresses[1], addresses[2]
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	validatorAddress := sdk.ValAddress(validatorAccount)
+	validator := teststaking.NewValidator(t, validatorAddress, pubKeys[0])
+	validator.DelegatorShares = sdk.NewDec(1_000_000)
+	validator.Tokens = sdk.NewInt(1_000_000)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// Validator bond from the validator's account
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: validatorAccount.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, stakeAmount),
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: validatorAccount.String(),
+		ValidatorAddress: validatorAddress.String(),
+	})
+	require.NoError(t, err)
+
+	bondEvents := getTypedEvents(t, ctx, &types.EventValidatorBond{})
+	require.Equal(t, []proto.Message{&types.EventValidatorBond{
+		DelegatorAddress:         validatorAccount.String(),
+		ValidatorAddress:         validatorAddress.String(),
+		Shares:                   sdk.NewDec(1000),
+		TotalValidatorBondShares: sdk.NewDec(1000),
+	}}, bondEvents, "validator bond event")
+
+	// Delegate and tokenize from the delegator
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, stakeAmount),
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	totalLiquidStakedBefore := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddress.String(),
+		ValidatorAddress:    validatorAddress.String(),
+		Amount:              sdk.NewCoin(bondDenom, stakeAmount),
+		TokenizedShareOwner: delegatorAddress.String(),
+	})
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	shareDenom := record.GetShareTokenDenom()
+
+	tokenizeEvents := getTypedEvents(t, ctx, &types.EventTokenizeShares{})
+	require.Equal(t, []proto.Message{&types.EventTokenizeShares{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		ShareOwner:       delegatorAddress.String(),
+		ShareRecordId:    1,
+		ModuleAccount:    record.GetModuleAddress().String(),
+		Shares:           sdk.NewDec(1000),
+		Tokens:           sdk.NewCoin(bondDenom, stakeAmount),
+		ShareTokens:      sdk.NewCoin(shareDenom, stakeAmount),
+	}}, tokenizeEvents, "tokenize event")
+
+	liquidCapEvents := getTypedEvents(t, ctx, &types.EventLiquidCapChanged{})
+	require.Equal(t, []proto.Message{
+		&types.EventLiquidCapChanged{
+			SharesDelta:                sdk.ZeroDec(),
+			ValidatorTotalLiquidShares: sdk.ZeroDec(),
+			TokensDelta:                stakeAmount,
+			TotalLiquidStakedTokens:    totalLiquidStakedBefore.Add(stakeAmount),
+		},
+		&types.EventLiquidCapChanged{
+			ValidatorAddress:           validatorAddress.String(),
+			SharesDelta:                sdk.NewDec(1000),
+			ValidatorTotalLiquidShares: sdk.NewDec(1000),
+			TokensDelta:                sdk.ZeroInt(),
+			TotalLiquidStakedTokens:    totalLiquidStakedBefore.Add(stakeAmount),
+		},
+	}, liquidCapEvents, "liquid cap events from tokenization")
+
+	// Transfer the record to a new owner
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: 1,
+		Sender:                delegatorAddress.String(),
+		NewOwner:              newOwnerAddress.String(),
+	})
+	require.NoError(t, err)
+
+	transferEvents := getTypedEvents(t, ctx, &types.EventTransferTokenizeShareRecord{})
+	require.Equal(t, []proto.Message{&types.EventTransferTokenizeShareRecord{
+		ShareRecordId:    1,
+		ValidatorAddress: validatorAddress.String(),
+		PreviousOwner:    delegatorAddress.String(),
+		NewOwner:         newOwnerAddress.String(),
+	}}, transferEvents, "transfer event")
+
+	// Redeem half of the share tokens
+	redeemAmount := stakeAmount.QuoRaw(2)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: delegatorAddress.String(),
+		Amount:           sdk.NewCoin(shareDenom, redeemAmount),
+	})
+	require.NoError(t, err)
+
+	redeemEvents := getTypedEvents(t, ctx, &types.EventRedeemShares{})
+	require.Equal(t, []proto.Message{&types.EventRedeemShares{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		ShareOwner:       newOwnerAddress.String(),
+		ShareRecordId:    1,
+		Shares:           sdk.NewDec(500),
+		ShareTokens:      sdk.NewCoin(shareDenom, redeemAmount),
+		Tokens:           sdk.NewCoin(bondDenom, redeemAmount),
+		RecordRemoved:    false,
+	}}, redeemEvents, "redeem event")
+
+	liquidCapEvents = getTypedEvents(t, ctx, &types.EventLiquidCapChanged{})
+	require.Equal(t, []proto.Message{
+		&types.EventLiquidCapChanged{
+			SharesDelta:                sdk.ZeroDec(),
+			ValidatorTotalLiquidShares: sdk.ZeroDec(),
+			TokensDelta:                redeemAmount.Neg(),
+			TotalLiquidStakedTokens:    totalLiquidStakedBefore.Add(redeemAmount),
+		},
+		&types.EventLiquidCapChanged{
+			ValidatorAddress:           validatorAddress.String(),
+			SharesDelta:                sdk.NewDec(-500),
+			ValidatorTotalLiquidShares: sdk.NewDec(500),
+			TokensDelta:                sdk.ZeroInt(),
+			TotalLiquidStakedTokens:    totalLiquidStakedBefore.Add(redeemAmount),
+		},
+	}, liquidCapEvents, "liquid cap events from redemption")
+}
+
+// tokenizeShareHooksRecorder records the calls to the tokenization lifecycle hooks
+type tokenizeShareHooksRecorder struct {
+	types.StakingHooks
+	calls []string
+}
+
+func (h *tokenizeShareHooksRecorder) AfterTokenizeShareRecordCreated(_ sdk.Context, recordID uint64) error {
+	h.calls = append(h.calls, fmt.Sprintf("created:%d", recordID))
+	return nil
+}
+
+func (h *tokenizeShareHooksRecorder) AfterTokenizeSharesRedeemed(_ sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	h.calls = append(h.calls, fmt.Sprintf("redeemed:%d:%s:%s:%s", recordID, delAddr, valAddr, shares))
+	return nil
+}
+
+func (h *tokenizeShareHooksRecorder) AfterTokenizeShareRecordTransferred(_ sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("transferred:%d:%s:%s", recordID, prevOwner, newOwner))
+	return nil
+}
+
+func (h *tokenizeShareHooksRecorder) AfterValidatorBondChanged(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, validatorBond bool) error {
+	h.calls = append(h.calls, fmt.Sprintf("validator-bond:%s:%s:%t", delAddr, valAddr, validatorBond))
+	return nil
+}
+
+func TestTokenizeShareHooks(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	recorder := &tokenizeShareHooksRecorder{StakingHooks: types.NewMultiStakingHooks()}
+	stakingKeeper := *app.StakingKeeper.SetHooks(recorder)
+	msgServer := keeper.NewMsgServerImpl(stakingKeeper)
+
+	stakeAmount := sdk.NewInt(1000)
+	bondDenom := stakingKeeper.BondDenom(ctx)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 3, stakeAmount)
+	delegatorAddress, validatorAccount, newOwnerAddress := addresses[0], addresses[1], addresses[2]
+
+	pubKeys := simapp.CreateTestPubKeys(1)
+	validatorAddress := sdk.ValAddress(validatorAccount)
+	validator := teststaking.NewValidator(t, validatorAddress, pubKeys[0])
+	validator.DelegatorShares = sdk.NewDec(1_000_000)
+	validator.Tokens = sdk.NewInt(1_000_000)
+	stakingKeeper.SetValidator(ctx, validator)
+
+	// Validator bond from the validator's account
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: validatorAccount.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, stakeAmount),
+	})
+	require.NoError(t, err)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: validatorAccount.String(),
+		ValidatorAddress: validatorAddress.String(),
+	})
+	require.NoError(t, err)
+
+	// Delegate, tokenize, transfer and fully redeem from the delegator
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), &types.MsgDelegate{
+		DelegatorAddress: delegatorAddress.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, stakeAmount),
+	})
+	require.NoError(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddress.String(),
+		ValidatorAddress:    validatorAddress.String(),
+		Amount:              sdk.NewCoin(bondDenom, stakeAmount),
+		TokenizedShareOwner: delegatorAddress.String(),
+	})
+	require.NoError(t, err)
+
+	record, err := stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: 1,
+		Sender:                delegatorAddress.String(),
+		NewOwner:              newOwnerAddress.String(),
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: delegatorAddress.String(),
+		Amount:           sdk.NewCoin(record.GetShareTokenDenom(), stakeAmount),
+	})
+	require.NoError(t, err)
+
+	// Redeeming all the share tokens should remove the record even though hooks are registered
+	_, err = stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists, "record should be removed")
+
+	// Undelegate the full validator bond
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), &types.MsgUndelegate{
+		DelegatorAddress: validatorAccount.String(),
+		ValidatorAddress: validatorAddress.String(),
+		Amount:           sdk.NewCoin(bondDenom, stakeAmount),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		fmt.Sprintf("validator-bond:%s:%s:true", validatorAccount, validatorAddress),
+		"created:1",
+		fmt.Sprintf("transferred:1:%s:%s", delegatorAddress, newOwnerAddress),
+		fmt.Sprintf("redeemed:1:%s:%s:%s", delegatorAddress, validatorAddress, sdk.NewDec(1000)),
+		fmt.Sprintf("validator-bond:%s:%s:false", validatorAccount, validatorAddress),
+	}, recorder.calls, "hook calls")
+}
+
+func TestTokenizeShareRecordTransferProposal(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+
+	unbondingPeriod := time.Hour * 24
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = unbondingPeriod
+	app.StakingKeeper.SetParams(ctx, params)
+
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	addresses := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1))
+	owner, newOwner, otherOwner, validatorAccount := addresses[0], addresses[1], addresses[2], addresses[3]
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", types.TokenizeShareModuleAccountPrefix, 1),
+		Validator:     sdk.ValAddress(validatorAccount).String(),
+	}
+	require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+
+	propose := func(sender, recipient sdk.AccAddress) error {
+		_, err := msgServer.ProposeTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), &types.MsgProposeTokenizeShareRecordTransfer{
+			TokenizeShareRecordId: record.Id,
+			Sender:                sender.String(),
+			NewOwner:              recipient.String(),
+		})
+		return err
+	}
+	accept := func(sender sdk.AccAddress) error {
+		_, err := msgServer.AcceptTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), &types.MsgAcceptTokenizeShareRecordTransfer{
+			TokenizeShareRecordId: record.Id,
+			NewOwner:              sender.String(),
+		})
+		return err
+	}
+	cancel := func(sender sdk.AccAddress) error {
+		_, err := msgServer.CancelTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), &types.MsgCancelTokenizeShareRecordTransfer{
+			TokenizeShareRecordId: record.Id,
+			Sender:                sender.String(),
+		})
+		return err
+	}
+	recordOwner := func() string {
+		record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+		require.NoError(t, err)
+		return record.Owner
+	}
+
+	// Only the owner can propose a transfer, and not to themselves
+	require.ErrorIs(t, propose(newOwner, otherOwner), types.ErrNotTokenizeShareRecordOwner)
+	require.ErrorIs(t, propose(owner, owner), types.ErrTokenizeShareRecordTransferToSelf)
+
+	// Accept and cancel fail without a pending transfer
+	require.ErrorIs(t, accept(newOwner), types.ErrNoPendingTokenizeShareRecordTransfer)
+	require.ErrorIs(t, cancel(owner), types.ErrNoPendingTokenizeShareRecordTransfer)
+
+	// Propose a transfer, the ownership should not change until it's accepted
+	res, err := msgServer.ProposeTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), &types.MsgProposeTokenizeShareRecordTransfer{
+		TokenizeShareRecordId: record.Id,
+		Sender:                owner.String(),
+		NewOwner:              otherOwner.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, blockTime.Add(unbondingPeriod), res.ExpirationTime, "expiration time")
+	require.Equal(t, owner.String(), recordOwner(), "owner after proposal")
+
+	// A second proposal replaces the first
+	require.NoError(t, propose(owner, newOwner))
+	expectedTransfer := types.PendingTokenizeShareRecordTransfer{
+		TokenizeShareRecordId: record.Id,
+		Owner:                 owner.String(),
+		NewOwner:              newOwner.String(),
+		ExpirationTime:        blockTime.Add(unbondingPeriod),
+	}
+	transferRes, err := querier.PendingTokenizeShareRecordTransfer(sdk.WrapSDKContext(ctx), &types.QueryPendingTokenizeShareRecordTransferRequest{
+		TokenizeShareRecordId: record.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedTransfer, transferRes.Transfer, "pending transfer")
+
+	transfersRes, err := querier.PendingTokenizeShareRecordTransfers(sdk.WrapSDKContext(ctx), &types.QueryPendingTokenizeShareRecordTransfersRequest{
+		NewOwner: otherOwner.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, transfersRes.Transfers, "replaced transfer should not be pending")
+
+	// Only the proposed new owner can accept, and only the owner can cancel
+	require.ErrorIs(t, accept(otherOwner), types.ErrNotPendingTokenizeShareRecordNewOwner)
+	require.ErrorIs(t, cancel(newOwner), types.ErrNotTokenizeShareRecordOwner)
+
+	// Cancel the transfer, it should no longer be accepted
+	require.NoError(t, cancel(owner))
+	require.ErrorIs(t, accept(newOwner), types.ErrNoPendingTokenizeShareRecordTransfer)
+	require.Empty(t, app.StakingKeeper.GetAllPendingTokenizeShareRecordTransfers(ctx), "pending transfers after cancel")
+
+	// Propose again and accept
+	require.NoError(t, propose(owner, newOwner))
+	require.NoError(t, accept(newOwner))
+	require.Equal(t, newOwner.String(), recordOwner(), "owner after accept")
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner), "records of previous owner")
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwner), 1, "records of new owner")
+	require.Empty(t, app.StakingKeeper.GetAllPendingTokenizeShareRecordTransfers(ctx), "pending transfers after accept")
+
+	// The previous owner can no longer propose a transfer
+	require.ErrorIs(t, propose(owner, otherOwner), types.ErrNotTokenizeShareRecordOwner)
+
+	// A one-shot transfer should clear a pending proposal
+	require.NoError(t, propose(newOwner, otherOwner))
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), &types.MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: record.Id,
+		Sender:                newOwner.String(),
+		NewOwner:              owner.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, owner.String(), recordOwner(), "owner after one-shot transfer")
+	require.ErrorIs(t, accept(otherOwner), types.ErrNoPendingTokenizeShareRecordTransfer)
+
+	// Deleting the record should clear a pending proposal
+	require.NoError(t, propose(owner, otherOwner))
+	require.NoError(t, app.StakingKeeper.DeleteTokenizeShareRecord(ctx, record.Id))
+	require.Empty(t, app.StakingKeeper.GetAllPendingTokenizeShareRecordTransfers(ctx), "pending transfers after delete")
+	require.Empty(t, app.StakingKeeper.RemoveExpiredTokenizeShareRecordTransfers(ctx, blockTime.Add(unbondingPeriod)),
+		"expired transfers after delete")
+}
+
 func TestUnbondValidator(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 	addrs := simapp.AddTestAddrs(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10000))
@@ -906,3 +1443,681 @@ func TestUnbondValidator(t *testing.T) {
 	require.True(t, found)
 	require.True(t, validator.Jailed)
 }
+
+func TestUpdateParams(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	// the genesis validator set is not empty, so the bond denom is locked in
+	require.NotEmpty(t, app.StakingKeeper.GetAllValidators(ctx))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.25")
+	params.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.5")
+	params.ValidatorBondFactor = sdk.NewDec(250)
+
+	invalidCap := params
+	invalidCap.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("1.1")
+
+	newBondDenom := params
+	newBondDenom.BondDenom = "newdenom"
+
+	testCases := []struct {
+		name      string
+		msg       types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "invalid authority",
+			msg:       types.MsgUpdateParams{Authority: "invalid", Params: params},
+			expectErr: true,
+		},
+		{
+			name:      "invalid liquid staking cap",
+			msg:       types.MsgUpdateParams{Authority: app.StakingKeeper.GetAuthority(), Params: invalidCap},
+			expectErr: true,
+		},
+		{
+			name:      "bond denom change with existing validators",
+			msg:       types.MsgUpdateParams{Authority: app.StakingKeeper.GetAuthority(), Params: newBondDenom},
+			expectErr: true,
+		},
+		{
+			name:      "valid update",
+			msg:       types.MsgUpdateParams{Authority: app.StakingKeeper.GetAuthority(), Params: params},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &tc.msg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Params, app.StakingKeeper.GetParams(ctx))
+		})
+	}
+}
+
+func TestUpdateParamsRefreshesLiquidTotals(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+
+	// Stale totals that are left over from when the caps were disabled
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewInt(999))
+
+	// Lowering the global cap below 100% starts the refresh
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.25")
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.StakingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	res, err := querier.TotalLiquidStakedRefreshStatus(sdk.WrapSDKContext(ctx), &types.QueryTotalLiquidStakedRefreshStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, res.InProgress)
+	require.Equal(t, ctx.BlockHeight(), res.StartHeight)
+
+	// LSM messages are rejected until the refresh completes
+	delegatorAddress := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddress.String(),
+		ValidatorAddress:    sdk.ValAddress(delegatorAddress).String(),
+		Amount:              sdk.NewInt64Coin(params.BondDenom, 100),
+		TokenizedShareOwner: delegatorAddress.String(),
+	})
+	require.ErrorIs(t, err, types.ErrTotalLiquidStakedRefreshInProgress)
+
+	done, err := app.StakingKeeper.ProcessTotalLiquidStakedRefresh(ctx, 10_000)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, sdk.ZeroInt(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	res, err = querier.TotalLiquidStakedRefreshStatus(sdk.WrapSDKContext(ctx), &types.QueryTotalLiquidStakedRefreshStatusRequest{})
+	require.NoError(t, err)
+	require.False(t, res.InProgress)
+
+	// Lowering a cap that is already enforced does not start another refresh
+	params.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.1")
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.StakingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.False(t, app.StakingKeeper.IsTotalLiquidStakedRefreshInProgress(ctx))
+}
+
+// tests that the liquid staking caps cannot be tightened below the liquid stake that is
+// already held, globally or by a validator
+func TestUpdateParamsCannotTightenCapsBelowLiquidStake(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+	updateParams := func(params types.Params) error {
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+			Authority: app.StakingKeeper.GetAuthority(),
+			Params:    params,
+		})
+		return err
+	}
+
+	// Enforce loose caps and let the liquid totals be recalculated
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.5")
+	params.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.5")
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	require.NoError(t, updateParams(params))
+	done, err := app.StakingKeeper.ProcessTotalLiquidStakedRefresh(ctx, 10_000)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	// A validator with 10 tokens of validator bond from its operator and 10 tokens from
+	// a liquid staking provider
+	operatorAddr := simapp.AddTestAddrsIncremental(app, ctx, 1, power(10))[0]
+	valAddr := sdk.ValAddress(operatorAddr)
+	createDelegatedValidators(t, ctx, app, []sdk.ValAddress{valAddr}, sdkstaking.Unbonded, power(10), sdk.ZeroInt())
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+		DelegatorAddress: operatorAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+
+	icaAddr := createICAAccount(app, ctx, "ica")
+	coins := sdk.NewCoins(sdk.NewCoin(params.BondDenom, power(10)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, icaAddr, coins))
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(icaAddr, valAddr, sdk.NewCoin(params.BondDenom, power(10))))
+	require.NoError(t, err)
+
+	globalLiquidStake := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).ToDec().
+		Quo(app.StakingKeeper.TotalBondedTokens(ctx).ToDec())
+	require.True(t, globalLiquidStake.IsPositive())
+
+	testCases := []struct {
+		name      string
+		update    func(params *types.Params)
+		expectErr bool
+	}{
+		{
+			name:      "global cap below the liquid stake",
+			update:    func(params *types.Params) { params.GlobalLiquidStakingCap = globalLiquidStake.QuoInt64(2) },
+			expectErr: true,
+		},
+		{
+			name:      "global cap at the liquid stake",
+			update:    func(params *types.Params) { params.GlobalLiquidStakingCap = globalLiquidStake },
+			expectErr: false,
+		},
+		{
+			name:      "validator cap below the liquid stake",
+			update:    func(params *types.Params) { params.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.4") },
+			expectErr: true,
+		},
+		{
+			name:      "validator cap at the liquid stake",
+			update:    func(params *types.Params) { params.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.5") },
+			expectErr: false,
+		},
+		{
+			name:      "validator bond factor below the liquid shares",
+			update:    func(params *types.Params) { params.ValidatorBondFactor = sdk.MustNewDecFromStr("0.5") },
+			expectErr: true,
+		},
+		{
+			name:      "validator bond factor at the liquid shares",
+			update:    func(params *types.Params) { params.ValidatorBondFactor = sdk.OneDec() },
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := app.StakingKeeper.GetParams(ctx)
+			tc.update(&params)
+			err := updateParams(params)
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidParamsUpdate)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+		})
+	}
+
+	// Loosening the caps is always allowed
+	params = app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.9")
+	params.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.9")
+	params.ValidatorBondFactor = sdk.NewDec(100)
+	require.NoError(t, updateParams(params))
+}
+
+// bootstrapLiquidSharesTest creates two bonded validators whose exchange rates are not one, so
+// that shares are rounded, each with a 1,000,000 token delegation from the first user
+// Returns the app, the context, the validator addresses, a funded liquid staking provider and
+// two funded users
+func bootstrapLiquidSharesTest(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.ValAddress, sdk.AccAddress, []sdk.AccAddress) {
+	_, app, ctx := createTestInput(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	provider := createICAAccount(app, ctx, "ica-module-account")
+	pubKeys := simapp.CreateTestPubKeys(4)
+	users := []sdk.AccAddress{sdk.AccAddress(pubKeys[2].Address()), sdk.AccAddress(pubKeys[3].Address())}
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(10_000_000)))
+	for _, address := range append([]sdk.AccAddress{provider}, users...) {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, address, coins))
+	}
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(pubKeys[0].Address()), sdk.ValAddress(pubKeys[1].Address())}
+	createDelegatedValidators(t, ctx, app, valAddrs, sdkstaking.Bonded, sdk.ZeroInt(), sdk.NewInt(1_000_000), users[0])
+	for i, slashedTokens := range []int64{333_333, 100_001} {
+		validator, _ := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[i])
+		app.StakingKeeper.RemoveValidatorTokens(ctx, validator, sdk.NewInt(slashedTokens))
+	}
+
+	return app, ctx, valAddrs, provider, users
+}
+
+// tests that the validator bond shares grow with the shares added to a validator bond
+// delegation by delegating, redelegating, cancelling an unbonding and redeeming share tokens
+func TestValidatorBondSharesFollowDelegations(t *testing.T) {
+	app, ctx, valAddrs, _, users := bootstrapLiquidSharesTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	valA, valB := valAddrs[0], valAddrs[1]
+	bondHolder, user := users[0], users[1]
+
+	requireBondShares := func(step string) {
+		delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, bondHolder, valA)
+		require.True(t, found, step)
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valA)
+		require.True(t, found, step)
+		require.True(t, delegation.Shares.Equal(validator.TotalValidatorBondShares),
+			"%s: delegation shares %s, validator bond shares %s", step, delegation.Shares, validator.TotalValidatorBondShares)
+	}
+
+	_, err := msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(bondHolder, valA))
+	require.NoError(t, err)
+	requireBondShares("validator bond")
+
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(bondHolder, valA, sdk.NewCoin(bondDenom, sdk.NewInt(123_457))))
+	require.NoError(t, err)
+	requireBondShares("delegate")
+
+	_, err = msgServer.BeginRedelegate(goCtx, types.NewMsgBeginRedelegate(bondHolder, valB, valA, sdk.NewCoin(bondDenom, sdk.NewInt(222_223))))
+	require.NoError(t, err)
+	requireBondShares("redelegate")
+
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(bondHolder, valA, sdk.NewCoin(bondDenom, sdk.NewInt(99_999))))
+	require.NoError(t, err)
+	requireBondShares("undelegate")
+	_, err = msgServer.CancelUnbondingDelegation(goCtx, types.NewMsgCancelUnbondingDelegation(
+		bondHolder, valA, ctx.BlockHeight(), sdk.NewCoin(bondDenom, sdk.NewInt(66_666))))
+	require.NoError(t, err)
+	requireBondShares("cancel unbonding")
+
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(user, valA, sdk.NewCoin(bondDenom, sdk.NewInt(555_555))))
+	require.NoError(t, err)
+	res, err := msgServer.TokenizeShares(goCtx, &types.MsgTokenizeShares{
+		DelegatorAddress:    user.String(),
+		ValidatorAddress:    valA.String(),
+		Amount:              sdk.NewCoin(bondDenom, sdk.NewInt(100_000)),
+		TokenizedShareOwner: user.String(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, user, bondHolder, sdk.NewCoins(res.Amount)))
+	_, err = msgServer.RedeemTokens(goCtx, &types.MsgRedeemTokensforShares{
+		DelegatorAddress: bondHolder.String(),
+		Amount:           res.Amount,
+	})
+	require.NoError(t, err)
+	requireBondShares("redeem")
+
+	msg, broken := keeper.ValidatorBondSharesInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+// tests that tokenizing shares whose tokens are truncated when unbonded moves only the
+// unbonded tokens, leaving the pools and the delegator's balance unchanged
+func TestTokenizeSharesMovesUnbondedTokens(t *testing.T) {
+	app, ctx, valAddrs, _, users := bootstrapLiquidSharesTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	valAddr, delegator := valAddrs[0], users[1]
+
+	// accrue tokens to the validator so that its tokens per share is above one and share
+	// amounts no longer convert back to whole tokens
+	rewards := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(2_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.BondedPoolName, rewards))
+	validator, _ := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validator)
+	validator.Tokens = validator.Tokens.Add(rewards.AmountOf(bondDenom))
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegator, valAddr, sdk.NewCoin(bondDenom, sdk.NewInt(555_555))))
+	require.NoError(t, err)
+
+	balances := func() []sdk.Coin {
+		return []sdk.Coin{
+			app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom),
+			app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), bondDenom),
+			app.BankKeeper.GetBalance(ctx, delegator, bondDenom),
+		}
+	}
+	balancesBefore := balances()
+
+	amount := sdk.NewCoin(bondDenom, sdk.NewInt(333_331))
+	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	shares, err := validator.SharesFromTokens(amount.Amount)
+	require.NoError(t, err)
+	unbondedTokens := validator.TokensFromShares(shares).TruncateInt()
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delegator.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              amount,
+		TokenizedShareOwner: delegator.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, balancesBefore, balances())
+
+	// The event reports the unbonded tokens that were tokenized
+	tokenizeEvents := getTypedEvents(t, ctx, &types.EventTokenizeShares{})
+	require.Len(t, tokenizeEvents, 1)
+	require.True(t, unbondedTokens.LT(amount.Amount), "unbonded tokens %s", unbondedTokens)
+	require.Equal(t, sdk.NewCoin(bondDenom, unbondedTokens), tokenizeEvents[0].(*types.EventTokenizeShares).Tokens)
+
+	// The record delegates the truncated tokens
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, res.Amount.Denom)
+	require.NoError(t, err)
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	recordTokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	require.True(t, recordTokens.LT(amount.Amount), "record tokens %s", recordTokens)
+	require.True(t, app.BankKeeper.GetBalance(ctx, record.GetModuleAddress(), bondDenom).IsZero())
+}
+
+// tests that cancelling an unbonding delegation from a liquid staking provider counts the
+// tokens towards the liquid staking caps again
+func TestCancelUnbondingDelegationLiquidStakingProvider(t *testing.T) {
+	app, ctx, valAddrs, provider, _ := bootstrapLiquidSharesTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	valAddr := valAddrs[0]
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(-1)
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err := msgServer.Delegate(goCtx, types.NewMsgDelegate(provider, valAddr, sdk.NewCoin(bondDenom, sdk.NewInt(100_000))))
+	require.NoError(t, err)
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(provider, valAddr, sdk.NewCoin(bondDenom, sdk.NewInt(100_000))))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	cancel := types.NewMsgCancelUnbondingDelegation(provider, valAddr, ctx.BlockHeight(), sdk.NewCoin(bondDenom, sdk.NewInt(50_000)))
+
+	// Rejected messages are run on a cached context, since their state changes are discarded
+	rejectedCancel := func() error {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(cacheCtx), cancel)
+		return err
+	}
+
+	// The cancelled tokens would exceed the global cap
+	params.GlobalLiquidStakingCap = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, rejectedCancel(), types.ErrGlobalLiquidStakingCapExceeded)
+
+	// The cancelled tokens would exceed the validator cap
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, rejectedCancel(), types.ErrValidatorLiquidStakingCapExceeded)
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+	_, err = msgServer.CancelUnbondingDelegation(goCtx, cancel)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt(50_000), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.TotalLiquidShares.IsPositive())
+}
+
+// tests that the liquid shares and validator bond shares invariants hold after each
+// liquid staking message, with exchange rates that cause shares to be rounded
+func TestLiquidStakingInvariantsAfterMessages(t *testing.T) {
+	app, ctx, valAddrs, provider, users := bootstrapLiquidSharesTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	valA, valB := valAddrs[0], valAddrs[1]
+	bondHolder, user := users[0], users[1]
+
+	requireInvariants := func(step string) {
+		msg, broken := keeper.LiquidStakingInvariants(app.StakingKeeper)(ctx)
+		require.False(t, broken, "%s: %s", step, msg)
+	}
+
+	_, err := msgServer.ValidatorBond(goCtx, &types.MsgValidatorBond{DelegatorAddress: bondHolder.String(), ValidatorAddress: valA.String()})
+	require.NoError(t, err)
+	requireInvariants("validator bond")
+
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(bondHolder, valA, sdk.NewCoin(bondDenom, sdk.NewInt(123_457))))
+	require.NoError(t, err)
+	requireInvariants("delegate to validator bond")
+
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(provider, valA, sdk.NewCoin(bondDenom, sdk.NewInt(777_777))))
+	require.NoError(t, err)
+	requireInvariants("provider delegate")
+
+	_, err = msgServer.BeginRedelegate(goCtx, types.NewMsgBeginRedelegate(provider, valA, valB, sdk.NewCoin(bondDenom, sdk.NewInt(222_223))))
+	require.NoError(t, err)
+	requireInvariants("provider redelegate")
+
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(user, valA, sdk.NewCoin(bondDenom, sdk.NewInt(555_555))))
+	require.NoError(t, err)
+	userResp, err := msgServer.TokenizeShares(goCtx, &types.MsgTokenizeShares{
+		DelegatorAddress:    user.String(),
+		ValidatorAddress:    valA.String(),
+		Amount:              sdk.NewCoin(bondDenom, sdk.NewInt(333_331)),
+		TokenizedShareOwner: user.String(),
+	})
+	require.NoError(t, err)
+	requireInvariants("user tokenize")
+
+	resp, err := msgServer.TokenizeShares(goCtx, &types.MsgTokenizeShares{
+		DelegatorAddress:    provider.String(),
+		ValidatorAddress:    valB.String(),
+		Amount:              sdk.NewCoin(bondDenom, sdk.NewInt(111_111)),
+		TokenizedShareOwner: provider.String(),
+	})
+	require.NoError(t, err)
+	requireInvariants("provider tokenize")
+
+	_, err = msgServer.RedeemTokens(goCtx, &types.MsgRedeemTokensforShares{
+		DelegatorAddress: provider.String(),
+		Amount:           sdk.NewCoin(resp.Amount.Denom, sdk.NewInt(55_555)),
+	})
+	require.NoError(t, err)
+	requireInvariants("provider redeem")
+
+	_, err = msgServer.RedeemTokens(goCtx, &types.MsgRedeemTokensforShares{
+		DelegatorAddress: user.String(),
+		Amount:           sdk.NewCoin(userResp.Amount.Denom, sdk.NewInt(111_111)),
+	})
+	require.NoError(t, err)
+	requireInvariants("user redeem")
+
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(provider, valA, sdk.NewCoin(bondDenom, sdk.NewInt(99_999))))
+	require.NoError(t, err)
+	_, err = msgServer.CancelUnbondingDelegation(goCtx, types.NewMsgCancelUnbondingDelegation(
+		provider, valA, ctx.BlockHeight(), sdk.NewCoin(bondDenom, sdk.NewInt(66_666))))
+	require.NoError(t, err)
+	requireInvariants("provider cancel unbonding")
+
+	// The validator bond can be reduced while the validator bond factor is disabled
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(bondHolder, valA, sdk.NewCoin(bondDenom, sdk.NewInt(500_000))))
+	require.NoError(t, err)
+	requireInvariants("undelegate validator bond")
+
+	// Corrupting either total breaks the invariants
+	validator, _ := app.StakingKeeper.GetLiquidValidator(ctx, valA)
+	app.StakingKeeper.IncreaseValidatorBondShares(ctx, validator, sdk.OneDec())
+	_, broken := keeper.ValidatorBondSharesInvariant(app.StakingKeeper)(ctx)
+	require.True(t, broken)
+
+	validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valB)
+	app.StakingKeeper.IncreaseValidatorTotalLiquidShares(ctx, validator, sdk.SmallestDec())
+	_, broken = keeper.LiquidSharesInvariant(app.StakingKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestTotalTokenizeSharedAssets(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, power(100))
+	delegator := addrs[2]
+
+	// Create two validators with validator bonds, each with a delegation to tokenize
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+	for i, valAddr := range valAddrs {
+		validator := teststaking.NewValidator(t, valAddr, PKs[i])
+		app.StakingKeeper.SetValidator(ctx, validator)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+		require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+
+		require.NoError(t, delegateCoinsFromAccount(ctx, app, addrs[i], power(30), validator))
+		_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+			DelegatorAddress: addrs[i].String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		require.NoError(t, err)
+
+		validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		require.NoError(t, delegateCoinsFromAccount(ctx, app, delegator, power(30), validator))
+	}
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, -1)
+
+	tokenize := func(valAddr sdk.ValAddress, amount sdk.Int) sdk.Coin {
+		resp, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delegator.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              sdk.NewCoin(bondDenom, amount),
+			TokenizedShareOwner: delegator.String(),
+		})
+		require.NoError(t, err)
+		return resp.Amount
+	}
+	query := func() *types.QueryTotalTokenizeSharedAssetsResponse {
+		res, err := querier.TotalTokenizeSharedAssets(sdk.WrapSDKContext(ctx), &types.QueryTotalTokenizeSharedAssetsRequest{})
+		require.NoError(t, err)
+		return res
+	}
+
+	res := query()
+	require.Equal(t, sdk.NewCoin(bondDenom, sdk.ZeroInt()), res.Value)
+	require.Empty(t, res.Validators)
+
+	tokenize(valAddrs[0], power(5))
+	tokenize(valAddrs[0], power(5))
+	shareTokens := tokenize(valAddrs[1], power(20))
+
+	res = query()
+	require.Equal(t, sdk.NewCoin(bondDenom, power(30)), res.Value)
+	require.ElementsMatch(t, []types.ValidatorTokenizedAssets{
+		{ValidatorAddress: valAddrs[0].String(), TokenizedShares: sdk.NewDecFromInt(power(10)), Value: sdk.NewCoin(bondDenom, power(10))},
+		{ValidatorAddress: valAddrs[1].String(), TokenizedShares: sdk.NewDecFromInt(power(20)), Value: sdk.NewCoin(bondDenom, power(20))},
+	}, res.Validators)
+
+	// Redeeming all of a validator's share tokens removes it from the breakdown
+	_, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: delegator.String(),
+		Amount:           shareTokens,
+	})
+	require.NoError(t, err)
+
+	res = query()
+	require.Equal(t, sdk.NewCoin(bondDenom, power(10)), res.Value)
+	require.Equal(t, []types.ValidatorTokenizedAssets{
+		{ValidatorAddress: valAddrs[0].String(), TokenizedShares: sdk.NewDecFromInt(power(10)), Value: sdk.NewCoin(bondDenom, power(10))},
+	}, res.Validators)
+
+	_, broken := keeper.TokenizedSharesInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestFundSlashInsurance(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	depositor := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))[0]
+
+	testCases := []struct {
+		name      string
+		amount    sdk.Coin
+		expectErr bool
+	}{
+		{
+			name:      "not the bond denom",
+			amount:    sdk.NewInt64Coin("otherdenom", 100),
+			expectErr: true,
+		},
+		{
+			name:      "insufficient funds",
+			amount:    sdk.NewInt64Coin(bondDenom, 1001),
+			expectErr: true,
+		},
+		{
+			name:      "valid deposit",
+			amount:    sdk.NewInt64Coin(bondDenom, 400),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.FundSlashInsurance(sdk.WrapSDKContext(ctx), types.NewMsgFundSlashInsurance(depositor, tc.amount))
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.amount, app.StakingKeeper.GetSlashInsuranceFundBalance(ctx))
+			require.Equal(t, sdk.NewInt(600), app.BankKeeper.GetBalance(ctx, depositor, bondDenom).Amount)
+		})
+	}
+}
+
+func TestUpdateSlashInsuranceCoverage(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	coverage := types.SlashInsuranceCoverage{
+		CoverageFraction:  sdk.NewDecWithPrec(5, 1),
+		MaxPayoutPerSlash: sdk.NewInt(1000),
+	}
+	invalidCoverage := types.SlashInsuranceCoverage{
+		CoverageFraction:  sdk.NewDecWithPrec(15, 1),
+		MaxPayoutPerSlash: sdk.NewInt(1000),
+	}
+
+	testCases := []struct {
+		name      string
+		msg       types.MsgUpdateSlashInsuranceCoverage
+		expectErr bool
+	}{
+		{
+			name:      "invalid authority",
+			msg:       types.MsgUpdateSlashInsuranceCoverage{Authority: "invalid", Coverage: coverage},
+			expectErr: true,
+		},
+		{
+			name:      "coverage fraction above one",
+			msg:       types.MsgUpdateSlashInsuranceCoverage{Authority: app.StakingKeeper.GetAuthority(), Coverage: invalidCoverage},
+			expectErr: true,
+		},
+		{
+			name:      "valid update",
+			msg:       types.MsgUpdateSlashInsuranceCoverage{Authority: app.StakingKeeper.GetAuthority(), Coverage: coverage},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateSlashInsuranceCoverage(sdk.WrapSDKContext(ctx), &tc.msg)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Equal(t, types.DefaultSlashInsuranceCoverage(), app.StakingKeeper.GetSlashInsuranceCoverage(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Coverage, app.StakingKeeper.GetSlashInsuranceCoverage(ctx))
+		})
+	}
+}
@@ -8,35 +8,30 @@ import (
 )
 
 // UnbondingTime
-func (k Keeper) UnbondingTime(ctx sdk.Context) (res time.Duration) {
-	k.paramstore.Get(ctx, types.KeyUnbondingTime, &res)
-	return
+func (k Keeper) UnbondingTime(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).UnbondingTime
 }
 
 // MaxValidators - Maximum number of validators
-func (k Keeper) MaxValidators(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxValidators, &res)
-	return
+func (k Keeper) MaxValidators(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxValidators
 }
 
 // MaxEntries - Maximum number of simultaneous unbonding
 // delegations or redelegations (per pair/trio)
-func (k Keeper) MaxEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxEntries, &res)
-	return
+func (k Keeper) MaxEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxEntries
 }
 
 // HistoricalEntries = number of historical info entries
 // to persist in store
-func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyHistoricalEntries, &res)
-	return
+func (k Keeper) HistoricalEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).HistoricalEntries
 }
 
 // BondDenom - Bondable coin denomination
-func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
-	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
-	return
+func (k Keeper) BondDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).BondDenom
 }
 
 // PowerReduction - is the amount of staking tokens required for 1 unit of consensus-engine power.
@@ -48,45 +43,115 @@ func (k Keeper) PowerReduction(_ sdk.Context) sdk.Int {
 }
 
 // MinCommissionRate - Minimum validator commission rate
-func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
-	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
-	return
+func (k Keeper) MinCommissionRate(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinCommissionRate
 }
 
 // Validator bond factor for all validators
-func (k Keeper) ValidatorBondFactor(ctx sdk.Context) (res sdk.Dec) {
-	k.paramstore.Get(ctx, types.KeyValidatorBondFactor, &res)
-	return
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorBondFactor
 }
 
 // Global liquid staking cap across all liquid staking providers
-func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
-	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
-	return
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).GlobalLiquidStakingCap
 }
 
 // Liquid staking cap for each validator
-func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
-	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
-	return
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorLiquidStakingCap
 }
 
-// Get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(
-		k.UnbondingTime(ctx),
-		k.MaxValidators(ctx),
-		k.MaxEntries(ctx),
-		k.HistoricalEntries(ctx),
-		k.BondDenom(ctx),
-		k.MinCommissionRate(ctx),
-		k.ValidatorBondFactor(ctx),
-		k.GlobalLiquidStakingCap(ctx),
-		k.ValidatorLiquidStakingCap(ctx),
-	)
+// Fraction of each slash that is absorbed by the validator bond delegations first
+func (k Keeper) ValidatorBondFirstLossFraction(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorBondFirstLossFraction
 }
 
-// set the params
+// GetParams returns the current x/staking module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the x/staking module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// ValidateParamsUpdate returns an error if the params are invalid or unsafe to
+// switch to given the current state
+func (k Keeper) ValidateParamsUpdate(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	// The bond denom backs every existing delegation and pool balance, so it can
+	// only be changed before any validator has been created
+	if params.BondDenom != k.BondDenom(ctx) {
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorsKey)
+		defer iterator.Close()
+		if iterator.Valid() {
+			return types.ErrInvalidParamsUpdate.Wrap("bond denom cannot be changed once validators exist")
+		}
+	}
+
+	// The liquid totals are recalculated after a cap is re-enabled and are incomplete while
+	// that recalculation is in progress, so the current liquid stake can only be checked
+	// against caps that were already enforced
+	oldParams := k.GetParams(ctx)
+	if LiquidStakingCapsReEnabled(oldParams, params) || k.IsTotalLiquidStakedRefreshInProgress(ctx) {
+		return nil
+	}
+
+	return k.checkLiquidStakingCapsTightening(ctx, oldParams, params)
+}
+
+// checkLiquidStakingCapsTightening returns an error if the update tightens a liquid staking
+// cap below the liquid stake that is already held, globally or by any validator
+func (k Keeper) checkLiquidStakingCapsTightening(ctx sdk.Context, oldParams, newParams types.Params) error {
+	if newParams.GlobalLiquidStakingCap.LT(oldParams.GlobalLiquidStakingCap) {
+		totalBonded := k.TotalBondedTokens(ctx)
+		if totalBonded.IsPositive() {
+			liquidStakePercent := k.GetTotalLiquidStakedTokens(ctx).ToDec().Quo(totalBonded.ToDec())
+			if liquidStakePercent.GT(newParams.GlobalLiquidStakingCap) {
+				return types.ErrInvalidParamsUpdate.Wrapf("global liquid staking cap %s is below the current liquid stake of %s",
+					newParams.GlobalLiquidStakingCap, liquidStakePercent)
+			}
+		}
+	}
+
+	validatorCapTightened := newParams.ValidatorLiquidStakingCap.LT(oldParams.ValidatorLiquidStakingCap)
+	bondFactorTightened := !newParams.ValidatorBondFactor.Equal(sdk.NewDec(-1)) &&
+		newParams.ValidatorBondFactor.LT(oldParams.ValidatorBondFactor)
+	if !validatorCapTightened && !bondFactorTightened {
+		return nil
+	}
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validatorCapTightened && validator.DelegatorShares.IsPositive() {
+			liquidStakePercent := validator.TotalLiquidShares.Quo(validator.DelegatorShares)
+			if liquidStakePercent.GT(newParams.ValidatorLiquidStakingCap) {
+				return types.ErrInvalidParamsUpdate.Wrapf("validator liquid staking cap %s is below the liquid stake of %s held by %s",
+					newParams.ValidatorLiquidStakingCap, liquidStakePercent, validator.OperatorAddress)
+			}
+		}
+
+		if bondFactorTightened {
+			maxLiquidShares := validator.TotalValidatorBondShares.Mul(newParams.ValidatorBondFactor)
+			if validator.TotalLiquidShares.GT(maxLiquidShares) {
+				return types.ErrInvalidParamsUpdate.Wrapf("validator bond factor %s allows %s liquid shares, but %s holds %s",
+					newParams.ValidatorBondFactor, maxLiquidShares, validator.OperatorAddress, validator.TotalLiquidShares)
+			}
+		}
+	}
+
+	return nil
 }
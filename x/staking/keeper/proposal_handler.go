@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// HandleUpdateParamsProposal is a handler for executing a passed update params proposal.
+// The proposal is executed as a MsgUpdateParams signed by the module authority, so that
+// it goes through the same checks and side effects as the message
+func HandleUpdateParamsProposal(ctx sdk.Context, k Keeper, p *types.UpdateParamsProposal) error {
+	_, err := NewMsgServerImpl(k).UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    p.Params,
+	})
+	return err
+}
+
+// HandleUpdateSlashInsuranceCoverageProposal is a handler for executing a passed update slash
+// insurance coverage proposal, as a MsgUpdateSlashInsuranceCoverage signed by the module authority
+func HandleUpdateSlashInsuranceCoverageProposal(ctx sdk.Context, k Keeper, p *types.UpdateSlashInsuranceCoverageProposal) error {
+	_, err := NewMsgServerImpl(k).UpdateSlashInsuranceCoverage(sdk.WrapSDKContext(ctx), &types.MsgUpdateSlashInsuranceCoverage{
+		Authority: k.GetAuthority(),
+		Coverage:  p.Coverage,
+	})
+	return err
+}
+
+// HandlePauseTokenizationProposal is a handler for executing a passed pause tokenization
+// proposal, as a MsgPauseTokenization signed by the module authority
+func HandlePauseTokenizationProposal(ctx sdk.Context, k Keeper, p *types.PauseTokenizationProposal) error {
+	_, err := NewMsgServerImpl(k).PauseTokenization(sdk.WrapSDKContext(ctx), &types.MsgPauseTokenization{
+		Authority: k.GetAuthority(),
+		Pause:     p.Pause,
+	})
+	return err
+}
+
+// HandleUnpauseTokenizationProposal is a handler for executing a passed unpause tokenization
+// proposal, as a MsgUnpauseTokenization signed by the module authority
+func HandleUnpauseTokenizationProposal(ctx sdk.Context, k Keeper, p *types.UnpauseTokenizationProposal) error {
+	_, err := NewMsgServerImpl(k).UnpauseTokenization(sdk.WrapSDKContext(ctx), &types.MsgUnpauseTokenization{
+		Authority:        k.GetAuthority(),
+		ValidatorAddress: p.ValidatorAddress,
+	})
+	return err
+}
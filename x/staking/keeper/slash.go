@@ -29,7 +29,7 @@ import (
 //
 //	Infraction was committed at the current height or at a past height,
 //	not at a height in the future
-func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec, _ sdkstaking.InfractionType) {
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec, infraction sdkstaking.InfractionType) {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
@@ -117,28 +117,83 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	tokensToBurn := sdk.MinInt(remainingSlashAmount, validator.Tokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
 
+	// When enabled, part of the burn is taken from the validator bond delegations alone
+	// before the rest is spread over all of the validator's delegators
+	var bondDelegations []types.Delegation
+	firstLoss := sdk.ZeroInt()
+	if firstLossFraction := k.ValidatorBondFirstLossFraction(ctx); firstLossFraction.IsPositive() && tokensToBurn.IsPositive() {
+		bondDelegations = k.GetValidatorBondDelegations(ctx, operatorAddress)
+		firstLoss = validatorBondFirstLoss(validator, bondDelegations, tokensToBurn, firstLossFraction)
+	}
+
+	// The rewards of the validator bond delegations are withdrawn before their shares are
+	// reduced, and their distribution records are started again afterwards, so the
+	// slash event below only needs to cover what the other delegators lose
+	if firstLoss.IsPositive() {
+		for _, delegation := range bondDelegations {
+			if err := k.BeforeDelegationSharesModified(ctx, delegation.GetDelegatorAddr(), operatorAddress); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	// we need to calculate the *effective* slash fraction for distribution
+	effectiveFraction := sdk.ZeroDec()
 	if validator.Tokens.IsPositive() {
-		effectiveFraction := sdk.NewDecFromInt(tokensToBurn).QuoRoundUp(sdk.NewDecFromInt(validator.Tokens))
+		effectiveFraction = sdk.NewDecFromInt(tokensToBurn).QuoRoundUp(sdk.NewDecFromInt(validator.Tokens))
 		// possible if power has changed
 		if effectiveFraction.GT(sdk.OneDec()) {
 			effectiveFraction = sdk.OneDec()
 		}
+		socializedFraction := effectiveFraction
+		if firstLoss.IsPositive() {
+			socializedFraction = sdk.NewDecFromInt(tokensToBurn.Sub(firstLoss)).QuoRoundUp(sdk.NewDecFromInt(validator.Tokens))
+		}
 		// call the before-slashed hook
-		err := k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
+		err := k.BeforeValidatorSlashed(ctx, operatorAddress, socializedFraction)
 		if err != nil {
 			panic(err)
 		}
 	}
 
+	// Value the validator's liquid shares before the tokens are burned so that the
+	// liquid total is reduced by exactly what the liquid delegations lose. This only
+	// covers the tokens burned from the validator itself; stake that has since been
+	// unbonded or redelegated left the liquid total when it left the validator
+	liquidTokensBefore := k.liquidTokensFromValidator(validator)
+
+	// Record the exchange rate before the slash, so that holders of the validator's
+	// share tokens can look up what their tokenize share records lost
+	slashRecord := types.SlashRecord{
+		ValidatorAddress:  operatorAddress.String(),
+		Height:            ctx.BlockHeight(),
+		InfractionHeight:  infractionHeight,
+		InfractionType:    infraction.String(),
+		SlashFactor:       slashFactor,
+		EffectiveFraction: effectiveFraction,
+		TokensBurned:      tokensToBurn,
+		TokensBefore:      validator.Tokens,
+		DelegatorShares:   validator.DelegatorShares,
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
-	// Proportionally deduct any liquid tokens from the global total
-	validatorLiquidRatio := validator.TotalLiquidShares.Quo(validator.DelegatorShares)
-	slashedLiquidTokens := validatorLiquidRatio.Mul(sdk.NewDecFromInt(slashAmount)).TruncateInt()
-	k.DecreaseTotalLiquidStakedTokens(ctx, slashedLiquidTokens)
+	validatorBondSharesRemoved := sdk.ZeroDec()
+	if firstLoss.IsPositive() {
+		validator, validatorBondSharesRemoved = k.absorbValidatorBondFirstLoss(ctx, validator, bondDelegations, firstLoss)
+	}
+	slashRecord.ValidatorBondFirstLoss = firstLoss
+	slashRecord.ValidatorBondSharesRemoved = validatorBondSharesRemoved
+
+	// Deduct the burned liquid tokens from the global total
+	slashedLiquidTokens := liquidTokensBefore.Sub(k.liquidTokensFromValidator(validator))
+	k.DecreaseTotalLiquidStakedTokensBySlash(ctx, slashedLiquidTokens)
+
+	slashRecord.LiquidTokensDeducted = slashedLiquidTokens
+	slashRecord.InsurancePayout = sdk.ZeroInt()
+	slashRecord = k.AddSlashRecord(ctx, slashRecord)
 
 	switch validator.GetStatus() {
 	case sdkstaking.Bonded:
@@ -153,14 +208,122 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		panic("invalid validator status")
 	}
 
+	// Reimburse the affected tokenize share records out of the slash insurance fund
+	validator, insurancePayout := k.payoutSlashInsurance(ctx, validator, slashRecord)
+	if insurancePayout.IsPositive() {
+		slashRecord.InsurancePayout = insurancePayout
+		k.SetSlashRecord(ctx, slashRecord)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSlash{
+		SlashRecordId:          slashRecord.Id,
+		ValidatorAddress:       slashRecord.ValidatorAddress,
+		InfractionHeight:       infractionHeight,
+		InfractionType:         slashRecord.InfractionType,
+		EffectiveFraction:      effectiveFraction,
+		TokensBurned:           tokensToBurn,
+		LiquidTokensDeducted:   slashedLiquidTokens,
+		ValidatorBondFirstLoss: firstLoss,
+		InsurancePayout:        insurancePayout,
+	}); err != nil {
+		panic(err)
+	}
+
 	logger.Info(
 		"validator slashed by slash factor",
 		"validator", validator.GetOperator().String(),
 		"slash_factor", slashFactor.String(),
 		"burned", tokensToBurn,
+		"validator_bond_first_loss", firstLoss,
+		"insurance_payout", insurancePayout,
+		"slash_record_id", slashRecord.Id,
 	)
 }
 
+// validatorBondFirstLoss returns the tokens of a burn that the validator bond delegations
+// absorb on their own. It is the first loss fraction of the burn, capped at what the
+// validator bond delegations still hold after their share of the rest of the burn.
+// There is no first loss if the validator bond delegations hold none or all of the shares
+func validatorBondFirstLoss(validator types.Validator, bondDelegations []types.Delegation, tokensToBurn sdk.Int, firstLossFraction sdk.Dec) sdk.Int {
+	bondShares := sdk.ZeroDec()
+	for _, delegation := range bondDelegations {
+		bondShares = bondShares.Add(delegation.Shares)
+	}
+
+	otherShares := validator.DelegatorShares.Sub(bondShares)
+	if !bondShares.IsPositive() || !otherShares.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	// If the first loss is L and the validator is left with T tokens, the other
+	// delegators keep the value of their shares at T + L tokens. The validator bond
+	// delegations are wiped out when that leaves them nothing, i.e. L = T * bond / other
+	tokensAfter := validator.Tokens.Sub(tokensToBurn)
+	maxFirstLoss := sdk.NewDecFromInt(tokensAfter).Mul(bondShares).Quo(otherShares).TruncateInt()
+
+	return sdk.MinInt(firstLossFraction.MulInt(tokensToBurn).TruncateInt(), maxFirstLoss)
+}
+
+// absorbValidatorBondFirstLoss removes shares from the validator bond delegations of a
+// slashed validator, so that they alone lose the first loss while the exchange rate of
+// the other shares is restored to what it would have been had only the rest of the
+// burn been taken. The shares are taken from each validator bond delegation in
+// proportion to its shares, and a delegation that is left with none is removed.
+// The tokens must already have been removed from the validator, and the rewards of the
+// validator bond delegations withdrawn. Returns the updated validator and the shares removed
+func (k Keeper) absorbValidatorBondFirstLoss(
+	ctx sdk.Context, validator types.Validator, bondDelegations []types.Delegation, firstLoss sdk.Int,
+) (types.Validator, sdk.Dec) {
+	bondShares := sdk.ZeroDec()
+	for _, delegation := range bondDelegations {
+		bondShares = bondShares.Add(delegation.Shares)
+	}
+
+	// The first loss is valued at the exchange rate the other delegators are left with
+	sharesToRemove := validator.DelegatorShares.MulInt(firstLoss).QuoInt(validator.Tokens.Add(firstLoss))
+	sharesToRemove = sdk.MinDec(sharesToRemove, bondShares)
+
+	sharesRemoved := sdk.ZeroDec()
+	modifiedDelegations := []types.Delegation{}
+	for _, delegation := range bondDelegations {
+		delegationSharesRemoved := sharesToRemove.MulTruncate(delegation.Shares).QuoTruncate(bondShares)
+		delegationSharesRemoved = sdk.MinDec(delegationSharesRemoved, delegation.Shares)
+		sharesRemoved = sharesRemoved.Add(delegationSharesRemoved)
+
+		delegation.Shares = delegation.Shares.Sub(delegationSharesRemoved)
+		if delegation.Shares.IsZero() {
+			if err := k.RemoveDelegation(ctx, delegation); err != nil {
+				panic(err)
+			}
+			continue
+		}
+
+		k.SetDelegation(ctx, delegation)
+		modifiedDelegations = append(modifiedDelegations, delegation)
+	}
+
+	validator.DelegatorShares = validator.DelegatorShares.Sub(sharesRemoved)
+	validator.TotalValidatorBondShares = validator.TotalValidatorBondShares.Sub(sharesRemoved)
+	k.SetValidator(ctx, validator)
+
+	// Start the distribution records again from the reduced shares
+	for _, delegation := range modifiedDelegations {
+		if err := k.AfterDelegationModified(ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()); err != nil {
+			panic(err)
+		}
+	}
+
+	return validator, sharesRemoved
+}
+
+// liquidTokensFromValidator returns the exact token value of a validator's liquid shares
+func (k Keeper) liquidTokensFromValidator(validator types.Validator) sdk.Dec {
+	if !validator.DelegatorShares.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return validator.TokensFromShares(validator.TotalLiquidShares)
+}
+
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
@@ -293,6 +456,17 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, _ types.Validator, redelegati
 			panic("destination validator not found")
 		}
 
+		// the unbonded shares no longer count towards the global liquid stake, or the
+		// destination validator's liquid shares or validator bond
+		if k.AccountIsLiquidStakingProvider(ctx, delegatorAddress) {
+			k.DecreaseTotalLiquidStakedTokens(ctx, tokensToBurn)
+			k.DecreaseValidatorTotalLiquidShares(ctx, dstValidator, sharesToUnbond)
+			dstValidator, _ = k.GetLiquidValidator(ctx, valDstAddr)
+		}
+		if delegation.ValidatorBond {
+			k.DecreaseValidatorBondShares(ctx, dstValidator, sharesToUnbond)
+		}
+
 		// tokens of a redelegation currently live in the destination validator
 		// therefor we must burn tokens from the destination-validator's bonding status
 		switch {
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// GetSlashInsuranceCoverage returns the coverage of the slash insurance fund
+func (k Keeper) GetSlashInsuranceCoverage(ctx sdk.Context) types.SlashInsuranceCoverage {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SlashInsuranceCoverageKey)
+	if bz == nil {
+		return types.DefaultSlashInsuranceCoverage()
+	}
+
+	var coverage types.SlashInsuranceCoverage
+	k.cdc.MustUnmarshal(bz, &coverage)
+	return coverage
+}
+
+// SetSlashInsuranceCoverage sets the coverage of the slash insurance fund
+func (k Keeper) SetSlashInsuranceCoverage(ctx sdk.Context, coverage types.SlashInsuranceCoverage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SlashInsuranceCoverageKey, k.cdc.MustMarshal(&coverage))
+}
+
+// GetSlashInsuranceFundBalance returns the bond denom tokens held by the slash insurance fund
+func (k Keeper) GetSlashInsuranceFundBalance(ctx sdk.Context) sdk.Coin {
+	fundAddress := k.authKeeper.GetModuleAddress(types.SlashInsuranceFundName)
+	return k.bankKeeper.GetBalance(ctx, fundAddress, k.BondDenom(ctx))
+}
+
+// SetSlashInsurancePayout stores a payout made by the slash insurance fund
+func (k Keeper) SetSlashInsurancePayout(ctx sdk.Context, payout types.SlashInsurancePayout) {
+	valAddr, err := sdk.ValAddressFromBech32(payout.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetSlashInsurancePayoutKey(valAddr, payout.SlashRecordId, payout.TokenizeShareRecordId)
+	store.Set(key, k.cdc.MustMarshal(&payout))
+}
+
+// GetSlashInsurancePayout returns the payout made to a tokenize share record for a slash
+// of a validator, and false if there was none
+func (k Keeper) GetSlashInsurancePayout(
+	ctx sdk.Context, valAddr sdk.ValAddress, slashRecordID uint64, recordID uint64,
+) (payout types.SlashInsurancePayout, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSlashInsurancePayoutKey(valAddr, slashRecordID, recordID))
+	if bz == nil {
+		return payout, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &payout)
+	return payout, true
+}
+
+// GetAllSlashInsurancePayouts returns the payouts made for the slashes of all validators,
+// used during genesis dump
+func (k Keeper) GetAllSlashInsurancePayouts(ctx sdk.Context) (payouts []types.SlashInsurancePayout) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashInsurancePayoutPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var payout types.SlashInsurancePayout
+		k.cdc.MustUnmarshal(iterator.Value(), &payout)
+
+		payouts = append(payouts, payout)
+	}
+
+	return payouts
+}
+
+// RemoveValidatorSlashInsurancePayouts deletes the payouts made for the slashes of a validator
+// This is called when the validator is removed, along with its slash records
+func (k Keeper) RemoveValidatorSlashInsurancePayouts(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSlashInsurancePayoutsByValidatorKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+// slashedTokenizeShareRecord is a tokenize share record delegated to a slashed validator,
+// with the value its delegation lost to the slash
+type slashedTokenizeShareRecord struct {
+	record types.TokenizeShareRecord
+	loss   sdk.Dec
+}
+
+// payoutSlashInsurance reimburses the tokenize share records delegated to a slashed validator
+// out of the slash insurance fund. The payout is the coverage fraction of what the records
+// lost, capped at the max payout per slash and at the fund's balance, and is split between
+// the records in proportion to their loss. Each record's share is delegated back to the
+// validator from the record's module account, which restores the exchange rate of the
+// record's share tokens. The slashed tokens must already have been removed from the validator
+// Returns the updated validator and the tokens paid out
+func (k Keeper) payoutSlashInsurance(
+	ctx sdk.Context, validator types.Validator, slashRecord types.SlashRecord,
+) (types.Validator, sdk.Int) {
+	coverage := k.GetSlashInsuranceCoverage(ctx)
+	if !coverage.PaysOut() || validator.InvalidExRate() || !slashRecord.DelegatorShares.IsPositive() {
+		return validator, sdk.ZeroInt()
+	}
+
+	valAddr := validator.GetOperator()
+	slashedRecords := []slashedTokenizeShareRecord{}
+	totalLoss := sdk.ZeroDec()
+	for _, record := range k.GetTokenizeShareRecordsByValidator(ctx, valAddr) {
+		delegation, found := k.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			continue
+		}
+
+		tokensBefore := delegation.Shares.MulInt(slashRecord.TokensBefore).Quo(slashRecord.DelegatorShares)
+		loss := tokensBefore.Sub(validator.TokensFromShares(delegation.Shares))
+		if !loss.IsPositive() {
+			continue
+		}
+
+		slashedRecords = append(slashedRecords, slashedTokenizeShareRecord{record: record, loss: loss})
+		totalLoss = totalLoss.Add(loss)
+	}
+	if !totalLoss.IsPositive() {
+		return validator, sdk.ZeroInt()
+	}
+
+	fundBalance := k.GetSlashInsuranceFundBalance(ctx)
+	payout := coverage.CoverageFraction.Mul(totalLoss).TruncateInt()
+	payout = sdk.MinInt(payout, coverage.MaxPayoutPerSlash)
+	payout = sdk.MinInt(payout, fundBalance.Amount)
+	if !payout.IsPositive() {
+		return validator, sdk.ZeroInt()
+	}
+
+	totalPaid := sdk.ZeroInt()
+	for _, slashed := range slashedRecords {
+		amount := sdk.NewDecFromInt(payout).Mul(slashed.loss).Quo(totalLoss).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		recordAddress := slashed.record.GetModuleAddress()
+		coins := sdk.NewCoins(sdk.NewCoin(fundBalance.Denom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.SlashInsuranceFundName, recordAddress, coins); err != nil {
+			panic(err)
+		}
+
+		shares, err := k.Delegate(ctx, recordAddress, amount, sdkstaking.Unbonded, validator, true)
+		if err != nil {
+			panic(err)
+		}
+
+		// The new shares are tokenized, so they count towards the liquid totals. They are
+		// not checked against the caps since they only restore what the slash removed
+		validator = k.mustGetLiquidValidator(ctx, valAddr)
+		k.IncreaseValidatorTotalLiquidShares(ctx, validator, shares)
+		validator = k.mustGetLiquidValidator(ctx, valAddr)
+		k.IncreaseValidatorTokenizedShares(ctx, validator, shares)
+		validator = k.mustGetLiquidValidator(ctx, valAddr)
+		k.IncreaseTotalLiquidStakedTokens(ctx, amount)
+
+		k.SetSlashInsurancePayout(ctx, types.SlashInsurancePayout{
+			SlashRecordId:         slashRecord.Id,
+			ValidatorAddress:      slashRecord.ValidatorAddress,
+			TokenizeShareRecordId: slashed.record.Id,
+			Amount:                amount,
+			Shares:                shares,
+		})
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSlashInsurancePayout{
+			SlashRecordId:         slashRecord.Id,
+			ValidatorAddress:      slashRecord.ValidatorAddress,
+			TokenizeShareRecordId: slashed.record.Id,
+			Amount:                amount,
+			Shares:                shares,
+		}); err != nil {
+			panic(err)
+		}
+
+		totalPaid = totalPaid.Add(amount)
+	}
+
+	return validator, totalPaid
+}
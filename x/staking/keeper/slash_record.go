@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+func (k Keeper) GetLastSlashRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastSlashRecordIDKey)
+	if bytes == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bytes)
+}
+
+func (k Keeper) SetLastSlashRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSlashRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSlashRecord returns a slash record of a validator, and false if it does not exist
+func (k Keeper) GetSlashRecord(ctx sdk.Context, valAddr sdk.ValAddress, id uint64) (record types.SlashRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSlashRecordKey(valAddr, id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetAllSlashRecords returns the slash records of all validators, used during genesis dump
+func (k Keeper) GetAllSlashRecords(ctx sdk.Context) (records []types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// SetSlashRecord stores a slash record under its validator
+func (k Keeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSlashRecordKey(valAddr, record.Id), k.cdc.MustMarshal(&record))
+}
+
+// AddSlashRecord assigns the next slash record id to the record and stores it
+// Returns the record with its id set
+func (k Keeper) AddSlashRecord(ctx sdk.Context, record types.SlashRecord) types.SlashRecord {
+	record.Id = k.GetLastSlashRecordID(ctx) + 1
+	k.SetLastSlashRecordID(ctx, record.Id)
+	k.SetSlashRecord(ctx, record)
+
+	return record
+}
+
+// RemoveValidatorSlashRecords deletes all slash records of a validator
+// This is called when the validator is removed, since its exchange rate can no longer be used
+func (k Keeper) RemoveValidatorSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSlashRecordsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -185,6 +186,43 @@ func TestSlashRedelegation(t *testing.T) {
 	require.Equal(t, balances.Sub(burnedCoins), app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress()))
 }
 
+// tests that slashing a redelegation from a liquid staking provider's validator bond removes
+// the unbonded shares from the destination validator's liquid and validator bond shares
+func TestSlashRedelegationLiquidShares(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapSlashTest(t, 10)
+	fraction := sdk.NewDecWithPrec(5, 1)
+
+	// add bonded tokens to pool for (re)delegations
+	startCoins := sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 15))
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	require.NoError(t, simapp_test.FundModuleAccount(app.BankKeeper, ctx, bondedPool.GetName(), startCoins))
+	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
+
+	provider := createICAAccount(app, ctx, "ica-module-account")
+	rd := types.NewRedelegation(provider, addrVals[0], addrVals[1], 0,
+		time.Unix(5, 0), sdk.NewInt(10), sdk.NewDec(10))
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(provider, addrVals[1], sdk.NewDec(10), true))
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[1])
+	require.True(t, found)
+	validator.TotalLiquidShares = sdk.NewDec(10)
+	validator.TotalValidatorBondShares = sdk.NewDec(10)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	slashAmount := app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 0, fraction)
+	require.True(t, slashAmount.Equal(sdk.NewInt(5)))
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, provider, addrVals[1])
+	require.True(t, found)
+	validator, found = app.StakingKeeper.GetLiquidValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.True(t, delegation.Shares.Equal(validator.TotalLiquidShares),
+		"delegation shares %s, liquid shares %s", delegation.Shares, validator.TotalLiquidShares)
+	require.True(t, delegation.Shares.Equal(validator.TotalValidatorBondShares),
+		"delegation shares %s, validator bond shares %s", delegation.Shares, validator.TotalValidatorBondShares)
+}
+
 // tests Slash at a future height (must panic)
 func TestSlashAtFutureHeight(t *testing.T) {
 	app, ctx, _, _ := bootstrapSlashTest(t, 10)
@@ -607,3 +645,476 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx)))
 }
+
+// tests that slashing reduces the total liquid staked tokens by exactly the value lost
+// by the liquid delegations, carrying the fractional remainder in the residue
+func TestSlashLiquidStakedTokens(t *testing.T) {
+	testCases := []struct {
+		name                string
+		infractionHeight    int64
+		power               int64
+		slashFactor         sdk.Dec
+		numSlashes          int
+		unbonding           bool
+		redelegation        bool
+		expectedBurnedPower sdk.Dec
+	}{
+		{
+			name:                "infraction at current height",
+			infractionHeight:    12,
+			power:               13,
+			slashFactor:         sdk.NewDecWithPrec(1, 1),
+			numSlashes:          1,
+			expectedBurnedPower: sdk.MustNewDecFromStr("1.3"),
+		},
+		{
+			name:                "past infraction with unbonding delegation",
+			infractionHeight:    10,
+			power:               13,
+			slashFactor:         sdk.NewDecWithPrec(5, 1),
+			numSlashes:          1,
+			unbonding:           true,
+			expectedBurnedPower: sdk.MustNewDecFromStr("4.5"),
+		},
+		{
+			name:                "past infraction with liquid redelegation",
+			infractionHeight:    10,
+			power:               13,
+			slashFactor:         sdk.NewDecWithPrec(5, 1),
+			numSlashes:          1,
+			redelegation:        true,
+			expectedBurnedPower: sdk.MustNewDecFromStr("6.5"),
+		},
+		{
+			name:                "overslash burns all of the validator's tokens",
+			infractionHeight:    12,
+			power:               100,
+			slashFactor:         sdk.NewDecWithPrec(5, 1),
+			numSlashes:          1,
+			expectedBurnedPower: sdk.MustNewDecFromStr("13.000000000000000001"),
+		},
+		{
+			name:                "repeated slashes accumulate the residue",
+			infractionHeight:    12,
+			power:               13,
+			slashFactor:         sdk.NewDecWithPrec(7, 3),
+			numSlashes:          5,
+			expectedBurnedPower: sdk.MustNewDecFromStr("0.455"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+			consAddr := sdk.ConsAddress(PKs[0].Address())
+			bondDenom := app.StakingKeeper.BondDenom(ctx)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+			// Delegate from a liquid staking provider to both the slashed validator and the
+			// destination of its redelegation. The extra token keeps the slashed validator's
+			// liquid ratio from dividing evenly, so the slashes leave a residue
+			providerAddr := createICAAccount(app, ctx, "provider")
+			providerTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6).AddRaw(1)
+			providerCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, providerTokens))
+			require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, providerAddr, providerCoins))
+
+			delegations := map[int]sdk.Int{
+				0: app.StakingKeeper.TokensFromConsensusPower(ctx, 3).AddRaw(1),
+				1: app.StakingKeeper.TokensFromConsensusPower(ctx, 3),
+			}
+			for i, amount := range delegations {
+				_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx),
+					types.NewMsgDelegate(providerAddr, addrVals[i], sdk.NewCoin(bondDenom, amount)))
+				require.NoError(t, err)
+			}
+			require.Equal(t, providerTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+			if tc.unbonding {
+				ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11, time.Unix(0, 0),
+					app.StakingKeeper.TokensFromConsensusPower(ctx, 4))
+				app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+			}
+			if tc.redelegation {
+				rdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+				rd := types.NewRedelegation(providerAddr, addrVals[0], addrVals[1], 11,
+					time.Unix(0, 0), rdTokens, sdk.NewDecFromInt(rdTokens))
+				app.StakingKeeper.SetRedelegation(ctx, rd)
+			}
+
+			bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+			oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+
+			ctx = ctx.WithBlockHeight(12)
+			for i := 0; i < tc.numSlashes; i++ {
+				app.StakingKeeper.Slash(ctx, consAddr, tc.infractionHeight, tc.power, tc.slashFactor, 0)
+			}
+
+			newBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+			expectedBurned := tc.expectedBurnedPower.MulInt(app.StakingKeeper.PowerReduction(ctx)).TruncateInt()
+			require.Equal(t, expectedBurned, oldBonded.Sub(newBonded), "tokens burned")
+
+			// The total, less the residue, must equal the value of the liquid delegations
+			expectedLiquidTokens := sdk.ZeroDec()
+			for _, delegation := range app.StakingKeeper.GetAllLiquidDelegations(ctx) {
+				validator, found := app.StakingKeeper.GetLiquidValidator(ctx, delegation.GetValidatorAddr())
+				require.True(t, found)
+				expectedLiquidTokens = expectedLiquidTokens.Add(validator.TokensFromShares(delegation.Shares))
+			}
+
+			residue := app.StakingKeeper.GetTotalLiquidStakedResidue(ctx)
+			require.False(t, residue.IsNegative(), "residue is negative")
+			require.True(t, residue.LT(sdk.OneDec()), "residue is a whole token or more")
+
+			totalLiquidStaked := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)
+			require.Equal(t, expectedLiquidTokens, sdk.NewDecFromInt(totalLiquidStaked).Sub(residue), "total liquid staked tokens")
+		})
+	}
+}
+
+// tests that a slash is recorded and emitted in an event
+func TestSlashRecordAndEvent(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(1, 1)
+
+	// a quarter of the validator's shares are liquid
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, addrVals[0])
+	require.True(t, found)
+	app.StakingKeeper.IncreaseValidatorTotalLiquidShares(ctx, validator, validator.DelegatorShares.QuoInt64(4))
+
+	ctx = ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	app.StakingKeeper.Slash(ctx, consAddr, 12, 10, fraction, sdkstaking.DoubleSign)
+
+	burned := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	liquidDeducted := sdk.NewDecFromInt(burned).QuoInt64(4)
+
+	slashEvents := getTypedEvents(t, ctx, &types.EventSlash{})
+	require.Equal(t, []proto.Message{&types.EventSlash{
+		SlashRecordId:          1,
+		ValidatorAddress:       addrVals[0].String(),
+		InfractionHeight:       12,
+		InfractionType:         sdkstaking.DoubleSign.String(),
+		EffectiveFraction:      fraction,
+		TokensBurned:           burned,
+		LiquidTokensDeducted:   liquidDeducted,
+		ValidatorBondFirstLoss: sdk.ZeroInt(),
+		InsurancePayout:        sdk.ZeroInt(),
+	}}, slashEvents, "slash event")
+
+	slashRecord, found := app.StakingKeeper.GetSlashRecord(ctx, addrVals[0], 1)
+	require.True(t, found)
+	require.Equal(t, types.SlashRecord{
+		Id:                         1,
+		ValidatorAddress:           addrVals[0].String(),
+		Height:                     12,
+		InfractionHeight:           12,
+		InfractionType:             sdkstaking.DoubleSign.String(),
+		SlashFactor:                fraction,
+		EffectiveFraction:          fraction,
+		TokensBurned:               burned,
+		LiquidTokensDeducted:       liquidDeducted,
+		TokensBefore:               validator.Tokens,
+		DelegatorShares:            validator.DelegatorShares,
+		ValidatorBondFirstLoss:     sdk.ZeroInt(),
+		ValidatorBondSharesRemoved: sdk.ZeroDec(),
+		InsurancePayout:            sdk.ZeroInt(),
+	}, slashRecord)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastSlashRecordID(ctx))
+
+	// the records are removed along with the validator
+	app.StakingKeeper.RemoveValidatorSlashRecords(ctx, addrVals[0])
+	_, found = app.StakingKeeper.GetSlashRecord(ctx, addrVals[0], 1)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetAllSlashRecords(ctx))
+}
+
+// tests that the validator bond delegations absorb the first loss of a slash and that the
+// validator's tokens, shares and the liquid staked total stay consistent
+func TestSlashValidatorBondFirstLoss(t *testing.T) {
+	testCases := []struct {
+		name               string
+		firstLossFraction  sdk.Dec
+		slashFactor        sdk.Dec
+		validatorBond      bool
+		expectedBondPower  sdk.Dec
+		expectedOtherPower sdk.Dec
+	}{
+		{
+			// 2 tokens are burned and spread evenly over the 20 tokens
+			name:               "disabled",
+			firstLossFraction:  sdk.ZeroDec(),
+			slashFactor:        sdk.NewDecWithPrec(1, 1),
+			validatorBond:      true,
+			expectedBondPower:  sdk.NewDec(9),
+			expectedOtherPower: sdk.NewDec(9),
+		},
+		{
+			// the bond absorbs 1 token, and the other token is spread evenly
+			name:               "half of the slash is absorbed first",
+			firstLossFraction:  sdk.NewDecWithPrec(5, 1),
+			slashFactor:        sdk.NewDecWithPrec(1, 1),
+			validatorBond:      true,
+			expectedBondPower:  sdk.MustNewDecFromStr("8.5"),
+			expectedOtherPower: sdk.MustNewDecFromStr("9.5"),
+		},
+		{
+			name:               "all of the slash is absorbed first",
+			firstLossFraction:  sdk.OneDec(),
+			slashFactor:        sdk.NewDecWithPrec(1, 1),
+			validatorBond:      true,
+			expectedBondPower:  sdk.NewDec(8),
+			expectedOtherPower: sdk.NewDec(10),
+		},
+		{
+			// 12 tokens are burned, the bond absorbs 8 of them before it is wiped out
+			name:               "first loss capped at the validator bond",
+			firstLossFraction:  sdk.OneDec(),
+			slashFactor:        sdk.NewDecWithPrec(6, 1),
+			validatorBond:      true,
+			expectedBondPower:  sdk.ZeroDec(),
+			expectedOtherPower: sdk.NewDec(8),
+		},
+		{
+			name:               "no validator bond",
+			firstLossFraction:  sdk.OneDec(),
+			slashFactor:        sdk.NewDecWithPrec(1, 1),
+			validatorBond:      false,
+			expectedBondPower:  sdk.NewDec(9),
+			expectedOtherPower: sdk.NewDec(9),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+			power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+			params := app.StakingKeeper.GetParams(ctx)
+			params.ValidatorBondFirstLossFraction = tc.firstLossFraction
+			app.StakingKeeper.SetParams(ctx, params)
+
+			// The validator has 10 tokens from its bond delegator, and 10 from a regular
+			// delegator and a liquid staking provider
+			addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, power(10))
+			bondAddr, regularAddr := addrs[0], addrs[1]
+			valAddr := sdk.ValAddress(bondAddr)
+			consAddr := sdk.ConsAddress(PKs[0].Address())
+
+			providerAddr := createICAAccount(app, ctx, "provider")
+			providerCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), power(4)))
+			require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, providerAddr, providerCoins))
+
+			validator := teststaking.NewValidator(t, valAddr, PKs[0])
+			app.StakingKeeper.SetValidator(ctx, validator)
+			app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+			require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+			for addr, amount := range map[string]sdk.Int{bondAddr.String(): power(10), regularAddr.String(): power(6)} {
+				validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+				require.NoError(t, delegateCoinsFromAccount(ctx, app, sdk.MustAccAddressFromBech32(addr), amount, validator))
+			}
+			applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+
+			_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(providerAddr, valAddr, providerCoins[0]))
+			require.NoError(t, err)
+
+			if tc.validatorBond {
+				_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), &types.MsgValidatorBond{
+					DelegatorAddress: bondAddr.String(),
+					ValidatorAddress: valAddr.String(),
+				})
+				require.NoError(t, err)
+			}
+
+			ctx = ctx.WithBlockHeight(12)
+			app.StakingKeeper.Slash(ctx, consAddr, 12, 20, tc.slashFactor, 0)
+
+			validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+			require.True(t, found)
+			require.Equal(t, power(20).Sub(tc.slashFactor.MulInt(power(20)).TruncateInt()), validator.Tokens, "tokens burned")
+
+			// Every delegator other than the bond delegator keeps the same share of the rest
+			tokensFromDelegation := func(delAddr sdk.AccAddress) sdk.Dec {
+				delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delAddr, valAddr)
+				if !found {
+					return sdk.ZeroDec()
+				}
+				return validator.TokensFromShares(delegation.Shares)
+			}
+			bondTokens := tokensFromDelegation(bondAddr)
+			otherTokens := tokensFromDelegation(regularAddr).Add(tokensFromDelegation(providerAddr))
+
+			tolerance := sdk.NewDecWithPrec(1, 6)
+			expectedBondTokens := tc.expectedBondPower.MulInt(app.StakingKeeper.PowerReduction(ctx))
+			expectedOtherTokens := tc.expectedOtherPower.MulInt(app.StakingKeeper.PowerReduction(ctx))
+			require.True(t, bondTokens.Sub(expectedBondTokens).Abs().LTE(tolerance), "bond tokens %s", bondTokens)
+			require.True(t, otherTokens.Sub(expectedOtherTokens).Abs().LTE(tolerance), "other tokens %s", otherTokens)
+			require.Equal(t, tokensFromDelegation(providerAddr).MulInt64(6), tokensFromDelegation(regularAddr).MulInt64(4))
+
+			// A wiped out validator bond delegation is removed
+			_, found = app.StakingKeeper.GetLiquidDelegation(ctx, bondAddr, valAddr)
+			require.Equal(t, tc.expectedBondPower.IsPositive(), found, "bond delegation found")
+
+			// The liquid staked total only loses the provider's share of the rest
+			residue := app.StakingKeeper.GetTotalLiquidStakedResidue(ctx)
+			totalLiquidStaked := sdk.NewDecFromInt(app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)).Sub(residue)
+			require.Equal(t, tokensFromDelegation(providerAddr), totalLiquidStaked, "total liquid staked tokens")
+
+			requireInvariants(t, ctx, app)
+
+			slashRecord, found := app.StakingKeeper.GetSlashRecord(ctx, valAddr, 1)
+			require.True(t, found)
+			bondLoss := sdk.NewDecFromInt(power(10)).Sub(bondTokens)
+			socializedLoss := sdk.NewDecFromInt(slashRecord.TokensBurned.Sub(slashRecord.ValidatorBondFirstLoss))
+			require.True(t, sdk.NewDecFromInt(slashRecord.ValidatorBondFirstLoss).LTE(bondLoss), "first loss")
+			require.True(t, sdk.NewDecFromInt(power(10)).Sub(otherTokens).Sub(socializedLoss.QuoInt64(2)).Abs().LTE(tolerance), "socialized loss")
+		})
+	}
+}
+
+// tests that the slash insurance fund reimburses the tokenize share records of a slashed
+// validator, capped at the coverage and at the fund's balance
+func TestSlashInsurancePayout(t *testing.T) {
+	testCases := []struct {
+		name           string
+		coverage       sdk.Dec
+		maxPayout      int64
+		fundPower      int64
+		expectedPayout sdk.Dec
+	}{
+		{
+			// the record loses 1 of its 10 tokens, half of which is paid out
+			name:           "half of the loss is covered",
+			coverage:       sdk.NewDecWithPrec(5, 1),
+			maxPayout:      10,
+			fundPower:      5,
+			expectedPayout: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:           "all of the loss is covered",
+			coverage:       sdk.OneDec(),
+			maxPayout:      10,
+			fundPower:      5,
+			expectedPayout: sdk.OneDec(),
+		},
+		{
+			name:           "payout capped at the max payout per slash",
+			coverage:       sdk.OneDec(),
+			maxPayout:      0,
+			fundPower:      5,
+			expectedPayout: sdk.ZeroDec(),
+		},
+		{
+			name:           "payout capped at the fund's balance",
+			coverage:       sdk.OneDec(),
+			maxPayout:      10,
+			fundPower:      0,
+			expectedPayout: sdk.ZeroDec(),
+		},
+		{
+			name:           "no coverage",
+			coverage:       sdk.ZeroDec(),
+			maxPayout:      10,
+			fundPower:      5,
+			expectedPayout: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+			power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+			bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+			// The validator has 10 tokens from its operator and 10 from a delegator who
+			// tokenizes all of them
+			addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, power(10))
+			operatorAddr, delegatorAddr, depositorAddr := addrs[0], addrs[1], addrs[2]
+			valAddr := sdk.ValAddress(operatorAddr)
+			consAddr := sdk.ConsAddress(PKs[0].Address())
+
+			validator := teststaking.NewValidator(t, valAddr, PKs[0])
+			app.StakingKeeper.SetValidator(ctx, validator)
+			app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+			require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+			for _, addr := range []sdk.AccAddress{operatorAddr, delegatorAddr} {
+				validator, _ = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+				require.NoError(t, delegateCoinsFromAccount(ctx, app, addr, power(10), validator))
+			}
+			applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+
+			_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+				DelegatorAddress:    delegatorAddr.String(),
+				ValidatorAddress:    valAddr.String(),
+				Amount:              sdk.NewCoin(bondDenom, power(10)),
+				TokenizedShareOwner: delegatorAddr.String(),
+			})
+			require.NoError(t, err)
+			records := app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr)
+			require.Len(t, records, 1)
+			record := records[0]
+
+			if tc.fundPower > 0 {
+				_, err = msgServer.FundSlashInsurance(sdk.WrapSDKContext(ctx),
+					types.NewMsgFundSlashInsurance(depositorAddr, sdk.NewCoin(bondDenom, power(tc.fundPower))))
+				require.NoError(t, err)
+			}
+			app.StakingKeeper.SetSlashInsuranceCoverage(ctx, types.SlashInsuranceCoverage{
+				CoverageFraction:  tc.coverage,
+				MaxPayoutPerSlash: power(tc.maxPayout),
+			})
+
+			ctx = ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+			app.StakingKeeper.Slash(ctx, consAddr, 12, 20, sdk.NewDecWithPrec(1, 1), 0)
+
+			expectedPayout := tc.expectedPayout.MulInt(app.StakingKeeper.PowerReduction(ctx)).TruncateInt()
+			validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+			require.True(t, found)
+			require.Equal(t, power(18).Add(expectedPayout), validator.Tokens, "validator tokens")
+			require.Equal(t, power(tc.fundPower).Sub(expectedPayout), app.StakingKeeper.GetSlashInsuranceFundBalance(ctx).Amount, "fund balance")
+
+			// The payout is delegated back from the record's module account, which keeps
+			// the share tokens redeemable for the slashed tokens plus the payout
+			delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+			require.True(t, found)
+			recordTokens := validator.TokensFromShares(delegation.Shares)
+			tolerance := sdk.NewDecWithPrec(1, 6)
+			expectedRecordTokens := sdk.NewDecFromInt(power(9).Add(expectedPayout))
+			require.True(t, recordTokens.Sub(expectedRecordTokens).Abs().LTE(tolerance), "record tokens %s", recordTokens)
+
+			slashRecord, found := app.StakingKeeper.GetSlashRecord(ctx, valAddr, 1)
+			require.True(t, found)
+			require.Equal(t, expectedPayout, slashRecord.InsurancePayout)
+
+			slashEvents := getTypedEvents(t, ctx, &types.EventSlash{})
+			require.Len(t, slashEvents, 1)
+			require.Equal(t, expectedPayout, slashEvents[0].(*types.EventSlash).InsurancePayout)
+
+			payoutEvents := getTypedEvents(t, ctx, &types.EventSlashInsurancePayout{})
+			payout, found := app.StakingKeeper.GetSlashInsurancePayout(ctx, valAddr, 1, record.Id)
+			if !expectedPayout.IsPositive() {
+				require.False(t, found)
+				require.Empty(t, payoutEvents)
+			} else {
+				require.True(t, found)
+				require.Equal(t, expectedPayout, payout.Amount)
+				require.Equal(t, []proto.Message{&types.EventSlashInsurancePayout{
+					SlashRecordId:         1,
+					ValidatorAddress:      valAddr.String(),
+					TokenizeShareRecordId: record.Id,
+					Amount:                payout.Amount,
+					Shares:                payout.Shares,
+				}}, payoutEvents)
+			}
+
+			// The payout counts towards the liquid totals
+			residue := app.StakingKeeper.GetTotalLiquidStakedResidue(ctx)
+			totalLiquidStaked := sdk.NewDecFromInt(app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)).Sub(residue)
+			require.True(t, totalLiquidStaked.Sub(recordTokens).Abs().LTE(tolerance), "total liquid staked tokens %s", totalLiquidStaked)
+			require.Equal(t, delegation.Shares, validator.TotalLiquidShares)
+
+			requireInvariants(t, ctx, app)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// GetGlobalTokenizationPause returns the pause of tokenization against every validator,
+// and false if tokenization is not paused globally
+func (k Keeper) GetGlobalTokenizationPause(ctx sdk.Context) (pause types.TokenizationPause, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GlobalTokenizationPauseKey)
+	if bz == nil {
+		return pause, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause, true
+}
+
+// GetValidatorTokenizationPause returns the pause of tokenization against a validator,
+// and false if governance has not paused the validator
+func (k Keeper) GetValidatorTokenizationPause(ctx sdk.Context, valAddr sdk.ValAddress) (pause types.TokenizationPause, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorTokenizationPauseKey(valAddr))
+	if bz == nil {
+		return pause, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause, true
+}
+
+// SetTokenizationPause stores a tokenization pause, replacing any pause already set for
+// the same validator, or the global pause if the validator address is empty
+func (k Keeper) SetTokenizationPause(ctx sdk.Context, pause types.TokenizationPause) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(k.tokenizationPauseKey(pause.ValidatorAddress), k.cdc.MustMarshal(&pause))
+}
+
+// RemoveTokenizationPause lifts the tokenization pause of a validator, or the global pause
+// if the validator address is empty
+func (k Keeper) RemoveTokenizationPause(ctx sdk.Context, validatorAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(k.tokenizationPauseKey(validatorAddress))
+}
+
+// GetAllTokenizationPauses returns the global pause, if set, followed by the pauses of
+// every validator, used during genesis dump
+func (k Keeper) GetAllTokenizationPauses(ctx sdk.Context) (pauses []types.TokenizationPause) {
+	if pause, found := k.GetGlobalTokenizationPause(ctx); found {
+		pauses = append(pauses, pause)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorTokenizationPausePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pause types.TokenizationPause
+		k.cdc.MustUnmarshal(iterator.Value(), &pause)
+
+		pauses = append(pauses, pause)
+	}
+
+	return pauses
+}
+
+// CheckTokenizationAllowed returns an error if share tokens cannot be minted against a
+// validator, because tokenization is paused globally, for the validator, or because the
+// validator is jailed
+func (k Keeper) CheckTokenizationAllowed(ctx sdk.Context, validator types.Validator) error {
+	if _, found := k.GetGlobalTokenizationPause(ctx); found {
+		return types.ErrTokenizationPaused.Wrap("tokenization is paused for every validator")
+	}
+	if _, found := k.GetValidatorTokenizationPause(ctx, validator.GetOperator()); found {
+		return types.ErrTokenizationPaused.Wrapf("tokenization is paused for validator %s", validator.OperatorAddress)
+	}
+	if validator.IsJailed() {
+		return types.ErrTokenizationPaused.Wrapf("validator %s is jailed", validator.OperatorAddress)
+	}
+
+	return nil
+}
+
+// CheckRedemptionAllowed returns an error if the share tokens of a validator's records
+// cannot be redeemed, because a global or validator pause also pauses redemptions
+func (k Keeper) CheckRedemptionAllowed(ctx sdk.Context, validator types.Validator) error {
+	if pause, found := k.GetGlobalTokenizationPause(ctx); found && pause.PauseRedemptions {
+		return types.ErrRedemptionPaused.Wrap("redemptions are paused for every validator")
+	}
+	if pause, found := k.GetValidatorTokenizationPause(ctx, validator.GetOperator()); found && pause.PauseRedemptions {
+		return types.ErrRedemptionPaused.Wrapf("redemptions are paused for validator %s", validator.OperatorAddress)
+	}
+
+	return nil
+}
+
+// tokenizationPauseKey returns the key of the tokenization pause of a validator, or of the
+// global pause if the validator address is empty
+func (k Keeper) tokenizationPauseKey(validatorAddress string) []byte {
+	if validatorAddress == "" {
+		return types.GlobalTokenizationPauseKey
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return types.GetValidatorTokenizationPauseKey(valAddr)
+}
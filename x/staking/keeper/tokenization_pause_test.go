@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// bootstrapTokenizationPauseTest creates two bonded validators, each with 10 tokens from its
+// operator and 10 from a shared delegator
+// Returns the app, the context, the validator addresses and the delegator
+func bootstrapTokenizationPauseTest(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.ValAddress, sdk.AccAddress) {
+	_, app, ctx := createTestInput(t)
+	power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, power(20))
+	delegatorAddr := addrs[2]
+
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:2])
+	createDelegatedValidators(t, ctx, app, valAddrs, sdkstaking.Unbonded, power(10), power(10), delegatorAddr)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+
+	return app, ctx, valAddrs, delegatorAddr
+}
+
+// tests that governance pauses reject tokenization, and redemptions if requested, until
+// they are lifted
+func TestPauseTokenization(t *testing.T) {
+	app, ctx, valAddrs, delegatorAddr := bootstrapTokenizationPauseTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	authority := app.StakingKeeper.GetAuthority()
+	oneToken := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+
+	tokenize := func(valAddr sdk.ValAddress) (sdk.Coin, error) {
+		res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delegatorAddr.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              oneToken,
+			TokenizedShareOwner: delegatorAddr.String(),
+		})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return res.Amount, nil
+	}
+	redeem := func(shareTokens sdk.Coin) error {
+		_, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+			DelegatorAddress: delegatorAddr.String(),
+			Amount:           sdk.NewCoin(shareTokens.Denom, shareTokens.Amount.QuoRaw(4)),
+		})
+		return err
+	}
+	pause := func(pause types.TokenizationPause) error {
+		_, err := msgServer.PauseTokenization(sdk.WrapSDKContext(ctx), &types.MsgPauseTokenization{
+			Authority: authority,
+			Pause:     pause,
+		})
+		return err
+	}
+	unpause := func(validatorAddress string) error {
+		_, err := msgServer.UnpauseTokenization(sdk.WrapSDKContext(ctx), &types.MsgUnpauseTokenization{
+			Authority:        authority,
+			ValidatorAddress: validatorAddress,
+		})
+		return err
+	}
+
+	shareTokens, err := tokenize(valAddrs[0])
+	require.NoError(t, err)
+
+	// Only the authority can pause tokenization
+	_, err = msgServer.PauseTokenization(sdk.WrapSDKContext(ctx), &types.MsgPauseTokenization{
+		Authority: delegatorAddr.String(),
+		Pause:     types.TokenizationPause{ValidatorAddress: valAddrs[0].String()},
+	})
+	require.Error(t, err)
+
+	// Pausing one validator leaves the other validator and redemptions unaffected
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, pause(types.TokenizationPause{ValidatorAddress: valAddrs[0].String()}))
+	require.Equal(t, []proto.Message{&types.EventPauseTokenization{
+		ValidatorAddress: valAddrs[0].String(),
+	}}, getTypedEvents(t, ctx, &types.EventPauseTokenization{}))
+
+	_, err = tokenize(valAddrs[0])
+	require.ErrorIs(t, err, types.ErrTokenizationPaused)
+	_, err = tokenize(valAddrs[1])
+	require.NoError(t, err)
+	require.NoError(t, redeem(shareTokens))
+
+	// Pausing the validator again can also pause its redemptions
+	require.NoError(t, pause(types.TokenizationPause{ValidatorAddress: valAddrs[0].String(), PauseRedemptions: true}))
+	require.ErrorIs(t, redeem(shareTokens), types.ErrRedemptionPaused)
+
+	// The global pause applies to every validator
+	require.NoError(t, pause(types.TokenizationPause{}))
+	_, err = tokenize(valAddrs[1])
+	require.ErrorIs(t, err, types.ErrTokenizationPaused)
+
+	require.Equal(t, []types.TokenizationPause{
+		{},
+		{ValidatorAddress: valAddrs[0].String(), PauseRedemptions: true},
+	}, app.StakingKeeper.GetAllTokenizationPauses(ctx))
+
+	// Tokenization stays paused while another pause applies
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, unpause(valAddrs[0].String()))
+	require.Equal(t, []proto.Message{&types.EventUnpauseTokenization{
+		ValidatorAddress: valAddrs[0].String(),
+	}}, getTypedEvents(t, ctx, &types.EventUnpauseTokenization{}))
+
+	_, err = tokenize(valAddrs[0])
+	require.ErrorIs(t, err, types.ErrTokenizationPaused)
+	require.NoError(t, redeem(shareTokens))
+
+	require.NoError(t, unpause(""))
+	_, err = tokenize(valAddrs[0])
+	require.NoError(t, err)
+	require.Empty(t, app.StakingKeeper.GetAllTokenizationPauses(ctx))
+
+	// Lifting a pause that is not set fails
+	require.ErrorIs(t, unpause(""), types.ErrTokenizationNotPaused)
+	require.ErrorIs(t, unpause(valAddrs[1].String()), types.ErrTokenizationNotPaused)
+}
+
+// tests that tokenization is paused for a validator while it is jailed
+func TestTokenizationPausedByJailing(t *testing.T) {
+	app, ctx, valAddrs, delegatorAddr := bootstrapTokenizationPauseTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+
+	msg := &types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddr.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		Amount:              sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 1)),
+		TokenizedShareOwner: delegatorAddr.String(),
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.StakingKeeper.Jail(ctx, consAddr)
+	require.Equal(t, []proto.Message{&types.EventPauseTokenization{
+		ValidatorAddress: valAddrs[0].String(),
+		Jailed:           true,
+	}}, getTypedEvents(t, ctx, &types.EventPauseTokenization{}))
+
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrTokenizationPaused)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.StakingKeeper.Unjail(ctx, consAddr)
+	require.Equal(t, []proto.Message{&types.EventUnpauseTokenization{
+		ValidatorAddress: valAddrs[0].String(),
+		Jailed:           true,
+	}}, getTypedEvents(t, ctx, &types.EventUnpauseTokenization{}))
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// A jailed validator that governance paused stays paused once it is unjailed
+	_, err = msgServer.PauseTokenization(sdk.WrapSDKContext(ctx), &types.MsgPauseTokenization{
+		Authority: app.StakingKeeper.GetAuthority(),
+		Pause:     types.TokenizationPause{ValidatorAddress: valAddrs[0].String()},
+	})
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(ctx, consAddr)
+	app.StakingKeeper.Unjail(ctx, consAddr)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrTokenizationPaused)
+}
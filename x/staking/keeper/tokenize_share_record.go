@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -56,6 +58,26 @@ func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAdd
 	return
 }
 
+// GetTokenizeShareRecordsByValidator returns the tokenize share records delegated to a validator
+func (k Keeper) GetTokenizeShareRecordsByValidator(ctx sdk.Context, valAddr sdk.ValAddress) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefixKey := types.GetTokenizeShareRecordIDsByValidatorPrefix(valAddr)
+	it := sdk.KVStorePrefixIterator(store, prefixKey)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		id := sdk.BigEndianToUint64(it.Key()[len(prefixKey):])
+
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, id)
+		if err != nil {
+			continue
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+	return
+}
+
 func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
@@ -96,10 +118,16 @@ func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord type
 		return err
 	}
 
+	valAddr, err := sdk.ValAddressFromBech32(tokenizeShareRecord.Validator)
+	if err != nil {
+		return err
+	}
+
 	k.setTokenizeShareRecordWithOwner(ctx, owner, tokenizeShareRecord.Id)
 	k.setTokenizeShareRecordWithDenom(ctx, tokenizeShareRecord.GetShareTokenDenom(), tokenizeShareRecord.Id)
+	k.setTokenizeShareRecordWithValidator(ctx, valAddr, tokenizeShareRecord.Id)
 
-	return nil
+	return k.mintTokenizeShareRecordNFT(ctx, tokenizeShareRecord, owner)
 }
 
 func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordID uint64) error {
@@ -111,12 +139,189 @@ func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordID uint64) erro
 	if err != nil {
 		return err
 	}
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetTokenizeShareRecordByIndexKey(recordID))
 	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, recordID))
 	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
-	return nil
+	store.Delete(types.GetTokenizeShareRecordIDByValidatorKey(valAddr, recordID))
+
+	// A pending transfer can no longer be accepted once the record is gone
+	k.RemovePendingTokenizeShareRecordTransfer(ctx, recordID)
+
+	return k.nftKeeper.Burn(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID())
+}
+
+// TransferTokenizeShareRecordOwnership moves the ownership of a tokenize share record to
+// the new owner along with its nft, clearing any pending transfer of the record
+// Returns the previous owner
+func (k Keeper) TransferTokenizeShareRecordOwnership(
+	ctx sdk.Context,
+	record types.TokenizeShareRecord,
+	newOwner sdk.AccAddress,
+) (prevOwner sdk.AccAddress, err error) {
+	prevOwner, err = sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	if err := k.setTokenizeShareRecordOwner(ctx, record, newOwner); err != nil {
+		return nil, err
+	}
+
+	if err := k.nftKeeper.Transfer(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID(), newOwner); err != nil {
+		return nil, err
+	}
+
+	return prevOwner, nil
+}
+
+// setTokenizeShareRecordOwner records the new owner of a tokenize share record, clearing
+// any pending transfer of the record
+func (k Keeper) setTokenizeShareRecordOwner(ctx sdk.Context, record types.TokenizeShareRecord, newOwner sdk.AccAddress) error {
+	prevOwner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+
+	k.RemovePendingTokenizeShareRecordTransfer(ctx, record.Id)
+
+	// Remove old account reference
+	k.deleteTokenizeShareRecordWithOwner(ctx, prevOwner, record.Id)
+
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+
+	// Set new account reference
+	k.setTokenizeShareRecordWithOwner(ctx, newOwner, record.Id)
+
+	return k.AfterTokenizeShareRecordTransferred(ctx, record.Id, prevOwner, newOwner)
+}
+
+// GetPendingTokenizeShareRecordTransfer returns the pending ownership transfer of a tokenize share record
+func (k Keeper) GetPendingTokenizeShareRecordTransfer(ctx sdk.Context, recordID uint64) (transfer types.PendingTokenizeShareRecordTransfer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetPendingTokenizeShareRecordTransferKey(recordID))
+	if bz == nil {
+		return transfer, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// GetAllPendingTokenizeShareRecordTransfers returns all pending tokenize share record transfers
+func (k Keeper) GetAllPendingTokenizeShareRecordTransfers(ctx sdk.Context) (transfers []types.PendingTokenizeShareRecordTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PendingTokenizeShareRecordTransferPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var transfer types.PendingTokenizeShareRecordTransfer
+		k.cdc.MustUnmarshal(it.Value(), &transfer)
+
+		transfers = append(transfers, transfer)
+	}
+	return
+}
+
+// SetPendingTokenizeShareRecordTransfer stores a pending tokenize share record transfer and
+// inserts it into the queue where it sits until it is accepted, cancelled or expires
+// Any transfer previously pending for the same record is replaced
+func (k Keeper) SetPendingTokenizeShareRecordTransfer(ctx sdk.Context, transfer types.PendingTokenizeShareRecordTransfer) {
+	k.RemovePendingTokenizeShareRecordTransfer(ctx, transfer.TokenizeShareRecordId)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingTokenizeShareRecordTransferKey(transfer.TokenizeShareRecordId), k.cdc.MustMarshal(&transfer))
+
+	// Append the record id to the list of transfers that also expire at this time
+	queue := k.getTokenizeShareRecordTransferQueue(ctx, transfer.ExpirationTime)
+	queue.Ids = append(queue.Ids, transfer.TokenizeShareRecordId)
+	k.setTokenizeShareRecordTransferQueue(ctx, transfer.ExpirationTime, queue)
+}
+
+// RemovePendingTokenizeShareRecordTransfer removes the pending transfer of a tokenize share
+// record, if there is one, along with its entry in the expiration queue
+func (k Keeper) RemovePendingTokenizeShareRecordTransfer(ctx sdk.Context, recordID uint64) {
+	transfer, found := k.GetPendingTokenizeShareRecordTransfer(ctx, recordID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingTokenizeShareRecordTransferKey(recordID))
+
+	queue := k.getTokenizeShareRecordTransferQueue(ctx, transfer.ExpirationTime)
+	updatedIds := []uint64{}
+	for _, id := range queue.Ids {
+		if id != recordID {
+			updatedIds = append(updatedIds, id)
+		}
+	}
+	queue.Ids = updatedIds
+	k.setTokenizeShareRecordTransferQueue(ctx, transfer.ExpirationTime, queue)
+}
+
+// RemoveExpiredTokenizeShareRecordTransfers removes all pending tokenize share record
+// transfers that were not accepted before their expiration time
+// Returns the expired transfers
+func (k Keeper) RemoveExpiredTokenizeShareRecordTransfers(ctx sdk.Context, blockTime time.Time) (expired []types.PendingTokenizeShareRecordTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	// iterators all time slices from time 0 until the current block time
+	prefixEnd := sdk.InclusiveEndBytes(types.GetTokenizeShareRecordTransferTimeKey(blockTime))
+	iterator := store.Iterator(types.TokenizeShareRecordTransferQueueKey, prefixEnd)
+	defer iterator.Close()
+
+	expired = []types.PendingTokenizeShareRecordTransfer{}
+	for ; iterator.Valid(); iterator.Next() {
+		queue := types.PendingTokenizeShareRecordTransferIds{}
+		k.cdc.MustUnmarshal(iterator.Value(), &queue)
+
+		for _, id := range queue.Ids {
+			transfer, found := k.GetPendingTokenizeShareRecordTransfer(ctx, id)
+			if !found {
+				continue
+			}
+			store.Delete(types.GetPendingTokenizeShareRecordTransferKey(id))
+			expired = append(expired, transfer)
+		}
+		store.Delete(iterator.Key())
+	}
+
+	return expired
+}
+
+func (k Keeper) getTokenizeShareRecordTransferQueue(ctx sdk.Context, expirationTime time.Time) types.PendingTokenizeShareRecordTransferIds {
+	store := ctx.KVStore(k.storeKey)
+
+	queue := types.PendingTokenizeShareRecordTransferIds{Ids: []uint64{}}
+	bz := store.Get(types.GetTokenizeShareRecordTransferTimeKey(expirationTime))
+	if len(bz) == 0 {
+		return queue
+	}
+	k.cdc.MustUnmarshal(bz, &queue)
+
+	return queue
+}
+
+// setTokenizeShareRecordTransferQueue stores the list of record ids with a transfer expiring
+// at the given time, removing the time slice entirely if the list is empty
+func (k Keeper) setTokenizeShareRecordTransferQueue(ctx sdk.Context, expirationTime time.Time, queue types.PendingTokenizeShareRecordTransferIds) {
+	store := ctx.KVStore(k.storeKey)
+	timeKey := types.GetTokenizeShareRecordTransferTimeKey(expirationTime)
+
+	if len(queue.Ids) == 0 {
+		store.Delete(timeKey)
+		return
+	}
+	store.Set(timeKey, k.cdc.MustMarshal(&queue))
 }
 
 func (k Keeper) hasTokenizeShareRecord(ctx sdk.Context, id uint64) bool {
@@ -149,3 +354,8 @@ func (k Keeper) setTokenizeShareRecordWithDenom(ctx sdk.Context, denom string, i
 
 	store.Set(types.GetTokenizeShareRecordIDByDenomKey(denom), bz)
 }
+
+func (k Keeper) setTokenizeShareRecordWithValidator(ctx sdk.Context, valAddr sdk.ValAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByValidatorKey(valAddr, id), []byte{})
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// GetTokenizeShareRecordClaim returns the claim on a released tokenize share record
+// by the share denom of the record, and false if there is none
+func (k Keeper) GetTokenizeShareRecordClaim(ctx sdk.Context, denom string) (claim types.TokenizeShareRecordClaim, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordClaimKey(denom))
+	if bz == nil {
+		return claim, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &claim)
+	return claim, true
+}
+
+// SetTokenizeShareRecordClaim stores a claim on a released tokenize share record
+func (k Keeper) SetTokenizeShareRecordClaim(ctx sdk.Context, claim types.TokenizeShareRecordClaim) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordClaimKey(claim.Record.GetShareTokenDenom()), k.cdc.MustMarshal(&claim))
+}
+
+// RemoveTokenizeShareRecordClaim deletes the claim on a released tokenize share record
+func (k Keeper) RemoveTokenizeShareRecordClaim(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordClaimKey(denom))
+}
+
+// GetAllTokenizeShareRecordClaims returns the claims on all released tokenize share records,
+// used during genesis dump
+func (k Keeper) GetAllTokenizeShareRecordClaims(ctx sdk.Context) (claims []types.TokenizeShareRecordClaim) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordClaimPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.TokenizeShareRecordClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+
+		claims = append(claims, claim)
+	}
+
+	return claims
+}
+
+// GetTokenizeShareRecordClaimBalance returns the bond denom tokens held for a claim. The
+// unbonded tokens are sent to the record's module account when the unbonding completes
+func (k Keeper) GetTokenizeShareRecordClaimBalance(ctx sdk.Context, claim types.TokenizeShareRecordClaim) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, claim.Record.GetModuleAddress(), k.BondDenom(ctx))
+}
+
+// IsTokenizeShareRecordClaimMature returns true once the unbonding of a released record
+// has completed and its tokens can be claimed
+func (k Keeper) IsTokenizeShareRecordClaimMature(ctx sdk.Context, claim types.TokenizeShareRecordClaim) bool {
+	valAddr, err := sdk.ValAddressFromBech32(claim.Record.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	_, found := k.GetUnbondingDelegation(ctx, claim.Record.GetModuleAddress(), valAddr)
+	return !found
+}
+
+// ReleaseTokenizeShareRecords releases the tokenize share records of a tombstoned validator
+// This is called by the slashing module when the validator is tombstoned
+func (k Keeper) ReleaseTokenizeShareRecords(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	if err := k.releaseTokenizeShareRecords(ctx, validator.GetOperator()); err != nil {
+		panic(err)
+	}
+}
+
+// releaseTokenizeShareRecords releases each tokenize share record delegated to a validator
+// that can no longer back its share tokens, because it was tombstoned or was left without
+// tokens. The rewards of the record are sent to its owner, its delegation is unbonded to
+// the record's module account and the record is deleted. The share tokens can then be
+// burned for their part of the unbonded tokens once the unbonding completes
+func (k Keeper) releaseTokenizeShareRecords(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	for _, record := range k.GetTokenizeShareRecordsByValidator(ctx, valAddr) {
+		if err := k.releaseTokenizeShareRecord(ctx, valAddr, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseTokenizeShareRecord releases a tokenize share record into a claim. This is used
+// by a zero height export for the records whose delegation no longer exists, since their
+// share tokens are still in supply
+func (k Keeper) ReleaseTokenizeShareRecord(ctx sdk.Context, recordID uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+
+	return k.releaseTokenizeShareRecord(ctx, valAddr, record)
+}
+
+// releaseTokenizeShareRecord releases a single tokenize share record into a claim
+func (k Keeper) releaseTokenizeShareRecord(ctx sdk.Context, valAddr sdk.ValAddress, record types.TokenizeShareRecord) error {
+	// The rewards are settled before the delegation is unbonded, since the record's module
+	// account will hold the unbonded tokens
+	if err := k.BeforeTokenizeShareRecordRemoved(ctx, record.Id); err != nil {
+		return err
+	}
+
+	shares := sdk.ZeroDec()
+	tokens := sdk.ZeroInt()
+	completionTime := ctx.BlockTime()
+
+	recordAddress := record.GetModuleAddress()
+	delegation, found := k.GetLiquidDelegation(ctx, recordAddress, valAddr)
+	if found {
+		shares = delegation.Shares
+
+		// The tokenized shares are no longer liquid once they are unbonded
+		validator := k.mustGetLiquidValidator(ctx, valAddr)
+		delegationTokens := validator.TokensFromShares(shares).TruncateInt()
+		k.DecreaseTotalLiquidStakedTokens(ctx, delegationTokens)
+		k.DecreaseValidatorTotalLiquidShares(ctx, validator, shares)
+		validator = k.mustGetLiquidValidator(ctx, valAddr)
+		k.DecreaseValidatorTokenizedShares(ctx, validator, shares)
+
+		if delegationTokens.IsZero() {
+			// There is nothing to unbond, so the shares are removed and the claim completes at once
+			if _, err := k.Unbond(ctx, recordAddress, valAddr, shares); err != nil {
+				return err
+			}
+		} else {
+			var err error
+			completionTime, err = k.Undelegate(ctx, recordAddress, valAddr, shares)
+			if err != nil {
+				return err
+			}
+
+			if ubd, found := k.GetUnbondingDelegation(ctx, recordAddress, valAddr); found {
+				tokens = ubd.Entries[len(ubd.Entries)-1].Balance
+			}
+		}
+	}
+
+	if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+		return err
+	}
+
+	claim := types.TokenizeShareRecordClaim{
+		Record:         record,
+		Shares:         shares,
+		Tokens:         tokens,
+		CompletionTime: completionTime,
+	}
+	k.SetTokenizeShareRecordClaim(ctx, claim)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventReleaseTokenizeShareRecord{
+		RecordId:         record.Id,
+		Owner:            record.Owner,
+		ValidatorAddress: record.Validator,
+		ShareTokenDenom:  record.GetShareTokenDenom(),
+		Shares:           shares,
+		Tokens:           tokens,
+		CompletionTime:   completionTime,
+	})
+}
+
+// ClaimTokenizeShareRecord burns share tokens of a released tokenize share record and sends
+// the delegator their part of the record's unbonded tokens, in proportion to the share token
+// supply. The claim is removed once every share token has been burned
+// Returns the tokens sent to the delegator
+func (k Keeper) ClaimTokenizeShareRecord(ctx sdk.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin) (sdk.Coin, error) {
+	claim, found := k.GetTokenizeShareRecordClaim(ctx, shareTokens.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordClaimNotFound
+	}
+
+	if !k.IsTokenizeShareRecordClaimMature(ctx, claim) {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordClaimNotMature.Wrapf(
+			"tokens can be claimed after %s", claim.CompletionTime.Format(time.RFC3339))
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareTokens.Denom)
+	if balance.Amount.LT(shareTokens.Amount) {
+		return sdk.Coin{}, types.ErrNotEnoughBalance
+	}
+
+	// available * burned / supply, so the last share tokens burned take what is left
+	supply := k.bankKeeper.GetSupply(ctx, shareTokens.Denom)
+	available := k.GetTokenizeShareRecordClaimBalance(ctx, claim)
+	tokens := sdk.NewCoin(available.Denom, available.Amount.Mul(shareTokens.Amount).Quo(supply.Amount))
+
+	// send share tokens to NotBondedPool and burn
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.NotBondedPoolName, sdk.Coins{shareTokens})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, sdk.Coins{shareTokens})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if tokens.IsPositive() {
+		err = k.bankKeeper.SendCoins(ctx, claim.Record.GetModuleAddress(), delAddr, sdk.Coins{tokens})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	claimRemoved := supply.Amount.Equal(shareTokens.Amount)
+	if claimRemoved {
+		k.RemoveTokenizeShareRecordClaim(ctx, shareTokens.Denom)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaimTokenizeShareRecord{
+		DelegatorAddress: delAddr.String(),
+		RecordId:         claim.Record.Id,
+		ShareTokens:      shareTokens,
+		Tokens:           tokens,
+		ClaimRemoved:     claimRemoved,
+	}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// bootstrapTokenizeShareRecordClaimTest creates a bonded validator with 10 tokens from its
+// operator and 10 from a delegator who tokenizes all of them, and gives half of the share
+// tokens to a second holder
+// Returns the app, the context, the validator's consensus address, the record and the holders
+func bootstrapTokenizeShareRecordClaimTest(t *testing.T) (
+	*simapp.SimApp, sdk.Context, sdk.ConsAddress, types.TokenizeShareRecord, []sdk.AccAddress,
+) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, power(10))
+	operatorAddr, delegatorAddr, holderAddr := addrs[0], addrs[1], addrs[2]
+	valAddr := sdk.ValAddress(operatorAddr)
+
+	createDelegatedValidators(t, ctx, app, []sdk.ValAddress{valAddr}, sdkstaking.Unbonded, power(10), power(10), delegatorAddr)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), power(10)),
+		TokenizedShareOwner: delegatorAddr.String(),
+	})
+	require.NoError(t, err)
+
+	records := app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr)
+	require.Len(t, records, 1)
+
+	half := sdk.NewCoin(res.Amount.Denom, res.Amount.Amount.QuoRaw(2))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delegatorAddr, holderAddr, sdk.NewCoins(half)))
+
+	return app, ctx, sdk.ConsAddress(PKs[0].Address()), records[0], []sdk.AccAddress{delegatorAddr, holderAddr}
+}
+
+// tests that the records of a tombstoned validator are released into claims that the share
+// token holders can burn their share tokens for once the unbonding completes
+func TestReleaseTokenizeShareRecordsOnTombstone(t *testing.T) {
+	app, ctx, consAddr, record, holders := bootstrapTokenizeShareRecordClaimTest(t)
+	power := func(p int64) sdk.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	require.NoError(t, err)
+	denom := record.GetShareTokenDenom()
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.StakingKeeper.ReleaseTokenizeShareRecords(ctx, consAddr)
+
+	// The record is replaced by a claim on its unbonding delegation
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.Error(t, err)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, valAddr))
+
+	completionTime := ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx))
+	claim, found := app.StakingKeeper.GetTokenizeShareRecordClaim(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, types.TokenizeShareRecordClaim{
+		Record:         record,
+		Shares:         delegation.Shares,
+		Tokens:         power(10),
+		CompletionTime: completionTime,
+	}, claim)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, power(10), ubd.Entries[0].Balance)
+
+	require.Equal(t, []proto.Message{&types.EventReleaseTokenizeShareRecord{
+		RecordId:         record.Id,
+		Owner:            record.Owner,
+		ValidatorAddress: record.Validator,
+		ShareTokenDenom:  denom,
+		Shares:           delegation.Shares,
+		Tokens:           power(10),
+		CompletionTime:   completionTime,
+	}}, getTypedEvents(t, ctx, &types.EventReleaseTokenizeShareRecord{}))
+
+	// The unbonded shares are no longer liquid
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.TotalLiquidShares.IsZero(), "validator liquid shares")
+	require.True(t, validator.TotalTokenizedShares.IsZero(), "validator tokenized shares")
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero(), "total liquid staked tokens")
+
+	// Nothing can be claimed until the unbonding completes
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err = msgServer.ClaimTokenizeShareRecord(sdk.WrapSDKContext(ctx),
+		types.NewMsgClaimTokenizeShareRecord(holders[0], sdk.NewCoin(denom, power(1))))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordClaimNotMature)
+
+	ctx = ctx.WithBlockTime(completionTime)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, sdk.NewCoin(bondDenom, power(10)), app.StakingKeeper.GetTokenizeShareRecordClaimBalance(ctx, claim))
+
+	// Each holder gets their part of the unbonded tokens, and the claim is removed
+	// along with the last share tokens
+	claims := []struct {
+		holder       sdk.AccAddress
+		shareTokens  sdk.Int
+		claimRemoved bool
+	}{
+		{holders[0], power(3), false},
+		{holders[1], power(5), false},
+		{holders[0], power(2), true},
+	}
+	for _, c := range claims {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		balanceBefore := app.BankKeeper.GetBalance(ctx, c.holder, bondDenom)
+
+		shareTokens := sdk.NewCoin(denom, c.shareTokens)
+		res, err := msgServer.ClaimTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgClaimTokenizeShareRecord(c.holder, shareTokens))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoin(bondDenom, c.shareTokens), res.Amount)
+		require.Equal(t, balanceBefore.Add(res.Amount), app.BankKeeper.GetBalance(ctx, c.holder, bondDenom))
+
+		require.Equal(t, []proto.Message{&types.EventClaimTokenizeShareRecord{
+			DelegatorAddress: c.holder.String(),
+			RecordId:         record.Id,
+			ShareTokens:      shareTokens,
+			Tokens:           res.Amount,
+			ClaimRemoved:     c.claimRemoved,
+		}}, getTypedEvents(t, ctx, &types.EventClaimTokenizeShareRecord{}))
+	}
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecordClaim(ctx, denom)
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetSupply(ctx, denom).IsZero())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	requireInvariants(t, ctx, app)
+}
+
+// tests that the records of a validator that finishes unbonding without any tokens are
+// released, leaving claims with nothing to pay out
+func TestReleaseTokenizeShareRecordsOfValidatorWithoutTokens(t *testing.T) {
+	app, ctx, consAddr, record, holders := bootstrapTokenizeShareRecordClaimTest(t)
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	require.NoError(t, err)
+	denom := record.GetShareTokenDenom()
+
+	// The validator is slashed of all its tokens and jailed
+	ctx = ctx.WithBlockHeight(12)
+	app.StakingKeeper.Slash(ctx, consAddr, 12, 20, sdk.OneDec(), 0)
+	app.StakingKeeper.Jail(ctx, consAddr)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsUnbonding())
+	require.True(t, validator.Tokens.IsZero())
+
+	// The records are kept until the validator finishes unbonding
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(validator.UnbondingTime.Add(time.Second))
+	app.StakingKeeper.UnbondAllMatureValidators(ctx)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.Error(t, err)
+	claim, found := app.StakingKeeper.GetTokenizeShareRecordClaim(ctx, denom)
+	require.True(t, found)
+	require.True(t, claim.Tokens.IsZero())
+
+	// Nothing is unbonded, so the claim completes at once
+	require.Equal(t, ctx.BlockTime(), claim.CompletionTime)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetLiquidDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	require.True(t, app.StakingKeeper.IsTokenizeShareRecordClaimMature(ctx, claim))
+
+	// The operator's shares are still held, so the validator is kept
+	_, found = app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+	require.True(t, found)
+
+	// The share tokens can be burned, but there is nothing left to claim
+	shareTokens := app.BankKeeper.GetBalance(ctx, holders[1], denom)
+	tokens, err := app.StakingKeeper.ClaimTokenizeShareRecord(ctx, holders[1], shareTokens)
+	require.NoError(t, err)
+	require.True(t, tokens.IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, holders[1], denom).IsZero())
+
+	requireInvariants(t, ctx, app)
+}
+
+// tests that a genesis exported while the share tokens of a released record are still
+// outstanding passes the liquid staking validation
+func TestExportGenesisWithTokenizeShareRecordClaim(t *testing.T) {
+	app, ctx, consAddr, record, _ := bootstrapTokenizeShareRecordClaimTest(t)
+	denom := record.GetShareTokenDenom()
+
+	app.StakingKeeper.ReleaseTokenizeShareRecords(ctx, consAddr)
+
+	genesis := app.StakingKeeper.ExportGenesis(ctx)
+	require.Empty(t, genesis.TokenizeShareRecords)
+	require.Len(t, genesis.TokenizeShareRecordClaims, 1)
+
+	var accounts authtypes.GenesisAccounts
+	for _, account := range app.AccountKeeper.GetAllAccounts(ctx) {
+		accounts = append(accounts, account.(authtypes.GenesisAccount))
+	}
+	supply := sdk.NewCoins()
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = supply.Add(coin)
+		return false
+	})
+	require.True(t, supply.AmountOf(denom).IsPositive())
+
+	require.NoError(t, staking.ValidateGenesis(genesis))
+	require.NoError(t, staking.ValidateLiquidStakingGenesis(genesis, accounts, supply))
+
+	// Without the claim, the outstanding share tokens are unbacked
+	genesis.TokenizeShareRecordClaims = nil
+	require.Error(t, staking.ValidateLiquidStakingGenesis(genesis, accounts, supply))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nfttypes "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// tokenizeShareRecordNFTClass is the x/nft class of the nfts that represent the ownership of
+// the tokenize share records
+var tokenizeShareRecordNFTClass = nfttypes.Class{
+	Id:          types.TokenizeShareRecordNFTClassID,
+	Name:        "Tokenize Share Record",
+	Description: "Ownership of a tokenize share record, which entitles its holder to the rewards of the record's delegation",
+}
+
+// mintTokenizeShareRecordNFT mints the nft that represents the ownership of a tokenize share
+// record to its owner, creating the nft class if needed
+// If the nft already exists, e.g. because it was imported by the nft genesis, it must be
+// held by the record owner
+func (k Keeper) mintTokenizeShareRecordNFT(ctx sdk.Context, record types.TokenizeShareRecord, owner sdk.AccAddress) error {
+	if !k.nftKeeper.HasClass(ctx, types.TokenizeShareRecordNFTClassID) {
+		if err := k.nftKeeper.SaveClass(ctx, tokenizeShareRecordNFTClass); err != nil {
+			return err
+		}
+	}
+
+	if k.nftKeeper.HasNFT(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID()) {
+		if holder := k.nftKeeper.GetOwner(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID()); !holder.Equals(owner) {
+			return errorsmod.Wrapf(types.ErrTokenizeShareRecordNFTOwnerMismatch,
+				"record %d is owned by %s, but its nft is held by %s", record.Id, owner, holder)
+		}
+		return nil
+	}
+
+	return k.nftKeeper.Mint(ctx, nfttypes.NFT{
+		ClassId: types.TokenizeShareRecordNFTClassID,
+		Id:      record.GetNFTID(),
+	}, owner)
+}
+
+// NFTHooks wrapper struct for the staking keeper, which keeps the owners of the tokenize
+// share records in sync with the holders of their nfts
+type NFTHooks struct {
+	k Keeper
+}
+
+var _ nfttypes.NFTHooks = NFTHooks{}
+
+// NFTHooks returns the hooks to register on the nft keeper
+func (k Keeper) NFTHooks() NFTHooks {
+	return NFTHooks{k}
+}
+
+// AfterNFTTransferred moves the ownership of a tokenize share record along with its nft
+func (h NFTHooks) AfterNFTTransferred(ctx sdk.Context, classID, nftID string, _, receiver sdk.AccAddress) error {
+	if classID != types.TokenizeShareRecordNFTClassID {
+		return nil
+	}
+
+	record, err := h.k.GetTokenizeShareRecordByDenom(ctx, nftID)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrTokenizeShareRecordNotExists, err.Error())
+	}
+
+	// Transfers made through the staking module have already moved the record
+	if record.Owner == receiver.String() {
+		return nil
+	}
+
+	return h.k.setTokenizeShareRecordOwner(ctx, record, receiver)
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nfttypes "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
 )
 
@@ -16,24 +18,25 @@ func (suite *KeeperTestSuite) TestGetLastTokenizeShareRecordId() {
 func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 	app, ctx := suite.app, suite.ctx
 	owner1, owner2 := suite.addrs[0], suite.addrs[1]
+	val1, val2 := suite.vals[0].GetOperator(), suite.vals[1].GetOperator()
 
 	tokenizeShareRecord1 := types.TokenizeShareRecord{
 		Id:            0,
 		Owner:         owner1.String(),
 		ModuleAccount: "test-module-account-1",
-		Validator:     "test-validator",
+		Validator:     val1.String(),
 	}
 	tokenizeShareRecord2 := types.TokenizeShareRecord{
 		Id:            1,
 		Owner:         owner2.String(),
 		ModuleAccount: "test-module-account-2",
-		Validator:     "test-validator",
+		Validator:     val1.String(),
 	}
 	tokenizeShareRecord3 := types.TokenizeShareRecord{
 		Id:            2,
 		Owner:         owner1.String(),
 		ModuleAccount: "test-module-account-3",
-		Validator:     "test-validator",
+		Validator:     val2.String(),
 	}
 	err := app.StakingKeeper.AddTokenizeShareRecord(ctx, tokenizeShareRecord1)
 	suite.NoError(err)
@@ -58,4 +61,85 @@ func (suite *KeeperTestSuite) TestGetTokenizeShareRecord() {
 
 	tokenizeShareRecords = app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner2)
 	suite.Equal(len(tokenizeShareRecords), 1)
+
+	tokenizeShareRecords = app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, val1)
+	suite.Equal([]types.TokenizeShareRecord{tokenizeShareRecord1, tokenizeShareRecord2}, tokenizeShareRecords)
+
+	// Deleting a record removes it from the validator index
+	suite.Require().NoError(app.StakingKeeper.DeleteTokenizeShareRecord(ctx, tokenizeShareRecord3.Id))
+	suite.Empty(app.StakingKeeper.GetTokenizeShareRecordsByValidator(ctx, val2))
+}
+
+func (suite *KeeperTestSuite) TestTokenizeShareRecordNFTOwnership() {
+	app, ctx := suite.app, suite.ctx
+	owner1, owner2, owner3 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	val := suite.vals[0].GetOperator()
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner1.String(),
+		ModuleAccount: types.TokenizeShareModuleAccountPrefix + "1",
+		Validator:     val.String(),
+	}
+	suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+
+	// Adding the record mints its nft to the owner
+	suite.Require().True(app.NFTKeeper.HasClass(ctx, types.TokenizeShareRecordNFTClassID))
+	suite.Require().Equal(owner1, app.NFTKeeper.GetOwner(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID()))
+
+	// Sending the nft moves the ownership of the record and drops its pending transfer
+	app.StakingKeeper.SetPendingTokenizeShareRecordTransfer(ctx, types.PendingTokenizeShareRecordTransfer{
+		TokenizeShareRecordId: record.Id,
+		Owner:                 owner1.String(),
+		NewOwner:              owner3.String(),
+		ExpirationTime:        ctx.BlockTime(),
+	})
+	_, err := app.NFTKeeper.Send(sdk.WrapSDKContext(ctx), &nfttypes.MsgSend{
+		ClassId:  types.TokenizeShareRecordNFTClassID,
+		Id:       record.GetNFTID(),
+		Sender:   owner1.String(),
+		Receiver: owner2.String(),
+	})
+	suite.Require().NoError(err)
+
+	record, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner2.String(), record.Owner)
+	suite.Require().Empty(app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner1))
+	suite.Require().Equal([]types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner2))
+	_, found := app.StakingKeeper.GetPendingTokenizeShareRecordTransfer(ctx, record.Id)
+	suite.Require().False(found)
+
+	// Transferring the record moves its nft
+	prevOwner, err := app.StakingKeeper.TransferTokenizeShareRecordOwnership(ctx, record, owner3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner2, prevOwner)
+	suite.Require().Equal(owner3, app.NFTKeeper.GetOwner(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID()))
+	suite.Require().Len(app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner3), 1)
+
+	// Deleting the record burns its nft
+	suite.Require().NoError(app.StakingKeeper.DeleteTokenizeShareRecord(ctx, record.Id))
+	suite.Require().False(app.NFTKeeper.HasNFT(ctx, types.TokenizeShareRecordNFTClassID, record.GetNFTID()))
+}
+
+func (suite *KeeperTestSuite) TestAddTokenizeShareRecordNFTOwnerMismatch() {
+	app, ctx := suite.app, suite.ctx
+	owner1, owner2 := suite.addrs[0], suite.addrs[1]
+
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner1.String(),
+		ModuleAccount: types.TokenizeShareModuleAccountPrefix + "1",
+		Validator:     suite.vals[0].GetOperator().String(),
+	}
+
+	// An nft imported for the record must be held by the record owner
+	suite.Require().NoError(app.NFTKeeper.SaveClass(ctx, nfttypes.Class{Id: types.TokenizeShareRecordNFTClassID}))
+	suite.Require().NoError(app.NFTKeeper.Mint(ctx, nfttypes.NFT{
+		ClassId: types.TokenizeShareRecordNFTClassID,
+		Id:      record.GetNFTID(),
+	}, owner2))
+
+	err := app.StakingKeeper.AddTokenizeShareRecord(ctx, record)
+	suite.Require().ErrorIs(err, types.ErrTokenizeShareRecordNFTOwnerMismatch)
 }
@@ -266,6 +266,14 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.DeleteValidatorByPowerIndex(ctx, validator)
+
+	// tokenization is paused for the validator until it is unjailed
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPauseTokenization{
+		ValidatorAddress: validator.OperatorAddress,
+		Jailed:           true,
+	}); err != nil {
+		panic(err)
+	}
 }
 
 // remove a validator from jail
@@ -277,6 +285,13 @@ func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = false
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUnpauseTokenization{
+		ValidatorAddress: validator.OperatorAddress,
+		Jailed:           true,
+	}); err != nil {
+		panic(err)
+	}
 }
 
 // perform all the store operations for when a validator status becomes bonded
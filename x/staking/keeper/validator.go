@@ -194,6 +194,8 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	k.RemoveValidatorSlashRecords(ctx, address)
+	k.RemoveValidatorSlashInsurancePayouts(ctx, address)
 
 	// call hooks
 	err = k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
@@ -489,6 +491,21 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 				}
 
 				val = k.UnbondingToUnbonded(ctx, val)
+
+				// A validator left without tokens can no longer back the share tokens of
+				// its tokenize share records, so they are released into claims. Unbonding
+				// the records can remove the validator
+				if val.Tokens.IsZero() {
+					if err := k.releaseTokenizeShareRecords(ctx, val.GetOperator()); err != nil {
+						panic(err)
+					}
+
+					val, found = k.GetLiquidValidator(ctx, addr)
+					if !found {
+						continue
+					}
+				}
+
 				if val.GetDelegatorShares().IsZero() {
 					k.RemoveValidator(ctx, val.GetOperator())
 				}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// tests that a validator's liquid staking policy restricts the liquid staking providers that
+// can delegate to it, its liquid shares and the tokenization of its delegations
+func TestValidatorLiquidStakingPolicy(t *testing.T) {
+	app, ctx, valAddrs, delegatorAddr := bootstrapTokenizationPauseTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	oneToken := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+
+	providerA := createICAAccount(app, ctx, "provider-a")
+	providerB := createICAAccount(app, ctx, "provider-b")
+	for _, provider := range []sdk.AccAddress{providerA, providerB} {
+		require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, provider, sdk.NewCoins(oneToken.Add(oneToken))))
+	}
+
+	delegate := func(provider sdk.AccAddress, valAddr sdk.ValAddress) error {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(provider, valAddr, oneToken))
+		return err
+	}
+	tokenize := func(valAddr sdk.ValAddress) error {
+		_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+			DelegatorAddress:    delegatorAddr.String(),
+			ValidatorAddress:    valAddr.String(),
+			Amount:              oneToken,
+			TokenizedShareOwner: delegatorAddr.String(),
+		})
+		return err
+	}
+	edit := func(policy *types.ValidatorLiquidStakingPolicy) error {
+		description := types.NewDescription(types.DoNotModifyDesc, types.DoNotModifyDesc,
+			types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc)
+		msg := types.NewMsgEditValidator(valAddrs[0], description, nil)
+		msg.LiquidStakingPolicy = policy
+		_, err := msgServer.EditValidator(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+	policyOf := func(valAddr sdk.ValAddress) types.ValidatorLiquidStakingPolicy {
+		validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddr)
+		require.True(t, found)
+		return validator.LiquidStakingPolicy
+	}
+
+	// The operator only accepts the first provider and disables tokenization
+	policy := types.ValidatorLiquidStakingPolicy{
+		AllowedLiquidStakingProviders: []string{providerA.String()},
+		TokenizationDisabled:          true,
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, edit(&policy))
+	require.Equal(t, policy, policyOf(valAddrs[0]))
+	require.Equal(t, []proto.Message{&types.EventUpdateValidatorLiquidStakingPolicy{
+		ValidatorAddress: valAddrs[0].String(),
+		Policy:           policy,
+	}}, getTypedEvents(t, ctx, &types.EventUpdateValidatorLiquidStakingPolicy{}))
+
+	require.ErrorIs(t, delegate(providerB, valAddrs[0]), types.ErrLiquidStakingProviderNotAllowed)
+	require.NoError(t, delegate(providerA, valAddrs[0]))
+	require.NoError(t, delegate(providerB, valAddrs[1]))
+
+	require.ErrorIs(t, tokenize(valAddrs[0]), types.ErrTokenizationDisabledByValidator)
+	require.NoError(t, tokenize(valAddrs[1]))
+
+	// Editing the validator without a policy leaves the policy unchanged
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, edit(nil))
+	require.Equal(t, policy, policyOf(valAddrs[0]))
+	require.Empty(t, getTypedEvents(t, ctx, &types.EventUpdateValidatorLiquidStakingPolicy{}))
+
+	// Replacing the policy lifts the allow-list, but a zero cap rejects any new liquid stake
+	// while the validator liquid staking cap param still allows it
+	zeroCap := sdk.ZeroDec()
+	require.NoError(t, edit(&types.ValidatorLiquidStakingPolicy{LiquidStakingCap: &zeroCap}))
+	require.ErrorIs(t, delegate(providerB, valAddrs[0]), types.ErrValidatorLiquidStakingCapExceeded)
+	require.ErrorIs(t, tokenize(valAddrs[0]), types.ErrValidatorLiquidStakingCapExceeded)
+
+	require.NoError(t, edit(&types.ValidatorLiquidStakingPolicy{}))
+	require.NoError(t, delegate(providerB, valAddrs[0]))
+	require.NoError(t, tokenize(valAddrs[0]))
+}
+
+// tests that a liquid staking provider can still redeem share tokens of a validator
+// whose policy no longer allows it, since redeeming does not add liquid stake
+func TestRedeemTokensFromLiquidStakingProviderNotAllowed(t *testing.T) {
+	app, ctx, valAddrs, delegatorAddr := bootstrapTokenizationPauseTest(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	oneToken := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+
+	provider := createICAAccount(app, ctx, "provider")
+
+	// The provider buys share tokens of the validator
+	tokenizeResponse, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), &types.MsgTokenizeShares{
+		DelegatorAddress:    delegatorAddr.String(),
+		ValidatorAddress:    valAddrs[0].String(),
+		Amount:              oneToken,
+		TokenizedShareOwner: delegatorAddr.String(),
+	})
+	require.NoError(t, err)
+	shareTokens := tokenizeResponse.Amount
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delegatorAddr, provider, sdk.NewCoins(shareTokens)))
+
+	// The operator then only accepts another provider
+	description := types.NewDescription(types.DoNotModifyDesc, types.DoNotModifyDesc,
+		types.DoNotModifyDesc, types.DoNotModifyDesc, types.DoNotModifyDesc)
+	msg := types.NewMsgEditValidator(valAddrs[0], description, nil)
+	msg.LiquidStakingPolicy = &types.ValidatorLiquidStakingPolicy{
+		AllowedLiquidStakingProviders: []string{createICAAccount(app, ctx, "other-provider").String()},
+	}
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	_, err = msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokensforShares{
+		DelegatorAddress: provider.String(),
+		Amount:           shareTokens,
+	})
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, provider, valAddrs[0])
+	require.True(t, found, "provider should hold the redeemed delegation")
+	validator, found := app.StakingKeeper.GetLiquidValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, oneToken.Amount, validator.TokensFromShares(delegation.Shares).TruncateInt())
+}
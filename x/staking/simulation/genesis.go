@@ -9,6 +9,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
@@ -16,11 +17,32 @@ import (
 
 // Simulation parameter constants
 const (
-	unbondingTime     = "unbonding_time"
-	maxValidators     = "max_validators"
-	historicalEntries = "historical_entries"
+	unbondingTime             = "unbonding_time"
+	maxValidators             = "max_validators"
+	historicalEntries         = "historical_entries"
+	validatorBondFactor       = "validator_bond_factor"
+	globalLiquidStakingCap    = "global_liquid_staking_cap"
+	validatorLiquidStakingCap = "validator_liquid_staking_cap"
+	validatorBondFirstLoss    = "validator_bond_first_loss_fraction"
 )
 
+// NumLiquidStakingProviders is the number of liquid staking provider accounts
+// added to the simulated genesis
+const NumLiquidStakingProviders = 3
+
+// LiquidStakingProviderAddress returns the address of the i-th simulated liquid staking
+// provider. Like an interchain account, it is a 32-byte module account address
+func LiquidStakingProviderAddress(i int) sdk.AccAddress {
+	name := LiquidStakingProviderName(i)
+	return address.Module(name, []byte(name))
+}
+
+// LiquidStakingProviderName returns the module account name of the i-th simulated
+// liquid staking provider
+func LiquidStakingProviderName(i int) string {
+	return fmt.Sprintf("liquid-staking-provider-%d", i)
+}
+
 // genUnbondingTime returns randomized UnbondingTime
 func genUnbondingTime(r *rand.Rand) (ubdTime time.Duration) {
 	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*3*2)) * time.Second
@@ -36,17 +58,40 @@ func getHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// genValidatorBondFactor returns a randomized ValidatorBondFactor, which is disabled half of the time
+func genValidatorBondFactor(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return types.DefaultValidatorBondFactor
+	}
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 1, 500)))
+}
+
+// genLiquidStakingCap returns a randomized liquid staking cap between 5% and 100%
+func genLiquidStakingCap(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 5, 101)), 2)
+}
+
+// genValidatorBondFirstLossFraction returns a randomized ValidatorBondFirstLossFraction
+// between 0% and 50%, which is disabled half of the time
+func genValidatorBondFirstLossFraction(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return types.DefaultValidatorBondFirstLossFraction
+	}
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 51)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	var (
-		unbondTime                time.Duration
-		maxVals                   uint32
-		histEntries               uint32
-		minCommissionRate         sdk.Dec
-		validatorBondFactor       sdk.Dec
-		globalLiquidStakingCap    sdk.Dec
-		validatorLiquidStakingCap sdk.Dec
+		unbondTime         time.Duration
+		maxVals            uint32
+		histEntries        uint32
+		minCommissionRate  sdk.Dec
+		bondFactor         sdk.Dec
+		globalLiquidCap    sdk.Dec
+		validatorLiquidCap sdk.Dec
+		bondFirstLoss      sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -64,6 +109,26 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = getHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validatorBondFactor, &bondFactor, simState.Rand,
+		func(r *rand.Rand) { bondFactor = genValidatorBondFactor(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, globalLiquidStakingCap, &globalLiquidCap, simState.Rand,
+		func(r *rand.Rand) { globalLiquidCap = genLiquidStakingCap(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validatorLiquidStakingCap, &validatorLiquidCap, simState.Rand,
+		func(r *rand.Rand) { validatorLiquidCap = genLiquidStakingCap(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validatorBondFirstLoss, &bondFirstLoss, simState.Rand,
+		func(r *rand.Rand) { bondFirstLoss = genValidatorBondFirstLossFraction(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
@@ -74,9 +139,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		histEntries,
 		sdk.DefaultBondDenom,
 		minCommissionRate,
-		validatorBondFactor,
-		globalLiquidStakingCap,
-		validatorLiquidStakingCap,
+		bondFactor,
+		globalLiquidCap,
+		validatorLiquidCap,
+		bondFirstLoss,
 	)
 
 	// validators & delegations
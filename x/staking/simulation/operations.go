@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"math/rand"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
@@ -16,9 +18,15 @@ import (
 )
 
 const (
-	DefaultWeightMsgTokenizeShares              int = 100
-	DefaultWeightMsgRedeemTokensforShares       int = 100
-	DefaultWeightMsgTransferTokenizeShareRecord int = 50
+	DefaultWeightMsgTokenizeShares                    int = 100
+	DefaultWeightMsgRedeemTokensforShares             int = 100
+	DefaultWeightMsgTransferTokenizeShareRecord       int = 50
+	DefaultWeightMsgValidatorBond                     int = 50
+	DefaultWeightMsgDisableTokenizeShares             int = 25
+	DefaultWeightMsgEnableTokenizeShares              int = 25
+	DefaultWeightLiquidStakingProviderDelegate        int = 100
+	DefaultWeightLiquidStakingProviderUndelegate      int = 50
+	DefaultWeightLiquidStakingProviderBeginRedelegate int = 50
 )
 
 // Simulation operation weights constants
@@ -34,8 +42,27 @@ const (
 	OpWeightMsgTokenizeShares              = "op_weight_msg_tokenize_shares"
 	OpWeightMsgRedeemTokensforShares       = "op_weight_msg_redeem_tokens_for_shares"
 	OpWeightMsgTransferTokenizeShareRecord = "op_weight_msg_transfer_tokenize_share_record"
+	OpWeightMsgValidatorBond               = "op_weight_msg_validator_bond"
+	OpWeightMsgDisableTokenizeShares       = "op_weight_msg_disable_tokenize_shares"
+	OpWeightMsgEnableTokenizeShares        = "op_weight_msg_enable_tokenize_shares"
+
+	OpWeightLiquidStakingProviderDelegate        = "op_weight_liquid_staking_provider_delegate"
+	OpWeightLiquidStakingProviderUndelegate      = "op_weight_liquid_staking_provider_undelegate"
+	OpWeightLiquidStakingProviderBeginRedelegate = "op_weight_liquid_staking_provider_begin_redelegate"
 )
 
+// expectedLiquidStakingErrors are the liquid staking restrictions that a randomly
+// generated message can legitimately run into, e.g. when a liquid staking cap is reached
+var expectedLiquidStakingErrors = []error{
+	types.ErrGlobalLiquidStakingCapExceeded,
+	types.ErrValidatorLiquidStakingCapExceeded,
+	types.ErrInsufficientValidatorBondShares,
+	types.ErrRedelegationNotAllowedForValidatorBond,
+	types.ErrValidatorBondNotAllowedForTokenizeShare,
+	types.ErrTokenizeSharesDisabledForAccount,
+	types.ErrTotalLiquidStakedRefreshInProgress,
+}
+
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
@@ -51,6 +78,12 @@ func WeightedOperations(
 		weightMsgTokenizeShares              int
 		weightMsgRedeemTokensforShares       int
 		weightMsgTransferTokenizeShareRecord int
+		weightMsgValidatorBond               int
+		weightMsgDisableTokenizeShares       int
+		weightMsgEnableTokenizeShares        int
+		weightProviderDelegate               int
+		weightProviderUndelegate             int
+		weightProviderBeginRedelegate        int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -107,6 +140,42 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgValidatorBond, &weightMsgValidatorBond, nil,
+		func(_ *rand.Rand) {
+			weightMsgValidatorBond = DefaultWeightMsgValidatorBond
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDisableTokenizeShares, &weightMsgDisableTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgDisableTokenizeShares = DefaultWeightMsgDisableTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgEnableTokenizeShares, &weightMsgEnableTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgEnableTokenizeShares = DefaultWeightMsgEnableTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightLiquidStakingProviderDelegate, &weightProviderDelegate, nil,
+		func(_ *rand.Rand) {
+			weightProviderDelegate = DefaultWeightLiquidStakingProviderDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightLiquidStakingProviderUndelegate, &weightProviderUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightProviderUndelegate = DefaultWeightLiquidStakingProviderUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightLiquidStakingProviderBeginRedelegate, &weightProviderBeginRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightProviderBeginRedelegate = DefaultWeightLiquidStakingProviderBeginRedelegate
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -144,6 +213,30 @@ func WeightedOperations(
 			weightMsgTransferTokenizeShareRecord,
 			SimulateMsgTransferTokenizeShareRecord(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgValidatorBond,
+			SimulateMsgValidatorBond(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDisableTokenizeShares,
+			SimulateMsgDisableTokenizeShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEnableTokenizeShares,
+			SimulateMsgEnableTokenizeShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightProviderDelegate,
+			SimulateLiquidStakingProviderDelegate(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightProviderUndelegate,
+			SimulateLiquidStakingProviderUndelegate(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightProviderBeginRedelegate,
+			SimulateLiquidStakingProviderBeginRedelegate(ak, bk, k),
+		),
 	}
 }
 
@@ -402,6 +495,10 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 				break
 			}
 		}
+		if simAccount.PrivKey == nil && isLiquidStakingAccount(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "liquid staking account can not sign"), nil, nil
+		}
+
 		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
 		if simAccount.PrivKey == nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
@@ -425,7 +522,7 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 			CoinsSpentInMsg: spendable,
 		}
 
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTxWithRandFees(txCtx)
 	}
 }
 
@@ -493,7 +590,7 @@ func SimulateMsgCancelUnbondingDelegate(ak types.AccountKeeper, bk types.BankKee
 			CoinsSpentInMsg: spendable,
 		}
 
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTxWithRandFees(txCtx)
 	}
 }
 
@@ -567,6 +664,10 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 			}
 		}
 
+		if simAccount.PrivKey == nil && isLiquidStakingAccount(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "liquid staking account can not sign"), nil, nil
+		}
+
 		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
 		if simAccount.PrivKey == nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
@@ -595,7 +696,7 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 			CoinsSpentInMsg: spendable,
 		}
 
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTxWithRandFees(txCtx)
 	}
 }
 
@@ -610,6 +711,10 @@ func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k ke
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "unable to pick validator"), nil, nil
 		}
 
+		if err := k.CheckTokenizationAllowed(ctx, srcVal); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "tokenization is paused"), nil, nil
+		}
+
 		srcAddr := srcVal.GetOperator()
 		delegations := k.GetValidatorDelegations(ctx, srcAddr)
 		if delegations == nil {
@@ -655,6 +760,10 @@ func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k ke
 			}
 		}
 
+		if simAccount.PrivKey == nil && isLiquidStakingAccount(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "liquid staking account can not sign"), nil, nil
+		}
+
 		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
 		if simAccount.PrivKey == nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
@@ -685,7 +794,7 @@ func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k ke
 			CoinsSpentInMsg: spendable,
 		}
 
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTxWithRandFees(txCtx)
 	}
 }
 
@@ -745,7 +854,7 @@ func SimulateMsgRedeemTokensforShares(ak types.AccountKeeper, bk types.BankKeepe
 			CoinsSpentInMsg: spendable,
 		}
 
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTxWithRandFees(txCtx)
 	}
 }
 
@@ -804,6 +913,347 @@ func SimulateMsgTransferTokenizeShareRecord(ak types.AccountKeeper, bk types.Ban
 			CoinsSpentInMsg: spendable,
 		}
 
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return genAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgValidatorBond generates a MsgValidatorBond with random values
+func SimulateMsgValidatorBond(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgValidatorBond, "unable to pick validator"), nil, nil
+		}
+
+		// only delegations from simulation accounts that are not yet validator bonds are eligible
+		var candidates []simtypes.Account
+		for _, delegation := range k.GetValidatorDelegations(ctx, validator.GetOperator()) {
+			if delegation.ValidatorBond {
+				continue
+			}
+			if simAccount, found := simtypes.FindAccount(accs, delegation.GetDelegatorAddr()); found {
+				candidates = append(candidates, simAccount)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgValidatorBond, "no eligible delegation"), nil, nil
+		}
+
+		simAccount := candidates[r.Intn(len(candidates))]
+		msg := types.NewMsgValidatorBond(simAccount.Address, validator.GetOperator())
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return genAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgDisableTokenizeShares generates a MsgDisableTokenizeShares for a random account
+// that has not already disabled tokenizing
+func SimulateMsgDisableTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		lockStatus, _ := k.GetTokenizeSharesLock(ctx, simAccount.Address)
+		if lockStatus == types.TokenizeShareLockStatus_LOCKED {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDisableTokenizeShares, "tokenize shares already disabled"), nil, nil
+		}
+
+		msg := &types.MsgDisableTokenizeShares{
+			DelegatorAddress: simAccount.Address.String(),
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return genAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgEnableTokenizeShares generates a MsgEnableTokenizeShares for a random account
+// that has disabled tokenizing
+func SimulateMsgEnableTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var lockedAccounts []simtypes.Account
+		for _, acc := range accs {
+			if lockStatus, _ := k.GetTokenizeSharesLock(ctx, acc.Address); lockStatus == types.TokenizeShareLockStatus_LOCKED {
+				lockedAccounts = append(lockedAccounts, acc)
+			}
+		}
+
+		if len(lockedAccounts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEnableTokenizeShares, "no account has tokenize shares disabled"), nil, nil
+		}
+
+		simAccount := lockedAccounts[r.Intn(len(lockedAccounts))]
+		msg := &types.MsgEnableTokenizeShares{
+			DelegatorAddress: simAccount.Address.String(),
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return genAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateLiquidStakingProviderDelegate delegates a random amount from a random liquid staking provider
+func SimulateLiquidStakingProviderDelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		providerAddr, ok := randomLiquidStakingProvider(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "no liquid staking provider"), nil, nil
+		}
+
+		val, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "unable to pick a validator"), nil, nil
+		}
+
+		if val.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "validator's invalid echange rate"), nil, nil
+		}
+
+		denom := k.BondDenom(ctx)
+		balance := bk.SpendableCoins(ctx, providerAddr).AmountOf(denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "unable to generate positive amount"), nil, err
+		}
+
+		msg := types.NewMsgDelegate(providerAddr, val.GetOperator(), sdk.NewCoin(denom, amount))
+
+		return deliverAsLiquidStakingProvider(ctx, msg, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).Delegate(sdk.WrapSDKContext(ctx), msg)
+			return err
+		})
+	}
+}
+
+// SimulateLiquidStakingProviderUndelegate undelegates a random amount of a random liquid staking
+// provider delegation
+func SimulateLiquidStakingProviderUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		providerAddr, ok := randomLiquidStakingProvider(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "no liquid staking provider"), nil, nil
+		}
+
+		delegations := k.GetAllDelegatorDelegations(ctx, providerAddr)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "provider has no delegations"), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+		valAddr := delegation.GetValidatorAddr()
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, providerAddr, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "keeper does have a max unbonding delegation entries"), nil, nil
+		}
+
+		validator, found := k.GetLiquidValidator(ctx, valAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "validator not found"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "total bond is negative"), nil, nil
+		}
+
+		unbondAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "invalid unbond amount"), nil, err
+		}
+
+		msg := types.NewMsgUndelegate(providerAddr, valAddr, sdk.NewCoin(k.BondDenom(ctx), unbondAmt))
+
+		return deliverAsLiquidStakingProvider(ctx, msg, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).Undelegate(sdk.WrapSDKContext(ctx), msg)
+			return err
+		})
+	}
+}
+
+// SimulateLiquidStakingProviderBeginRedelegate redelegates a random amount of a random liquid
+// staking provider delegation to a random validator
+func SimulateLiquidStakingProviderBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		providerAddr, ok := randomLiquidStakingProvider(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "no liquid staking provider"), nil, nil
+		}
+
+		delegations := k.GetAllDelegatorDelegations(ctx, providerAddr)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "provider has no delegations"), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+		srcAddr := delegation.GetValidatorAddr()
+
+		if k.HasReceivingRedelegation(ctx, providerAddr, srcAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "receveing redelegation is not allowed"), nil, nil // skip
+		}
+
+		srcVal, found := k.GetLiquidValidator(ctx, srcAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "validator not found"), nil, nil
+		}
+
+		destVal, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "unable to pick validator"), nil, nil
+		}
+
+		destAddr := destVal.GetOperator()
+		if srcAddr.Equals(destAddr) || destVal.InvalidExRate() || k.HasMaxRedelegationEntries(ctx, providerAddr, srcAddr, destAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "checks failed"), nil, nil
+		}
+
+		totalBond := srcVal.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "total bond is negative"), nil, nil
+		}
+
+		redAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "unable to generate positive amount"), nil, err
+		}
+
+		// check if the shares truncate to zero
+		shares, err := srcVal.SharesFromTokens(redAmt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "invalid shares"), nil, err
+		}
+
+		if srcVal.TokensFromShares(shares).TruncateInt().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "shares truncate to zero"), nil, nil // skip
+		}
+
+		msg := types.NewMsgBeginRedelegate(providerAddr, srcAddr, destAddr, sdk.NewCoin(k.BondDenom(ctx), redAmt))
+
+		return deliverAsLiquidStakingProvider(ctx, msg, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+			return err
+		})
+	}
+}
+
+// randomLiquidStakingProvider returns the address of a random simulated liquid staking provider,
+// if that provider exists in state
+func randomLiquidStakingProvider(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (sdk.AccAddress, bool) {
+	providerAddr := LiquidStakingProviderAddress(r.Intn(NumLiquidStakingProviders))
+	return providerAddr, k.AccountIsLiquidStakingProvider(ctx, providerAddr)
+}
+
+// isLiquidStakingAccount returns true if the address is a liquid staking provider or the module
+// account of a tokenize share record. Neither have a key to sign simulated txs
+func isLiquidStakingAccount(ctx sdk.Context, k keeper.Keeper, address sdk.AccAddress) bool {
+	if k.AccountIsLiquidStakingProvider(ctx, address) {
+		return true
+	}
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		if record.GetModuleAddress().Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// genAndDeliverTxWithRandFees delivers the tx like simulation.GenAndDeliverTxWithRandFees,
+// but reports a message rejected by an expected liquid staking restriction as a no-op
+func genAndDeliverTxWithRandFees(txCtx simulation.OperationInput) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	opMsg, futureOps, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+	if err != nil && errorsmod.IsOf(err, expectedLiquidStakingErrors...) {
+		return simtypes.NoOpMsg(types.ModuleName, txCtx.MsgType, err.Error()), nil, nil
+	}
+	return opMsg, futureOps, err
+}
+
+// deliverAsLiquidStakingProvider executes a message on behalf of a liquid staking provider.
+// Providers can't sign txs, so the message is passed straight to the msg server like the
+// interchain accounts host does. The state changes are only kept if the message succeeds
+func deliverAsLiquidStakingProvider(
+	ctx sdk.Context, msg legacytx.LegacyMsg, deliver func(ctx sdk.Context) error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "invalid msg"), nil, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := deliver(cacheCtx); err != nil {
+		if errorsmod.IsOf(err, expectedLiquidStakingErrors...) {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver msg"), nil, err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
@@ -1,5 +1,186 @@
 package simulation_test
 
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp_test "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	simapp "github.com/iqlusioninc/liquidity-staking-module/app"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/keeper"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/simulation"
+	"github.com/iqlusioninc/liquidity-staking-module/x/staking/types"
+)
+
+// TestSimulateLiquidStakingProviderOperations checks that the liquid staking provider
+// operations delegate, redelegate and undelegate while keeping the liquid staking invariants
+func TestSimulateLiquidStakingProviderOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app, ctx, accounts := createLiquidStakingTestApp(t, r, 3)
+
+	ops := []struct {
+		name string
+		op   simtypes.Operation
+	}{
+		{types.TypeMsgDelegate, simulation.SimulateLiquidStakingProviderDelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)},
+		{types.TypeMsgBeginRedelegate, simulation.SimulateLiquidStakingProviderBeginRedelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)},
+		{types.TypeMsgUndelegate, simulation.SimulateLiquidStakingProviderUndelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)},
+	}
+
+	for _, tc := range ops {
+		delivered := false
+		for i := 0; i < 20 && !delivered; i++ {
+			operationMsg, futureOperations, err := tc.op(r, app.BaseApp, ctx, accounts, "")
+			require.NoError(t, err, tc.name)
+			require.Len(t, futureOperations, 0)
+			require.Equal(t, tc.name, operationMsg.Name)
+			delivered = operationMsg.OK
+		}
+		require.True(t, delivered, tc.name)
+
+		msg, broken := keeper.LiquidStakingInvariants(app.StakingKeeper)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsPositive())
+}
+
+// TestSimulateLiquidStakingProviderDelegateCapExceeded checks that a provider delegation
+// rejected by the global liquid staking cap is reported as a no-op
+func TestSimulateLiquidStakingProviderDelegateCapExceeded(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app, ctx, accounts := createLiquidStakingTestApp(t, r, 3)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	op := simulation.SimulateLiquidStakingProviderDelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+	require.Contains(t, operationMsg.Comment, types.ErrGlobalLiquidStakingCapExceeded.Error())
+
+	for i := 0; i < simulation.NumLiquidStakingProviders; i++ {
+		delegations := app.StakingKeeper.GetAllDelegatorDelegations(ctx, simulation.LiquidStakingProviderAddress(i))
+		require.Empty(t, delegations)
+	}
+}
+
+// TestSimulateMsgValidatorBond tests the normal scenario of a valid message of type TypeMsgValidatorBond.
+func TestSimulateMsgValidatorBond(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app, ctx, accounts := createLiquidStakingTestApp(t, r, 3)
+
+	// The self delegation of the created validator is already a validator bond, so the
+	// delegation below is the only eligible one. The genesis validator has no delegations
+	// from simulation accounts, so picking it is a no-op
+	validatorAddress := sdk.ValAddress(accounts[0].Address)
+	delegator := accounts[1]
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err := msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(accounts[0].Address, validatorAddress))
+	require.NoError(t, err)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(
+		delegator.Address, validatorAddress, sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))))
+	require.NoError(t, err)
+
+	op := simulation.SimulateMsgValidatorBond(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	var (
+		operationMsg     simtypes.OperationMsg
+		futureOperations []simtypes.FutureOperation
+	)
+	for i := 0; i < 10 && !operationMsg.OK; i++ {
+		operationMsg, futureOperations, err = op(r, app.BaseApp, ctx, accounts, "")
+		require.NoError(t, err)
+	}
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgValidatorBond, operationMsg.Name)
+	require.Len(t, futureOperations, 0)
+
+	delegation, found := app.StakingKeeper.GetLiquidDelegation(ctx, delegator.Address, validatorAddress)
+	require.True(t, found)
+	require.True(t, delegation.ValidatorBond)
+
+	// no eligible delegation is left on either validator
+	for i := 0; i < 10; i++ {
+		operationMsg, _, err = op(r, app.BaseApp, ctx, accounts, "")
+		require.NoError(t, err)
+		require.False(t, operationMsg.OK)
+	}
+}
+
+// TestSimulateMsgDisableEnableTokenizeShares tests that an account that disabled tokenizing
+// is picked to enable it again.
+func TestSimulateMsgDisableEnableTokenizeShares(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	app, ctx, accounts := createLiquidStakingTestApp(t, r, 3)
+
+	enableOp := simulation.SimulateMsgEnableTokenizeShares(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, _, err := enableOp(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+
+	disableOp := simulation.SimulateMsgDisableTokenizeShares(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, _, err = disableOp(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgDisableTokenizeShares, operationMsg.Name)
+
+	var locked []sdk.AccAddress
+	for _, account := range accounts {
+		if status, _ := app.StakingKeeper.GetTokenizeSharesLock(ctx, account.Address); status == types.TokenizeShareLockStatus_LOCKED {
+			locked = append(locked, account.Address)
+		}
+	}
+	require.Len(t, locked, 1)
+
+	operationMsg, _, err = enableOp(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgEnableTokenizeShares, operationMsg.Name)
+
+	status, _ := app.StakingKeeper.GetTokenizeSharesLock(ctx, locked[0])
+	require.Equal(t, types.TokenizeShareLockStatus_LOCK_EXPIRING, status)
+}
+
+// createLiquidStakingTestApp returns a simapp with funded simulation accounts, funded liquid
+// staking providers and a second validator created by the first simulation account
+func createLiquidStakingTestApp(t *testing.T, r *rand.Rand, n int) (*simapp.SimApp, sdk.Context, []simtypes.Account) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 200)))
+
+	accounts := simtypes.RandomAccounts(r, n)
+	for _, account := range accounts {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, account.Address))
+		require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, account.Address, initCoins))
+	}
+
+	for i := 0; i < simulation.NumLiquidStakingProviders; i++ {
+		address := simulation.LiquidStakingProviderAddress(i)
+		provider := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(address), simulation.LiquidStakingProviderName(i))
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, provider))
+		require.NoError(t, simapp_test.FundAccount(app.BankKeeper, ctx, address, initCoins))
+	}
+
+	msg, err := types.NewMsgCreateValidator(
+		sdk.ValAddress(accounts[0].Address), accounts[0].ConsKey.PubKey(),
+		sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 50)),
+		types.Description{}, types.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.OneDec()),
+	)
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	return app, ctx, accounts
+}
+
 // // TestWeightedOperations tests the weights of the operations.
 // func TestWeightedOperations(t *testing.T) {
 // 	s := rand.NewSource(1)
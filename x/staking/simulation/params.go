@@ -30,5 +30,25 @@ func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", getHistEntries(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyValidatorBondFactor),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genValidatorBondFactor(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyGlobalLiquidStakingCap),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genLiquidStakingCap(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyValidatorLiquidStakingCap),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genLiquidStakingCap(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyValidatorBondFirstLossFraction),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genValidatorBondFirstLossFraction(r))
+			},
+		),
 	}
 }
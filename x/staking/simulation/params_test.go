@@ -22,11 +22,15 @@ func TestParamChanges(t *testing.T) {
 		{"staking/MaxValidators", "MaxValidators", "82", "staking"},
 		{"staking/UnbondingTime", "UnbondingTime", "\"275307000000000\"", "staking"},
 		{"staking/HistoricalEntries", "HistoricalEntries", "9149", "staking"},
+		{"staking/ValidatorBondFactor", "ValidatorBondFactor", "\"41.000000000000000000\"", "staking"},
+		{"staking/GlobalLiquidStakingCap", "GlobalLiquidStakingCap", "\"0.110000000000000000\"", "staking"},
+		{"staking/ValidatorLiquidStakingCap", "ValidatorLiquidStakingCap", "\"0.300000000000000000\"", "staking"},
+		{"staking/ValidatorBondFirstLossFraction", "ValidatorBondFirstLossFraction", "\"0.000000000000000000\"", "staking"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 7)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
@@ -15,6 +15,7 @@ const gasCostPerIteration = uint64(10)
 // Normalized Msg type URLs
 var (
 	_ authz.Authorization = &StakeAuthorization{}
+	_ authz.Authorization = &TokenizeShareAuthorization{}
 )
 
 // NewStakeAuthorization creates a new StakeAuthorization object.
@@ -39,6 +40,22 @@ func NewStakeAuthorization(allowed []sdk.ValAddress, denied []sdk.ValAddress, au
 	return &a, nil
 }
 
+// NewTransferTokenizeShareRecordAuthorization creates a new StakeAuthorization object for
+// Msg/TransferTokenizeShareRecord. The new owner must be one of the allowed share owners or,
+// when none are set, the granter.
+func NewTransferTokenizeShareRecordAuthorization(allowedShareOwners []sdk.AccAddress) (*StakeAuthorization, error) {
+	a := StakeAuthorization{AuthorizationType: AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD}
+	for _, owner := range allowedShareOwners {
+		a.AllowedShareOwners = append(a.AllowedShareOwners, owner.String())
+	}
+
+	if err := a.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
 // MsgTypeURL implements Authorization.MsgTypeURL.
 func (a StakeAuthorization) MsgTypeURL() string {
 	authzType, err := normalizeAuthzType(a.AuthorizationType)
@@ -55,6 +72,25 @@ func (a StakeAuthorization) ValidateBasic() error {
 	if a.AuthorizationType == AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unknown authorization type")
 	}
+	// Msg/TokenizeShares is granted with a TokenizeShareAuthorization
+	if _, err := normalizeAuthzType(a.AuthorizationType); err != nil {
+		return err
+	}
+
+	// Msg/TransferTokenizeShareRecord only carries the record id, so its authorization
+	// restricts the new owner rather than the validator and the amount
+	if a.AuthorizationType == AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD {
+		if a.MaxTokens != nil || a.Validators != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("max tokens and validators are not supported for %s", a.AuthorizationType)
+		}
+	} else if len(a.AllowedShareOwners) > 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("allowed share owners are not supported for %s", a.AuthorizationType)
+	}
+	for _, owner := range a.AllowedShareOwners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed share owner address: %s", err)
+		}
+	}
 
 	return nil
 }
@@ -62,22 +98,71 @@ func (a StakeAuthorization) ValidateBasic() error {
 // Accept implements Authorization.Accept.
 func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
 	var validatorAddress string
-	var amount sdk.Coin
+	var amount *sdk.Coin
 
 	switch msg := msg.(type) {
 	case *MsgDelegate:
 		validatorAddress = msg.ValidatorAddress
-		amount = msg.Amount
+		amount = &msg.Amount
 	case *MsgUndelegate:
 		validatorAddress = msg.ValidatorAddress
-		amount = msg.Amount
+		amount = &msg.Amount
 	case *MsgBeginRedelegate:
 		validatorAddress = msg.ValidatorDstAddress
-		amount = msg.Amount
+		amount = &msg.Amount
+	case *MsgRedeemTokensforShares:
+		// The share tokens are counted against the limit at the bond tokens they
+		// were minted for, since one share token is minted per tokenized bond token
+		valAddr, _, err := ParseShareTokenDenom(msg.Amount.Denom)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+		}
+		validatorAddress = valAddr.String()
+		if a.MaxTokens != nil {
+			redeemed := sdk.NewCoin(a.MaxTokens.Denom, msg.Amount.Amount)
+			amount = &redeemed
+		}
+	case *MsgTransferTokenizeShareRecord:
+		// The sender is the granter, since it is the signer of the executed message
+		if err := a.checkShareOwner(ctx, msg.NewOwner, msg.Sender); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	case *MsgValidatorBond:
+		validatorAddress = msg.ValidatorAddress
 	default:
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
 
+	if err := a.checkValidator(ctx, validatorAddress); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if a.MaxTokens == nil || amount == nil {
+		return authz.AcceptResponse{
+			Accept: true, Delete: false,
+			Updated: &StakeAuthorization{
+				Validators: a.GetValidators(), AuthorizationType: a.GetAuthorizationType(),
+				MaxTokens: a.MaxTokens, AllowedShareOwners: a.GetAllowedShareOwners(),
+			},
+		}, nil
+	}
+
+	limitLeft := a.MaxTokens.Sub(*amount)
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{
+		Accept: true, Delete: false,
+		Updated: &StakeAuthorization{
+			Validators: a.GetValidators(), AuthorizationType: a.GetAuthorizationType(),
+			MaxTokens: &limitLeft, AllowedShareOwners: a.GetAllowedShareOwners(),
+		},
+	}, nil
+}
+
+// checkValidator returns an error if the validator is not in the allow list
+// (when one is set) or is in the deny list
+func (a StakeAuthorization) checkValidator(ctx sdk.Context, validatorAddress string) error {
 	isValidatorExists := false
 	allowedList := a.GetAllowList().GetAddress()
 	for _, validator := range allowedList {
@@ -92,29 +177,35 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 	for _, validator := range denyList {
 		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
 		if validator == validatorAddress {
-			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(" cannot delegate/undelegate to %s validator", validator)
+			return sdkerrors.ErrUnauthorized.Wrapf(" cannot delegate/undelegate to %s validator", validator)
 		}
 	}
 
-	if !isValidatorExists {
-		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
+	if len(allowedList) > 0 && !isValidatorExists {
+		return sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
 	}
 
-	if a.MaxTokens == nil {
-		return authz.AcceptResponse{
-			Accept: true, Delete: false,
-			Updated: &StakeAuthorization{Validators: a.GetValidators(), AuthorizationType: a.GetAuthorizationType()},
-		}, nil
+	return nil
+}
+
+// checkShareOwner returns an error if the new share owner is not one of the allowed
+// share owners or, when none are set, the granter
+func (a StakeAuthorization) checkShareOwner(ctx sdk.Context, shareOwner string, granter string) error {
+	if len(a.AllowedShareOwners) == 0 {
+		if shareOwner != granter {
+			return sdkerrors.ErrUnauthorized.Wrapf("share owner must be the granter %s", granter)
+		}
+		return nil
 	}
 
-	limitLeft := a.MaxTokens.Sub(amount)
-	if limitLeft.IsZero() {
-		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	for _, owner := range a.AllowedShareOwners {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
+		if owner == shareOwner {
+			return nil
+		}
 	}
-	return authz.AcceptResponse{
-		Accept: true, Delete: false,
-		Updated: &StakeAuthorization{Validators: a.GetValidators(), AuthorizationType: a.GetAuthorizationType(), MaxTokens: &limitLeft},
-	}, nil
+
+	return sdkerrors.ErrUnauthorized.Wrapf("cannot set %s as the share owner", shareOwner)
 }
 
 func validateAndBech32fy(allowed []sdk.ValAddress, denied []sdk.ValAddress) ([]string, []string, error) {
@@ -150,7 +241,161 @@ func normalizeAuthzType(authzType AuthorizationType) (string, error) {
 		return sdk.MsgTypeURL(&MsgUndelegate{}), nil
 	case AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
 		return sdk.MsgTypeURL(&MsgBeginRedelegate{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS:
+		return sdk.MsgTypeURL(&MsgRedeemTokensforShares{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD:
+		return sdk.MsgTypeURL(&MsgTransferTokenizeShareRecord{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND:
+		return sdk.MsgTypeURL(&MsgValidatorBond{}), nil
 	default:
 		return "", sdkerrors.ErrInvalidType.Wrapf("unknown authorization type %T", authzType)
 	}
 }
+
+// NewTokenizeShareAuthorization creates a new TokenizeShareAuthorization object.
+func NewTokenizeShareAuthorization(
+	validators []TokenizeShareAuthorization_AllowedValidator,
+	maxTokens *sdk.Coin,
+	rewardOwner sdk.AccAddress,
+	allowedRecipients []sdk.AccAddress,
+) (*TokenizeShareAuthorization, error) {
+	a := TokenizeShareAuthorization{
+		AllowedValidators: validators,
+		MaxTokens:         maxTokens,
+	}
+	if !rewardOwner.Empty() {
+		a.RewardOwner = rewardOwner.String()
+	}
+	for _, recipient := range allowedRecipients {
+		a.AllowedRecipients = append(a.AllowedRecipients, recipient.String())
+	}
+
+	if err := a.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TokenizeShareAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTokenizeShares{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TokenizeShareAuthorization) ValidateBasic() error {
+	if len(a.AllowedValidators) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowed validators cannot be empty")
+	}
+
+	seenValidators := make(map[string]bool, len(a.AllowedValidators))
+	for _, validator := range a.AllowedValidators {
+		if _, err := sdk.ValAddressFromBech32(validator.ValidatorAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+		if seenValidators[validator.ValidatorAddress] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowed validator %s", validator.ValidatorAddress)
+		}
+		seenValidators[validator.ValidatorAddress] = true
+	}
+
+	if a.MaxTokens != nil && (!a.MaxTokens.IsValid() || a.MaxTokens.IsZero()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max tokens: %v", a.MaxTokens)
+	}
+
+	if a.RewardOwner != "" {
+		if _, err := sdk.AccAddressFromBech32(a.RewardOwner); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid reward owner address: %s", err)
+		}
+		if len(a.AllowedRecipients) > 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("cannot set both reward owner & allowed recipients")
+		}
+	}
+
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed recipient address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. Validators whose expiration has passed
+// are pruned from the updated authorization.
+func (a TokenizeShareAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	tokenizeMsg, ok := msg.(*MsgTokenizeShares)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	isValidatorAllowed := false
+	activeValidators := []TokenizeShareAuthorization_AllowedValidator{}
+	for _, validator := range a.AllowedValidators {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "tokenize share authorization")
+		if validator.Expiration != nil && !ctx.BlockTime().Before(*validator.Expiration) {
+			continue
+		}
+		activeValidators = append(activeValidators, validator)
+		if validator.ValidatorAddress == tokenizeMsg.ValidatorAddress {
+			isValidatorAllowed = true
+		}
+	}
+	if !isValidatorAllowed {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot tokenize shares of %s validator", tokenizeMsg.ValidatorAddress)
+	}
+
+	if err := a.checkShareOwner(ctx, tokenizeMsg); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	updated := TokenizeShareAuthorization{
+		AllowedValidators: activeValidators,
+		MaxTokens:         a.MaxTokens,
+		RewardOwner:       a.RewardOwner,
+		AllowedRecipients: a.AllowedRecipients,
+	}
+
+	if a.MaxTokens != nil {
+		if tokenizeMsg.Amount.Denom != a.MaxTokens.Denom {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrapf(
+				"invalid coin denom: got %s, expected %s", tokenizeMsg.Amount.Denom, a.MaxTokens.Denom)
+		}
+		if a.MaxTokens.IsLT(tokenizeMsg.Amount) {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf(
+				"requested amount %s is more than the remaining limit %s", tokenizeMsg.Amount, a.MaxTokens)
+		}
+
+		limitLeft := a.MaxTokens.Sub(tokenizeMsg.Amount)
+		if limitLeft.IsZero() {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+		updated.MaxTokens = &limitLeft
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &updated}, nil
+}
+
+// checkShareOwner returns an error if the tokenized share owner is neither the
+// required reward owner nor, when none is required, the granter or an allowed recipient
+func (a TokenizeShareAuthorization) checkShareOwner(ctx sdk.Context, msg *MsgTokenizeShares) error {
+	if a.RewardOwner != "" {
+		if msg.TokenizedShareOwner != a.RewardOwner {
+			return sdkerrors.ErrUnauthorized.Wrapf("tokenized share owner must be %s", a.RewardOwner)
+		}
+		return nil
+	}
+
+	// The delegator is the granter, since it is the signer of the executed message
+	if msg.TokenizedShareOwner == msg.DelegatorAddress {
+		return nil
+	}
+	for _, recipient := range a.AllowedRecipients {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "tokenize share authorization")
+		if recipient == msg.TokenizedShareOwner {
+			return nil
+		}
+	}
+
+	return sdkerrors.ErrUnauthorized.Wrapf("cannot set %s as the tokenized share owner", msg.TokenizedShareOwner)
+}
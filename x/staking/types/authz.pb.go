@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,13 @@ const (
 	AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE AuthorizationType = 2
 	// AUTHORIZATION_TYPE_REDELEGATE defines an authorization type for Msg/BeginRedelegate
 	AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE AuthorizationType = 3
+	// AUTHORIZATION_TYPE_REDEEM_TOKENS defines an authorization type for Msg/RedeemTokensforShares
+	AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS AuthorizationType = 5
+	// AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD defines an authorization type for
+	// Msg/TransferTokenizeShareRecord
+	AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD AuthorizationType = 6
+	// AUTHORIZATION_TYPE_VALIDATOR_BOND defines an authorization type for Msg/ValidatorBond
+	AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND AuthorizationType = 7
 )
 
 var AuthorizationType_name = map[int32]string{
@@ -44,13 +55,19 @@ var AuthorizationType_name = map[int32]string{
 	1: "AUTHORIZATION_TYPE_DELEGATE",
 	2: "AUTHORIZATION_TYPE_UNDELEGATE",
 	3: "AUTHORIZATION_TYPE_REDELEGATE",
+	5: "AUTHORIZATION_TYPE_REDEEM_TOKENS",
+	6: "AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD",
+	7: "AUTHORIZATION_TYPE_VALIDATOR_BOND",
 }
 
 var AuthorizationType_value = map[string]int32{
-	"AUTHORIZATION_TYPE_UNSPECIFIED": 0,
-	"AUTHORIZATION_TYPE_DELEGATE":    1,
-	"AUTHORIZATION_TYPE_UNDELEGATE":  2,
-	"AUTHORIZATION_TYPE_REDELEGATE":  3,
+	"AUTHORIZATION_TYPE_UNSPECIFIED":                    0,
+	"AUTHORIZATION_TYPE_DELEGATE":                       1,
+	"AUTHORIZATION_TYPE_UNDELEGATE":                     2,
+	"AUTHORIZATION_TYPE_REDELEGATE":                     3,
+	"AUTHORIZATION_TYPE_REDEEM_TOKENS":                  5,
+	"AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD": 6,
+	"AUTHORIZATION_TYPE_VALIDATOR_BOND":                 7,
 }
 
 func (x AuthorizationType) String() string {
@@ -61,12 +78,16 @@ func (AuthorizationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_dbc817c76ffc2c21, []int{0}
 }
 
-// StakeAuthorization defines authorization for delegate/undelegate/redelegate.
+// StakeAuthorization defines authorization for delegate/undelegate/redelegate and
+// the liquid staking messages (redeem/transfer record/validator bond).
 type StakeAuthorization struct {
 	// max_tokens specifies the maximum amount of tokens can be delegate to a validator. If it is
-	// empty, there is no spend limit and any amount of coins can be delegated.
+	// empty, there is no spend limit and any amount of coins can be delegated. Redeemed share
+	// tokens are counted at the bond tokens they were minted for. It must be empty for
+	// Msg/TransferTokenizeShareRecord.
 	MaxTokens *types.Coin `protobuf:"bytes,1,opt,name=max_tokens,json=maxTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"max_tokens,omitempty"`
-	// validators is the oneof that represents either allow_list or deny_list
+	// validators is the oneof that represents either allow_list or deny_list. It must be empty
+	// for Msg/TransferTokenizeShareRecord, whose message does not carry the validator.
 	//
 	// Types that are valid to be assigned to Validators:
 	//	*StakeAuthorization_AllowList
@@ -74,6 +95,10 @@ type StakeAuthorization struct {
 	Validators isStakeAuthorization_Validators `protobuf_oneof:"validators"`
 	// authorization_type defines one of AuthorizationType.
 	AuthorizationType AuthorizationType `protobuf:"varint,4,opt,name=authorization_type,json=authorizationType,proto3,enum=liquidstaking.staking.v1beta1.AuthorizationType" json:"authorization_type,omitempty"`
+	// allowed_share_owners restricts the addresses the grantee may set as the new
+	// tokenize share record owner (Msg/TransferTokenizeShareRecord). If it is
+	// empty, only the granter is allowed.
+	AllowedShareOwners []string `protobuf:"bytes,5,rep,name=allowed_share_owners,json=allowedShareOwners,proto3" json:"allowed_share_owners,omitempty"`
 }
 
 func (m *StakeAuthorization) Reset()         { *m = StakeAuthorization{} }
@@ -160,6 +185,13 @@ func (m *StakeAuthorization) GetAuthorizationType() AuthorizationType {
 	return AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED
 }
 
+func (m *StakeAuthorization) GetAllowedShareOwners() []string {
+	if m != nil {
+		return m.AllowedShareOwners
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StakeAuthorization) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -213,48 +245,207 @@ func (m *StakeAuthorization_Validators) GetAddress() []string {
 	return nil
 }
 
+// TokenizeShareAuthorization defines an authorization for Msg/TokenizeShares that
+// constrains who ends up owning the tokenized share record (and therefore its
+// rewards) so that a grantee cannot claim the granter's rewards.
+type TokenizeShareAuthorization struct {
+	// allowed_validators lists the validators whose delegations may be tokenized.
+	AllowedValidators []TokenizeShareAuthorization_AllowedValidator `protobuf:"bytes,1,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators"`
+	// max_tokens specifies the maximum cumulative amount of tokens that can be
+	// tokenized. If it is empty, there is no limit.
+	MaxTokens *types.Coin `protobuf:"bytes,2,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// reward_owner is the only address the grantee may set as the tokenized share
+	// owner. If it is empty, the owner must be the granter or one of
+	// allowed_recipients.
+	RewardOwner string `protobuf:"bytes,3,opt,name=reward_owner,json=rewardOwner,proto3" json:"reward_owner,omitempty"`
+	// allowed_recipients lists the addresses, besides the granter, that may be set
+	// as the tokenized share owner when no reward_owner is required.
+	AllowedRecipients []string `protobuf:"bytes,4,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *TokenizeShareAuthorization) Reset()         { *m = TokenizeShareAuthorization{} }
+func (m *TokenizeShareAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareAuthorization) ProtoMessage()    {}
+func (*TokenizeShareAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbc817c76ffc2c21, []int{1}
+}
+func (m *TokenizeShareAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareAuthorization.Merge(m, src)
+}
+func (m *TokenizeShareAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareAuthorization proto.InternalMessageInfo
+
+func (m *TokenizeShareAuthorization) GetAllowedValidators() []TokenizeShareAuthorization_AllowedValidator {
+	if m != nil {
+		return m.AllowedValidators
+	}
+	return nil
+}
+
+func (m *TokenizeShareAuthorization) GetMaxTokens() *types.Coin {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return nil
+}
+
+func (m *TokenizeShareAuthorization) GetRewardOwner() string {
+	if m != nil {
+		return m.RewardOwner
+	}
+	return ""
+}
+
+func (m *TokenizeShareAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+// AllowedValidator defines a validator the grantee may tokenize shares of and
+// until when.
+type TokenizeShareAuthorization_AllowedValidator struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// expiration is the time after which the validator is no longer allowed. If
+	// it is not set, the validator is allowed for the lifetime of the grant.
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *TokenizeShareAuthorization_AllowedValidator) Reset() {
+	*m = TokenizeShareAuthorization_AllowedValidator{}
+}
+func (m *TokenizeShareAuthorization_AllowedValidator) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TokenizeShareAuthorization_AllowedValidator) ProtoMessage() {}
+func (*TokenizeShareAuthorization_AllowedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbc817c76ffc2c21, []int{1, 0}
+}
+func (m *TokenizeShareAuthorization_AllowedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareAuthorization_AllowedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareAuthorization_AllowedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareAuthorization_AllowedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareAuthorization_AllowedValidator.Merge(m, src)
+}
+func (m *TokenizeShareAuthorization_AllowedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareAuthorization_AllowedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareAuthorization_AllowedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareAuthorization_AllowedValidator proto.InternalMessageInfo
+
+func (m *TokenizeShareAuthorization_AllowedValidator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *TokenizeShareAuthorization_AllowedValidator) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("liquidstaking.staking.v1beta1.AuthorizationType", AuthorizationType_name, AuthorizationType_value)
 	proto.RegisterType((*StakeAuthorization)(nil), "liquidstaking.staking.v1beta1.StakeAuthorization")
 	proto.RegisterType((*StakeAuthorization_Validators)(nil), "liquidstaking.staking.v1beta1.StakeAuthorization.Validators")
+	proto.RegisterType((*TokenizeShareAuthorization)(nil), "liquidstaking.staking.v1beta1.TokenizeShareAuthorization")
+	proto.RegisterType((*TokenizeShareAuthorization_AllowedValidator)(nil), "liquidstaking.staking.v1beta1.TokenizeShareAuthorization.AllowedValidator")
 }
 
 func init() { proto.RegisterFile("staking/v1beta1/authz.proto", fileDescriptor_dbc817c76ffc2c21) }
 
 var fileDescriptor_dbc817c76ffc2c21 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0xed, 0x06, 0x01, 0x39, 0x5c, 0xd4, 0x8c, 0xba, 0x48, 0x53, 0xd5, 0x2d, 0xdd, 0x10,
-	0x81, 0x6c, 0xd3, 0xb0, 0x43, 0x48, 0xe0, 0xb4, 0x86, 0x46, 0xaa, 0xda, 0xca, 0x71, 0x91, 0x5a,
-	0x84, 0xac, 0x49, 0x3c, 0x72, 0x46, 0xb1, 0x67, 0x52, 0xcf, 0xb8, 0x24, 0x7d, 0x0a, 0x9e, 0x80,
-	0x07, 0x60, 0xdd, 0x87, 0x40, 0xac, 0x2a, 0x56, 0xec, 0x40, 0xc9, 0x63, 0xb0, 0x41, 0xbe, 0x24,
-	0xb4, 0xb4, 0xc0, 0xa6, 0xab, 0xf1, 0xf8, 0x7c, 0xfe, 0xfe, 0xe3, 0xb9, 0xc0, 0x92, 0x90, 0xb8,
-	0x4f, 0x59, 0x60, 0x1e, 0xaf, 0x77, 0x88, 0xc4, 0xeb, 0x26, 0x4e, 0x64, 0xef, 0xc4, 0x18, 0xc4,
-	0x5c, 0x72, 0xb4, 0x1c, 0xd2, 0xa3, 0x84, 0xfa, 0x05, 0x62, 0x4c, 0xc7, 0x02, 0xad, 0x2d, 0x04,
-	0x3c, 0xe0, 0x19, 0x69, 0xa6, 0x4f, 0xf9, 0x47, 0xb5, 0xc5, 0x2e, 0x17, 0x11, 0x17, 0x5e, 0x5e,
-	0xc8, 0x27, 0x45, 0x49, 0xcb, 0x67, 0x66, 0x07, 0x0b, 0x32, 0x0b, 0xec, 0x72, 0xca, 0xf2, 0xfa,
-	0xda, 0xcf, 0x12, 0xa0, 0xb6, 0xc4, 0x7d, 0x62, 0x25, 0xb2, 0xc7, 0x63, 0x7a, 0x82, 0x25, 0xe5,
-	0x0c, 0x11, 0x80, 0x08, 0x0f, 0x3d, 0xc9, 0xfb, 0x84, 0x89, 0xaa, 0xba, 0xaa, 0xd6, 0xef, 0x34,
-	0x16, 0x8d, 0xc2, 0x9c, 0xba, 0xa6, 0x1d, 0x19, 0x1b, 0x9c, 0xb2, 0xe6, 0xe3, 0x4f, 0xdf, 0x57,
-	0x1e, 0x06, 0x54, 0xf6, 0x92, 0x8e, 0xd1, 0xe5, 0x51, 0xd1, 0x42, 0x31, 0xe8, 0xc2, 0xef, 0x9b,
-	0x72, 0x34, 0x20, 0x22, 0x83, 0x9d, 0x72, 0x84, 0x87, 0x6e, 0x26, 0x46, 0xef, 0x00, 0x70, 0x18,
-	0xf2, 0xf7, 0x5e, 0x48, 0x85, 0xac, 0xce, 0x65, 0x31, 0xcf, 0x8d, 0x7f, 0x2e, 0x81, 0x71, 0xb9,
-	0x5b, 0xe3, 0x0d, 0x0e, 0xa9, 0x8f, 0x25, 0x8f, 0xc5, 0x96, 0xe2, 0x94, 0x33, 0xe3, 0x36, 0x15,
-	0x12, 0xbd, 0x85, 0xb2, 0x4f, 0xd8, 0x28, 0xb7, 0x97, 0xae, 0xc5, 0x7e, 0x3b, 0x15, 0x66, 0x72,
-	0x0f, 0x10, 0x3e, 0xcf, 0x79, 0xe9, 0x2f, 0x56, 0x6f, 0xac, 0xaa, 0xf5, 0xfb, 0x8d, 0x27, 0xff,
-	0x49, 0xb9, 0x10, 0xe0, 0x8e, 0x06, 0xc4, 0xa9, 0xe0, 0x3f, 0x5f, 0xd5, 0x5e, 0x02, 0xfc, 0x8e,
-	0x46, 0x0d, 0xb8, 0x85, 0x7d, 0x3f, 0x26, 0x22, 0xdd, 0x8e, 0x52, 0xbd, 0xdc, 0xac, 0x7e, 0x3d,
-	0xd5, 0x17, 0x8a, 0x1d, 0xb1, 0xf2, 0x4a, 0x5b, 0xc6, 0x94, 0x05, 0xce, 0x14, 0x7c, 0x56, 0xf9,
-	0x72, 0xaa, 0xdf, 0xbb, 0x90, 0xd5, 0xbc, 0x0b, 0x70, 0x3c, 0x93, 0x3e, 0xfa, 0xa8, 0x42, 0xe5,
-	0x52, 0x2f, 0x68, 0x0d, 0x34, 0x6b, 0xdf, 0xdd, 0xda, 0x75, 0x5a, 0x87, 0x96, 0xdb, 0xda, 0xdd,
-	0xf1, 0xdc, 0x83, 0x3d, 0xdb, 0xdb, 0xdf, 0x69, 0xef, 0xd9, 0x1b, 0xad, 0x57, 0x2d, 0x7b, 0x73,
-	0x5e, 0x41, 0x2b, 0xb0, 0x74, 0x05, 0xb3, 0x69, 0x6f, 0xdb, 0xaf, 0x2d, 0xd7, 0x9e, 0x57, 0xd1,
-	0x03, 0x58, 0xbe, 0x52, 0x32, 0x43, 0xe6, 0xfe, 0x82, 0x38, 0xf6, 0x0c, 0x29, 0x35, 0x0f, 0x3e,
-	0x8f, 0x35, 0xf5, 0x6c, 0xac, 0xa9, 0x3f, 0xc6, 0x9a, 0xfa, 0x61, 0xa2, 0x29, 0x67, 0x13, 0x4d,
-	0xf9, 0x36, 0xd1, 0x94, 0xc3, 0x17, 0xe7, 0x8e, 0x1b, 0x3d, 0x0a, 0x13, 0x41, 0x39, 0xa3, 0xac,
-	0x6b, 0xe6, 0x0b, 0x4f, 0xe5, 0x48, 0x2f, 0x16, 0x5d, 0x8f, 0xb8, 0x9f, 0x84, 0xc4, 0x1c, 0x9a,
-	0xd3, 0x7b, 0x97, 0x9d, 0xc5, 0xce, 0xcd, 0xec, 0x02, 0x3c, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0x4b, 0x1a, 0x66, 0x8f, 0x03, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xe2, 0x46,
+	0x1c, 0xc7, 0xc4, 0xfb, 0xc1, 0x64, 0x5b, 0x99, 0x51, 0x0e, 0x2c, 0xab, 0x35, 0x2c, 0x6a, 0xd5,
+	0x68, 0x2b, 0xec, 0x86, 0xaa, 0x52, 0xd5, 0x56, 0xea, 0x9a, 0xe0, 0xdd, 0xb0, 0x4d, 0x61, 0x65,
+	0x7b, 0x57, 0xda, 0x54, 0x95, 0x35, 0xe0, 0x29, 0x8c, 0x30, 0x1e, 0xd6, 0x33, 0x6c, 0x20, 0x97,
+	0xbe, 0x41, 0x95, 0x7b, 0xdf, 0xa0, 0xe7, 0xbc, 0x43, 0xa3, 0xaa, 0x87, 0xa8, 0xa7, 0x9e, 0x9a,
+	0x2a, 0x79, 0x91, 0x8a, 0xf1, 0x07, 0x90, 0x90, 0x70, 0xe9, 0xc9, 0x78, 0xe6, 0xf7, 0xf1, 0xff,
+	0xf2, 0x1f, 0xf0, 0x88, 0x71, 0x34, 0x20, 0x41, 0x4f, 0x7f, 0xbf, 0xd3, 0xc1, 0x1c, 0xed, 0xe8,
+	0x68, 0xcc, 0xfb, 0x47, 0xda, 0x28, 0xa4, 0x9c, 0xc2, 0xc7, 0x3e, 0x79, 0x37, 0x26, 0x5e, 0x0c,
+	0xd1, 0x92, 0x67, 0x0c, 0x2d, 0x6e, 0xf5, 0x68, 0x8f, 0x0a, 0xa4, 0x3e, 0xfb, 0x15, 0x91, 0x8a,
+	0x0f, 0xbb, 0x94, 0x0d, 0x29, 0x73, 0xa3, 0x8b, 0xe8, 0x25, 0xbe, 0x52, 0xa3, 0x37, 0xbd, 0x83,
+	0x18, 0x4e, 0x0d, 0xbb, 0x94, 0x04, 0xf1, 0x7d, 0xa9, 0x47, 0x69, 0xcf, 0xc7, 0xba, 0x78, 0xeb,
+	0x8c, 0x7f, 0xd2, 0x39, 0x19, 0x62, 0xc6, 0xd1, 0x70, 0x14, 0x01, 0x2a, 0xbf, 0xcb, 0x00, 0xda,
+	0x1c, 0x0d, 0xb0, 0x31, 0xe6, 0x7d, 0x1a, 0x92, 0x23, 0xc4, 0x09, 0x0d, 0x20, 0x06, 0x60, 0x88,
+	0x26, 0x2e, 0xa7, 0x03, 0x1c, 0xb0, 0x82, 0x54, 0x96, 0xb6, 0x37, 0x6b, 0x0f, 0xb5, 0xd8, 0x7a,
+	0x66, 0x96, 0x84, 0xac, 0xed, 0x52, 0x12, 0xd4, 0x3f, 0xfd, 0xed, 0xbc, 0xf4, 0x49, 0x8f, 0xf0,
+	0xfe, 0xb8, 0xa3, 0x75, 0xe9, 0x30, 0x8e, 0x31, 0x7e, 0x54, 0x99, 0x37, 0xd0, 0xf9, 0x74, 0x84,
+	0x99, 0x00, 0x5b, 0xb9, 0x21, 0x9a, 0x38, 0x42, 0x18, 0xfe, 0x08, 0x00, 0xf2, 0x7d, 0x7a, 0xe8,
+	0xfa, 0x84, 0xf1, 0x42, 0x56, 0xd8, 0x7c, 0xa3, 0xdd, 0x5a, 0x23, 0xed, 0x7a, 0xb4, 0xda, 0x1b,
+	0xe4, 0x13, 0x0f, 0x71, 0x1a, 0xb2, 0xbd, 0x8c, 0x95, 0x13, 0x8a, 0xfb, 0x84, 0x71, 0xf8, 0x03,
+	0xc8, 0x79, 0x38, 0x98, 0x46, 0xea, 0x1b, 0xff, 0x8b, 0xfa, 0xfd, 0x99, 0xa0, 0x10, 0x77, 0x01,
+	0x44, 0x8b, 0x38, 0x77, 0x96, 0x62, 0x41, 0x2e, 0x4b, 0xdb, 0x1f, 0xd6, 0x3e, 0x5b, 0xe3, 0xb2,
+	0x64, 0xe0, 0x4c, 0x47, 0xd8, 0xca, 0xa3, 0xab, 0x47, 0xf0, 0x25, 0xd8, 0x12, 0xa9, 0x60, 0xcf,
+	0x65, 0x7d, 0x14, 0x62, 0x97, 0x1e, 0x06, 0x38, 0x64, 0x85, 0x3b, 0xe5, 0x8d, 0xed, 0x5c, 0xbd,
+	0xf0, 0xd7, 0x49, 0x75, 0x2b, 0x6e, 0x88, 0xe1, 0x79, 0x21, 0x66, 0xcc, 0xe6, 0x21, 0x09, 0x7a,
+	0x16, 0x8c, 0x59, 0xf6, 0x8c, 0xd4, 0x16, 0x9c, 0xe2, 0x33, 0x00, 0xe6, 0x69, 0xc0, 0x1a, 0xb8,
+	0x87, 0x22, 0x4a, 0x41, 0x5a, 0x23, 0x96, 0x00, 0xbf, 0xca, 0xff, 0x71, 0x52, 0xfd, 0x60, 0x29,
+	0xee, 0xfa, 0x03, 0x00, 0xde, 0xa7, 0xa2, 0x95, 0x5f, 0x64, 0x50, 0x14, 0x6d, 0x25, 0x47, 0x58,
+	0x58, 0x2f, 0x4f, 0xd4, 0xcf, 0x20, 0x89, 0xcb, 0x9d, 0x93, 0x84, 0xfd, 0x66, 0xed, 0xe5, 0x9a,
+	0x72, 0xdd, 0x2c, 0xab, 0x19, 0x91, 0x66, 0x9a, 0x5c, 0x5d, 0x3e, 0xfd, 0xa7, 0x94, 0xb1, 0xf2,
+	0xe8, 0xca, 0x39, 0x83, 0x5f, 0x2e, 0x8d, 0x74, 0x76, 0xcd, 0x48, 0x2f, 0x4e, 0xe9, 0xd7, 0xe0,
+	0x41, 0x88, 0x0f, 0x51, 0xe8, 0x45, 0x1d, 0x10, 0x93, 0x74, 0x5b, 0xcd, 0x36, 0x23, 0xb4, 0x28,
+	0x3d, 0x7c, 0x31, 0xcf, 0x3b, 0xc4, 0x5d, 0x32, 0x22, 0x38, 0xe0, 0xac, 0x20, 0xaf, 0x29, 0x7b,
+	0x12, 0xbf, 0x95, 0x52, 0x8a, 0xbf, 0x4a, 0x40, 0xb9, 0x9a, 0x2d, 0x34, 0x41, 0x3e, 0xad, 0xa6,
+	0x3b, 0xef, 0xe9, 0xed, 0xf1, 0x29, 0x29, 0x25, 0x3e, 0x87, 0xcf, 0x00, 0xc0, 0x93, 0x11, 0x09,
+	0x45, 0x4d, 0xe3, 0xda, 0x14, 0xb5, 0x68, 0x77, 0x68, 0xc9, 0xee, 0xd0, 0x9c, 0x64, 0x77, 0xd4,
+	0xe5, 0xe3, 0xf3, 0x92, 0x64, 0x2d, 0x70, 0x56, 0x8c, 0xc7, 0xd3, 0x3f, 0xb3, 0x20, 0x7f, 0x6d,
+	0xd0, 0x61, 0x05, 0xa8, 0xc6, 0x6b, 0x67, 0xaf, 0x6d, 0x35, 0x0f, 0x0c, 0xa7, 0xd9, 0x6e, 0xb9,
+	0xce, 0xdb, 0x57, 0xa6, 0xfb, 0xba, 0x65, 0xbf, 0x32, 0x77, 0x9b, 0xcf, 0x9b, 0x66, 0x43, 0xc9,
+	0xc0, 0x12, 0x78, 0xb4, 0x02, 0xd3, 0x30, 0xf7, 0xcd, 0x17, 0x86, 0x63, 0x2a, 0x12, 0x7c, 0x02,
+	0x1e, 0xaf, 0x14, 0x49, 0x21, 0xd9, 0x1b, 0x20, 0x96, 0x99, 0x42, 0x36, 0xe0, 0x47, 0xa0, 0x7c,
+	0x03, 0xc4, 0xfc, 0xde, 0x75, 0xda, 0xdf, 0x99, 0x2d, 0x5b, 0xb9, 0x03, 0xbf, 0x00, 0x3b, 0x2b,
+	0x50, 0x8e, 0x65, 0xb4, 0xec, 0xe7, 0xa6, 0x15, 0xe1, 0x9a, 0x07, 0xa6, 0x6b, 0xef, 0x19, 0xd6,
+	0x8c, 0xbd, 0xdb, 0xb6, 0x1a, 0xca, 0x5d, 0xf8, 0x31, 0x78, 0xb2, 0x82, 0xf6, 0xc6, 0xd8, 0x6f,
+	0x36, 0x0c, 0xa7, 0x6d, 0xb9, 0xf5, 0x76, 0xab, 0xa1, 0xdc, 0xab, 0xc8, 0xf7, 0x65, 0x45, 0x7e,
+	0x5a, 0x59, 0xe5, 0xb0, 0x24, 0x6c, 0xd7, 0xdf, 0x9e, 0x5e, 0xa8, 0xd2, 0xd9, 0x85, 0x2a, 0xfd,
+	0x7b, 0xa1, 0x4a, 0xc7, 0x97, 0x6a, 0xe6, 0xec, 0x52, 0xcd, 0xfc, 0x7d, 0xa9, 0x66, 0x0e, 0xbe,
+	0x5d, 0xd8, 0xbc, 0xe4, 0x9d, 0x3f, 0x66, 0x84, 0x06, 0x24, 0xe8, 0xea, 0xd1, 0x47, 0x45, 0xf8,
+	0xb4, 0x1a, 0x7f, 0x50, 0xd5, 0x21, 0xf5, 0xc6, 0x3e, 0xd6, 0x27, 0x7a, 0xf2, 0x1f, 0x25, 0xd6,
+	0x72, 0xe7, 0xae, 0x68, 0xf1, 0xe7, 0xff, 0x0d, 0x00, 0x1e, 0xc7, 0xab, 0x61, 0xbb, 0x06, 0x00,
+	0x00,
 }
 
 func (m *StakeAuthorization) Marshal() (dAtA []byte, err error) {
@@ -277,6 +468,15 @@ func (m *StakeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedShareOwners) > 0 {
+		for iNdEx := len(m.AllowedShareOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedShareOwners[iNdEx])
+			copy(dAtA[i:], m.AllowedShareOwners[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedShareOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.AuthorizationType != 0 {
 		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
 		i--
@@ -380,6 +580,111 @@ func (m *StakeAuthorization_Validators) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardOwner) > 0 {
+		i -= len(m.RewardOwner)
+		copy(dAtA[i:], m.RewardOwner)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.RewardOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxTokens != nil {
+		{
+			size, err := m.MaxTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedValidators) > 0 {
+		for iNdEx := len(m.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareAuthorization_AllowedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareAuthorization_AllowedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareAuthorization_AllowedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -407,6 +712,12 @@ func (m *StakeAuthorization) Size() (n int) {
 	if m.AuthorizationType != 0 {
 		n += 1 + sovAuthz(uint64(m.AuthorizationType))
 	}
+	if len(m.AllowedShareOwners) > 0 {
+		for _, s := range m.AllowedShareOwners {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -449,6 +760,52 @@ func (m *StakeAuthorization_Validators) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedValidators) > 0 {
+		for _, e := range m.AllowedValidators {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxTokens != nil {
+		l = m.MaxTokens.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.RewardOwner)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareAuthorization_AllowedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -609,6 +966,38 @@ func (m *StakeAuthorization) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedShareOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedShareOwners = append(m.AllowedShareOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -712,6 +1101,308 @@ func (m *StakeAuthorization_Validators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidators = append(m.AllowedValidators, TokenizeShareAuthorization_AllowedValidator{})
+			if err := m.AllowedValidators[len(m.AllowedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTokens == nil {
+				m.MaxTokens = &types.Coin{}
+			}
+			if err := m.MaxTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareAuthorization_AllowedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -270,3 +272,421 @@ func TestAuthzAuthorizations(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthzLSMAuthorizations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	owner1 := sdk.AccAddress("_____owner1_________")
+	owner2 := sdk.AccAddress("_____owner2_________")
+	shareDenom1 := strings.ToLower(val1.String()) + "/1"
+	shareDenom3 := strings.ToLower(val3.String()) + "/2"
+
+	// verify MethodName
+	for authzType, msg := range map[stakingtypes.AuthorizationType]sdk.Msg{
+		stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS:  &stakingtypes.MsgRedeemTokensforShares{},
+		stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND: &stakingtypes.MsgValidatorBond{},
+	} {
+		auth, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{}, authzType, nil)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+		require.NoError(t, auth.ValidateBasic())
+	}
+	auth, err := stakingtypes.NewTransferTokenizeShareRecordAuthorization(nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MsgTypeURL(&stakingtypes.MsgTransferTokenizeShareRecord{}), auth.MsgTypeURL())
+
+	// tokenize shares is only granted with a TokenizeShareAuthorization
+	auth, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{}, stakingtypes.AuthorizationType(4), nil)
+	require.NoError(t, err)
+	require.Error(t, auth.ValidateBasic())
+
+	// allowed share owners are only valid for transfer authorizations
+	auth, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+	require.NoError(t, err)
+	auth.AllowedShareOwners = []string{owner1.String()}
+	require.Error(t, auth.ValidateBasic())
+
+	// transfer authorizations cannot restrict the validator or the amount, which the message does not carry
+	auth, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD, nil)
+	require.NoError(t, err)
+	require.Error(t, auth.ValidateBasic())
+
+	auth, err = stakingtypes.NewTransferTokenizeShareRecordAuthorization([]sdk.AccAddress{owner1})
+	require.NoError(t, err)
+	auth.MaxTokens = &coin100
+	require.Error(t, auth.ValidateBasic())
+
+	auth.MaxTokens = nil
+	auth.AllowedShareOwners = []string{"invalid"}
+	require.Error(t, auth.ValidateBasic())
+
+	validators1 := []string{val1.String()}
+	owners1 := []string{owner1.String()}
+
+	testCases := []struct {
+		msg                  string
+		allowed              []sdk.ValAddress
+		denied               []sdk.ValAddress
+		msgType              stakingtypes.AuthorizationType
+		limit                *sdk.Coin
+		srvMsg               sdk.Msg
+		expectErr            bool
+		isDelete             bool
+		updatedAuthorization *stakingtypes.StakeAuthorization
+	}{
+		{
+			"redeem: verify remaining coins at the share tokens amount",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin100,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(shareDenom1, 50)},
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			},
+		},
+		{
+			"redeem: expect 0 remaining coins",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin50,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(shareDenom1, 50)},
+			false,
+			true,
+			nil,
+		},
+		{
+			"redeem: fail validator not allowed",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			&coin100,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(shareDenom3, 50)},
+			true,
+			false,
+			nil,
+		},
+		{
+			"redeem: fail validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val3},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			nil,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin(shareDenom3, 50)},
+			true,
+			false,
+			nil,
+		},
+		{
+			"redeem: fail not share tokens",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val3},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDEEM_TOKENS,
+			nil,
+			&stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: delAddr.String(), Amount: sdk.NewInt64Coin("steak", 50)},
+			true,
+			false,
+			nil,
+		},
+
+		{
+			"validator bond: validator allowed",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND,
+			&coin100,
+			stakingtypes.NewMsgValidatorBond(delAddr, val1),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1},
+				}, MaxTokens: &coin100, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND,
+			},
+		},
+		{
+			"validator bond: fail validator not allowed",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_VALIDATOR_BOND,
+			nil,
+			stakingtypes.NewMsgValidatorBond(delAddr, val2),
+			true,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			auth, err := stakingtypes.NewStakeAuthorization(tc.allowed, tc.denied, tc.msgType, tc.limit)
+			require.NoError(t, err)
+			resp, err := auth.Accept(ctx, tc.srvMsg)
+			require.Equal(t, tc.isDelete, resp.Delete)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				if tc.updatedAuthorization != nil {
+					require.Equal(t, tc.updatedAuthorization.String(), resp.Updated.String())
+				}
+			}
+		})
+	}
+
+	transferTestCases := []struct {
+		msg                  string
+		shareOwners          []sdk.AccAddress
+		srvMsg               sdk.Msg
+		expectErr            bool
+		updatedAuthorization *stakingtypes.StakeAuthorization
+	}{
+		{
+			"transfer: new owner allowed",
+			[]sdk.AccAddress{owner1},
+			&stakingtypes.MsgTransferTokenizeShareRecord{TokenizeShareRecordId: 1, Sender: delAddr.String(), NewOwner: owner1.String()},
+			false,
+			&stakingtypes.StakeAuthorization{
+				AuthorizationType:  stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD,
+				AllowedShareOwners: owners1,
+			},
+		},
+		{
+			"transfer: fail new owner not allowed",
+			[]sdk.AccAddress{owner1},
+			&stakingtypes.MsgTransferTokenizeShareRecord{TokenizeShareRecordId: 1, Sender: delAddr.String(), NewOwner: owner2.String()},
+			true,
+			nil,
+		},
+		{
+			"transfer: new owner defaults to the granter",
+			nil,
+			&stakingtypes.MsgTransferTokenizeShareRecord{TokenizeShareRecordId: 1, Sender: delAddr.String(), NewOwner: delAddr.String()},
+			false,
+			&stakingtypes.StakeAuthorization{
+				AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_TOKENIZE_SHARE_RECORD,
+			},
+		},
+		{
+			"transfer: fail new owner is not the granter",
+			nil,
+			&stakingtypes.MsgTransferTokenizeShareRecord{TokenizeShareRecordId: 1, Sender: delAddr.String(), NewOwner: owner1.String()},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range transferTestCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			auth, err := stakingtypes.NewTransferTokenizeShareRecordAuthorization(tc.shareOwners)
+			require.NoError(t, err)
+			resp, err := auth.Accept(ctx, tc.srvMsg)
+			require.False(t, resp.Delete)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.updatedAuthorization.String(), resp.Updated.String())
+			}
+		})
+	}
+}
+
+func TestTokenizeShareAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Unix(1000, 0))
+
+	owner1 := sdk.AccAddress("_____owner1_________")
+	owner2 := sdk.AccAddress("_____owner2_________")
+	expired := time.Unix(1000, 0)
+	notExpired := time.Unix(2000, 0)
+
+	validators := []stakingtypes.TokenizeShareAuthorization_AllowedValidator{
+		{ValidatorAddress: val1.String()},
+		{ValidatorAddress: val2.String(), Expiration: &expired},
+		{ValidatorAddress: val3.String(), Expiration: &notExpired},
+	}
+	activeValidators := []stakingtypes.TokenizeShareAuthorization_AllowedValidator{validators[0], validators[2]}
+
+	// verify MethodName
+	auth, err := stakingtypes.NewTokenizeShareAuthorization(validators, &coin100, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MsgTypeURL(&stakingtypes.MsgTokenizeShares{}), auth.MsgTypeURL())
+
+	// invalid authorizations
+	_, err = stakingtypes.NewTokenizeShareAuthorization(nil, nil, nil, nil)
+	require.Error(t, err)
+	_, err = stakingtypes.NewTokenizeShareAuthorization(append(validators, validators[0]), nil, nil, nil)
+	require.Error(t, err)
+	_, err = stakingtypes.NewTokenizeShareAuthorization(validators, &sdk.Coin{Denom: "steak", Amount: sdk.ZeroInt()}, nil, nil)
+	require.Error(t, err)
+	_, err = stakingtypes.NewTokenizeShareAuthorization(validators, nil, owner1, []sdk.AccAddress{owner2})
+	require.Error(t, err)
+
+	tokenizeMsg := func(val sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress) *stakingtypes.MsgTokenizeShares {
+		return &stakingtypes.MsgTokenizeShares{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorAddress:    val.String(),
+			Amount:              amount,
+			TokenizedShareOwner: owner.String(),
+		}
+	}
+
+	testCases := []struct {
+		msg                  string
+		limit                *sdk.Coin
+		rewardOwner          sdk.AccAddress
+		recipients           []sdk.AccAddress
+		srvMsg               sdk.Msg
+		expectErr            bool
+		isDelete             bool
+		updatedAuthorization *stakingtypes.TokenizeShareAuthorization
+	}{
+		{
+			"verify remaining coins and pruned validators",
+			&coin100,
+			nil,
+			nil,
+			tokenizeMsg(val1, coin50, delAddr),
+			false,
+			false,
+			&stakingtypes.TokenizeShareAuthorization{AllowedValidators: activeValidators, MaxTokens: &coin50},
+		},
+		{
+			"expect 0 remaining coins",
+			&coin100,
+			nil,
+			nil,
+			tokenizeMsg(val3, coin100, delAddr),
+			false,
+			true,
+			nil,
+		},
+		{
+			"fail amount exceeds limit",
+			&coin50,
+			nil,
+			nil,
+			tokenizeMsg(val1, coin100, delAddr),
+			true,
+			false,
+			nil,
+		},
+		{
+			"fail amount in a different denom",
+			&coin100,
+			nil,
+			nil,
+			tokenizeMsg(val1, sdk.NewInt64Coin("other", 50), delAddr),
+			true,
+			false,
+			nil,
+		},
+		{
+			"fail validator expired",
+			nil,
+			nil,
+			nil,
+			tokenizeMsg(val2, coin50, delAddr),
+			true,
+			false,
+			nil,
+		},
+		{
+			"fail share owner defaults to granter",
+			nil,
+			nil,
+			nil,
+			tokenizeMsg(val1, coin50, owner1),
+			true,
+			false,
+			nil,
+		},
+		{
+			"share owner is an allowed recipient",
+			nil,
+			nil,
+			[]sdk.AccAddress{owner1},
+			tokenizeMsg(val1, coin50, owner1),
+			false,
+			false,
+			&stakingtypes.TokenizeShareAuthorization{AllowedValidators: activeValidators, AllowedRecipients: []string{owner1.String()}},
+		},
+		{
+			"share owner is the granter with allowed recipients",
+			nil,
+			nil,
+			[]sdk.AccAddress{owner1},
+			tokenizeMsg(val1, coin50, delAddr),
+			false,
+			false,
+			&stakingtypes.TokenizeShareAuthorization{AllowedValidators: activeValidators, AllowedRecipients: []string{owner1.String()}},
+		},
+		{
+			"fail share owner is not an allowed recipient",
+			nil,
+			nil,
+			[]sdk.AccAddress{owner2},
+			tokenizeMsg(val1, coin50, owner1),
+			true,
+			false,
+			nil,
+		},
+		{
+			"share owner is the required reward owner",
+			nil,
+			owner1,
+			nil,
+			tokenizeMsg(val1, coin50, owner1),
+			false,
+			false,
+			&stakingtypes.TokenizeShareAuthorization{AllowedValidators: activeValidators, RewardOwner: owner1.String()},
+		},
+		{
+			"fail share owner is not the required reward owner",
+			nil,
+			owner1,
+			nil,
+			tokenizeMsg(val1, coin50, delAddr),
+			true,
+			false,
+			nil,
+		},
+		{
+			"fail unsupported message",
+			nil,
+			nil,
+			nil,
+			stakingtypes.NewMsgDelegate(delAddr, val1, coin50),
+			true,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			auth, err := stakingtypes.NewTokenizeShareAuthorization(validators, tc.limit, tc.rewardOwner, tc.recipients)
+			require.NoError(t, err)
+			resp, err := auth.Accept(ctx, tc.srvMsg)
+			require.Equal(t, tc.isDelete, resp.Delete)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				if tc.updatedAuthorization != nil {
+					require.Equal(t, tc.updatedAuthorization.String(), resp.Updated.String())
+				}
+			}
+		})
+	}
+}
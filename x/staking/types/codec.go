@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/staking interfaces and concrete types
@@ -20,8 +21,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares", nil)
 	cdc.RegisterConcrete(&MsgRedeemTokensforShares{}, "cosmos-sdk/MsgRedeemTokensforShares", nil)
 	cdc.RegisterConcrete(&MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgProposeTokenizeShareRecordTransfer{}, "cosmos-sdk/MsgProposeTokenizeShareRecordTransfer", nil)
+	cdc.RegisterConcrete(&MsgAcceptTokenizeShareRecordTransfer{}, "cosmos-sdk/MsgAcceptTokenizeShareRecordTransfer", nil)
+	cdc.RegisterConcrete(&MsgCancelTokenizeShareRecordTransfer{}, "cosmos-sdk/MsgCancelTokenizeShareRecordTransfer", nil)
 	cdc.RegisterConcrete(&MsgDisableTokenizeShares{}, "cosmos-sdk/MsgDisableTokenizeShares", nil)
 	cdc.RegisterConcrete(&MsgEnableTokenizeShares{}, "cosmos-sdk/MsgEnableTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgCancelEnableTokenizeShares{}, "cosmos-sdk/MsgCancelEnableTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgFundSlashInsurance{}, "cosmos-sdk/MsgFundSlashInsurance", nil)
+	cdc.RegisterConcrete(&MsgUpdateSlashInsuranceCoverage{}, "cosmos-sdk/x/staking/MsgUpdateSlashInsuranceCoverage", nil)
+	cdc.RegisterConcrete(&MsgClaimTokenizeShareRecord{}, "cosmos-sdk/MsgClaimTokenizeShareRecord", nil)
+	cdc.RegisterConcrete(&MsgPauseTokenization{}, "cosmos-sdk/x/staking/MsgPauseTokenization", nil)
+	cdc.RegisterConcrete(&MsgUnpauseTokenization{}, "cosmos-sdk/x/staking/MsgUnpauseTokenization", nil)
 
 	// cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	// cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
@@ -41,12 +52,30 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgTokenizeShares{},
 		&MsgRedeemTokensforShares{},
 		&MsgTransferTokenizeShareRecord{},
+		&MsgProposeTokenizeShareRecordTransfer{},
+		&MsgAcceptTokenizeShareRecordTransfer{},
+		&MsgCancelTokenizeShareRecordTransfer{},
 		&MsgDisableTokenizeShares{},
 		&MsgEnableTokenizeShares{},
+		&MsgCancelEnableTokenizeShares{},
+		&MsgUpdateParams{},
+		&MsgFundSlashInsurance{},
+		&MsgUpdateSlashInsuranceCoverage{},
+		&MsgClaimTokenizeShareRecord{},
+		&MsgPauseTokenization{},
+		&MsgUnpauseTokenization{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&StakeAuthorization{},
+		&TokenizeShareAuthorization{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdateParamsProposal{},
+		&UpdateSlashInsuranceCoverageProposal{},
+		&PauseTokenizationProposal{},
+		&UnpauseTokenizationProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
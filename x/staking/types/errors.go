@@ -66,4 +66,19 @@ var (
 	ErrUnableToDisableTokenizeShares            = errorsmod.Register(ModuleName, 55, "unable to disable tokenize shares for account")
 	ErrTokenizeSharesAlreadyEnabledForAccount   = errorsmod.Register(ModuleName, 57, "tokenize shares is already enabled for this account")
 	ErrTokenizeSharesAlreadyDisabledForAccount  = errorsmod.Register(ModuleName, 58, "tokenize shares is already disabled for this account")
+	ErrNoTokenizeSharesUnlockInProgress         = errorsmod.Register(ModuleName, 59, "no tokenize shares unlock in progress for this account")
+	ErrNoPendingTokenizeShareRecordTransfer     = errorsmod.Register(ModuleName, 60, "no pending transfer for tokenize share record")
+	ErrNotPendingTokenizeShareRecordNewOwner    = errorsmod.Register(ModuleName, 61, "not the proposed new owner of the tokenize share record")
+	ErrTokenizeShareRecordTransferToSelf        = errorsmod.Register(ModuleName, 62, "tokenize share record is already owned by the new owner")
+	ErrTokenizeShareRecordNFTOwnerMismatch      = errorsmod.Register(ModuleName, 63, "tokenize share record nft is not held by the record owner")
+	ErrInvalidParamsUpdate                      = errorsmod.Register(ModuleName, 64, "params update is unsafe given the current state")
+	ErrTotalLiquidStakedRefreshInProgress       = errorsmod.Register(ModuleName, 65, "liquid staked totals are being recalculated")
+	ErrOnlyBondDenomAllowedForSlashInsurance    = errorsmod.Register(ModuleName, 66, "only bond denom is allowed for slash insurance")
+	ErrTokenizeShareRecordClaimNotFound         = errorsmod.Register(ModuleName, 67, "no claim found for the share tokens")
+	ErrTokenizeShareRecordClaimNotMature        = errorsmod.Register(ModuleName, 68, "the unbonding of the released tokenize share record has not completed")
+	ErrTokenizationPaused                       = errorsmod.Register(ModuleName, 69, "tokenization is paused")
+	ErrRedemptionPaused                         = errorsmod.Register(ModuleName, 70, "redemption of share tokens is paused")
+	ErrTokenizationNotPaused                    = errorsmod.Register(ModuleName, 71, "tokenization is not paused")
+	ErrLiquidStakingProviderNotAllowed          = errorsmod.Register(ModuleName, 72, "liquid staking provider is not allowed by the validator")
+	ErrTokenizationDisabledByValidator          = errorsmod.Register(ModuleName, 73, "tokenization is disabled by the validator")
 )
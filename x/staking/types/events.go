@@ -2,17 +2,24 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding           = "complete_unbonding"
-	EventTypeCompleteRedelegation        = "complete_redelegation"
-	EventTypeCreateValidator             = "create_validator"
-	EventTypeEditValidator               = "edit_validator"
-	EventTypeDelegate                    = "delegate"
-	EventTypeUnbond                      = "unbond"
-	EventTypeRedelegate                  = "redelegate"
-	EventTypeTokenizeShares              = "tokenize_shares"
-	EventTypeRedeemShares                = "redeem_shares"
-	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
-	EventTypeValidatorBondDelegation     = "validator_bond_delegation"
+	EventTypeCompleteUnbonding            = "complete_unbonding"
+	EventTypeCompleteRedelegation         = "complete_redelegation"
+	EventTypeCreateValidator              = "create_validator"
+	EventTypeEditValidator                = "edit_validator"
+	EventTypeDelegate                     = "delegate"
+	EventTypeUnbond                       = "unbond"
+	EventTypeRedelegate                   = "redelegate"
+	EventTypeTokenizeShares               = "tokenize_shares"
+	EventTypeRedeemShares                 = "redeem_shares"
+	EventTypeTransferTokenizeShareRecord  = "transfer_tokenize_share_record"
+	EventTypeValidatorBondDelegation      = "validator_bond_delegation"
+	EventTypeAddTokenizeSharesLock        = "add_tokenize_shares_lock"
+	EventTypeQueueTokenizeSharesUnlock    = "queue_tokenize_shares_unlock"
+	EventTypeCompleteTokenizeSharesUnlock = "complete_tokenize_shares_unlock"
+
+	EventTypeProposeTokenizeShareRecordTransfer = "propose_tokenize_share_record_transfer"
+	EventTypeCancelTokenizeShareRecordTransfer  = "cancel_tokenize_share_record_transfer"
+	EventTypeExpireTokenizeShareRecordTransfer  = "expire_tokenize_share_record_transfer"
 
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
@@ -23,6 +30,7 @@ const (
 	AttributeKeyNewShares      = "new_shares"
 	AttributeKeyShareOwner     = "share_owner"
 	AttributeKeyShareRecordID  = "share_record_id"
+	AttributeKeyNewOwner       = "new_owner"
 	AttributeKeyAmount         = "amount"
 	AttributeValueCategory     = ModuleName
 )
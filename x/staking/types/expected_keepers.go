@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	nfttypes "github.com/iqlusioninc/liquidity-staking-module/x/nft/types"
 )
 
 // DistributionKeeper expected distribution keeper (noalias)
@@ -44,6 +45,20 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// NFTKeeper defines the expected nft keeper, which holds the nfts that represent the
+// ownership of the tokenize share records
+type NFTKeeper interface {
+	SaveClass(ctx sdk.Context, class nfttypes.Class) error
+	HasClass(ctx sdk.Context, classID string) bool
+
+	Mint(ctx sdk.Context, token nfttypes.NFT, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID string, nftID string) error
+	Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error
+
+	HasNFT(ctx sdk.Context, classID, id string) bool
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+}
+
 // ValidatorSet expected properties for the set of all validators (noalias)
 type ValidatorSet interface {
 	// iterate through validators by operator address, execute func for each validator
@@ -99,6 +114,11 @@ type StakingHooks interface {
 	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator is deleted
 	BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error                       // Must be called when tokenize share record is deleted
 
+	AfterTokenizeShareRecordCreated(ctx sdk.Context, recordID uint64) error                                                             // Must be called when a tokenize share record is created
+	AfterTokenizeSharesRedeemed(ctx sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error // Must be called when share tokens are redeemed
+	AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error                     // Must be called when a tokenize share record changes owner
+	AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, validatorBond bool) error                // Must be called when a delegation becomes or stops being validator bond
+
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error         // Must be called when a validator is bonded
 	AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator begins unbonding
 
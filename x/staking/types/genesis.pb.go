@@ -48,6 +48,28 @@ type GenesisState struct {
 	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
 	// last tokenize share record id, used for next share record id calculation
 	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the global amount of tokens that are either tokenized
+	// or owned by a liquid staking provider, counted against the global liquid staking cap
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
+	// total_liquid_staked_residue is the fraction of a token that slashing has removed from
+	// liquid stake but that has not yet been deducted from total_liquid_staked_tokens
+	TotalLiquidStakedResidue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=total_liquid_staked_residue,json=totalLiquidStakedResidue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_liquid_staked_residue"`
+	// slash records of the validators, used to query the tokenize share records
+	// affected by a slash
+	SlashRecords []SlashRecord `protobuf:"bytes,13,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	// last slash record id, used for next slash record id calculation
+	LastSlashRecordId uint64 `protobuf:"varint,14,opt,name=last_slash_record_id,json=lastSlashRecordId,proto3" json:"last_slash_record_id,omitempty"`
+	// coverage of the slash insurance fund
+	SlashInsuranceCoverage SlashInsuranceCoverage `protobuf:"bytes,15,opt,name=slash_insurance_coverage,json=slashInsuranceCoverage,proto3" json:"slash_insurance_coverage"`
+	// payouts made by the slash insurance fund to tokenize share records
+	SlashInsurancePayouts []SlashInsurancePayout `protobuf:"bytes,16,rep,name=slash_insurance_payouts,json=slashInsurancePayouts,proto3" json:"slash_insurance_payouts"`
+	// claims on the unbonded tokens of released tokenize share records
+	TokenizeShareRecordClaims []TokenizeShareRecordClaim `protobuf:"bytes,17,rep,name=tokenize_share_record_claims,json=tokenizeShareRecordClaims,proto3" json:"tokenize_share_record_claims"`
+	// tokenization pauses set by governance, the global pause having an empty validator address
+	TokenizationPauses []TokenizationPause `protobuf:"bytes,18,rep,name=tokenization_pauses,json=tokenizationPauses,proto3" json:"tokenization_pauses"`
+	// pending ownership transfers of tokenize share records, whose expiration queue is
+	// rebuilt from their expiration times
+	PendingTokenizeShareRecordTransfers []PendingTokenizeShareRecordTransfer `protobuf:"bytes,19,rep,name=pending_tokenize_share_record_transfers,json=pendingTokenizeShareRecordTransfers,proto3" json:"pending_tokenize_share_record_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -146,6 +168,55 @@ func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastSlashRecordId() uint64 {
+	if m != nil {
+		return m.LastSlashRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlashInsuranceCoverage() SlashInsuranceCoverage {
+	if m != nil {
+		return m.SlashInsuranceCoverage
+	}
+	return SlashInsuranceCoverage{}
+}
+
+func (m *GenesisState) GetSlashInsurancePayouts() []SlashInsurancePayout {
+	if m != nil {
+		return m.SlashInsurancePayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecordClaims() []TokenizeShareRecordClaim {
+	if m != nil {
+		return m.TokenizeShareRecordClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizationPauses() []TokenizationPause {
+	if m != nil {
+		return m.TokenizationPauses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTokenizeShareRecordTransfers() []PendingTokenizeShareRecordTransfer {
+	if m != nil {
+		return m.PendingTokenizeShareRecordTransfers
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -195,43 +266,61 @@ func init() {
 func init() { proto.RegisterFile("staking/v1beta1/genesis.proto", fileDescriptor_30376b0921a07e54) }
 
 var fileDescriptor_30376b0921a07e54 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x13, 0xb6, 0x75, 0x9d, 0x3b, 0x10, 0x32, 0x1d, 0xca, 0x2a, 0x35, 0xad, 0x26, 0x81,
-	0x82, 0x50, 0x13, 0xb5, 0xdc, 0xb8, 0x00, 0x05, 0x09, 0x4d, 0x42, 0x68, 0xa4, 0xe3, 0xef, 0x25,
-	0x72, 0x6b, 0x2b, 0xb5, 0x9a, 0xda, 0x5d, 0xec, 0x8c, 0x8d, 0x4f, 0xc0, 0x91, 0x8f, 0xb0, 0x0f,
-	0xc1, 0x87, 0xd8, 0x71, 0x82, 0x0b, 0xe2, 0x30, 0xa1, 0xf6, 0xc2, 0xc7, 0x40, 0x89, 0x9d, 0x12,
-	0x14, 0x44, 0x39, 0x25, 0xd6, 0xf3, 0x3e, 0xbf, 0xe7, 0xb5, 0xe5, 0xd7, 0xa0, 0x29, 0x24, 0x9a,
-	0x50, 0x16, 0x7a, 0xc7, 0xdd, 0x21, 0x91, 0xa8, 0xeb, 0x85, 0x84, 0x11, 0x41, 0x85, 0x3b, 0x8b,
-	0xb9, 0xe4, 0xb0, 0x19, 0xd1, 0xa3, 0x84, 0x62, 0x5d, 0xe4, 0xe6, 0x5f, 0x5d, 0xdc, 0xa8, 0x87,
-	0x3c, 0xe4, 0x59, 0xa5, 0x97, 0xfe, 0x29, 0x53, 0x63, 0x77, 0xc4, 0xc5, 0x94, 0x8b, 0x40, 0x09,
-	0x6a, 0xa1, 0xa5, 0x52, 0x5c, 0x4e, 0xcc, 0xe4, 0xbd, 0xaf, 0x15, 0xb0, 0xfd, 0x54, 0x35, 0x30,
-	0x90, 0x48, 0x12, 0xf8, 0x18, 0x54, 0x66, 0x28, 0x46, 0x53, 0x61, 0x99, 0x6d, 0xd3, 0xa9, 0xf5,
-	0x6e, 0xb9, 0xff, 0x6c, 0xc8, 0x3d, 0xc8, 0x8a, 0xfb, 0xeb, 0xe7, 0x97, 0x2d, 0xc3, 0xd7, 0x56,
-	0xf8, 0x06, 0x5c, 0x8f, 0x90, 0x90, 0x81, 0xe4, 0x12, 0x45, 0xc1, 0x8c, 0xbf, 0x27, 0xb1, 0x75,
-	0xa5, 0x6d, 0x3a, 0xdb, 0x7d, 0x37, 0xad, 0xfb, 0x7e, 0xd9, 0xba, 0x1d, 0x52, 0x39, 0x4e, 0x86,
-	0xee, 0x88, 0x4f, 0x75, 0xbf, 0xfa, 0xd3, 0x11, 0x78, 0xe2, 0xc9, 0xd3, 0x19, 0x11, 0xee, 0x3e,
-	0x93, 0xfe, 0xb5, 0x94, 0x73, 0x98, 0x62, 0x0e, 0x52, 0x0a, 0x9c, 0x80, 0x9d, 0x8c, 0x7c, 0x8c,
-	0x22, 0x8a, 0x91, 0xe4, 0xb1, 0xa2, 0x0b, 0x6b, 0xad, 0xbd, 0xe6, 0xd4, 0x7a, 0xdd, 0x15, 0xdd,
-	0x3e, 0x43, 0x42, 0xbe, 0xca, 0xad, 0x19, 0x51, 0x77, 0x7e, 0x23, 0x2a, 0x29, 0x02, 0x3e, 0x07,
-	0x60, 0x99, 0x23, 0xac, 0xf5, 0x2c, 0xc1, 0x59, 0x91, 0xb0, 0x64, 0x68, 0x70, 0x81, 0x00, 0x5f,
-	0x80, 0x1a, 0x26, 0x11, 0x09, 0x91, 0xa4, 0x9c, 0x09, 0x6b, 0x23, 0x03, 0xde, 0x59, 0x01, 0x7c,
-	0xb2, 0x74, 0x68, 0x62, 0x91, 0x01, 0xa7, 0x60, 0x27, 0x61, 0x43, 0xce, 0x30, 0x65, 0x61, 0x50,
-	0x84, 0x57, 0x32, 0x78, 0x6f, 0x05, 0xfc, 0x65, 0xee, 0x2d, 0xa5, 0xd4, 0x93, 0xb2, 0x24, 0xe0,
-	0x6b, 0x70, 0x35, 0x26, 0xc5, 0x98, 0xcd, 0x2c, 0xe6, 0xee, 0x8a, 0x18, 0xbf, 0xe0, 0xd1, 0xfc,
-	0x3f, 0x39, 0xb0, 0x01, 0xaa, 0xe4, 0x64, 0xc6, 0x63, 0x49, 0xb0, 0x55, 0x6d, 0x9b, 0x4e, 0xd5,
-	0x5f, 0xae, 0x21, 0x03, 0x37, 0x25, 0x9f, 0x10, 0x46, 0x3f, 0x90, 0x40, 0x8c, 0x51, 0x4c, 0x82,
-	0x98, 0x8c, 0x78, 0x8c, 0x85, 0xb5, 0xf5, 0x5f, 0x9b, 0x3c, 0xd4, 0xe6, 0x41, 0xea, 0xf5, 0x33,
-	0x6b, 0xbe, 0x49, 0x59, 0x96, 0x04, 0x7c, 0x08, 0x9a, 0xfa, 0xf6, 0xfe, 0x25, 0x34, 0xa0, 0xd8,
-	0x02, 0x6d, 0xd3, 0x59, 0xf7, 0x77, 0xd5, 0xd5, 0x2c, 0x01, 0xf6, 0xf1, 0xde, 0x18, 0xc0, 0xf2,
-	0x4d, 0x83, 0x3d, 0xb0, 0x89, 0x30, 0x8e, 0x89, 0x50, 0xb3, 0xb5, 0xd5, 0xb7, 0xbe, 0x7c, 0xee,
-	0xd4, 0xf5, 0xb4, 0x3e, 0x52, 0xca, 0x40, 0xc6, 0x94, 0x85, 0x7e, 0x5e, 0x08, 0xeb, 0x60, 0xe3,
-	0xf7, 0xf8, 0xac, 0xf9, 0x6a, 0x71, 0xbf, 0xfa, 0xf1, 0xac, 0x65, 0xfc, 0x3c, 0x6b, 0x19, 0xfd,
-	0xb7, 0xe7, 0x73, 0xdb, 0xbc, 0x98, 0xdb, 0xe6, 0x8f, 0xb9, 0x6d, 0x7e, 0x5a, 0xd8, 0xc6, 0xc5,
-	0xc2, 0x36, 0xbe, 0x2d, 0x6c, 0xe3, 0xdd, 0x83, 0xc2, 0x84, 0xd1, 0xa3, 0x28, 0x11, 0x94, 0x33,
-	0xca, 0x46, 0x9e, 0x3a, 0x2b, 0x2a, 0x4f, 0x3b, 0xfa, 0x9c, 0x3a, 0x53, 0x8e, 0x93, 0x88, 0x78,
-	0x27, 0xf9, 0xd3, 0xa0, 0xc6, 0x6f, 0x58, 0xc9, 0x5e, 0x88, 0x7b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x5e, 0x1f, 0x8a, 0x11, 0xb1, 0x04, 0x00, 0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x24, 0x4d, 0x93, 0xc9, 0x07, 0xed, 0xc4, 0x69, 0x27, 0x81, 0x38, 0x56, 0x11,
+	0x60, 0x40, 0xb6, 0x49, 0x2a, 0x84, 0x84, 0x90, 0xa0, 0x4e, 0x24, 0x14, 0xa9, 0x42, 0x61, 0x9d,
+	0xf2, 0x75, 0xb3, 0x9a, 0xec, 0x0c, 0xeb, 0x91, 0xd7, 0x3b, 0x9b, 0x3d, 0xb3, 0xa1, 0x06, 0x89,
+	0x6b, 0x2e, 0x79, 0x01, 0xa4, 0x3e, 0x44, 0x9f, 0x01, 0xf5, 0xb2, 0xea, 0x15, 0xe2, 0xa2, 0x42,
+	0xc9, 0x0d, 0x8f, 0x81, 0x76, 0x66, 0xd6, 0xdd, 0x64, 0x0d, 0x1b, 0x5f, 0xd9, 0xb3, 0xe7, 0xfc,
+	0x7f, 0xff, 0x33, 0x73, 0x66, 0x76, 0x16, 0x6d, 0x83, 0xa2, 0x43, 0x11, 0x05, 0xdd, 0xb3, 0xdd,
+	0x13, 0xae, 0xe8, 0x6e, 0x37, 0xe0, 0x11, 0x07, 0x01, 0x9d, 0x38, 0x91, 0x4a, 0xe2, 0xed, 0x50,
+	0x9c, 0xa6, 0x82, 0xd9, 0xa4, 0x4e, 0xfe, 0x6b, 0x93, 0xb7, 0xea, 0x81, 0x0c, 0xa4, 0xce, 0xec,
+	0x66, 0xff, 0x8c, 0x68, 0x6b, 0xd3, 0x97, 0x30, 0x92, 0xe0, 0x99, 0x80, 0x19, 0xd8, 0x50, 0xc9,
+	0x2e, 0x27, 0xea, 0xf0, 0xbd, 0x3f, 0xd6, 0xd0, 0xca, 0x17, 0xa6, 0x80, 0xbe, 0xa2, 0x8a, 0xe3,
+	0x7d, 0xb4, 0x10, 0xd3, 0x84, 0x8e, 0x80, 0x38, 0x4d, 0xa7, 0xb5, 0xbc, 0xf7, 0x76, 0xe7, 0x7f,
+	0x0b, 0xea, 0x1c, 0xe9, 0xe4, 0xde, 0xfc, 0xb3, 0x97, 0x3b, 0x35, 0xd7, 0x4a, 0xf1, 0xb7, 0xe8,
+	0x56, 0x48, 0x41, 0x79, 0x4a, 0x2a, 0x1a, 0x7a, 0xb1, 0xfc, 0x91, 0x27, 0xe4, 0xb5, 0xa6, 0xd3,
+	0x5a, 0xe9, 0x75, 0xb2, 0xbc, 0xbf, 0x5e, 0xee, 0xbc, 0x13, 0x08, 0x35, 0x48, 0x4f, 0x3a, 0xbe,
+	0x1c, 0xd9, 0x7a, 0xed, 0x4f, 0x1b, 0xd8, 0xb0, 0xab, 0xc6, 0x31, 0x87, 0xce, 0x61, 0xa4, 0xdc,
+	0xb5, 0x8c, 0x73, 0x9c, 0x61, 0x8e, 0x32, 0x0a, 0x1e, 0xa2, 0x0d, 0x4d, 0x3e, 0xa3, 0xa1, 0x60,
+	0x54, 0xc9, 0xc4, 0xd0, 0x81, 0xcc, 0x35, 0xe7, 0x5a, 0xcb, 0x7b, 0xbb, 0x15, 0xd5, 0x3e, 0xa4,
+	0xa0, 0xbe, 0xce, 0xa5, 0x9a, 0x68, 0x2b, 0x5f, 0x0f, 0x4b, 0x11, 0xc0, 0x5f, 0x22, 0x34, 0xf1,
+	0x01, 0x32, 0xaf, 0x1d, 0x5a, 0x15, 0x0e, 0x13, 0x86, 0x05, 0x17, 0x08, 0xf8, 0x2b, 0xb4, 0xcc,
+	0x78, 0xc8, 0x03, 0xaa, 0x84, 0x8c, 0x80, 0xdc, 0xd0, 0xc0, 0xf7, 0x2a, 0x80, 0x07, 0x13, 0x85,
+	0x25, 0x16, 0x19, 0x78, 0x84, 0x36, 0xd2, 0xe8, 0x44, 0x46, 0x4c, 0x44, 0x81, 0x57, 0x84, 0x2f,
+	0x68, 0xf8, 0x5e, 0x05, 0xfc, 0x51, 0xae, 0x2d, 0xb9, 0xd4, 0xd3, 0x72, 0x08, 0xf0, 0x37, 0x68,
+	0x35, 0xe1, 0x45, 0x9b, 0x9b, 0xda, 0xe6, 0x83, 0x0a, 0x1b, 0x97, 0xb3, 0xab, 0xfc, 0xcb, 0x1c,
+	0xbc, 0x85, 0x16, 0xf9, 0xe3, 0x58, 0x26, 0x8a, 0x33, 0xb2, 0xd8, 0x74, 0x5a, 0x8b, 0xee, 0x64,
+	0x8c, 0x23, 0x74, 0x47, 0xc9, 0x21, 0x8f, 0xc4, 0x4f, 0xdc, 0x83, 0x01, 0x4d, 0xb8, 0x97, 0x70,
+	0x5f, 0x26, 0x0c, 0xc8, 0xd2, 0xb5, 0x26, 0x79, 0x6c, 0xc5, 0xfd, 0x4c, 0xeb, 0x6a, 0x69, 0x3e,
+	0x49, 0x55, 0x0e, 0x01, 0xfe, 0x1c, 0x6d, 0xdb, 0xdd, 0x3b, 0xc5, 0xd4, 0x13, 0x8c, 0xa0, 0xa6,
+	0xd3, 0x9a, 0x77, 0x37, 0xcd, 0xd6, 0x2c, 0x01, 0x0e, 0x19, 0x1e, 0xa3, 0x2d, 0xb3, 0xf5, 0x4d,
+	0x61, 0x5e, 0x56, 0x11, 0x67, 0x06, 0x08, 0x64, 0xb9, 0xe9, 0xb4, 0x96, 0x7a, 0x9f, 0xce, 0x76,
+	0x12, 0x5e, 0x3c, 0x6d, 0x23, 0xf3, 0x3c, 0x1b, 0xb9, 0x77, 0x35, 0xff, 0xa1, 0xc6, 0xf7, 0x35,
+	0x5d, 0x57, 0x02, 0xf8, 0x67, 0xf4, 0xc6, 0x34, 0xeb, 0x84, 0x83, 0x60, 0x29, 0x27, 0x2b, 0x33,
+	0x7b, 0x1f, 0x70, 0xbf, 0xe0, 0x7d, 0xc0, 0x7d, 0x97, 0x94, 0xbc, 0x5d, 0x43, 0xc7, 0x8f, 0xd0,
+	0x2a, 0x84, 0x14, 0x06, 0x93, 0x06, 0xad, 0xea, 0x06, 0xbd, 0x5f, 0xd1, 0xa0, 0x7e, 0xa6, 0xb9,
+	0xd4, 0x98, 0x15, 0x78, 0xf5, 0x08, 0x70, 0x17, 0xd5, 0x75, 0x43, 0x8a, 0xec, 0xac, 0x0f, 0x6b,
+	0xba, 0x0f, 0xb7, 0xb3, 0x58, 0x01, 0x71, 0xc8, 0x70, 0x8a, 0x88, 0xc9, 0x15, 0x11, 0xa4, 0x09,
+	0x8d, 0x7c, 0xee, 0xf9, 0xf2, 0x8c, 0x27, 0x34, 0xe0, 0xe4, 0x75, 0xfd, 0x5a, 0xfb, 0xe8, 0x3a,
+	0x25, 0x1d, 0xe6, 0xea, 0x7d, 0x2b, 0xb6, 0xd5, 0xdd, 0x81, 0xa9, 0x51, 0x7c, 0x8a, 0xee, 0x5e,
+	0xb5, 0x8d, 0xe9, 0x58, 0xa6, 0x0a, 0xc8, 0x2d, 0xbd, 0x10, 0xf7, 0x67, 0x72, 0x3d, 0xd2, 0x5a,
+	0xeb, 0xb9, 0x01, 0x53, 0x62, 0x80, 0x7f, 0x41, 0x6f, 0x4e, 0xdf, 0xa6, 0x7e, 0x48, 0xc5, 0x08,
+	0xc8, 0x6d, 0xed, 0xfb, 0xf1, 0xec, 0x27, 0x64, 0x3f, 0xd3, 0x5b, 0xef, 0x4d, 0xf5, 0x1f, 0x71,
+	0xc0, 0x01, 0x5a, 0xb7, 0x41, 0x7d, 0x90, 0xbd, 0x98, 0xa6, 0xc0, 0x81, 0x60, 0x6d, 0xfb, 0xe1,
+	0xf5, 0x6c, 0xb5, 0xf2, 0x28, 0x13, 0x5a, 0x3f, 0xac, 0xae, 0x06, 0x00, 0xff, 0xee, 0xa0, 0x77,
+	0x63, 0x6e, 0xde, 0x73, 0xd3, 0x67, 0xac, 0x12, 0x1a, 0xc1, 0x0f, 0xd9, 0x5d, 0xb0, 0xae, 0xdd,
+	0x1f, 0x54, 0xdd, 0x5c, 0x86, 0x36, 0x65, 0xee, 0xc7, 0x96, 0x64, 0xcb, 0x79, 0x2b, 0xae, 0xcc,
+	0x84, 0x7b, 0x03, 0x84, 0xcb, 0x97, 0x0b, 0xde, 0x43, 0x37, 0x29, 0x63, 0x09, 0x07, 0x73, 0x9d,
+	0x2e, 0xf5, 0xc8, 0x8b, 0xa7, 0xed, 0xba, 0x3d, 0x4b, 0x0f, 0x4c, 0xa4, 0xaf, 0x12, 0x11, 0x05,
+	0x6e, 0x9e, 0x88, 0xeb, 0xe8, 0xc6, 0xab, 0x1b, 0x73, 0xce, 0x35, 0x83, 0x4f, 0x16, 0x7f, 0x7d,
+	0xb2, 0x53, 0xfb, 0xe7, 0xc9, 0x4e, 0xad, 0xf7, 0xdd, 0xb3, 0xf3, 0x86, 0xf3, 0xfc, 0xbc, 0xe1,
+	0xfc, 0x7d, 0xde, 0x70, 0x7e, 0xbb, 0x68, 0xd4, 0x9e, 0x5f, 0x34, 0x6a, 0x7f, 0x5e, 0x34, 0x6a,
+	0xdf, 0x7f, 0x56, 0x38, 0xce, 0xe2, 0x34, 0x4c, 0x41, 0xc8, 0x48, 0x44, 0x7e, 0xd7, 0xac, 0x83,
+	0x50, 0xe3, 0xb6, 0x5d, 0x83, 0xf6, 0x48, 0xb2, 0x34, 0xe4, 0xdd, 0xc7, 0xf9, 0xd7, 0x80, 0x39,
+	0xeb, 0x27, 0x0b, 0xfa, 0xa3, 0xe0, 0xfe, 0xbf, 0x03, 0x00, 0x52, 0xb4, 0x67, 0xec, 0xa4, 0x08,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -254,6 +343,119 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTokenizeShareRecordTransfers) > 0 {
+		for iNdEx := len(m.PendingTokenizeShareRecordTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTokenizeShareRecordTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.TokenizationPauses) > 0 {
+		for iNdEx := len(m.TokenizationPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizationPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TokenizeShareRecordClaims) > 0 {
+		for iNdEx := len(m.TokenizeShareRecordClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecordClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.SlashInsurancePayouts) > 0 {
+		for iNdEx := len(m.SlashInsurancePayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashInsurancePayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.SlashInsuranceCoverage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.LastSlashRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashRecordId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.TotalLiquidStakedResidue.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedResidue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if m.LastTokenizeShareRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
 		i--
@@ -474,6 +676,45 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastTokenizeShareRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
 	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalLiquidStakedResidue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSlashRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashRecordId))
+	}
+	l = m.SlashInsuranceCoverage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashInsurancePayouts) > 0 {
+		for _, e := range m.SlashInsurancePayouts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecordClaims) > 0 {
+		for _, e := range m.TokenizeShareRecordClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizationPauses) > 0 {
+		for _, e := range m.TokenizationPauses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTokenizeShareRecordTransfers) > 0 {
+		for _, e := range m.PendingTokenizeShareRecordTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -837,6 +1078,296 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedResidue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedResidue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashRecordId", wireType)
+			}
+			m.LastSlashRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashInsuranceCoverage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashInsuranceCoverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashInsurancePayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashInsurancePayouts = append(m.SlashInsurancePayouts, SlashInsurancePayout{})
+			if err := m.SlashInsurancePayouts[len(m.SlashInsurancePayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecordClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecordClaims = append(m.TokenizeShareRecordClaims, TokenizeShareRecordClaim{})
+			if err := m.TokenizeShareRecordClaims[len(m.TokenizeShareRecordClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizationPauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizationPauses = append(m.TokenizationPauses, TokenizationPause{})
+			if err := m.TokenizationPauses[len(m.TokenizationPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTokenizeShareRecordTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTokenizeShareRecordTransfers = append(m.PendingTokenizeShareRecordTransfers, PendingTokenizeShareRecordTransfer{})
+			if err := m.PendingTokenizeShareRecordTransfers[len(m.PendingTokenizeShareRecordTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
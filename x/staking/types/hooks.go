@@ -50,6 +50,42 @@ func (h MultiStakingHooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, rec
 	return nil
 }
 
+func (h MultiStakingHooks) AfterTokenizeShareRecordCreated(ctx sdk.Context, recordID uint64) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordCreated(ctx, recordID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeSharesRedeemed(ctx sdk.Context, recordID uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeSharesRedeemed(ctx, recordID, delAddr, valAddr, shares); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterTokenizeShareRecordTransferred(ctx sdk.Context, recordID uint64, prevOwner, newOwner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterTokenizeShareRecordTransferred(ctx, recordID, prevOwner, newOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterValidatorBondChanged(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, validatorBond bool) error {
+	for i := range h {
+		if err := h[i].AfterValidatorBondChanged(ctx, delAddr, valAddr, validatorBond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	for i := range h {
 		if err := h[i].AfterValidatorBonded(ctx, consAddr, valAddr); err != nil {
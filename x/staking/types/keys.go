@@ -27,6 +27,10 @@ const (
 
 	// Prefix for module accounts that custodian tokenized shares
 	TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+	// TokenizeShareRecordNFTClassID is the id of the x/nft class whose nfts represent the
+	// ownership of the tokenize share records
+	TokenizeShareRecordNFTClassID = "tokenize-share-record"
 )
 
 var (
@@ -51,6 +55,7 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+	ParamsKey         = []byte{0x51} // prefix for parameters for module x/staking
 
 	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenizeshare record prefix
 	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // key for tokenizeshare record id by owner prefix
@@ -59,6 +64,21 @@ var (
 	TotalLiquidStakedTokensKey         = []byte{0x65} // key for total liquid staked tokens
 	TokenizeSharesLockKey              = []byte{0x66} // key for locking tokenize shares
 	TokenizeSharesUnlockQueueKey       = []byte{0x67} // key for the queue that unlocks tokenize shares
+
+	PendingTokenizeShareRecordTransferPrefix = []byte{0x68} // key for pending tokenize share record transfers by record id
+	TokenizeShareRecordTransferQueueKey      = []byte{0x69} // key for the queue that expires pending tokenize share record transfers
+	TotalLiquidStakedRefreshKey              = []byte{0x6a} // key for the progress of an in-flight liquid staked totals refresh
+	LiquidDelegationIndexKey                 = []byte{0x6b} // prefix for each key to a delegation held by a liquid staking provider
+	TotalLiquidStakedResidueKey              = []byte{0x6c} // key for the slashed liquid tokens not yet deducted from the total
+	SlashRecordPrefix                        = []byte{0x6d} // prefix for each key to a slash record, by validator operator
+	LastSlashRecordIDKey                     = []byte{0x6e} // key for last slash record id
+	ValidatorBondDelegationIndexKey          = []byte{0x6f} // prefix for each key to a validator bond delegation, by validator operator
+	SlashInsuranceCoverageKey                = []byte{0x70} // key for the coverage of the slash insurance fund
+	TokenizeShareRecordIDByValidatorPrefix   = []byte{0x71} // prefix for each key to a tokenize share record id, by validator operator
+	SlashInsurancePayoutPrefix               = []byte{0x72} // prefix for each key to a slash insurance payout, by validator operator and slash record id
+	TokenizeShareRecordClaimPrefix           = []byte{0x73} // prefix for each key to a released tokenize share record claim, by share denom
+	GlobalTokenizationPauseKey               = []byte{0x74} // key for the tokenization pause of every validator
+	ValidatorTokenizationPausePrefix         = []byte{0x75} // prefix for each key to a tokenization pause, by validator operator
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -196,6 +216,50 @@ func GetLiquidDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
 }
 
+// GetLiquidDelegationIndexKey creates the key for the index of a delegation held by a
+// liquid staking provider
+// VALUE: none (the delegation key is derived from the index key)
+func GetLiquidDelegationIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	key := append(LiquidDelegationIndexKey, address.MustLengthPrefix(delAddr)...)
+	return append(key, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationKeyFromLiquidDelegationIndexKey rearranges the liquid delegation index key
+// into the key of the delegation it points to
+func GetDelegationKeyFromLiquidDelegationIndexKey(indexKey []byte) []byte {
+	kv.AssertKeyAtLeastLength(indexKey, len(LiquidDelegationIndexKey)+1)
+	key := append([]byte{}, DelegationKey...)
+	return append(key, indexKey[len(LiquidDelegationIndexKey):]...)
+}
+
+// GetValidatorBondDelegationIndexKey creates the key for the index of a validator bond
+// delegation, stored by validator operator
+// VALUE: none (the delegation key is derived from the index key)
+func GetValidatorBondDelegationIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetValidatorBondDelegationsIndexKey(valAddr), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetValidatorBondDelegationsIndexKey creates the prefix keyspace for the indexes of the
+// validator bond delegations to a validator
+func GetValidatorBondDelegationsIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondDelegationIndexKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationKeyFromValidatorBondDelegationIndexKey rearranges the validator bond
+// delegation index key into the key of the delegation it points to
+func GetDelegationKeyFromValidatorBondDelegationIndexKey(indexKey []byte) []byte {
+	kv.AssertKeyAtLeastLength(indexKey, 2)
+	addrs := indexKey[1:] // remove prefix bytes
+
+	valAddrLen := addrs[0]
+	kv.AssertKeyAtLeastLength(addrs, 2+int(valAddrLen))
+	valAddr := addrs[1 : 1+valAddrLen]
+	kv.AssertKeyAtLeastLength(addrs, 3+int(valAddrLen))
+	delAddr := addrs[valAddrLen+2:]
+
+	return GetLiquidDelegationKey(delAddr, valAddr)
+}
+
 // GetUBDKey creates the key for an unbonding delegation by delegator and validator addr
 // VALUE: staking/UnbondingDelegation
 func GetUBDKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
@@ -378,6 +442,17 @@ func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
 	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
 }
 
+// GetTokenizeShareRecordClaimKey returns the key of the claim on a released tokenize
+// share record, by the share denom of the record
+func GetTokenizeShareRecordClaimKey(denom string) []byte {
+	return append(TokenizeShareRecordClaimPrefix, []byte(denom)...)
+}
+
+// GetValidatorTokenizationPauseKey returns the key for storing the tokenization pause of a validator
+func GetValidatorTokenizationPauseKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorTokenizationPausePrefix, address.MustLengthPrefix(valAddr)...)
+}
+
 // GetTokenizeSharesLockKey returns the key for storing a tokenize share lock for a specified account
 func GetTokenizeSharesLockKey(owner sdk.AccAddress) []byte {
 	return append(TokenizeSharesLockKey, address.MustLengthPrefix(owner)...)
@@ -389,3 +464,56 @@ func GetTokenizeShareAuthorizationTimeKey(timestamp time.Time) []byte {
 	bz := sdk.FormatTimeBytes(timestamp)
 	return append(TokenizeSharesUnlockQueueKey, bz...)
 }
+
+// GetPendingTokenizeShareRecordTransferKey returns the key for storing the pending
+// ownership transfer of the specified tokenize share record
+func GetPendingTokenizeShareRecordTransferKey(id uint64) []byte {
+	return append(PendingTokenizeShareRecordTransferPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordTransferTimeKey returns the prefix key used for getting the set of
+// pending tokenize share record transfers that expire at the given time
+func GetTokenizeShareRecordTransferTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(TokenizeShareRecordTransferQueueKey, bz...)
+}
+
+// GetSlashRecordsKey returns the prefix key used for getting all slash records of a validator
+func GetSlashRecordsKey(valAddr sdk.ValAddress) []byte {
+	return append(SlashRecordPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetSlashRecordKey returns the key for storing the specified slash record of a validator
+func GetSlashRecordKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(GetSlashRecordsKey(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByValidatorPrefix returns the prefix key used for getting the
+// ids of all tokenize share records delegated to a validator
+func GetTokenizeShareRecordIDsByValidatorPrefix(valAddr sdk.ValAddress) []byte {
+	return append(TokenizeShareRecordIDByValidatorPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetTokenizeShareRecordIDByValidatorKey returns the key for indexing a tokenize share record
+// under the validator it is delegated to
+func GetTokenizeShareRecordIDByValidatorKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByValidatorPrefix(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSlashInsurancePayoutsByValidatorKey returns the prefix key used for getting all slash
+// insurance payouts made for a validator's slashes
+func GetSlashInsurancePayoutsByValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(SlashInsurancePayoutPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetSlashInsurancePayoutsBySlashKey returns the prefix key used for getting all slash
+// insurance payouts made for one slash of a validator
+func GetSlashInsurancePayoutsBySlashKey(valAddr sdk.ValAddress, slashRecordID uint64) []byte {
+	return append(GetSlashInsurancePayoutsByValidatorKey(valAddr), sdk.Uint64ToBigEndian(slashRecordID)...)
+}
+
+// GetSlashInsurancePayoutKey returns the key for storing the slash insurance payout made
+// to a tokenize share record for a slash of a validator
+func GetSlashInsurancePayoutKey(valAddr sdk.ValAddress, slashRecordID uint64, recordID uint64) []byte {
+	return append(GetSlashInsurancePayoutsBySlashKey(valAddr, slashRecordID), sdk.Uint64ToBigEndian(recordID)...)
+}
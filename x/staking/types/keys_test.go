@@ -76,6 +76,22 @@ func TestGetREDByValDstIndexKey(t *testing.T) {
 	}
 }
 
+func TestGetDelegationKeyFromLiquidDelegationIndexKey(t *testing.T) {
+	delAddr, valAddr := sdk.AccAddress(keysAddr1), sdk.ValAddress(keysAddr2)
+
+	indexKey := types.GetLiquidDelegationIndexKey(delAddr, valAddr)
+	require.Equal(t, types.LiquidDelegationIndexKey, indexKey[:1])
+	require.Equal(t, types.GetLiquidDelegationKey(delAddr, valAddr), types.GetDelegationKeyFromLiquidDelegationIndexKey(indexKey))
+}
+
+func TestGetDelegationKeyFromValidatorBondDelegationIndexKey(t *testing.T) {
+	delAddr, valAddr := sdk.AccAddress(keysAddr1), sdk.ValAddress(keysAddr2)
+
+	indexKey := types.GetValidatorBondDelegationIndexKey(delAddr, valAddr)
+	require.Equal(t, types.GetValidatorBondDelegationsIndexKey(valAddr), indexKey[:len(indexKey)-len(delAddr)-1])
+	require.Equal(t, types.GetLiquidDelegationKey(delAddr, valAddr), types.GetDelegationKeyFromValidatorBondDelegationIndexKey(indexKey))
+}
+
 func TestGetREDByValSrcIndexKey(t *testing.T) {
 	tests := []struct {
 		delAddr    sdk.AccAddress
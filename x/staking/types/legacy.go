@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Conversions from the state of the cosmos-sdk v0.45 staking module, used when onboarding
+// an existing chain either through the in-place store migration or the genesis migration
+
+// IsLiquidStakingProvider returns true if the account is owned by a liquid staking provider
+// This is determined by checking if the account is a 32-length module account, which
+// captures ICA accounts as well as the tokenize share record module accounts
+func IsLiquidStakingProvider(account authtypes.AccountI) bool {
+	_, isModuleAccount := account.(*authtypes.ModuleAccount)
+	return isModuleAccount && len(account.GetAddress()) == 32
+}
+
+// ValidatorFromLegacy converts a validator from the cosmos-sdk v0.45 staking module,
+// dropping the min self delegation and ICS unbonding fields. The validator starts
+// with no validator bond, liquid or tokenized shares
+func ValidatorFromLegacy(legacy sdkstaking.Validator) Validator {
+	return Validator{
+		OperatorAddress: legacy.OperatorAddress,
+		ConsensusPubkey: legacy.ConsensusPubkey,
+		Jailed:          legacy.Jailed,
+		Status:          legacy.Status,
+		Tokens:          legacy.Tokens,
+		DelegatorShares: legacy.DelegatorShares,
+		Description:     Description(legacy.Description),
+		UnbondingHeight: legacy.UnbondingHeight,
+		UnbondingTime:   legacy.UnbondingTime,
+		Commission: Commission{
+			CommissionRates: CommissionRates(legacy.Commission.CommissionRates),
+			UpdateTime:      legacy.Commission.UpdateTime,
+		},
+		TotalValidatorBondShares: sdk.ZeroDec(),
+		TotalLiquidShares:        sdk.ZeroDec(),
+		TotalTokenizedShares:     sdk.ZeroDec(),
+	}
+}
+
+// DelegationFromLegacy converts a delegation from the cosmos-sdk v0.45 staking module
+// The delegation is not a validator bond
+func DelegationFromLegacy(legacy sdkstaking.Delegation) Delegation {
+	return Delegation{
+		DelegatorAddress: legacy.DelegatorAddress,
+		ValidatorAddress: legacy.ValidatorAddress,
+		Shares:           legacy.Shares,
+	}
+}
+
+// UnbondingDelegationFromLegacy converts an unbonding delegation from the cosmos-sdk
+// v0.45 staking module, dropping the ICS unbonding fields of each entry
+func UnbondingDelegationFromLegacy(legacy sdkstaking.UnbondingDelegation) UnbondingDelegation {
+	entries := make([]UnbondingDelegationEntry, len(legacy.Entries))
+	for i, entry := range legacy.Entries {
+		entries[i] = UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: entry.InitialBalance,
+			Balance:        entry.Balance,
+		}
+	}
+
+	return UnbondingDelegation{
+		DelegatorAddress: legacy.DelegatorAddress,
+		ValidatorAddress: legacy.ValidatorAddress,
+		Entries:          entries,
+	}
+}
+
+// RedelegationFromLegacy converts a redelegation from the cosmos-sdk v0.45 staking module,
+// dropping the ICS unbonding fields of each entry
+func RedelegationFromLegacy(legacy sdkstaking.Redelegation) Redelegation {
+	entries := make([]RedelegationEntry, len(legacy.Entries))
+	for i, entry := range legacy.Entries {
+		entries[i] = RedelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: entry.InitialBalance,
+			SharesDst:      entry.SharesDst,
+		}
+	}
+
+	return Redelegation{
+		DelegatorAddress:    legacy.DelegatorAddress,
+		ValidatorSrcAddress: legacy.ValidatorSrcAddress,
+		ValidatorDstAddress: legacy.ValidatorDstAddress,
+		Entries:             entries,
+	}
+}
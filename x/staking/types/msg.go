@@ -27,7 +27,18 @@ const (
 	TypeMsgTransferTokenizeShareRecord = "transfer_tokenize_share_record"
 	TypeMsgDisableTokenizeShares       = "disable_tokenize_shares"
 	TypeMsgEnableTokenizeShares        = "enable_tokenize_shares"
+	TypeMsgCancelEnableTokenizeShares  = "cancel_enable_tokenize_shares"
 	TypeMsgValidatorBond               = "validator_bond"
+
+	TypeMsgProposeTokenizeShareRecordTransfer = "propose_tokenize_share_record_transfer"
+	TypeMsgAcceptTokenizeShareRecordTransfer  = "accept_tokenize_share_record_transfer"
+	TypeMsgCancelTokenizeShareRecordTransfer  = "cancel_tokenize_share_record_transfer"
+	TypeMsgUpdateParams                       = "update_params"
+	TypeMsgFundSlashInsurance                 = "fund_slash_insurance"
+	TypeMsgUpdateSlashInsuranceCoverage       = "update_slash_insurance_coverage"
+	TypeMsgClaimTokenizeShareRecord           = "claim_tokenize_share_record"
+	TypeMsgPauseTokenization                  = "pause_tokenization"
+	TypeMsgUnpauseTokenization                = "unpause_tokenization"
 )
 
 var (
@@ -42,10 +53,20 @@ var (
 	_ sdk.Msg                            = &MsgTokenizeShares{}
 	_ sdk.Msg                            = &MsgRedeemTokensforShares{}
 	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgProposeTokenizeShareRecordTransfer{}
+	_ sdk.Msg                            = &MsgAcceptTokenizeShareRecordTransfer{}
+	_ sdk.Msg                            = &MsgCancelTokenizeShareRecordTransfer{}
 	_ sdk.Msg                            = &MsgDisableTokenizeShares{}
 	_ sdk.Msg                            = &MsgEnableTokenizeShares{}
+	_ sdk.Msg                            = &MsgCancelEnableTokenizeShares{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgValidatorBond{}
+	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgFundSlashInsurance{}
+	_ sdk.Msg                            = &MsgUpdateSlashInsuranceCoverage{}
+	_ sdk.Msg                            = &MsgClaimTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgPauseTokenization{}
+	_ sdk.Msg                            = &MsgUnpauseTokenization{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -132,9 +153,15 @@ func (msg MsgCreateValidator) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty commission")
 	}
 
-	err = msg.Commission.Validate()
+	if err := msg.Commission.Validate(); err != nil {
+		return err
+	}
 
-	return err
+	if err := msg.LiquidStakingPolicy.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -188,6 +215,12 @@ func (msg MsgEditValidator) ValidateBasic() error {
 		}
 	}
 
+	if msg.LiquidStakingPolicy != nil {
+		if err := msg.LiquidStakingPolicy.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -389,6 +422,9 @@ func (msg MsgUnbondValidator) ValidateBasic() error {
 	return nil
 }
 
+// Route implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+
 // Type implements the sdk.Msg interface.
 func (msg MsgTokenizeShares) Type() string { return TypeMsgTokenizeShares }
 
@@ -426,6 +462,9 @@ func (msg MsgTokenizeShares) ValidateBasic() error {
 	return nil
 }
 
+// Route implements the sdk.Msg interface.
+func (msg MsgRedeemTokensforShares) Route() string { return RouterKey }
+
 // Type implements the sdk.Msg interface.
 func (msg MsgRedeemTokensforShares) Type() string { return TypeMsgRedeemTokensforShares }
 
@@ -457,6 +496,9 @@ func (msg MsgRedeemTokensforShares) ValidateBasic() error {
 	return nil
 }
 
+// Route implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) Route() string { return RouterKey }
+
 // Type implements the sdk.Msg interface.
 func (msg MsgTransferTokenizeShareRecord) Type() string { return TypeMsgTransferTokenizeShareRecord }
 
@@ -484,6 +526,111 @@ func (msg MsgTransferTokenizeShareRecord) ValidateBasic() error {
 	return nil
 }
 
+// Route implements the sdk.Msg interface.
+func (msg MsgProposeTokenizeShareRecordTransfer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgProposeTokenizeShareRecordTransfer) Type() string {
+	return TypeMsgProposeTokenizeShareRecordTransfer
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgProposeTokenizeShareRecordTransfer) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgProposeTokenizeShareRecordTransfer) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgProposeTokenizeShareRecordTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+	if msg.Sender == msg.NewOwner {
+		return ErrTokenizeShareRecordTransferToSelf
+	}
+
+	return nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAcceptTokenizeShareRecordTransfer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAcceptTokenizeShareRecordTransfer) Type() string {
+	return TypeMsgAcceptTokenizeShareRecordTransfer
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAcceptTokenizeShareRecordTransfer) GetSigners() []sdk.AccAddress {
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{newOwner}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAcceptTokenizeShareRecordTransfer) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAcceptTokenizeShareRecordTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	return nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelTokenizeShareRecordTransfer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelTokenizeShareRecordTransfer) Type() string {
+	return TypeMsgCancelTokenizeShareRecordTransfer
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelTokenizeShareRecordTransfer) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelTokenizeShareRecordTransfer) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelTokenizeShareRecordTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	return nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDisableTokenizeShares) Route() string { return RouterKey }
+
 // Type implements the sdk.Msg interface.
 func (msg MsgDisableTokenizeShares) Type() string { return TypeMsgDisableTokenizeShares }
 
@@ -511,6 +658,9 @@ func (msg MsgDisableTokenizeShares) ValidateBasic() error {
 	return nil
 }
 
+// Route implements the sdk.Msg interface.
+func (msg MsgEnableTokenizeShares) Route() string { return RouterKey }
+
 // Type implements the sdk.Msg interface.
 func (msg MsgEnableTokenizeShares) Type() string { return TypeMsgEnableTokenizeShares }
 
@@ -538,6 +688,36 @@ func (msg MsgEnableTokenizeShares) ValidateBasic() error {
 	return nil
 }
 
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelEnableTokenizeShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelEnableTokenizeShares) Type() string { return TypeMsgCancelEnableTokenizeShares }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelEnableTokenizeShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelEnableTokenizeShares) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelEnableTokenizeShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	return nil
+}
+
 // NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
 //
 //nolint:interfacer
@@ -635,3 +815,222 @@ func (msg MsgValidatorBond) ValidateBasic() error {
 
 	return nil
 }
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgFundSlashInsurance creates a new MsgFundSlashInsurance instance.
+//
+//nolint:interfacer
+func NewMsgFundSlashInsurance(depositor sdk.AccAddress, amount sdk.Coin) *MsgFundSlashInsurance {
+	return &MsgFundSlashInsurance{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgFundSlashInsurance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgFundSlashInsurance) Type() string { return TypeMsgFundSlashInsurance }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgFundSlashInsurance) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgFundSlashInsurance) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgFundSlashInsurance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid deposit amount",
+		)
+	}
+
+	return nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateSlashInsuranceCoverage) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateSlashInsuranceCoverage) Type() string { return TypeMsgUpdateSlashInsuranceCoverage }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateSlashInsuranceCoverage) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateSlashInsuranceCoverage) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateSlashInsuranceCoverage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Coverage.Validate()
+}
+
+// NewMsgClaimTokenizeShareRecord creates a new MsgClaimTokenizeShareRecord instance.
+//
+//nolint:interfacer
+func NewMsgClaimTokenizeShareRecord(delAddr sdk.AccAddress, amount sdk.Coin) *MsgClaimTokenizeShareRecord {
+	return &MsgClaimTokenizeShareRecord{
+		DelegatorAddress: delAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgClaimTokenizeShareRecord) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgClaimTokenizeShareRecord) Type() string { return TypeMsgClaimTokenizeShareRecord }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgClaimTokenizeShareRecord) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgClaimTokenizeShareRecord) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgClaimTokenizeShareRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid share token amount",
+		)
+	}
+
+	return nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgPauseTokenization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgPauseTokenization) Type() string { return TypeMsgPauseTokenization }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgPauseTokenization) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgPauseTokenization) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPauseTokenization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Pause.Validate()
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUnpauseTokenization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUnpauseTokenization) Type() string { return TypeMsgUnpauseTokenization }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUnpauseTokenization) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUnpauseTokenization) GetSignBytes() []byte {
+	bz := legacy.Cdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUnpauseTokenization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if msg.ValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -112,6 +112,52 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic of the liquid staking policy of MsgCreateValidator and MsgEditValidator
+func TestMsgValidatorLiquidStakingPolicy(t *testing.T) {
+	decPtr := func(s string) *sdk.Dec {
+		d := sdk.MustNewDecFromStr(s)
+		return &d
+	}
+	provider := sdk.AccAddress(valAddr2).String()
+
+	tests := []struct {
+		name       string
+		policy     types.ValidatorLiquidStakingPolicy
+		expectPass bool
+	}{
+		{"empty policy", types.ValidatorLiquidStakingPolicy{}, true},
+		{"full policy", types.ValidatorLiquidStakingPolicy{
+			LiquidStakingCap:              decPtr("0.25"),
+			AllowedLiquidStakingProviders: []string{provider},
+			TokenizationDisabled:          true,
+		}, true},
+		{"zero cap", types.ValidatorLiquidStakingPolicy{LiquidStakingCap: decPtr("0")}, true},
+		{"negative cap", types.ValidatorLiquidStakingPolicy{LiquidStakingCap: decPtr("-0.1")}, false},
+		{"cap above one", types.ValidatorLiquidStakingPolicy{LiquidStakingCap: decPtr("1.1")}, false},
+		{"invalid provider", types.ValidatorLiquidStakingPolicy{AllowedLiquidStakingProviders: []string{valAddr2.String()}}, false},
+		{"duplicate provider", types.ValidatorLiquidStakingPolicy{AllowedLiquidStakingProviders: []string{provider, provider}}, false},
+	}
+
+	commission := types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	description := types.NewDescription("a", "b", "c", "d", "e")
+	for _, tc := range tests {
+		createMsg, err := types.NewMsgCreateValidator(valAddr1, pk1, coinPos, description, commission)
+		require.NoError(t, err)
+		createMsg.LiquidStakingPolicy = tc.policy
+
+		editMsg := types.NewMsgEditValidator(valAddr1, description, nil)
+		editMsg.LiquidStakingPolicy = &tc.policy
+
+		if tc.expectPass {
+			require.Nil(t, createMsg.ValidateBasic(), "test: %v", tc.name)
+			require.Nil(t, editMsg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, createMsg.ValidateBasic(), "test: %v", tc.name)
+			require.NotNil(t, editMsg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDelegate
 func TestMsgDelegate(t *testing.T) {
 	tests := []struct {
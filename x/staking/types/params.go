@@ -41,18 +41,21 @@ var (
 	DefaultGlobalLiquidStakingCap = sdk.OneDec()
 	// DefaultValidatorLiquidStakingCap is set to 100%
 	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+	// DefaultValidatorBondFirstLossFraction is set to 0% (disabled)
+	DefaultValidatorBondFirstLossFraction = sdk.ZeroDec()
 )
 
 var (
-	KeyUnbondingTime             = []byte("UnbondingTime")
-	KeyMaxValidators             = []byte("MaxValidators")
-	KeyMaxEntries                = []byte("MaxEntries")
-	KeyBondDenom                 = []byte("BondDenom")
-	KeyHistoricalEntries         = []byte("HistoricalEntries")
-	KeyMinCommissionRate         = []byte("MinCommissionRate")
-	KeyValidatorBondFactor       = []byte("ValidatorBondFactor")
-	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
-	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+	KeyUnbondingTime                  = []byte("UnbondingTime")
+	KeyMaxValidators                  = []byte("MaxValidators")
+	KeyMaxEntries                     = []byte("MaxEntries")
+	KeyBondDenom                      = []byte("BondDenom")
+	KeyHistoricalEntries              = []byte("HistoricalEntries")
+	KeyMinCommissionRate              = []byte("MinCommissionRate")
+	KeyValidatorBondFactor            = []byte("ValidatorBondFactor")
+	KeyGlobalLiquidStakingCap         = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap      = []byte("ValidatorLiquidStakingCap")
+	KeyValidatorBondFirstLossFraction = []byte("ValidatorBondFirstLossFraction")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -73,17 +76,19 @@ func NewParams(
 	validatorBondFactor sdk.Dec,
 	globalLiquidStakingCap sdk.Dec,
 	validatorLiquidStakingCap sdk.Dec,
+	validatorBondFirstLossFraction sdk.Dec,
 ) Params {
 	return Params{
-		UnbondingTime:             unbondingTime,
-		MaxValidators:             maxValidators,
-		MaxEntries:                maxEntries,
-		HistoricalEntries:         historicalEntries,
-		BondDenom:                 bondDenom,
-		MinCommissionRate:         minCommissionRate,
-		ValidatorBondFactor:       validatorBondFactor,
-		GlobalLiquidStakingCap:    globalLiquidStakingCap,
-		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		UnbondingTime:                  unbondingTime,
+		MaxValidators:                  maxValidators,
+		MaxEntries:                     maxEntries,
+		HistoricalEntries:              historicalEntries,
+		BondDenom:                      bondDenom,
+		MinCommissionRate:              minCommissionRate,
+		ValidatorBondFactor:            validatorBondFactor,
+		GlobalLiquidStakingCap:         globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:      validatorLiquidStakingCap,
+		ValidatorBondFirstLossFraction: validatorBondFirstLossFraction,
 	}
 }
 
@@ -99,6 +104,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
 		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateGlobalLiquidStakingCap),
 		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateValidatorLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorBondFirstLossFraction, &p.ValidatorBondFirstLossFraction, validateValidatorBondFirstLossFraction),
 	}
 }
 
@@ -114,6 +120,7 @@ func DefaultParams() Params {
 		DefaultValidatorBondFactor,
 		DefaultGlobalLiquidStakingCap,
 		DefaultValidatorLiquidStakingCap,
+		DefaultValidatorBondFirstLossFraction,
 	)
 }
 
@@ -157,6 +164,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateHistoricalEntries(p.HistoricalEntries); err != nil {
+		return err
+	}
+
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
@@ -165,9 +176,19 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	err := validateValidatorBondFactor(p.ValidatorBondFactor)
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
 
-	return err
+	if err := validateGlobalLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateValidatorLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	return validateValidatorBondFirstLossFraction(p.ValidatorBondFirstLossFraction)
 }
 
 func validateUnbondingTime(i interface{}) error {
@@ -306,3 +327,19 @@ func validateValidatorLiquidStakingCap(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorBondFirstLossFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("validator bond first loss fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("validator bond first loss fraction cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
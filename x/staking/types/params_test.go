@@ -35,4 +35,18 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate validator bond first loss fraction
+	params = types.DefaultParams()
+	params.ValidatorBondFirstLossFraction = sdk.MustNewDecFromStr("0.5")
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFirstLossFraction = sdk.OneDec()
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFirstLossFraction = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorBondFirstLossFraction = sdk.MustNewDecFromStr("1.01")
+	require.Error(t, params.Validate())
 }
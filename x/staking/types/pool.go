@@ -7,9 +7,12 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - SlashInsuranceFund -> "slash_insurance_fund"
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName      = "not_bonded_tokens_pool"
+	BondedPoolName         = "bonded_tokens_pool"
+	SlashInsuranceFundName = "slash_insurance_fund"
 )
 
 // NewPool creates a new Pool instance used for queries
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateParams defines the type for an UpdateParamsProposal
+	ProposalTypeUpdateParams = "UpdateStakingParams"
+	// ProposalTypeUpdateSlashInsuranceCoverage defines the type for an UpdateSlashInsuranceCoverageProposal
+	ProposalTypeUpdateSlashInsuranceCoverage = "UpdateSlashInsuranceCoverage"
+	// ProposalTypePauseTokenization defines the type for a PauseTokenizationProposal
+	ProposalTypePauseTokenization = "PauseTokenization"
+	// ProposalTypeUnpauseTokenization defines the type for an UnpauseTokenizationProposal
+	ProposalTypeUnpauseTokenization = "UnpauseTokenization"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &UpdateParamsProposal{}
+	_ govtypes.Content = &UpdateSlashInsuranceCoverageProposal{}
+	_ govtypes.Content = &PauseTokenizationProposal{}
+	_ govtypes.Content = &UnpauseTokenizationProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "cosmos-sdk/x/staking/UpdateParamsProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateSlashInsuranceCoverage)
+	govtypes.RegisterProposalTypeCodec(&UpdateSlashInsuranceCoverageProposal{}, "cosmos-sdk/x/staking/UpdateSlashInsuranceCoverageProposal")
+	govtypes.RegisterProposalType(ProposalTypePauseTokenization)
+	govtypes.RegisterProposalTypeCodec(&PauseTokenizationProposal{}, "cosmos-sdk/x/staking/PauseTokenizationProposal")
+	govtypes.RegisterProposalType(ProposalTypeUnpauseTokenization)
+	govtypes.RegisterProposalTypeCodec(&UnpauseTokenizationProposal{}, "cosmos-sdk/x/staking/UnpauseTokenizationProposal")
+}
+
+// NewUpdateParamsProposal creates a new proposal to update the x/staking params.
+func NewUpdateParamsProposal(title, description string, params Params) *UpdateParamsProposal {
+	return &UpdateParamsProposal{title, description, params}
+}
+
+// GetTitle returns the title of an update params proposal.
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update params proposal.
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update params proposal.
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update params proposal.
+func (p *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Params.Validate()
+}
+
+// String implements the Stringer interface.
+func (p UpdateParamsProposal) String() string {
+	return fmt.Sprintf(`Update Staking Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:
+%s
+`, p.Title, p.Description, p.Params)
+}
+
+// NewUpdateSlashInsuranceCoverageProposal creates a new proposal to set the coverage of the
+// slash insurance fund.
+func NewUpdateSlashInsuranceCoverageProposal(title, description string, coverage SlashInsuranceCoverage) *UpdateSlashInsuranceCoverageProposal {
+	return &UpdateSlashInsuranceCoverageProposal{title, description, coverage}
+}
+
+// GetTitle returns the title of an update slash insurance coverage proposal.
+func (p *UpdateSlashInsuranceCoverageProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update slash insurance coverage proposal.
+func (p *UpdateSlashInsuranceCoverageProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update slash insurance coverage proposal.
+func (p *UpdateSlashInsuranceCoverageProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update slash insurance coverage proposal.
+func (p *UpdateSlashInsuranceCoverageProposal) ProposalType() string {
+	return ProposalTypeUpdateSlashInsuranceCoverage
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateSlashInsuranceCoverageProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Coverage.Validate()
+}
+
+// String implements the Stringer interface.
+func (p UpdateSlashInsuranceCoverageProposal) String() string {
+	return fmt.Sprintf(`Update Slash Insurance Coverage Proposal:
+  Title:                %s
+  Description:          %s
+  Coverage Fraction:    %s
+  Max Payout Per Slash: %s
+`, p.Title, p.Description, p.Coverage.CoverageFraction, p.Coverage.MaxPayoutPerSlash)
+}
+
+// NewPauseTokenizationProposal creates a new proposal to pause tokenization globally, or for
+// the validator of the pause.
+func NewPauseTokenizationProposal(title, description string, pause TokenizationPause) *PauseTokenizationProposal {
+	return &PauseTokenizationProposal{title, description, pause}
+}
+
+// GetTitle returns the title of a pause tokenization proposal.
+func (p *PauseTokenizationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a pause tokenization proposal.
+func (p *PauseTokenizationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a pause tokenization proposal.
+func (p *PauseTokenizationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pause tokenization proposal.
+func (p *PauseTokenizationProposal) ProposalType() string { return ProposalTypePauseTokenization }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *PauseTokenizationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Pause.Validate()
+}
+
+// String implements the Stringer interface.
+func (p PauseTokenizationProposal) String() string {
+	return fmt.Sprintf(`Pause Tokenization Proposal:
+  Title:             %s
+  Description:       %s
+  Validator:         %s
+  Pause Redemptions: %t
+`, p.Title, p.Description, p.Pause.ValidatorAddress, p.Pause.PauseRedemptions)
+}
+
+// NewUnpauseTokenizationProposal creates a new proposal to lift the pause of a validator, or
+// the global pause if the validator address is empty.
+func NewUnpauseTokenizationProposal(title, description, validatorAddress string) *UnpauseTokenizationProposal {
+	return &UnpauseTokenizationProposal{title, description, validatorAddress}
+}
+
+// GetTitle returns the title of an unpause tokenization proposal.
+func (p *UnpauseTokenizationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an unpause tokenization proposal.
+func (p *UnpauseTokenizationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an unpause tokenization proposal.
+func (p *UnpauseTokenizationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an unpause tokenization proposal.
+func (p *UnpauseTokenizationProposal) ProposalType() string { return ProposalTypeUnpauseTokenization }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UnpauseTokenizationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return TokenizationPause{ValidatorAddress: p.ValidatorAddress}.Validate()
+}
+
+// String implements the Stringer interface.
+func (p UnpauseTokenizationProposal) String() string {
+	return fmt.Sprintf(`Unpause Tokenization Proposal:
+  Title:       %s
+  Description: %s
+  Validator:   %s
+`, p.Title, p.Description, p.ValidatorAddress)
+}
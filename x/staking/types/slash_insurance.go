@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultSlashInsuranceCoverage returns the default coverage of the slash insurance fund,
+// which pays nothing out
+func DefaultSlashInsuranceCoverage() SlashInsuranceCoverage {
+	return SlashInsuranceCoverage{
+		CoverageFraction:  sdk.ZeroDec(),
+		MaxPayoutPerSlash: sdk.ZeroInt(),
+	}
+}
+
+// Validate checks that the coverage fraction is in [0, 1] and the max payout is not negative
+func (c SlashInsuranceCoverage) Validate() error {
+	if c.CoverageFraction.IsNil() || c.CoverageFraction.IsNegative() || c.CoverageFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash insurance coverage fraction must be between 0 and 1: %s", c.CoverageFraction)
+	}
+	if c.MaxPayoutPerSlash.IsNil() || c.MaxPayoutPerSlash.IsNegative() {
+		return fmt.Errorf("slash insurance max payout per slash cannot be negative: %s", c.MaxPayoutPerSlash)
+	}
+
+	return nil
+}
+
+// PaysOut returns true if the coverage allows the fund to pay anything out
+func (c SlashInsuranceCoverage) PaysOut() bool {
+	return c.CoverageFraction.IsPositive() && c.MaxPayoutPerSlash.IsPositive()
+}
@@ -10,8 +10,8 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	types3 "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 	proto "github.com/gogo/protobuf/proto"
@@ -336,6 +336,10 @@ type Validator struct {
 	TotalValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=total_validator_bond_shares,json=totalValidatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_validator_bond_shares"`
 	// Total number of shares either tokenized or owned by a liquid staking provider
 	TotalLiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=total_liquid_shares,json=totalLiquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_liquid_shares"`
+	// Number of shares delegated by tokenize share record module accounts
+	TotalTokenizedShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=total_tokenized_shares,json=totalTokenizedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_tokenized_shares"`
+	// liquid stake the validator's operator accepts
+	LiquidStakingPolicy ValidatorLiquidStakingPolicy `protobuf:"bytes,14,opt,name=liquid_staking_policy,json=liquidStakingPolicy,proto3" json:"liquid_staking_policy"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -859,6 +863,10 @@ type Params struct {
 	// validator_liquid_staking_cap represents a cap on the portion of stake that
 	// comes from liquid staking providers for a specific validator
 	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// validator_bond_first_loss_fraction is the fraction of each slash of a validator's
+	// tokens that is absorbed by its validator bond delegations before the rest is
+	// spread over all of its delegators. Zero disables the first loss
+	ValidatorBondFirstLossFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_bond_first_loss_fraction,json=validatorBondFirstLossFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_first_loss_fraction" yaml:"validator_bond_first_loss_fraction"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1239,1699 +1247,2112 @@ func (m *PendingTokenizeShareAuthorizations) GetAddresses() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("liquidstaking.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
-	proto.RegisterType((*HistoricalInfo)(nil), "liquidstaking.staking.v1beta1.HistoricalInfo")
-	proto.RegisterType((*CommissionRates)(nil), "liquidstaking.staking.v1beta1.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "liquidstaking.staking.v1beta1.Commission")
-	proto.RegisterType((*Description)(nil), "liquidstaking.staking.v1beta1.Description")
-	proto.RegisterType((*Validator)(nil), "liquidstaking.staking.v1beta1.Validator")
-	proto.RegisterType((*ValAddresses)(nil), "liquidstaking.staking.v1beta1.ValAddresses")
-	proto.RegisterType((*DVPair)(nil), "liquidstaking.staking.v1beta1.DVPair")
-	proto.RegisterType((*DVPairs)(nil), "liquidstaking.staking.v1beta1.DVPairs")
-	proto.RegisterType((*DVVTriplet)(nil), "liquidstaking.staking.v1beta1.DVVTriplet")
-	proto.RegisterType((*DVVTriplets)(nil), "liquidstaking.staking.v1beta1.DVVTriplets")
-	proto.RegisterType((*Delegation)(nil), "liquidstaking.staking.v1beta1.Delegation")
-	proto.RegisterType((*UnbondingDelegation)(nil), "liquidstaking.staking.v1beta1.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "liquidstaking.staking.v1beta1.UnbondingDelegationEntry")
-	proto.RegisterType((*RedelegationEntry)(nil), "liquidstaking.staking.v1beta1.RedelegationEntry")
-	proto.RegisterType((*Redelegation)(nil), "liquidstaking.staking.v1beta1.Redelegation")
-	proto.RegisterType((*Params)(nil), "liquidstaking.staking.v1beta1.Params")
-	proto.RegisterType((*DelegationResponse)(nil), "liquidstaking.staking.v1beta1.DelegationResponse")
-	proto.RegisterType((*RedelegationEntryResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationEntryResponse")
-	proto.RegisterType((*RedelegationResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationResponse")
-	proto.RegisterType((*Pool)(nil), "liquidstaking.staking.v1beta1.Pool")
-	proto.RegisterType((*TokenizeShareRecord)(nil), "liquidstaking.staking.v1beta1.TokenizeShareRecord")
-	proto.RegisterType((*PendingTokenizeShareAuthorizations)(nil), "liquidstaking.staking.v1beta1.PendingTokenizeShareAuthorizations")
+// PendingTokenizeShareRecordTransfer represents a proposed transfer of a tokenize
+// share record's ownership that is waiting to be accepted by the new owner
+type PendingTokenizeShareRecordTransfer struct {
+	TokenizeShareRecordId uint64    `protobuf:"varint,1,opt,name=tokenize_share_record_id,json=tokenizeShareRecordId,proto3" json:"tokenize_share_record_id,omitempty"`
+	Owner                 string    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	NewOwner              string    `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	ExpirationTime        time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
 }
 
-func init() { proto.RegisterFile("staking/v1beta1/staking.proto", fileDescriptor_76a7656dabf68054) }
-
-var fileDescriptor_76a7656dabf68054 = []byte{
-	// 1935 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xdb, 0x6f, 0x63, 0x47,
-	0x19, 0xf7, 0x71, 0xbc, 0x8e, 0xfd, 0x39, 0xb1, 0x93, 0xd9, 0xb4, 0x38, 0x66, 0x37, 0xb6, 0x8c,
-	0xb6, 0x64, 0x0b, 0xb1, 0x69, 0x90, 0x0a, 0xac, 0x90, 0x50, 0x1c, 0x67, 0xd9, 0xb0, 0xdb, 0xad,
-	0x39, 0xb9, 0x94, 0x16, 0x24, 0x6b, 0x7c, 0xce, 0xac, 0x33, 0xe4, 0xf8, 0x1c, 0xf7, 0xcc, 0x78,
-	0x1b, 0x17, 0x90, 0x10, 0x48, 0xa8, 0x8a, 0x84, 0xb4, 0x4f, 0xa8, 0x2f, 0x2b, 0xad, 0x04, 0xbc,
-	0xa0, 0x3e, 0x56, 0xfc, 0x01, 0x3c, 0x55, 0x48, 0x48, 0x4b, 0x9f, 0x80, 0xa2, 0x50, 0xed, 0xbe,
-	0x20, 0x9e, 0x10, 0xef, 0x48, 0x68, 0x2e, 0xe7, 0x12, 0x3b, 0x5d, 0xaf, 0x57, 0x41, 0xaa, 0xd4,
-	0x97, 0xf8, 0xcc, 0xe5, 0xfb, 0xcd, 0x37, 0xbf, 0xef, 0x36, 0x33, 0x81, 0xcb, 0x8c, 0xe3, 0x43,
-	0xea, 0x76, 0xeb, 0x77, 0x5f, 0xea, 0x10, 0x8e, 0x5f, 0xaa, 0xeb, 0x76, 0xad, 0xef, 0x7b, 0xdc,
-	0x43, 0x97, 0x1d, 0xfa, 0xe6, 0x80, 0xda, 0x41, 0x67, 0xf0, 0xab, 0x27, 0x97, 0x96, 0xba, 0x5e,
-	0xd7, 0x93, 0x33, 0xeb, 0xe2, 0x4b, 0x09, 0x95, 0x96, 0xbb, 0x9e, 0xd7, 0x75, 0x48, 0x5d, 0xb6,
-	0x3a, 0x83, 0x3b, 0x75, 0xec, 0x0e, 0xf5, 0xd0, 0xca, 0xe8, 0x90, 0x3d, 0xf0, 0x31, 0xa7, 0x9e,
-	0xab, 0xc7, 0xcb, 0xa3, 0xe3, 0x9c, 0xf6, 0x08, 0xe3, 0xb8, 0xd7, 0x0f, 0xb0, 0x2d, 0x8f, 0xf5,
-	0x3c, 0xd6, 0x56, 0x8b, 0xaa, 0x46, 0x80, 0xad, 0x5a, 0xf5, 0x0e, 0x66, 0x24, 0xdc, 0x8e, 0xe5,
-	0xd1, 0x00, 0xfb, 0x12, 0x27, 0xae, 0x4d, 0xfc, 0x1e, 0x75, 0x79, 0x9d, 0x0f, 0xfb, 0x84, 0xa9,
-	0xbf, 0x6a, 0xb4, 0x7a, 0xcf, 0x80, 0xfc, 0x0d, 0xca, 0xb8, 0xe7, 0x53, 0x0b, 0x3b, 0xdb, 0xee,
-	0x1d, 0x0f, 0xbd, 0x0c, 0xe9, 0x03, 0x82, 0x6d, 0xe2, 0x17, 0x8d, 0x8a, 0xb1, 0x9a, 0x5b, 0x2f,
-	0xd6, 0x22, 0x84, 0x9a, 0x92, 0xbd, 0x21, 0xc7, 0x1b, 0xa9, 0x0f, 0x4e, 0xca, 0x09, 0x53, 0xcf,
-	0x46, 0xd7, 0x21, 0x7d, 0x17, 0x3b, 0x8c, 0xf0, 0x62, 0xb2, 0x32, 0xb3, 0x9a, 0x5b, 0x5f, 0xad,
-	0x3d, 0x91, 0xc5, 0xda, 0x3e, 0x76, 0xa8, 0x8d, 0xb9, 0x17, 0xe2, 0x28, 0xe9, 0xea, 0x7b, 0x49,
-	0x28, 0x6c, 0x7a, 0xbd, 0x1e, 0x65, 0x8c, 0x7a, 0xae, 0x89, 0x39, 0x61, 0xa8, 0x05, 0x29, 0x1f,
-	0x73, 0x22, 0x35, 0xca, 0x36, 0xbe, 0x29, 0xe6, 0xff, 0xed, 0xa4, 0xfc, 0x42, 0x97, 0xf2, 0x83,
-	0x41, 0xa7, 0x66, 0x79, 0x3d, 0xcd, 0x89, 0xfe, 0x59, 0x63, 0xf6, 0xa1, 0xde, 0x66, 0x93, 0x58,
-	0x1f, 0xbe, 0xbf, 0x06, 0x9a, 0xb2, 0x26, 0xb1, 0x4c, 0x89, 0x84, 0x5e, 0x83, 0x4c, 0x0f, 0x1f,
-	0xb5, 0x25, 0x6a, 0xf2, 0x1c, 0x50, 0x67, 0x7b, 0xf8, 0x48, 0xe8, 0x8a, 0x6c, 0x28, 0x08, 0x60,
-	0xeb, 0x00, 0xbb, 0x5d, 0xa2, 0xf0, 0x67, 0xce, 0x01, 0x7f, 0xbe, 0x87, 0x8f, 0x36, 0x25, 0xa6,
-	0x58, 0xe5, 0x5a, 0xe6, 0xdd, 0x07, 0xe5, 0xc4, 0x3f, 0x1f, 0x94, 0x8d, 0xea, 0x1f, 0x0c, 0x80,
-	0x88, 0x2e, 0x64, 0xc1, 0x82, 0x15, 0xb6, 0xe4, 0xf2, 0x4c, 0xdb, 0xb1, 0x36, 0xc1, 0x1e, 0x23,
-	0x9c, 0x37, 0x32, 0x42, 0xdf, 0x87, 0x27, 0x65, 0xc3, 0x2c, 0x58, 0x23, 0xe6, 0xd8, 0x82, 0xdc,
-	0xa0, 0x6f, 0x63, 0x4e, 0xda, 0xc2, 0x51, 0x25, 0x7f, 0xb9, 0xf5, 0x52, 0x4d, 0x79, 0x71, 0x2d,
-	0xf0, 0xe2, 0xda, 0x6e, 0xe0, 0xc5, 0x0a, 0xeb, 0xde, 0x3f, 0xca, 0x86, 0x09, 0x4a, 0x50, 0x0c,
-	0xc5, 0x36, 0xf1, 0x9e, 0x01, 0xb9, 0x26, 0x61, 0x96, 0x4f, 0xfb, 0x22, 0x2c, 0x50, 0x11, 0x66,
-	0x7b, 0x9e, 0x4b, 0x0f, 0xb5, 0x13, 0x66, 0xcd, 0xa0, 0x89, 0x4a, 0x90, 0xa1, 0x36, 0x71, 0x39,
-	0xe5, 0x43, 0x65, 0x37, 0x33, 0x6c, 0x0b, 0xa9, 0xb7, 0x48, 0x87, 0xd1, 0x80, 0x72, 0x33, 0x68,
-	0xa2, 0xab, 0xb0, 0xc0, 0x88, 0x35, 0xf0, 0x29, 0x1f, 0xb6, 0x2d, 0xcf, 0xe5, 0xd8, 0xe2, 0xc5,
-	0x94, 0x9c, 0x52, 0x08, 0xfa, 0x37, 0x55, 0xb7, 0x00, 0xb1, 0x09, 0xc7, 0xd4, 0x61, 0xc5, 0x0b,
-	0x0a, 0x44, 0x37, 0x63, 0xea, 0x7e, 0x34, 0x0b, 0xd9, 0xd0, 0x7d, 0xd1, 0x26, 0x2c, 0x78, 0x7d,
-	0xe2, 0x8b, 0xef, 0x36, 0xb6, 0x6d, 0x9f, 0x30, 0xa6, 0x1d, 0xb5, 0xf8, 0xe1, 0xfb, 0x6b, 0x4b,
-	0xda, 0x88, 0x1b, 0x6a, 0x64, 0x87, 0xfb, 0xd4, 0xed, 0x9a, 0x85, 0x40, 0x42, 0x77, 0xa3, 0xd7,
-	0x85, 0xdd, 0x5c, 0x46, 0x5c, 0x36, 0x60, 0xed, 0xfe, 0xa0, 0x73, 0x48, 0x86, 0x9a, 0xd7, 0xa5,
-	0x31, 0x5e, 0x37, 0xdc, 0x61, 0xa3, 0xf8, 0xc7, 0x08, 0xda, 0xf2, 0x87, 0x7d, 0xee, 0xd5, 0x5a,
-	0x83, 0xce, 0x4d, 0x32, 0x14, 0xd6, 0xd2, 0x38, 0x2d, 0x09, 0x83, 0x9e, 0x87, 0xf4, 0x0f, 0x31,
-	0x75, 0x88, 0x2d, 0x59, 0xc9, 0x98, 0xba, 0x85, 0x36, 0x20, 0xcd, 0x38, 0xe6, 0x03, 0x26, 0xa9,
-	0xc8, 0xaf, 0x5f, 0x9d, 0xe0, 0x20, 0x0d, 0xcf, 0xb5, 0x77, 0xa4, 0x80, 0xa9, 0x05, 0xd1, 0x2e,
-	0xa4, 0xb9, 0x77, 0x48, 0x5c, 0xcd, 0xd5, 0x54, 0x3e, 0xbe, 0xed, 0xf2, 0x98, 0x8f, 0x6f, 0xbb,
-	0xdc, 0xd4, 0x58, 0xa8, 0x0b, 0x0b, 0x36, 0x71, 0x48, 0x57, 0x32, 0xca, 0x0e, 0xb0, 0x4f, 0x58,
-	0x31, 0x7d, 0x0e, 0x31, 0x54, 0x08, 0x51, 0x77, 0x24, 0x28, 0x32, 0x21, 0x67, 0x47, 0x5e, 0x57,
-	0x9c, 0x95, 0x7c, 0xbf, 0x38, 0x81, 0x86, 0x98, 0x9f, 0xea, 0xcc, 0x15, 0x07, 0x11, 0xae, 0x36,
-	0x70, 0x3b, 0x9e, 0x6b, 0x53, 0xb7, 0xdb, 0x3e, 0x20, 0xb4, 0x7b, 0xc0, 0x8b, 0x99, 0x8a, 0xb1,
-	0x3a, 0x63, 0x16, 0xc2, 0xfe, 0x1b, 0xb2, 0x1b, 0xdd, 0x84, 0x7c, 0x34, 0x55, 0x46, 0x52, 0x76,
-	0x8a, 0x48, 0x9a, 0x0f, 0x65, 0xc5, 0x28, 0x7a, 0x15, 0x20, 0x0a, 0xd3, 0x22, 0x48, 0xa0, 0xab,
-	0x4f, 0x1d, 0xf2, 0x7a, 0x27, 0x31, 0x08, 0xf4, 0x23, 0xf8, 0x3c, 0xf7, 0x38, 0x76, 0xda, 0x77,
-	0x03, 0x4f, 0x6f, 0x8b, 0xf5, 0x02, 0x83, 0xe4, 0xce, 0xc1, 0x20, 0x45, 0xb9, 0x40, 0x54, 0x08,
-	0x84, 0x83, 0x29, 0xcb, 0x38, 0x70, 0x51, 0x2d, 0xae, 0x36, 0x10, 0x2c, 0x3a, 0x77, 0x0e, 0x8b,
-	0x2e, 0x4a, 0xe0, 0x5b, 0x12, 0x57, 0xad, 0x76, 0x6d, 0xee, 0x9d, 0x07, 0xe5, 0x84, 0x8e, 0xee,
-	0x44, 0xb5, 0x05, 0x73, 0xfb, 0xd8, 0xd1, 0x81, 0x49, 0x18, 0x7a, 0x19, 0xb2, 0x38, 0x68, 0x14,
-	0x8d, 0xca, 0xcc, 0x13, 0x03, 0x3b, 0x9a, 0xaa, 0xf2, 0xc5, 0x4f, 0xff, 0x5e, 0x31, 0xaa, 0xbf,
-	0x31, 0x20, 0xdd, 0xdc, 0x6f, 0x61, 0xea, 0xa3, 0x2d, 0x58, 0x8c, 0x7c, 0xfb, 0x69, 0xb3, 0x45,
-	0x14, 0x0e, 0x41, 0xba, 0xd8, 0x82, 0xc5, 0xc8, 0x2c, 0x01, 0x4c, 0x72, 0x12, 0x4c, 0x28, 0xa2,
-	0xfb, 0x47, 0x36, 0x7e, 0x0b, 0x66, 0x95, 0x96, 0x0c, 0x6d, 0xc0, 0x85, 0xbe, 0xf8, 0x90, 0xfb,
-	0xcd, 0xad, 0x5f, 0x99, 0x14, 0x13, 0x52, 0x4c, 0x3b, 0x91, 0x92, 0xac, 0xfe, 0xd7, 0x00, 0x68,
-	0xee, 0xef, 0xef, 0xfa, 0xb4, 0xef, 0x10, 0x7e, 0x5e, 0x1b, 0xbf, 0x05, 0xcf, 0x45, 0x1b, 0x67,
-	0xbe, 0xf5, 0xd4, 0x9b, 0xbf, 0x18, 0x8a, 0xed, 0xf8, 0xd6, 0x99, 0x68, 0x36, 0xe3, 0x21, 0xda,
-	0xcc, 0x53, 0xa3, 0x35, 0x19, 0x3f, 0x9b, 0xcd, 0x37, 0x20, 0x17, 0x6d, 0x9f, 0xa1, 0x9b, 0x90,
-	0xe1, 0xfa, 0x5b, 0x93, 0x7a, 0x75, 0x22, 0xa9, 0x81, 0xb4, 0x26, 0x36, 0x04, 0xa8, 0xfe, 0x36,
-	0x09, 0xd0, 0x54, 0xd4, 0x88, 0x50, 0xfd, 0x54, 0x39, 0x95, 0x28, 0x0a, 0x3a, 0x5c, 0xcf, 0xe3,
-	0xe0, 0xa3, 0xb1, 0xd0, 0x15, 0xc8, 0x9f, 0x4e, 0x44, 0xb2, 0x6a, 0x65, 0xcc, 0xf9, 0xbb, 0xf1,
-	0xf4, 0x31, 0x62, 0x83, 0xe3, 0x24, 0x5c, 0xdc, 0x0b, 0xd2, 0xe4, 0xa7, 0x96, 0xb0, 0xd7, 0x60,
-	0x96, 0xb8, 0xdc, 0xa7, 0x92, 0x31, 0xe1, 0x19, 0x5f, 0x9b, 0xe0, 0x19, 0x67, 0x6c, 0x69, 0xcb,
-	0xe5, 0xfe, 0x50, 0xfb, 0x49, 0x80, 0x36, 0x42, 0xc6, 0x47, 0x49, 0x28, 0x7e, 0x92, 0x24, 0xfa,
-	0x22, 0x14, 0x2c, 0x9f, 0xc8, 0x8e, 0xa0, 0x6a, 0x19, 0xb2, 0x6a, 0xe5, 0x83, 0x6e, 0x5d, 0xb4,
-	0x5e, 0x01, 0x71, 0x1c, 0x14, 0x6e, 0x28, 0xa6, 0x4e, 0x7d, 0xfe, 0xcb, 0x47, 0xc2, 0xb2, 0x6c,
-	0x11, 0x28, 0x50, 0x97, 0x72, 0x8a, 0x9d, 0x76, 0x07, 0x3b, 0xd8, 0xb5, 0x9e, 0xe5, 0xb8, 0x3c,
-	0x7e, 0x94, 0xc8, 0x6b, 0xd0, 0x86, 0xc2, 0x44, 0xfb, 0x30, 0x1b, 0xc0, 0xa7, 0xce, 0x01, 0x3e,
-	0x00, 0x8b, 0x9d, 0x09, 0xff, 0x9a, 0x84, 0x45, 0x93, 0xd8, 0x9f, 0x2d, 0x5a, 0xbf, 0x0f, 0xa0,
-	0xc2, 0x53, 0x24, 0xcf, 0x67, 0x60, 0x76, 0x3c, 0xdc, 0xb3, 0x0a, 0xaf, 0xc9, 0x78, 0x8c, 0xdb,
-	0x3f, 0x27, 0x61, 0x2e, 0xce, 0xed, 0x67, 0xa0, 0x98, 0xa0, 0x56, 0x94, 0x14, 0x52, 0x32, 0x29,
-	0x7c, 0x65, 0x42, 0x52, 0x18, 0x73, 0xbe, 0x27, 0x67, 0x83, 0x07, 0x69, 0x48, 0xb7, 0xb0, 0x8f,
-	0x7b, 0x0c, 0x7d, 0x67, 0xec, 0x1c, 0xaa, 0x6e, 0x8c, 0xcb, 0x63, 0xae, 0xd7, 0xd4, 0xef, 0x16,
-	0xca, 0xf3, 0xde, 0x3d, 0xe3, 0x18, 0x7a, 0x05, 0xf2, 0xe2, 0xfa, 0x1b, 0xee, 0x48, 0x71, 0x39,
-	0x2f, 0xef, 0xaf, 0xe1, 0x41, 0x8f, 0xa1, 0x32, 0xe4, 0xc4, 0xb4, 0x28, 0xed, 0x89, 0x39, 0xd0,
-	0xc3, 0x47, 0x5b, 0xaa, 0x07, 0xad, 0x01, 0x3a, 0x08, 0xdf, 0x25, 0xda, 0x11, 0x13, 0x62, 0xde,
-	0x62, 0x34, 0x12, 0x4c, 0xbf, 0x0c, 0x20, 0x0f, 0xa7, 0x36, 0x71, 0xbd, 0x9e, 0xbe, 0xb8, 0x65,
-	0x45, 0x4f, 0x53, 0x74, 0xa0, 0x1f, 0xc3, 0xc5, 0x1e, 0x75, 0xdb, 0x23, 0x37, 0x63, 0x7d, 0xa9,
-	0xb8, 0x35, 0x9d, 0xc3, 0xfe, 0xe7, 0xa4, 0x5c, 0x1a, 0xe2, 0x9e, 0x73, 0xad, 0x7a, 0x06, 0x64,
-	0xd5, 0x5c, 0xec, 0x51, 0xf7, 0xf4, 0x55, 0x1a, 0xfd, 0xcc, 0x88, 0x7b, 0x86, 0xd4, 0xf3, 0x0e,
-	0xb6, 0xb8, 0xe7, 0xcb, 0x1b, 0x47, 0xb6, 0x71, 0x7b, 0x6a, 0x05, 0x2e, 0x29, 0x05, 0xce, 0x04,
-	0xad, 0xc6, 0xfc, 0x49, 0x94, 0xc4, 0xeb, 0xb2, 0x17, 0xfd, 0xd2, 0x80, 0xe5, 0xae, 0xe3, 0x75,
-	0x62, 0x67, 0x6a, 0xe5, 0x40, 0x6d, 0x0b, 0xf7, 0xe5, 0x0d, 0x25, 0xdb, 0x30, 0xa7, 0x56, 0xa4,
-	0xa2, 0x14, 0xf9, 0x44, 0xe0, 0xaa, 0xf9, 0xbc, 0x1a, 0xd3, 0xe7, 0x6d, 0x35, 0xb2, 0x89, 0xfb,
-	0xe8, 0x57, 0x06, 0x5c, 0x8a, 0xf4, 0x3f, 0x43, 0xa5, 0xac, 0x54, 0x69, 0x6f, 0x6a, 0x95, 0xbe,
-	0x30, 0xca, 0xcd, 0x59, 0x5a, 0x2d, 0x87, 0xc3, 0xa3, 0x8a, 0xc5, 0xd2, 0xce, 0xef, 0x0c, 0x40,
-	0x51, 0x9d, 0x34, 0x09, 0xeb, 0x8b, 0x9b, 0xb5, 0xb8, 0x69, 0x45, 0x91, 0xa6, 0x43, 0x65, 0xe2,
-	0x59, 0x2e, 0x14, 0x08, 0x6e, 0x5a, 0xb1, 0x6c, 0xf6, 0x8d, 0xa8, 0x38, 0x25, 0x75, 0xe0, 0xe9,
-	0x3c, 0xd1, 0xc1, 0x8c, 0xc4, 0x6e, 0x6b, 0x34, 0x90, 0x1e, 0xab, 0x3f, 0x89, 0xea, 0xc7, 0x06,
-	0x2c, 0x8f, 0xa5, 0x80, 0x50, 0x67, 0x02, 0xc8, 0x8f, 0x0d, 0xca, 0x80, 0x1a, 0x6a, 0xdd, 0x9f,
-	0x35, 0xb1, 0x2c, 0xfa, 0x63, 0xe5, 0xee, 0xff, 0x55, 0x66, 0x53, 0xd2, 0x1e, 0x7f, 0x32, 0x60,
-	0x29, 0xae, 0x4c, 0xb8, 0xbb, 0x3d, 0x98, 0x8b, 0xeb, 0xa2, 0xf7, 0xf5, 0xa5, 0x29, 0xf6, 0xa5,
-	0xb7, 0x74, 0x0a, 0x06, 0x7d, 0x2f, 0x4a, 0xc1, 0xea, 0x49, 0xf3, 0xeb, 0xd3, 0x32, 0x15, 0x68,
-	0x38, 0x9a, 0x8a, 0x53, 0xd2, 0x64, 0x3f, 0x4f, 0x42, 0xaa, 0xe5, 0x79, 0x0e, 0xfa, 0x09, 0x2c,
-	0xba, 0x1e, 0x97, 0x41, 0x4c, 0xec, 0xb6, 0x7e, 0x51, 0x51, 0xe5, 0xec, 0xbb, 0xd3, 0x11, 0xf8,
-	0xaf, 0x93, 0xf2, 0x38, 0xd4, 0x08, 0xab, 0x05, 0xd7, 0xe3, 0x0d, 0x39, 0xbe, 0xab, 0xde, 0x5b,
-	0x7c, 0x98, 0x3f, 0xbd, 0xb4, 0x2a, 0x7f, 0xaf, 0x4c, 0xbd, 0xf4, 0xfc, 0x93, 0x96, 0x9d, 0xeb,
-	0xc4, 0xd6, 0xbc, 0x96, 0x11, 0x16, 0xfd, 0xb7, 0xb0, 0xea, 0x2f, 0x0c, 0xb8, 0x28, 0x3b, 0xe9,
-	0xdb, 0x44, 0xde, 0xc7, 0x4d, 0x62, 0x79, 0xbe, 0x8d, 0xf2, 0x90, 0xa4, 0xb6, 0x64, 0x21, 0x65,
-	0x26, 0xa9, 0x8d, 0x96, 0xe0, 0x82, 0xf7, 0x96, 0x4b, 0x7c, 0xfd, 0xec, 0xa7, 0x1a, 0xb2, 0xde,
-	0x78, 0xf6, 0xc0, 0x21, 0x6d, 0x6c, 0x59, 0xde, 0xc0, 0xe5, 0xfa, 0xe9, 0x6f, 0x5e, 0xf5, 0x6e,
-	0xa8, 0x4e, 0x74, 0x09, 0xb2, 0x61, 0xc4, 0xeb, 0x97, 0xbf, 0xa8, 0x43, 0xbb, 0xd7, 0x0f, 0xa0,
-	0xda, 0x22, 0xaa, 0x92, 0xc5, 0xd5, 0xd9, 0x18, 0xf0, 0x03, 0xcf, 0xa7, 0x6f, 0x4b, 0xab, 0x3e,
-	0xf3, 0x6b, 0xc0, 0x8b, 0xbf, 0x37, 0x00, 0xa2, 0x17, 0x34, 0xf4, 0x65, 0xf8, 0x5c, 0xe3, 0xd5,
-	0xdb, 0xcd, 0xf6, 0xce, 0xee, 0xc6, 0xee, 0xde, 0x4e, 0x7b, 0xef, 0xf6, 0x4e, 0x6b, 0x6b, 0x73,
-	0xfb, 0xfa, 0xf6, 0x56, 0x73, 0x21, 0x51, 0x2a, 0x1c, 0xdf, 0xaf, 0xe4, 0xf6, 0x5c, 0xd6, 0x27,
-	0x16, 0xbd, 0x43, 0x89, 0x8d, 0x5e, 0x80, 0xa5, 0xd3, 0xb3, 0x45, 0x6b, 0xab, 0xb9, 0x60, 0x94,
-	0xe6, 0x8e, 0xef, 0x57, 0x32, 0xea, 0x54, 0x4f, 0x6c, 0xb4, 0x0a, 0xcf, 0x8d, 0xcf, 0xdb, 0xbe,
-	0xfd, 0xed, 0x85, 0x64, 0x69, 0xfe, 0xf8, 0x7e, 0x25, 0x1b, 0x1e, 0xff, 0x51, 0x15, 0x50, 0x7c,
-	0xa6, 0xc6, 0x9b, 0x29, 0xc1, 0xf1, 0xfd, 0x4a, 0x5a, 0x79, 0x47, 0x29, 0xf5, 0xce, 0xaf, 0x57,
-	0x12, 0x8d, 0xd7, 0x3f, 0x78, 0xb4, 0x62, 0x3c, 0x7c, 0xb4, 0x62, 0x7c, 0xfc, 0x68, 0xc5, 0xb8,
-	0xf7, 0x78, 0x25, 0xf1, 0xf0, 0xf1, 0x4a, 0xe2, 0x2f, 0x8f, 0x57, 0x12, 0x6f, 0x7c, 0x2b, 0xe6,
-	0x18, 0xf4, 0x4d, 0x67, 0x20, 0x0a, 0x1e, 0x75, 0xad, 0xba, 0x0a, 0x12, 0xca, 0x87, 0x6b, 0x3a,
-	0x40, 0xd6, 0x94, 0x31, 0xea, 0x47, 0xc1, 0xff, 0x59, 0x94, 0xd7, 0x74, 0xd2, 0xf2, 0x60, 0xf1,
-	0xd5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x82, 0x25, 0xf9, 0xf6, 0x8f, 0x19, 0x00, 0x00,
+func (m *PendingTokenizeShareRecordTransfer) Reset()         { *m = PendingTokenizeShareRecordTransfer{} }
+func (m *PendingTokenizeShareRecordTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingTokenizeShareRecordTransfer) ProtoMessage()    {}
+func (*PendingTokenizeShareRecordTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{22}
 }
-
-func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	return StakingDescription()
+func (m *PendingTokenizeShareRecordTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
-	var gzipped = []byte{
-		// 7757 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x7d, 0x70, 0x1c, 0xc9,
-		0x75, 0x1f, 0xf6, 0x03, 0x8b, 0xdd, 0xb7, 0x8b, 0xc5, 0xa0, 0x01, 0x92, 0x4b, 0xf0, 0x08, 0xe0,
-		0xf6, 0x74, 0x77, 0x24, 0xef, 0x08, 0xde, 0xf1, 0x8e, 0xe4, 0x71, 0x69, 0xe9, 0xb2, 0x5f, 0xe4,
-		0x81, 0xc4, 0xc7, 0x6a, 0x16, 0xe0, 0x7d, 0x38, 0xa9, 0xc9, 0x60, 0xb6, 0xb1, 0x98, 0xe3, 0xec,
-		0xcc, 0x68, 0x66, 0x96, 0x24, 0x18, 0x27, 0x75, 0x8e, 0x12, 0x47, 0x66, 0xca, 0xb6, 0x1c, 0xa7,
-		0x6c, 0x59, 0x16, 0x95, 0x93, 0x65, 0x47, 0x8e, 0xa2, 0x7c, 0xd8, 0x52, 0x94, 0x38, 0xa9, 0xa4,
-		0x14, 0xa7, 0x92, 0x28, 0xae, 0x4a, 0x4a, 0xf2, 0x1f, 0xb1, 0x13, 0x27, 0x17, 0xe5, 0xa4, 0x4a,
-		0x14, 0x47, 0x8e, 0x1d, 0xe7, 0x52, 0x95, 0x94, 0x4a, 0x55, 0xa9, 0xfe, 0x9a, 0x99, 0xfd, 0xc2,
-		0x2c, 0x18, 0x9e, 0xec, 0x2a, 0xff, 0x05, 0xf4, 0xeb, 0xf7, 0x7e, 0xfd, 0xfa, 0xf5, 0xeb, 0xf7,
-		0x5e, 0xf7, 0xcc, 0x2c, 0xfc, 0xb3, 0x2b, 0xb0, 0xdc, 0xb6, 0xac, 0xb6, 0x81, 0xcf, 0xd9, 0x8e,
-		0xe5, 0x59, 0x3b, 0xdd, 0xdd, 0x73, 0x2d, 0xec, 0x6a, 0x8e, 0x6e, 0x7b, 0x96, 0xb3, 0x42, 0x69,
-		0x68, 0x86, 0x71, 0xac, 0x08, 0x8e, 0xe2, 0x3a, 0xcc, 0x5e, 0xd5, 0x0d, 0x5c, 0xf3, 0x19, 0x9b,
-		0xd8, 0x43, 0x2f, 0x41, 0x72, 0x57, 0x37, 0x70, 0x21, 0xb6, 0x9c, 0x38, 0x95, 0x3d, 0xff, 0x81,
-		0x95, 0x3e, 0xa1, 0x95, 0x5e, 0x89, 0x06, 0x21, 0xcb, 0x54, 0xa2, 0xf8, 0xad, 0x24, 0xcc, 0x0d,
-		0xe9, 0x45, 0x08, 0x92, 0xa6, 0xda, 0x21, 0x88, 0xb1, 0x53, 0x19, 0x99, 0xfe, 0x8f, 0x0a, 0x30,
-		0x65, 0xab, 0xda, 0x2d, 0xb5, 0x8d, 0x0b, 0x71, 0x4a, 0x16, 0x4d, 0xb4, 0x08, 0xd0, 0xc2, 0x36,
-		0x36, 0x5b, 0xd8, 0xd4, 0xf6, 0x0b, 0x89, 0xe5, 0xc4, 0xa9, 0x8c, 0x1c, 0xa2, 0xa0, 0x67, 0x60,
-		0xd6, 0xee, 0xee, 0x18, 0xba, 0xa6, 0x84, 0xd8, 0x60, 0x39, 0x71, 0x6a, 0x52, 0x96, 0x58, 0x47,
-		0x2d, 0x60, 0x7e, 0x1a, 0x66, 0xee, 0x60, 0xf5, 0x56, 0x98, 0x35, 0x4b, 0x59, 0xf3, 0x84, 0x1c,
-		0x62, 0xac, 0x42, 0xae, 0x83, 0x5d, 0x57, 0x6d, 0x63, 0xc5, 0xdb, 0xb7, 0x71, 0x21, 0x49, 0x67,
-		0xbf, 0x3c, 0x30, 0xfb, 0xfe, 0x99, 0x67, 0xb9, 0xd4, 0xd6, 0xbe, 0x8d, 0x51, 0x19, 0x32, 0xd8,
-		0xec, 0x76, 0x18, 0xc2, 0xe4, 0x08, 0xfb, 0xd5, 0xcd, 0x6e, 0xa7, 0x1f, 0x25, 0x4d, 0xc4, 0x38,
-		0xc4, 0x94, 0x8b, 0x9d, 0xdb, 0xba, 0x86, 0x0b, 0x29, 0x0a, 0xf0, 0xf4, 0x00, 0x40, 0x93, 0xf5,
-		0xf7, 0x63, 0x08, 0x39, 0x54, 0x85, 0x0c, 0xbe, 0xeb, 0x61, 0xd3, 0xd5, 0x2d, 0xb3, 0x30, 0x45,
-		0x41, 0x9e, 0x1c, 0xb2, 0x8a, 0xd8, 0x68, 0xf5, 0x43, 0x04, 0x72, 0xe8, 0x22, 0x4c, 0x59, 0xb6,
-		0xa7, 0x5b, 0xa6, 0x5b, 0x48, 0x2f, 0xc7, 0x4e, 0x65, 0xcf, 0x3f, 0x36, 0xd4, 0x11, 0x36, 0x19,
-		0x8f, 0x2c, 0x98, 0xd1, 0x2a, 0x48, 0xae, 0xd5, 0x75, 0x34, 0xac, 0x68, 0x56, 0x0b, 0x2b, 0xba,
-		0xb9, 0x6b, 0x15, 0x32, 0x14, 0x60, 0x69, 0x70, 0x22, 0x94, 0xb1, 0x6a, 0xb5, 0xf0, 0xaa, 0xb9,
-		0x6b, 0xc9, 0x79, 0xb7, 0xa7, 0x8d, 0x8e, 0x42, 0xca, 0xdd, 0x37, 0x3d, 0xf5, 0x6e, 0x21, 0x47,
-		0x3d, 0x84, 0xb7, 0x8a, 0xbf, 0x9a, 0x82, 0x99, 0x71, 0x5c, 0xec, 0x0a, 0x4c, 0xee, 0x92, 0x59,
-		0x16, 0xe2, 0x87, 0xb1, 0x01, 0x93, 0xe9, 0x35, 0x62, 0xea, 0x21, 0x8d, 0x58, 0x86, 0xac, 0x89,
-		0x5d, 0x0f, 0xb7, 0x98, 0x47, 0x24, 0xc6, 0xf4, 0x29, 0x60, 0x42, 0x83, 0x2e, 0x95, 0x7c, 0x28,
-		0x97, 0x7a, 0x0d, 0x66, 0x7c, 0x95, 0x14, 0x47, 0x35, 0xdb, 0xc2, 0x37, 0xcf, 0x45, 0x69, 0xb2,
-		0x52, 0x17, 0x72, 0x32, 0x11, 0x93, 0xf3, 0xb8, 0xa7, 0x8d, 0x6a, 0x00, 0x96, 0x89, 0xad, 0x5d,
-		0xa5, 0x85, 0x35, 0xa3, 0x90, 0x1e, 0x61, 0xa5, 0x4d, 0xc2, 0x32, 0x60, 0x25, 0x8b, 0x51, 0x35,
-		0x03, 0x5d, 0x0e, 0x5c, 0x6d, 0x6a, 0x84, 0xa7, 0xac, 0xb3, 0x4d, 0x36, 0xe0, 0x6d, 0xdb, 0x90,
-		0x77, 0x30, 0xf1, 0x7b, 0xdc, 0xe2, 0x33, 0xcb, 0x50, 0x25, 0x56, 0x22, 0x67, 0x26, 0x73, 0x31,
-		0x36, 0xb1, 0x69, 0x27, 0xdc, 0x44, 0x4f, 0x80, 0x4f, 0x50, 0xa8, 0x5b, 0x01, 0x8d, 0x42, 0x39,
-		0x41, 0xdc, 0x50, 0x3b, 0x78, 0xe1, 0x1e, 0xe4, 0x7b, 0xcd, 0x83, 0xe6, 0x61, 0xd2, 0xf5, 0x54,
-		0xc7, 0xa3, 0x5e, 0x38, 0x29, 0xb3, 0x06, 0x92, 0x20, 0x81, 0xcd, 0x16, 0x8d, 0x72, 0x93, 0x32,
-		0xf9, 0x17, 0xfd, 0x89, 0x60, 0xc2, 0x09, 0x3a, 0xe1, 0xa7, 0x06, 0x57, 0xb4, 0x07, 0xb9, 0x7f,
-		0xde, 0x0b, 0x97, 0x60, 0xba, 0x67, 0x02, 0xe3, 0x0e, 0x5d, 0xfc, 0x21, 0x38, 0x32, 0x14, 0x1a,
-		0xbd, 0x06, 0xf3, 0x5d, 0x53, 0x37, 0x3d, 0xec, 0xd8, 0x0e, 0x26, 0x1e, 0xcb, 0x86, 0x2a, 0xfc,
-		0xd7, 0xa9, 0x11, 0x3e, 0xb7, 0x1d, 0xe6, 0x66, 0x28, 0xf2, 0x5c, 0x77, 0x90, 0x78, 0x26, 0x93,
-		0xfe, 0xf6, 0x94, 0xf4, 0xd6, 0x5b, 0x6f, 0xbd, 0x15, 0x2f, 0xfe, 0xd3, 0x14, 0xcc, 0x0f, 0xdb,
-		0x33, 0x43, 0xb7, 0xef, 0x51, 0x48, 0x99, 0xdd, 0xce, 0x0e, 0x76, 0xa8, 0x91, 0x26, 0x65, 0xde,
-		0x42, 0x65, 0x98, 0x34, 0xd4, 0x1d, 0x6c, 0x14, 0x92, 0xcb, 0xb1, 0x53, 0xf9, 0xf3, 0xcf, 0x8c,
-		0xb5, 0x2b, 0x57, 0xd6, 0x88, 0x88, 0xcc, 0x24, 0xd1, 0x87, 0x20, 0xc9, 0x43, 0x34, 0x41, 0x38,
-		0x33, 0x1e, 0x02, 0xd9, 0x4b, 0x32, 0x95, 0x43, 0x27, 0x20, 0x43, 0xfe, 0x32, 0xdf, 0x48, 0x51,
-		0x9d, 0xd3, 0x84, 0x40, 0xfc, 0x02, 0x2d, 0x40, 0x9a, 0x6e, 0x93, 0x16, 0x16, 0xa9, 0xcd, 0x6f,
-		0x13, 0xc7, 0x6a, 0xe1, 0x5d, 0xb5, 0x6b, 0x78, 0xca, 0x6d, 0xd5, 0xe8, 0x62, 0xea, 0xf0, 0x19,
-		0x39, 0xc7, 0x89, 0x37, 0x09, 0x0d, 0x2d, 0x41, 0x96, 0xed, 0x2a, 0xdd, 0x6c, 0xe1, 0xbb, 0x34,
-		0x7a, 0x4e, 0xca, 0x6c, 0xa3, 0xad, 0x12, 0x0a, 0x19, 0xfe, 0x4d, 0xd7, 0x32, 0x85, 0x6b, 0xd2,
-		0x21, 0x08, 0x81, 0x0e, 0x7f, 0xa9, 0x3f, 0x70, 0x9f, 0x1c, 0x3e, 0xbd, 0x81, 0xbd, 0xf4, 0x34,
-		0xcc, 0x50, 0x8e, 0x17, 0xf8, 0xd2, 0xab, 0x46, 0x61, 0x76, 0x39, 0x76, 0x2a, 0x2d, 0xe7, 0x19,
-		0x79, 0x93, 0x53, 0x8b, 0x5f, 0x8e, 0x43, 0x92, 0x06, 0x96, 0x19, 0xc8, 0x6e, 0xbd, 0xde, 0xa8,
-		0x2b, 0xb5, 0xcd, 0xed, 0xca, 0x5a, 0x5d, 0x8a, 0xa1, 0x3c, 0x00, 0x25, 0x5c, 0x5d, 0xdb, 0x2c,
-		0x6f, 0x49, 0x71, 0xbf, 0xbd, 0xba, 0xb1, 0x75, 0xf1, 0x45, 0x29, 0xe1, 0x0b, 0x6c, 0x33, 0x42,
-		0x32, 0xcc, 0xf0, 0xc2, 0x79, 0x69, 0x12, 0x49, 0x90, 0x63, 0x00, 0xab, 0xaf, 0xd5, 0x6b, 0x17,
-		0x5f, 0x94, 0x52, 0xbd, 0x94, 0x17, 0xce, 0x4b, 0x53, 0x68, 0x1a, 0x32, 0x94, 0x52, 0xd9, 0xdc,
-		0x5c, 0x93, 0xd2, 0x3e, 0x66, 0x73, 0x4b, 0x5e, 0xdd, 0xb8, 0x26, 0x65, 0x7c, 0xcc, 0x6b, 0xf2,
-		0xe6, 0x76, 0x43, 0x02, 0x1f, 0x61, 0xbd, 0xde, 0x6c, 0x96, 0xaf, 0xd5, 0xa5, 0xac, 0xcf, 0x51,
-		0x79, 0x7d, 0xab, 0xde, 0x94, 0x72, 0x3d, 0x6a, 0xbd, 0x70, 0x5e, 0x9a, 0xf6, 0x87, 0xa8, 0x6f,
-		0x6c, 0xaf, 0x4b, 0x79, 0x34, 0x0b, 0xd3, 0x6c, 0x08, 0xa1, 0xc4, 0x4c, 0x1f, 0xe9, 0xe2, 0x8b,
-		0x92, 0x14, 0x28, 0xc2, 0x50, 0x66, 0x7b, 0x08, 0x17, 0x5f, 0x94, 0x50, 0xb1, 0x0a, 0x93, 0xd4,
-		0x0d, 0x11, 0x82, 0xfc, 0x5a, 0xb9, 0x52, 0x5f, 0x53, 0x36, 0x1b, 0x5b, 0xab, 0x9b, 0x1b, 0xe5,
-		0x35, 0x29, 0x16, 0xd0, 0xe4, 0xfa, 0x87, 0xb7, 0x57, 0xe5, 0x7a, 0x4d, 0x8a, 0x87, 0x69, 0x8d,
-		0x7a, 0x79, 0xab, 0x5e, 0x93, 0x12, 0x45, 0x0d, 0xe6, 0x87, 0x05, 0xd4, 0xa1, 0x5b, 0x28, 0xe4,
-		0x0b, 0xf1, 0x11, 0xbe, 0x40, 0xb1, 0xfa, 0x7d, 0xa1, 0xf8, 0xcd, 0x38, 0xcc, 0x0d, 0x49, 0x2a,
-		0x43, 0x07, 0x79, 0x19, 0x26, 0x99, 0x2f, 0xb3, 0x34, 0x7b, 0x7a, 0x68, 0x76, 0xa2, 0x9e, 0x3d,
-		0x90, 0x6a, 0xa9, 0x5c, 0xb8, 0xd4, 0x48, 0x8c, 0x28, 0x35, 0x08, 0xc4, 0x80, 0xc3, 0xfe, 0xa9,
-		0x81, 0xe0, 0xcf, 0xf2, 0xe3, 0xc5, 0x71, 0xf2, 0x23, 0xa5, 0x1d, 0x2e, 0x09, 0x4c, 0x0e, 0x49,
-		0x02, 0x57, 0x60, 0x76, 0x00, 0x68, 0xec, 0x60, 0xfc, 0xd1, 0x18, 0x14, 0x46, 0x19, 0x27, 0x22,
-		0x24, 0xc6, 0x7b, 0x42, 0xe2, 0x95, 0x7e, 0x0b, 0x3e, 0x3e, 0x7a, 0x11, 0x06, 0xd6, 0xfa, 0x73,
-		0x31, 0x38, 0x3a, 0xbc, 0xa4, 0x1c, 0xaa, 0xc3, 0x87, 0x20, 0xd5, 0xc1, 0xde, 0x9e, 0x25, 0xca,
-		0xaa, 0xa7, 0x86, 0x24, 0x6b, 0xd2, 0xdd, 0xbf, 0xd8, 0x5c, 0x2a, 0x9c, 0xed, 0x13, 0xa3, 0xea,
-		0x42, 0xa6, 0xcd, 0x80, 0xa6, 0x3f, 0x1a, 0x87, 0x23, 0x43, 0xc1, 0x87, 0x2a, 0x7a, 0x12, 0x40,
-		0x37, 0xed, 0xae, 0xc7, 0x4a, 0x27, 0x16, 0x89, 0x33, 0x94, 0x42, 0x83, 0x17, 0x89, 0xb2, 0x5d,
-		0xcf, 0xef, 0x4f, 0xd0, 0x7e, 0x60, 0x24, 0xca, 0xf0, 0x52, 0xa0, 0x68, 0x92, 0x2a, 0xba, 0x38,
-		0x62, 0xa6, 0x03, 0x8e, 0xf9, 0x1c, 0x48, 0x9a, 0xa1, 0x63, 0xd3, 0x53, 0x5c, 0xcf, 0xc1, 0x6a,
-		0x47, 0x37, 0xdb, 0x34, 0xd5, 0xa4, 0x4b, 0x93, 0xbb, 0xaa, 0xe1, 0x62, 0x79, 0x86, 0x75, 0x37,
-		0x45, 0x2f, 0x91, 0xa0, 0x0e, 0xe4, 0x84, 0x24, 0x52, 0x3d, 0x12, 0xac, 0xdb, 0x97, 0x28, 0xfe,
-		0x64, 0x06, 0xb2, 0xa1, 0x02, 0x1c, 0x3d, 0x0e, 0xb9, 0x37, 0xd5, 0xdb, 0xaa, 0x22, 0x0e, 0x55,
-		0xcc, 0x12, 0x59, 0x42, 0x6b, 0xf0, 0x83, 0xd5, 0x73, 0x30, 0x4f, 0x59, 0xac, 0xae, 0x87, 0x1d,
-		0x45, 0x33, 0x54, 0xd7, 0xa5, 0x46, 0x4b, 0x53, 0x56, 0x44, 0xfa, 0x36, 0x49, 0x57, 0x55, 0xf4,
-		0xa0, 0x0b, 0x30, 0x47, 0x25, 0x3a, 0x5d, 0xc3, 0xd3, 0x6d, 0x03, 0x2b, 0xe4, 0x98, 0xe7, 0xd2,
-		0x94, 0xe3, 0x6b, 0x36, 0x4b, 0x38, 0xd6, 0x39, 0x03, 0xd1, 0xc8, 0x45, 0x35, 0x38, 0x49, 0xc5,
-		0xda, 0xd8, 0xc4, 0x8e, 0xea, 0x61, 0x05, 0x7f, 0xa4, 0xab, 0x1a, 0xae, 0xa2, 0x9a, 0x2d, 0x65,
-		0x4f, 0x75, 0xf7, 0x0a, 0xf3, 0x04, 0xa0, 0x12, 0x2f, 0xc4, 0xe4, 0xe3, 0x84, 0xf1, 0x1a, 0xe7,
-		0xab, 0x53, 0xb6, 0xb2, 0xd9, 0x7a, 0x45, 0x75, 0xf7, 0x50, 0x09, 0x8e, 0x52, 0x14, 0xd7, 0x73,
-		0x74, 0xb3, 0xad, 0x68, 0x7b, 0x58, 0xbb, 0xa5, 0x74, 0xbd, 0xdd, 0x97, 0x0a, 0x27, 0xc2, 0xe3,
-		0x53, 0x0d, 0x9b, 0x94, 0xa7, 0x4a, 0x58, 0xb6, 0xbd, 0xdd, 0x97, 0x50, 0x13, 0x72, 0x64, 0x31,
-		0x3a, 0xfa, 0x3d, 0xac, 0xec, 0x5a, 0x0e, 0xcd, 0xa1, 0xf9, 0x21, 0xa1, 0x29, 0x64, 0xc1, 0x95,
-		0x4d, 0x2e, 0xb0, 0x6e, 0xb5, 0x70, 0x69, 0xb2, 0xd9, 0xa8, 0xd7, 0x6b, 0x72, 0x56, 0xa0, 0x5c,
-		0xb5, 0x1c, 0xe2, 0x50, 0x6d, 0xcb, 0x37, 0x70, 0x96, 0x39, 0x54, 0xdb, 0x12, 0xe6, 0xbd, 0x00,
-		0x73, 0x9a, 0xc6, 0xe6, 0xac, 0x6b, 0x0a, 0x3f, 0x8c, 0xb9, 0x05, 0xa9, 0xc7, 0x58, 0x9a, 0x76,
-		0x8d, 0x31, 0x70, 0x1f, 0x77, 0xd1, 0x65, 0x38, 0x12, 0x18, 0x2b, 0x2c, 0x38, 0x3b, 0x30, 0xcb,
-		0x7e, 0xd1, 0x0b, 0x30, 0x67, 0xef, 0x0f, 0x0a, 0xa2, 0x9e, 0x11, 0xed, 0xfd, 0x7e, 0xb1, 0x4b,
-		0x30, 0x6f, 0xef, 0xd9, 0x83, 0x72, 0x67, 0xc2, 0x72, 0xc8, 0xde, 0xb3, 0xfb, 0x05, 0x9f, 0xa4,
-		0x27, 0x73, 0x07, 0x6b, 0xaa, 0x87, 0x5b, 0x85, 0x63, 0x61, 0xf6, 0x50, 0x07, 0x5a, 0x01, 0x49,
-		0xd3, 0x14, 0x6c, 0xaa, 0x3b, 0x06, 0x56, 0x54, 0x07, 0x9b, 0xaa, 0x5b, 0x58, 0xa2, 0xcc, 0x49,
-		0xcf, 0xe9, 0x62, 0x39, 0xaf, 0x69, 0x75, 0xda, 0x59, 0xa6, 0x7d, 0xe8, 0x0c, 0xcc, 0x5a, 0x3b,
-		0x6f, 0x6a, 0xcc, 0x23, 0x15, 0xdb, 0xc1, 0xbb, 0xfa, 0xdd, 0xc2, 0x07, 0xa8, 0x79, 0x67, 0x48,
-		0x07, 0xf5, 0xc7, 0x06, 0x25, 0xa3, 0xd3, 0x20, 0x69, 0xee, 0x9e, 0xea, 0xd8, 0x34, 0x24, 0xbb,
-		0xb6, 0xaa, 0xe1, 0xc2, 0x93, 0x8c, 0x95, 0xd1, 0x37, 0x04, 0x99, 0xec, 0x08, 0xf7, 0x8e, 0xbe,
-		0xeb, 0x09, 0xc4, 0xa7, 0xd9, 0x8e, 0xa0, 0x34, 0x8e, 0x76, 0x0a, 0x24, 0x62, 0x89, 0x9e, 0x81,
-		0x4f, 0x51, 0xb6, 0xbc, 0xbd, 0x67, 0x87, 0xc7, 0x7d, 0x02, 0xa6, 0x09, 0x67, 0x30, 0xe8, 0x69,
-		0x56, 0xb8, 0xd9, 0x7b, 0xa1, 0x11, 0x5f, 0x84, 0xa3, 0x84, 0xa9, 0x83, 0x3d, 0xb5, 0xa5, 0x7a,
-		0x6a, 0x88, 0xfb, 0x59, 0xca, 0x4d, 0xcc, 0xbe, 0xce, 0x3b, 0x7b, 0xf4, 0x74, 0xba, 0x3b, 0xfb,
-		0xbe, 0x63, 0x9d, 0x65, 0x7a, 0x12, 0x9a, 0x70, 0xad, 0xf7, 0xad, 0x38, 0x2f, 0x96, 0x20, 0x17,
-		0xf6, 0x7b, 0x94, 0x01, 0xe6, 0xf9, 0x52, 0x8c, 0x14, 0x41, 0xd5, 0xcd, 0x1a, 0x29, 0x5f, 0xde,
-		0xa8, 0x4b, 0x71, 0x52, 0x46, 0xad, 0xad, 0x6e, 0xd5, 0x15, 0x79, 0x7b, 0x63, 0x6b, 0x75, 0xbd,
-		0x2e, 0x25, 0x42, 0x85, 0xfd, 0xf5, 0x64, 0xfa, 0x29, 0xe9, 0x69, 0x52, 0x35, 0xe4, 0x7b, 0x4f,
-		0x6a, 0xe8, 0x07, 0xe0, 0x98, 0xb8, 0x56, 0x71, 0xb1, 0xa7, 0xdc, 0xd1, 0x1d, 0xba, 0x21, 0x3b,
-		0x2a, 0x4b, 0x8e, 0xbe, 0xff, 0xcc, 0x73, 0xae, 0x26, 0xf6, 0x5e, 0xd5, 0x1d, 0xb2, 0xdd, 0x3a,
-		0xaa, 0x87, 0xd6, 0x60, 0xc9, 0xb4, 0x14, 0xd7, 0x53, 0xcd, 0x96, 0xea, 0xb4, 0x94, 0xe0, 0x42,
-		0x4b, 0x51, 0x35, 0x0d, 0xbb, 0xae, 0xc5, 0x12, 0xa1, 0x8f, 0xf2, 0x98, 0x69, 0x35, 0x39, 0x73,
-		0x90, 0x21, 0xca, 0x9c, 0xb5, 0xcf, 0x7d, 0x13, 0xa3, 0xdc, 0xf7, 0x04, 0x64, 0x3a, 0xaa, 0xad,
-		0x60, 0xd3, 0x73, 0xf6, 0x69, 0x7d, 0x9e, 0x96, 0xd3, 0x1d, 0xd5, 0xae, 0x93, 0xf6, 0xf7, 0xe5,
-		0x98, 0x74, 0x3d, 0x99, 0x4e, 0x4a, 0x93, 0xd7, 0x93, 0xe9, 0x49, 0x29, 0x75, 0x3d, 0x99, 0x4e,
-		0x49, 0x53, 0xd7, 0x93, 0xe9, 0xb4, 0x94, 0xb9, 0x9e, 0x4c, 0x67, 0x24, 0x28, 0xbe, 0x9b, 0x80,
-		0x5c, 0xb8, 0x82, 0x27, 0x07, 0x22, 0x8d, 0xe6, 0xb0, 0x18, 0x8d, 0x72, 0x4f, 0x1c, 0x58, 0xef,
-		0xaf, 0x54, 0x49, 0x72, 0x2b, 0xa5, 0x58, 0xb9, 0x2c, 0x33, 0x49, 0x52, 0x58, 0x10, 0xf7, 0xc3,
-		0xac, 0x3c, 0x49, 0xcb, 0xbc, 0x85, 0xae, 0x41, 0xea, 0x4d, 0x97, 0x62, 0xa7, 0x28, 0xf6, 0x07,
-		0x0e, 0xc6, 0xbe, 0xde, 0xa4, 0xe0, 0x99, 0xeb, 0x4d, 0x65, 0x63, 0x53, 0x5e, 0x2f, 0xaf, 0xc9,
-		0x5c, 0x1c, 0x1d, 0x87, 0xa4, 0xa1, 0xde, 0xdb, 0xef, 0x4d, 0x83, 0x94, 0x34, 0xee, 0xb2, 0x1c,
-		0x87, 0xe4, 0x1d, 0xac, 0xde, 0xea, 0x4d, 0x3e, 0x94, 0xf4, 0x3e, 0x6e, 0x8f, 0x73, 0x30, 0x49,
-		0xed, 0x85, 0x00, 0xb8, 0xc5, 0xa4, 0x09, 0x94, 0x86, 0x64, 0x75, 0x53, 0x26, 0x5b, 0x44, 0x82,
-		0x1c, 0xa3, 0x2a, 0x8d, 0xd5, 0x7a, 0xb5, 0x2e, 0xc5, 0x8b, 0x17, 0x20, 0xc5, 0x8c, 0x40, 0xb6,
-		0x8f, 0x6f, 0x06, 0x69, 0x82, 0x37, 0x39, 0x46, 0x4c, 0xf4, 0x6e, 0xaf, 0x57, 0xea, 0xb2, 0x14,
-		0x1f, 0x58, 0xfc, 0xa2, 0x0b, 0xb9, 0x70, 0x65, 0xfe, 0xfd, 0x39, 0x9e, 0x7f, 0x25, 0x06, 0xd9,
-		0x50, 0xa5, 0x4d, 0x4a, 0x24, 0xd5, 0x30, 0xac, 0x3b, 0x8a, 0x6a, 0xe8, 0xaa, 0xcb, 0x5d, 0x03,
-		0x28, 0xa9, 0x4c, 0x28, 0xe3, 0x2e, 0xdd, 0xf7, 0x69, 0xd3, 0x4c, 0x4a, 0xa9, 0xe2, 0xa7, 0x63,
-		0x20, 0xf5, 0x97, 0xba, 0x7d, 0x6a, 0xc6, 0xfe, 0x30, 0xd5, 0x2c, 0x7e, 0x2a, 0x06, 0xf9, 0xde,
-		0xfa, 0xb6, 0x4f, 0xbd, 0xc7, 0xff, 0x50, 0xd5, 0xfb, 0x46, 0x1c, 0xa6, 0x7b, 0xaa, 0xda, 0x71,
-		0xb5, 0xfb, 0x08, 0xcc, 0xea, 0x2d, 0xdc, 0xb1, 0x2d, 0x0f, 0x9b, 0xda, 0xbe, 0x62, 0xe0, 0xdb,
-		0xd8, 0x28, 0x14, 0x69, 0xd0, 0x38, 0x77, 0x70, 0xdd, 0xbc, 0xb2, 0x1a, 0xc8, 0xad, 0x11, 0xb1,
-		0xd2, 0xdc, 0x6a, 0xad, 0xbe, 0xde, 0xd8, 0xdc, 0xaa, 0x6f, 0x54, 0x5f, 0x57, 0xb6, 0x37, 0x6e,
-		0x6c, 0x6c, 0xbe, 0xba, 0x21, 0x4b, 0x7a, 0x1f, 0xdb, 0xfb, 0xb8, 0xed, 0x1b, 0x20, 0xf5, 0x2b,
-		0x85, 0x8e, 0xc1, 0x30, 0xb5, 0xa4, 0x09, 0x34, 0x07, 0x33, 0x1b, 0x9b, 0x4a, 0x73, 0xb5, 0x56,
-		0x57, 0xea, 0x57, 0xaf, 0xd6, 0xab, 0x5b, 0x4d, 0x76, 0x13, 0xe2, 0x73, 0x6f, 0xf5, 0x6c, 0xf0,
-		0xe2, 0x27, 0x13, 0x30, 0x37, 0x44, 0x13, 0x54, 0xe6, 0x67, 0x18, 0x76, 0xac, 0x3a, 0x3b, 0x8e,
-		0xf6, 0x2b, 0xa4, 0x8a, 0x68, 0xa8, 0x8e, 0xc7, 0x8f, 0x3c, 0xa7, 0x81, 0x58, 0xc9, 0xf4, 0xf4,
-		0x5d, 0x1d, 0x3b, 0xfc, 0x86, 0x89, 0x1d, 0x6c, 0x66, 0x02, 0x3a, 0xbb, 0x64, 0x7a, 0x16, 0x90,
-		0x6d, 0xb9, 0xba, 0xa7, 0xdf, 0xc6, 0x8a, 0x6e, 0x8a, 0xeb, 0x28, 0x72, 0xd0, 0x49, 0xca, 0x92,
-		0xe8, 0x59, 0x35, 0x3d, 0x9f, 0xdb, 0xc4, 0x6d, 0xb5, 0x8f, 0x9b, 0x04, 0xf3, 0x84, 0x2c, 0x89,
-		0x1e, 0x9f, 0xfb, 0x71, 0xc8, 0xb5, 0xac, 0x2e, 0xa9, 0xfe, 0x18, 0x1f, 0xc9, 0x1d, 0x31, 0x39,
-		0xcb, 0x68, 0x3e, 0x0b, 0xaf, 0xeb, 0x83, 0x7b, 0xb0, 0x9c, 0x9c, 0x65, 0x34, 0xc6, 0xf2, 0x34,
-		0xcc, 0xa8, 0xed, 0xb6, 0x43, 0xc0, 0x05, 0x10, 0x3b, 0xa9, 0xe4, 0x7d, 0x32, 0x65, 0x5c, 0xb8,
-		0x0e, 0x69, 0x61, 0x07, 0x92, 0xbc, 0x89, 0x25, 0x14, 0x9b, 0x1d, 0xbf, 0xe3, 0xa7, 0x32, 0x72,
-		0xda, 0x14, 0x9d, 0x8f, 0x43, 0x4e, 0x77, 0x95, 0xe0, 0x5a, 0x3f, 0xbe, 0x1c, 0x3f, 0x95, 0x96,
-		0xb3, 0xba, 0xeb, 0x5f, 0x89, 0x16, 0x3f, 0x17, 0x87, 0x7c, 0xef, 0x63, 0x09, 0x54, 0x83, 0xb4,
-		0x61, 0x69, 0x2a, 0x75, 0x2d, 0xf6, 0x4c, 0xec, 0x54, 0xc4, 0x93, 0x8c, 0x95, 0x35, 0xce, 0x2f,
-		0xfb, 0x92, 0x0b, 0xff, 0x26, 0x06, 0x69, 0x41, 0x46, 0x47, 0x21, 0x69, 0xab, 0xde, 0x1e, 0x85,
-		0x9b, 0xac, 0xc4, 0xa5, 0x98, 0x4c, 0xdb, 0x84, 0xee, 0xda, 0xaa, 0x49, 0x5d, 0x80, 0xd3, 0x49,
-		0x9b, 0xac, 0xab, 0x81, 0xd5, 0x16, 0x3d, 0x06, 0x59, 0x9d, 0x0e, 0x36, 0x3d, 0x57, 0xac, 0x2b,
-		0xa7, 0x57, 0x39, 0x19, 0x3d, 0x03, 0xb3, 0x9e, 0xa3, 0xea, 0x46, 0x0f, 0x6f, 0x92, 0xf2, 0x4a,
-		0xa2, 0xc3, 0x67, 0x2e, 0xc1, 0x71, 0x81, 0xdb, 0xc2, 0x9e, 0xaa, 0xed, 0xe1, 0x56, 0x20, 0x94,
-		0xa2, 0xd7, 0x1d, 0xc7, 0x38, 0x43, 0x8d, 0xf7, 0x0b, 0xd9, 0xe2, 0xd7, 0x63, 0x30, 0x2b, 0x0e,
-		0x6e, 0x2d, 0xdf, 0x58, 0xeb, 0x00, 0xaa, 0x69, 0x5a, 0x5e, 0xd8, 0x5c, 0x83, 0xae, 0x3c, 0x20,
-		0xb7, 0x52, 0xf6, 0x85, 0xe4, 0x10, 0xc0, 0x42, 0x07, 0x20, 0xe8, 0x19, 0x69, 0xb6, 0x25, 0xc8,
-		0xf2, 0x67, 0x4e, 0xf4, 0xc1, 0x25, 0x3b, 0xea, 0x03, 0x23, 0x91, 0x13, 0x1e, 0x9a, 0x87, 0xc9,
-		0x1d, 0xdc, 0xd6, 0x4d, 0x7e, 0x93, 0xcc, 0x1a, 0xe2, 0x42, 0x26, 0xe9, 0x5f, 0xc8, 0x54, 0xfe,
-		0x1c, 0xcc, 0x69, 0x56, 0xa7, 0x5f, 0xdd, 0x8a, 0xd4, 0x77, 0xdd, 0xe0, 0xbe, 0x12, 0x7b, 0xe3,
-		0x2c, 0x67, 0x6a, 0x5b, 0x86, 0x6a, 0xb6, 0x57, 0x2c, 0xa7, 0x1d, 0x3c, 0x78, 0x25, 0x15, 0x8f,
-		0x1b, 0x7a, 0xfc, 0x6a, 0xef, 0xfc, 0x9f, 0x58, 0xec, 0xe7, 0xe3, 0x89, 0x6b, 0x8d, 0xca, 0xe7,
-		0xe3, 0x0b, 0xd7, 0x98, 0x60, 0x43, 0x18, 0x43, 0xc6, 0xbb, 0x06, 0xd6, 0xc8, 0x04, 0xe1, 0x77,
-		0x9f, 0x81, 0xf9, 0xb6, 0xd5, 0xb6, 0x28, 0xd2, 0x39, 0xf2, 0x1f, 0x7f, 0x72, 0x9b, 0xf1, 0xa9,
-		0x0b, 0x91, 0x8f, 0x79, 0x4b, 0x1b, 0x30, 0xc7, 0x99, 0x15, 0xfa, 0xe8, 0x88, 0x1d, 0x6c, 0xd0,
-		0x81, 0xb7, 0x6a, 0x85, 0x5f, 0xfe, 0x16, 0x4d, 0xdf, 0xf2, 0x2c, 0x17, 0x25, 0x7d, 0xec, 0xec,
-		0x53, 0x92, 0xe1, 0x48, 0x0f, 0x1e, 0xdb, 0xa4, 0xd8, 0x89, 0x40, 0xfc, 0xe7, 0x1c, 0x71, 0x2e,
-		0x84, 0xd8, 0xe4, 0xa2, 0xa5, 0x2a, 0x4c, 0x1f, 0x06, 0xeb, 0x5f, 0x70, 0xac, 0x1c, 0x0e, 0x83,
-		0x5c, 0x83, 0x19, 0x0a, 0xa2, 0x75, 0x5d, 0xcf, 0xea, 0xd0, 0x08, 0x78, 0x30, 0xcc, 0xbf, 0xfc,
-		0x16, 0xdb, 0x35, 0x79, 0x22, 0x56, 0xf5, 0xa5, 0x4a, 0x25, 0xa0, 0x4f, 0xcb, 0x5a, 0x58, 0x33,
-		0x22, 0x10, 0xbe, 0xca, 0x15, 0xf1, 0xf9, 0x4b, 0x37, 0x61, 0x9e, 0xfc, 0x4f, 0x03, 0x54, 0x58,
-		0x93, 0xe8, 0x2b, 0xb8, 0xc2, 0xd7, 0x3f, 0xca, 0x36, 0xe6, 0x9c, 0x0f, 0x10, 0xd2, 0x29, 0xb4,
-		0x8a, 0x6d, 0xec, 0x79, 0xd8, 0x71, 0x15, 0xd5, 0x18, 0xa6, 0x5e, 0xe8, 0x0e, 0xa3, 0xf0, 0xb3,
-		0xdf, 0xe9, 0x5d, 0xc5, 0x6b, 0x4c, 0xb2, 0x6c, 0x18, 0xa5, 0x6d, 0x38, 0x36, 0xc4, 0x2b, 0xc6,
-		0xc0, 0xfc, 0x24, 0xc7, 0x9c, 0x1f, 0xf0, 0x0c, 0x02, 0xdb, 0x00, 0x41, 0xf7, 0xd7, 0x72, 0x0c,
-		0xcc, 0x9f, 0xe3, 0x98, 0x88, 0xcb, 0x8a, 0x25, 0x25, 0x88, 0xd7, 0x61, 0xf6, 0x36, 0x76, 0x76,
-		0x2c, 0x97, 0xdf, 0x1b, 0x8d, 0x01, 0xf7, 0x29, 0x0e, 0x37, 0xc3, 0x05, 0xe9, 0x45, 0x12, 0xc1,
-		0xba, 0x0c, 0xe9, 0x5d, 0x55, 0xc3, 0x63, 0x40, 0x3c, 0xe0, 0x10, 0x53, 0x84, 0x9f, 0x88, 0x96,
-		0x21, 0xd7, 0xb6, 0x78, 0x8e, 0x8a, 0x16, 0xff, 0x34, 0x17, 0xcf, 0x0a, 0x19, 0x0e, 0x61, 0x5b,
-		0x76, 0xd7, 0x20, 0x09, 0x2c, 0x1a, 0xe2, 0xaf, 0x09, 0x08, 0x21, 0xc3, 0x21, 0x0e, 0x61, 0xd6,
-		0xb7, 0x05, 0x84, 0x1b, 0xb2, 0xe7, 0xcb, 0x90, 0xb5, 0x4c, 0x63, 0xdf, 0x32, 0xc7, 0x51, 0xe2,
-		0x33, 0x1c, 0x01, 0xb8, 0x08, 0x01, 0xb8, 0x02, 0x99, 0x71, 0x17, 0xe2, 0x17, 0xbf, 0x23, 0xb6,
-		0x87, 0x58, 0x81, 0x6b, 0x30, 0x23, 0x02, 0x94, 0x6e, 0x99, 0x63, 0x40, 0xfc, 0x75, 0x0e, 0x91,
-		0x0f, 0x89, 0xf1, 0x69, 0x78, 0xd8, 0xf5, 0xda, 0x78, 0x1c, 0x90, 0xcf, 0x89, 0x69, 0x70, 0x11,
-		0x6e, 0xca, 0x1d, 0x6c, 0x6a, 0x7b, 0xe3, 0x21, 0xfc, 0x92, 0x30, 0xa5, 0x90, 0x21, 0x10, 0x55,
-		0x98, 0xee, 0xa8, 0x8e, 0xbb, 0xa7, 0x1a, 0x63, 0x2d, 0xc7, 0xdf, 0xe0, 0x18, 0x39, 0x5f, 0x88,
-		0x5b, 0xa4, 0x6b, 0x1e, 0x06, 0xe6, 0xf3, 0xc2, 0x22, 0x21, 0x31, 0xbe, 0xf5, 0x5c, 0x8f, 0x5e,
-		0xb2, 0x1d, 0x06, 0xed, 0x6f, 0x8a, 0xad, 0xc7, 0x64, 0xd7, 0xc3, 0x88, 0x57, 0x20, 0xe3, 0xea,
-		0xf7, 0xc6, 0x82, 0xf9, 0x82, 0x58, 0x69, 0x2a, 0x40, 0x84, 0x5f, 0x87, 0xe3, 0x43, 0xd3, 0xc4,
-		0x18, 0x60, 0x7f, 0x8b, 0x83, 0x1d, 0x1d, 0x92, 0x2a, 0x78, 0x48, 0x38, 0x2c, 0xe4, 0xdf, 0x16,
-		0x21, 0x01, 0xf7, 0x61, 0x35, 0xc8, 0xa9, 0xc1, 0x55, 0x77, 0x0f, 0x67, 0xb5, 0xbf, 0x23, 0xac,
-		0xc6, 0x64, 0x7b, 0xac, 0xb6, 0x05, 0x47, 0x39, 0xe2, 0xe1, 0xd6, 0xf5, 0xef, 0x8a, 0xc0, 0xca,
-		0xa4, 0xb7, 0x7b, 0x57, 0xf7, 0x07, 0x61, 0xc1, 0x37, 0xa7, 0x28, 0x4f, 0x5d, 0xa5, 0xa3, 0xda,
-		0x63, 0x20, 0xff, 0x32, 0x47, 0x16, 0x11, 0xdf, 0xaf, 0x6f, 0xdd, 0x75, 0xd5, 0x26, 0xe0, 0xaf,
-		0x41, 0x41, 0x80, 0x77, 0x4d, 0x07, 0x6b, 0x56, 0xdb, 0xd4, 0xef, 0xe1, 0xd6, 0x18, 0xd0, 0xbf,
-		0xd2, 0xb7, 0x54, 0xdb, 0x21, 0x71, 0x82, 0xbc, 0x0a, 0x92, 0x5f, 0xab, 0x28, 0x7a, 0xc7, 0xb6,
-		0x1c, 0x2f, 0x02, 0xf1, 0x8b, 0x62, 0xa5, 0x7c, 0xb9, 0x55, 0x2a, 0x56, 0xaa, 0x03, 0x7b, 0xf2,
-		0x3c, 0xae, 0x4b, 0x7e, 0x89, 0x03, 0x4d, 0x07, 0x52, 0x3c, 0x70, 0x68, 0x56, 0xc7, 0x56, 0x9d,
-		0x71, 0xe2, 0xdf, 0xdf, 0x13, 0x81, 0x83, 0x8b, 0xf0, 0xc0, 0x41, 0x2a, 0x3a, 0x92, 0xed, 0xc7,
-		0x40, 0xf8, 0xb2, 0x08, 0x1c, 0x42, 0x86, 0x43, 0x88, 0x82, 0x61, 0x0c, 0x88, 0xbf, 0x2f, 0x20,
-		0x84, 0x0c, 0x81, 0xf8, 0x70, 0x90, 0x68, 0x1d, 0xdc, 0xd6, 0x5d, 0xcf, 0x61, 0x45, 0xf1, 0xc1,
-		0x50, 0xff, 0xe0, 0x3b, 0xbd, 0x45, 0x98, 0x1c, 0x12, 0x25, 0x91, 0x88, 0x5f, 0xbb, 0xd2, 0x33,
-		0x53, 0xb4, 0x62, 0xbf, 0x2a, 0x22, 0x51, 0x48, 0x8c, 0xe8, 0x16, 0xaa, 0x10, 0x89, 0xd9, 0x35,
-		0x72, 0x52, 0x18, 0x03, 0xee, 0x1f, 0xf6, 0x29, 0xd7, 0x14, 0xb2, 0x04, 0x33, 0x54, 0xff, 0x74,
-		0xcd, 0x5b, 0x78, 0x7f, 0x2c, 0xef, 0xfc, 0x47, 0x7d, 0xf5, 0xcf, 0x36, 0x93, 0x64, 0x31, 0x64,
-		0xa6, 0xaf, 0x9e, 0x42, 0x51, 0xef, 0x19, 0x15, 0x7e, 0xf8, 0x3d, 0x3e, 0xdf, 0xde, 0x72, 0xaa,
-		0xb4, 0x46, 0x9c, 0xbc, 0xb7, 0xe8, 0x89, 0x06, 0xfb, 0xe8, 0x7b, 0xbe, 0x9f, 0xf7, 0xd4, 0x3c,
-		0xa5, 0xab, 0x30, 0xdd, 0x53, 0xf0, 0x44, 0x43, 0xfd, 0x05, 0x0e, 0x95, 0x0b, 0xd7, 0x3b, 0xa5,
-		0x0b, 0x90, 0x24, 0xc5, 0x4b, 0xb4, 0xf8, 0x5f, 0xe4, 0xe2, 0x94, 0xbd, 0xf4, 0x41, 0x48, 0x8b,
-		0xa2, 0x25, 0x5a, 0xf4, 0x47, 0xb8, 0xa8, 0x2f, 0x42, 0xc4, 0x45, 0xc1, 0x12, 0x2d, 0xfe, 0x97,
-		0x84, 0xb8, 0x10, 0x21, 0xe2, 0xe3, 0x9b, 0xf0, 0x2b, 0x7f, 0x39, 0xc9, 0x93, 0x8e, 0xb0, 0xdd,
-		0x15, 0x98, 0xe2, 0x95, 0x4a, 0xb4, 0xf4, 0x8f, 0xf2, 0xc1, 0x85, 0x44, 0xe9, 0x12, 0x4c, 0x8e,
-		0x69, 0xf0, 0x1f, 0xe3, 0xa2, 0x8c, 0xbf, 0x54, 0x85, 0x6c, 0xa8, 0x3a, 0x89, 0x16, 0xff, 0x71,
-		0x2e, 0x1e, 0x96, 0x22, 0xaa, 0xf3, 0xea, 0x24, 0x1a, 0xe0, 0x27, 0x84, 0xea, 0x5c, 0x82, 0x98,
-		0x4d, 0x14, 0x26, 0xd1, 0xd2, 0x1f, 0x17, 0x56, 0x17, 0x22, 0xa5, 0x97, 0x21, 0xe3, 0x27, 0x9b,
-		0x68, 0xf9, 0x9f, 0xe4, 0xf2, 0x81, 0x0c, 0xb1, 0x40, 0x28, 0xd9, 0x45, 0x43, 0xfc, 0x15, 0x61,
-		0x81, 0x90, 0x14, 0xd9, 0x46, 0xfd, 0x05, 0x4c, 0x34, 0xd2, 0x4f, 0x89, 0x6d, 0xd4, 0x57, 0xbf,
-		0x90, 0xd5, 0xa4, 0x31, 0x3f, 0x1a, 0xe2, 0xaf, 0x8a, 0xd5, 0xa4, 0xfc, 0x44, 0x8d, 0xfe, 0x8a,
-		0x20, 0x1a, 0xe3, 0x67, 0x84, 0x1a, 0x7d, 0x05, 0x41, 0xa9, 0x01, 0x68, 0xb0, 0x1a, 0x88, 0xc6,
-		0xfb, 0x04, 0xc7, 0x9b, 0x1d, 0x28, 0x06, 0x4a, 0xaf, 0xc2, 0xd1, 0xe1, 0x95, 0x40, 0x34, 0xea,
-		0xcf, 0xbe, 0xd7, 0x77, 0x76, 0x0b, 0x17, 0x02, 0xa5, 0xad, 0x20, 0xa5, 0x84, 0xab, 0x80, 0x68,
-		0xd8, 0x4f, 0xbe, 0xd7, 0x1b, 0xb8, 0xc3, 0x45, 0x40, 0xa9, 0x0c, 0x10, 0x24, 0xe0, 0x68, 0xac,
-		0x4f, 0x71, 0xac, 0x90, 0x10, 0xd9, 0x1a, 0x3c, 0xff, 0x46, 0xcb, 0x3f, 0x10, 0x5b, 0x83, 0x4b,
-		0x90, 0xad, 0x21, 0x52, 0x6f, 0xb4, 0xf4, 0xa7, 0xc5, 0xd6, 0x10, 0x22, 0xc4, 0xb3, 0x43, 0xd9,
-		0x2d, 0x1a, 0xe1, 0x33, 0xc2, 0xb3, 0x43, 0x52, 0xa5, 0x0d, 0x98, 0x1d, 0x48, 0x88, 0xd1, 0x50,
-		0x3f, 0xcf, 0xa1, 0xa4, 0xfe, 0x7c, 0x18, 0x4e, 0x5e, 0x3c, 0x19, 0x46, 0xa3, 0x7d, 0xb6, 0x2f,
-		0x79, 0xf1, 0x5c, 0x58, 0xba, 0x02, 0x69, 0xb3, 0x6b, 0x18, 0x64, 0xf3, 0xa0, 0x83, 0xdf, 0x0d,
-		0x2c, 0xfc, 0xb7, 0xef, 0x72, 0xeb, 0x08, 0x81, 0xd2, 0x05, 0x98, 0xc4, 0x9d, 0x1d, 0xdc, 0x8a,
-		0x92, 0xfc, 0x9d, 0xef, 0x8a, 0x80, 0x49, 0xb8, 0x4b, 0x2f, 0x03, 0xb0, 0xab, 0x11, 0xfa, 0x30,
-		0x30, 0x42, 0xf6, 0xbf, 0x7f, 0x97, 0xbf, 0x8c, 0x13, 0x88, 0x04, 0x00, 0xec, 0xd5, 0x9e, 0x83,
-		0x01, 0xbe, 0xd3, 0x0b, 0x40, 0x57, 0xe4, 0x32, 0x4c, 0xbd, 0xe9, 0x5a, 0xa6, 0xa7, 0xb6, 0xa3,
-		0xa4, 0x7f, 0x97, 0x4b, 0x0b, 0x7e, 0x62, 0xb0, 0x8e, 0xe5, 0x60, 0x4f, 0x6d, 0xbb, 0x51, 0xb2,
-		0xff, 0x83, 0xcb, 0xfa, 0x02, 0x44, 0x58, 0x53, 0x5d, 0x6f, 0x9c, 0x79, 0xff, 0x9e, 0x10, 0x16,
-		0x02, 0x44, 0x69, 0xf2, 0xff, 0x2d, 0xbc, 0x1f, 0x25, 0xfb, 0xfb, 0x42, 0x69, 0xce, 0x5f, 0xfa,
-		0x20, 0x64, 0xc8, 0xbf, 0xec, 0x0d, 0xbb, 0x08, 0xe1, 0xff, 0xc9, 0x85, 0x03, 0x09, 0x32, 0xb2,
-		0xeb, 0xb5, 0x3c, 0x3d, 0xda, 0xd8, 0x7f, 0xc0, 0x57, 0x5a, 0xf0, 0x97, 0xca, 0x90, 0x75, 0xbd,
-		0x56, 0xab, 0xcb, 0xeb, 0xd3, 0x08, 0xf1, 0xff, 0xf5, 0x5d, 0xff, 0xca, 0xc2, 0x97, 0x21, 0xab,
-		0x7d, 0xe7, 0x96, 0x67, 0x5b, 0xf4, 0x81, 0x47, 0x14, 0xc2, 0x7b, 0x1c, 0x21, 0x24, 0x52, 0xaa,
-		0x42, 0x8e, 0xcc, 0xc5, 0xc1, 0x36, 0xa6, 0x4f, 0xa7, 0x22, 0x20, 0xfe, 0x37, 0x37, 0x40, 0x8f,
-		0x50, 0xe5, 0x4f, 0x7f, 0xf5, 0xdd, 0xc5, 0xd8, 0xd7, 0xde, 0x5d, 0x8c, 0x7d, 0xe3, 0xdd, 0xc5,
-		0xd8, 0xc7, 0xbf, 0xb9, 0x38, 0xf1, 0xb5, 0x6f, 0x2e, 0x4e, 0xfc, 0xd6, 0x37, 0x17, 0x27, 0x86,
-		0xdf, 0x12, 0xc3, 0x35, 0xeb, 0x9a, 0xc5, 0xee, 0x87, 0xdf, 0x78, 0xb2, 0xad, 0x7b, 0x7b, 0xdd,
-		0x9d, 0x15, 0xcd, 0xea, 0x9c, 0xd3, 0x2c, 0xb7, 0x63, 0xb9, 0xe7, 0x7a, 0xef, 0x75, 0xe9, 0x7f,
-		0xf0, 0xbd, 0x18, 0x39, 0x33, 0xf7, 0x5e, 0xe7, 0xaa, 0xe6, 0xfe, 0xa8, 0xcf, 0x75, 0x2e, 0x42,
-		0xa2, 0x6c, 0xee, 0xa3, 0xe3, 0x2c, 0xc0, 0x29, 0x5d, 0xc7, 0xe0, 0xaf, 0x79, 0x4d, 0x91, 0xf6,
-		0xb6, 0x63, 0xa0, 0xf9, 0xe0, 0x5d, 0xcc, 0xd8, 0xa9, 0x1c, 0x7f, 0xc1, 0xb2, 0xf2, 0xe3, 0xb1,
-		0xc3, 0xcd, 0x24, 0x5d, 0x36, 0xf7, 0xe9, 0x44, 0x1a, 0xb1, 0x37, 0x9e, 0x8d, 0xbc, 0xe7, 0xbe,
-		0x65, 0x5a, 0x77, 0x4c, 0xa2, 0xb6, 0xbd, 0x23, 0xee, 0xb8, 0x17, 0xfb, 0xef, 0xb8, 0x5f, 0xc5,
-		0x86, 0x71, 0x83, 0xf0, 0x6d, 0x11, 0x91, 0x9d, 0x14, 0x7b, 0xa3, 0x18, 0x7e, 0x2a, 0x0e, 0x8b,
-		0x03, 0xd7, 0xd9, 0xdc, 0x09, 0x46, 0x19, 0xa1, 0x04, 0xe9, 0x9a, 0xf0, 0xad, 0x02, 0x4c, 0xb9,
-		0x58, 0xb3, 0xcc, 0x96, 0x4b, 0x0d, 0x91, 0x90, 0x45, 0x93, 0x18, 0xc2, 0x54, 0x4d, 0xcb, 0xe5,
-		0x2f, 0x4a, 0xb2, 0x46, 0xe5, 0xe7, 0x0e, 0x69, 0x88, 0x69, 0x31, 0x92, 0xb0, 0xc6, 0xf3, 0x63,
-		0x5a, 0x43, 0x4c, 0xa2, 0xe7, 0xe6, 0x7f, 0x5c, 0xab, 0xfc, 0x4c, 0x1c, 0x96, 0xfa, 0xad, 0x42,
-		0x76, 0x96, 0xeb, 0xa9, 0x1d, 0x7b, 0x94, 0x59, 0xae, 0x40, 0x66, 0x4b, 0xf0, 0x1c, 0xda, 0x2e,
-		0x0f, 0x0e, 0x69, 0x97, 0xbc, 0x3f, 0x94, 0x30, 0xcc, 0xf9, 0x31, 0x0d, 0xe3, 0xcf, 0xe3, 0xa1,
-		0x2c, 0xf3, 0x7f, 0x53, 0x70, 0x9c, 0x6d, 0x27, 0x85, 0x6d, 0x25, 0xd6, 0xe0, 0x36, 0xc9, 0x85,
-		0xbb, 0xa2, 0x9f, 0x93, 0x14, 0x6f, 0xc0, 0xdc, 0x2a, 0x89, 0x16, 0xe4, 0x14, 0x14, 0x3c, 0xe1,
-		0x19, 0xfa, 0x2e, 0xe9, 0x72, 0x4f, 0xc1, 0xcf, 0x9f, 0x30, 0x85, 0x49, 0xc5, 0x1f, 0x8e, 0x81,
-		0xd4, 0xd4, 0x54, 0x43, 0x75, 0xfe, 0x7f, 0xa1, 0xd0, 0x25, 0x00, 0xfa, 0x0d, 0x52, 0xf0, 0xd1,
-		0x50, 0xfe, 0x7c, 0x61, 0x25, 0x3c, 0xb9, 0x15, 0x36, 0x12, 0xfd, 0x22, 0x21, 0x43, 0x79, 0xc9,
-		0xbf, 0x67, 0x5e, 0x03, 0x08, 0x3a, 0xd0, 0x09, 0x38, 0xd6, 0xac, 0x96, 0xd7, 0xca, 0xb2, 0xc2,
-		0x5e, 0x6e, 0xdf, 0x68, 0x36, 0xea, 0xd5, 0xd5, 0xab, 0xab, 0xf5, 0x9a, 0x34, 0x81, 0x8e, 0x02,
-		0x0a, 0x77, 0xfa, 0xef, 0xa5, 0x1c, 0x81, 0xd9, 0x30, 0x9d, 0xbd, 0x21, 0x1f, 0x27, 0x95, 0xa2,
-		0xde, 0xb1, 0x0d, 0x4c, 0x1f, 0xfd, 0x29, 0xba, 0xb0, 0x5a, 0x74, 0x11, 0xf2, 0xaf, 0xfe, 0x2d,
-		0x7b, 0x6b, 0x7a, 0x2e, 0x10, 0xf7, 0x6d, 0x5e, 0x5a, 0x83, 0x59, 0x55, 0xd3, 0xb0, 0xdd, 0x03,
-		0x19, 0x11, 0xaa, 0x09, 0x20, 0x7d, 0x98, 0xc9, 0x25, 0x03, 0xb4, 0x4b, 0x90, 0x72, 0xe9, 0xec,
-		0xa3, 0x20, 0x7e, 0x9d, 0x43, 0x70, 0xf6, 0x92, 0x09, 0xb3, 0xa4, 0xf2, 0x53, 0x1d, 0x1c, 0x52,
-		0xe3, 0xe0, 0x7b, 0x86, 0x7f, 0xfc, 0xc5, 0xe7, 0xe8, 0xa3, 0xcd, 0xc7, 0x7b, 0x97, 0x65, 0x88,
-		0x3b, 0xc9, 0x12, 0xc7, 0x0e, 0x14, 0xc5, 0x90, 0x17, 0xe3, 0x71, 0x85, 0x0f, 0x1e, 0xec, 0x9f,
-		0xf0, 0xc1, 0x16, 0x87, 0xf9, 0x40, 0x68, 0xa4, 0x69, 0x8e, 0xca, 0x3a, 0x2a, 0xf5, 0x51, 0x7b,
-		0xfa, 0x8d, 0x67, 0x06, 0xb3, 0x13, 0xfb, 0x73, 0x96, 0x22, 0x5f, 0x09, 0x0f, 0xe3, 0xef, 0xbd,
-		0xdf, 0x4c, 0xc0, 0x22, 0x67, 0xde, 0x51, 0x5d, 0x7c, 0xee, 0xf6, 0xf3, 0x3b, 0xd8, 0x53, 0x9f,
-		0x3f, 0xa7, 0x59, 0xba, 0x88, 0xd5, 0x73, 0x7c, 0x3b, 0x92, 0xfe, 0x15, 0xde, 0xbf, 0x30, 0xf4,
-		0x81, 0xe6, 0xc2, 0xe8, 0x6d, 0x5c, 0xdc, 0x86, 0x64, 0xd5, 0xd2, 0x4d, 0x12, 0xaa, 0x5a, 0xd8,
-		0xb4, 0x3a, 0x7c, 0xf7, 0xb0, 0x06, 0x7a, 0x1e, 0x52, 0x6a, 0xc7, 0xea, 0x9a, 0x1e, 0xdb, 0x39,
-		0x95, 0xe3, 0x5f, 0x7d, 0x67, 0x69, 0xe2, 0xdf, 0xbf, 0xb3, 0x94, 0x58, 0x35, 0xbd, 0xdf, 0xf8,
-		0xd2, 0x59, 0xe0, 0x50, 0xab, 0xa6, 0x27, 0x73, 0xc6, 0x52, 0xf2, 0xdb, 0x6f, 0x2f, 0xc5, 0x8a,
-		0xaf, 0xc1, 0x54, 0x0d, 0x6b, 0x0f, 0x83, 0x5c, 0xc3, 0x5a, 0x08, 0xb9, 0x86, 0xb5, 0x3e, 0xe4,
-		0x4b, 0x90, 0x5e, 0x35, 0x3d, 0xf6, 0x22, 0xfa, 0x33, 0x90, 0xd0, 0x4d, 0xf6, 0x6e, 0xe3, 0x81,
-		0xba, 0x11, 0x2e, 0x22, 0x58, 0xc3, 0x9a, 0x2f, 0xd8, 0xc2, 0x5a, 0xbf, 0xe0, 0xe0, 0xd0, 0x84,
-		0xab, 0x52, 0xfb, 0xad, 0xff, 0xbc, 0x38, 0xf1, 0xd6, 0xbb, 0x8b, 0x13, 0x23, 0x97, 0xb8, 0x38,
-		0x72, 0x89, 0xdd, 0xd6, 0x2d, 0x16, 0x91, 0xfd, 0x95, 0xfd, 0x7c, 0x12, 0x4e, 0xd2, 0xef, 0x93,
-		0x9c, 0x8e, 0x6e, 0x7a, 0xe7, 0x34, 0x67, 0xdf, 0xf6, 0x2c, 0x12, 0x37, 0xad, 0x5d, 0xbe, 0xb0,
-		0xb3, 0x41, 0xf7, 0x0a, 0xeb, 0x1e, 0xbe, 0xac, 0xc5, 0x5d, 0x98, 0x6c, 0x10, 0x39, 0x62, 0x62,
-		0xcf, 0xf2, 0x54, 0x83, 0xe7, 0x1f, 0xd6, 0x20, 0x54, 0xf6, 0x4d, 0x53, 0x9c, 0x51, 0x75, 0xf1,
-		0x39, 0x93, 0x81, 0xd5, 0x5d, 0xf6, 0x6a, 0x78, 0x82, 0x16, 0x2e, 0x69, 0x42, 0xa0, 0x6f, 0x81,
-		0xcf, 0xc3, 0xa4, 0xda, 0x65, 0xef, 0x30, 0x24, 0x48, 0x45, 0x43, 0x1b, 0xc5, 0x1b, 0x30, 0xc5,
-		0x9f, 0xa4, 0x22, 0x09, 0x12, 0xb7, 0xf0, 0x3e, 0x1d, 0x27, 0x27, 0x93, 0x7f, 0xd1, 0x0a, 0x4c,
-		0x52, 0xe5, 0xf9, 0x37, 0x2f, 0x85, 0x95, 0x01, 0xed, 0x57, 0xa8, 0x92, 0x32, 0x63, 0x2b, 0x5e,
-		0x87, 0x74, 0xcd, 0xea, 0xe8, 0xa6, 0xd5, 0x8b, 0x96, 0x61, 0x68, 0x54, 0x67, 0xbb, 0xcb, 0xbd,
-		0x42, 0x66, 0x0d, 0x74, 0x14, 0x52, 0xec, 0x53, 0x01, 0xfe, 0x1e, 0x06, 0x6f, 0x15, 0xab, 0x30,
-		0x45, 0xb1, 0x37, 0x6d, 0x12, 0xfc, 0xfd, 0xb7, 0x32, 0x33, 0xfc, 0xc3, 0x31, 0x0e, 0x1f, 0x0f,
-		0x94, 0x45, 0x90, 0x6c, 0xa9, 0x9e, 0xca, 0xe7, 0x4d, 0xff, 0x2f, 0x7e, 0x08, 0xd2, 0x1c, 0xc4,
-		0x45, 0xe7, 0x21, 0x61, 0xd9, 0x2e, 0x7f, 0x93, 0x62, 0x61, 0xd4, 0x54, 0x36, 0xed, 0x4a, 0x92,
-		0xf8, 0x8c, 0x4c, 0x98, 0x2b, 0xf2, 0x48, 0xb7, 0x78, 0x29, 0xe4, 0x16, 0xa1, 0x25, 0x0f, 0xfd,
-		0xcb, 0x96, 0x74, 0xc0, 0x1d, 0x7c, 0x67, 0xf9, 0x4c, 0x1c, 0x16, 0x43, 0xbd, 0xb7, 0xb1, 0xe3,
-		0xea, 0x96, 0xc9, 0x3c, 0x8a, 0x7b, 0x0b, 0x0a, 0x29, 0xc9, 0xfb, 0x47, 0xb8, 0xcb, 0x07, 0x21,
-		0x51, 0xb6, 0x6d, 0xb4, 0x00, 0x69, 0xda, 0xd6, 0x2c, 0xe6, 0x2f, 0x49, 0xd9, 0x6f, 0x93, 0x3e,
-		0xd7, 0xda, 0xf5, 0xee, 0xa8, 0x8e, 0xff, 0x35, 0x9d, 0x68, 0x17, 0x2f, 0x43, 0xa6, 0x6a, 0x99,
-		0x2e, 0x36, 0xdd, 0x2e, 0xad, 0x6c, 0x76, 0x0c, 0x4b, 0xbb, 0xc5, 0x11, 0x58, 0x83, 0x18, 0x5c,
-		0xb5, 0x6d, 0x2a, 0x99, 0x94, 0xc9, 0xbf, 0x6c, 0xcf, 0x56, 0x9a, 0x23, 0x4d, 0x74, 0xf9, 0xf0,
-		0x26, 0xe2, 0x93, 0xf4, 0x6d, 0xf4, 0xbd, 0x18, 0x3c, 0x36, 0xb8, 0xa1, 0x6e, 0xe1, 0x7d, 0xf7,
-		0xb0, 0xfb, 0xe9, 0x35, 0xc8, 0x34, 0xe8, 0x27, 0xed, 0x37, 0xf0, 0x3e, 0x5a, 0x80, 0x29, 0xdc,
-		0x3a, 0x7f, 0xe1, 0xc2, 0xf3, 0x97, 0x99, 0xb7, 0xbf, 0x32, 0x21, 0x0b, 0x02, 0x5a, 0x84, 0x8c,
-		0x8b, 0x35, 0xfb, 0xfc, 0x85, 0x8b, 0xb7, 0x9e, 0x67, 0xee, 0xf5, 0xca, 0x84, 0x1c, 0x90, 0x4a,
-		0x69, 0x32, 0xeb, 0x6f, 0x7f, 0x66, 0x29, 0x56, 0x99, 0x84, 0x84, 0xdb, 0xed, 0xbc, 0xaf, 0x3e,
-		0xf2, 0xc9, 0x49, 0x58, 0x0e, 0x4b, 0xd2, 0xfa, 0xef, 0xb6, 0x6a, 0xe8, 0x2d, 0x35, 0xf8, 0x31,
-		0x02, 0x29, 0x64, 0x03, 0xca, 0x31, 0x22, 0x53, 0x1c, 0x68, 0xc9, 0xe2, 0xaf, 0xc4, 0x20, 0x77,
-		0x53, 0x20, 0x37, 0xb1, 0x87, 0xae, 0x00, 0xf8, 0x23, 0x89, 0x6d, 0x73, 0x62, 0xa5, 0x7f, 0xac,
-		0x15, 0x5f, 0x46, 0x0e, 0xb1, 0xa3, 0x4b, 0xd4, 0x11, 0x6d, 0xcb, 0xe5, 0x5f, 0x58, 0x45, 0x88,
-		0xfa, 0xcc, 0xe8, 0x59, 0x40, 0x34, 0xc2, 0x29, 0xb7, 0x2d, 0x4f, 0x37, 0xdb, 0x8a, 0x6d, 0xdd,
-		0xe1, 0xdf, 0xad, 0x26, 0x64, 0x89, 0xf6, 0xdc, 0xa4, 0x1d, 0x0d, 0x42, 0x27, 0x4a, 0x67, 0x7c,
-		0x14, 0x52, 0xac, 0xab, 0xad, 0x96, 0x83, 0x5d, 0x97, 0x07, 0x31, 0xd1, 0x44, 0x57, 0x60, 0xca,
-		0xee, 0xee, 0x28, 0x22, 0x62, 0x64, 0xcf, 0x3f, 0x36, 0x6c, 0xff, 0x0b, 0xff, 0xe0, 0x11, 0x20,
-		0x65, 0x77, 0x77, 0x88, 0xb7, 0x3c, 0x0e, 0xb9, 0x21, 0xca, 0x64, 0x6f, 0x07, 0x7a, 0xd0, 0x5f,
-		0x52, 0xe0, 0x33, 0x50, 0x6c, 0x47, 0xb7, 0x1c, 0xdd, 0xdb, 0xa7, 0xaf, 0x43, 0x25, 0x64, 0x49,
-		0x74, 0x34, 0x38, 0xbd, 0x78, 0x0b, 0x66, 0x9a, 0xb4, 0x88, 0x0b, 0x34, 0xbf, 0x10, 0xe8, 0x17,
-		0x8b, 0xd6, 0x6f, 0xa4, 0x66, 0xf1, 0x01, 0xcd, 0x2a, 0x1f, 0x1e, 0xe9, 0x9d, 0x97, 0x0e, 0xef,
-		0x9d, 0xbd, 0xd9, 0xee, 0xf7, 0x8e, 0xf7, 0x6c, 0x4e, 0xe6, 0x9c, 0xe1, 0xf0, 0x35, 0xae, 0x63,
-		0x46, 0x9d, 0xd1, 0x16, 0x0e, 0x4e, 0xaa, 0x0b, 0x11, 0x61, 0x74, 0x21, 0x72, 0x0b, 0x15, 0x2f,
-		0xc3, 0x74, 0x43, 0x75, 0xbc, 0x26, 0xf6, 0x5e, 0xc1, 0x6a, 0x0b, 0x3b, 0xbd, 0x59, 0x77, 0x5a,
-		0x64, 0x5d, 0x04, 0x49, 0x9a, 0x5a, 0x59, 0xd6, 0xa1, 0xff, 0x17, 0xf7, 0x20, 0x49, 0x5f, 0x89,
-		0xf4, 0x33, 0x32, 0x97, 0x60, 0x19, 0x99, 0xc4, 0xd2, 0x7d, 0x0f, 0xbb, 0xe2, 0x1a, 0x81, 0x36,
-		0xd0, 0x8b, 0x22, 0xaf, 0x26, 0x0e, 0xce, 0xab, 0xdc, 0x11, 0x79, 0x76, 0x35, 0x60, 0xaa, 0x42,
-		0x42, 0xf1, 0x6a, 0xcd, 0x57, 0x24, 0x16, 0x28, 0x82, 0xd6, 0x61, 0xc6, 0x56, 0x1d, 0x8f, 0x7e,
-		0x1d, 0xb2, 0x47, 0x67, 0xc1, 0x7d, 0x7d, 0x69, 0x70, 0xe7, 0xf5, 0x4c, 0x96, 0x8f, 0x32, 0x6d,
-		0x87, 0x89, 0xc5, 0xff, 0x92, 0x84, 0x14, 0x37, 0xc6, 0x07, 0x61, 0x8a, 0x9b, 0x95, 0x7b, 0xe7,
-		0xc9, 0x95, 0xc1, 0xc4, 0xb4, 0xe2, 0x27, 0x10, 0x8e, 0x27, 0x64, 0xd0, 0x53, 0x90, 0xd6, 0xf6,
-		0x54, 0xdd, 0x54, 0xf4, 0x16, 0x2f, 0x08, 0xb3, 0xef, 0xbe, 0xb3, 0x34, 0x55, 0x25, 0xb4, 0xd5,
-		0x9a, 0x3c, 0x45, 0x3b, 0x57, 0x5b, 0xa4, 0x12, 0xd8, 0xc3, 0x7a, 0x7b, 0xcf, 0xe3, 0x3b, 0x8c,
-		0xb7, 0xd0, 0x4b, 0x90, 0x24, 0x0e, 0xc1, 0xbf, 0x1d, 0x5c, 0x18, 0xa8, 0xf0, 0xfd, 0x23, 0x74,
-		0x25, 0x4d, 0x06, 0xfe, 0xf8, 0x7f, 0x5a, 0x8a, 0xc9, 0x54, 0x02, 0x55, 0x61, 0xda, 0x50, 0x5d,
-		0x4f, 0xa1, 0x19, 0x8c, 0x0c, 0x3f, 0x49, 0x21, 0x8e, 0x0f, 0x1a, 0x84, 0x1b, 0x96, 0xab, 0x9e,
-		0x25, 0x52, 0x8c, 0xd4, 0x42, 0xa7, 0x40, 0xa2, 0x20, 0x9a, 0xd5, 0xe9, 0xe8, 0x1e, 0xab, 0xad,
-		0x52, 0xd4, 0xee, 0x79, 0x42, 0xaf, 0x52, 0x32, 0xad, 0xb0, 0x4e, 0x40, 0x86, 0x7e, 0xad, 0x44,
-		0x59, 0xd8, 0x7b, 0xb8, 0x69, 0x42, 0xa0, 0x9d, 0x4f, 0xc3, 0x4c, 0x10, 0x1f, 0x19, 0x4b, 0x9a,
-		0xa1, 0x04, 0x64, 0xca, 0xf8, 0x1c, 0xcc, 0x9b, 0xf8, 0x2e, 0x7d, 0x33, 0xb8, 0x87, 0x3b, 0x43,
-		0xb9, 0x11, 0xe9, 0xbb, 0xd9, 0x2b, 0xf1, 0x24, 0xe4, 0x35, 0x61, 0x7c, 0xc6, 0x0b, 0x94, 0x77,
-		0xda, 0xa7, 0x52, 0xb6, 0xe3, 0x90, 0x56, 0x6d, 0x9b, 0x31, 0x64, 0x79, 0x7c, 0xb4, 0x6d, 0xda,
-		0x75, 0x06, 0x66, 0xe9, 0x1c, 0x1d, 0xec, 0x76, 0x0d, 0x8f, 0x83, 0xe4, 0x28, 0xcf, 0x0c, 0xe9,
-		0x90, 0x19, 0x9d, 0xf2, 0x3e, 0x01, 0xd3, 0xf8, 0xb6, 0xde, 0xc2, 0xa6, 0x86, 0x19, 0xdf, 0x34,
-		0xe5, 0xcb, 0x09, 0x22, 0x65, 0x3a, 0x0d, 0x7e, 0xdc, 0x53, 0x44, 0x4c, 0xce, 0x33, 0x3c, 0x41,
-		0x2f, 0x33, 0x72, 0xb1, 0x00, 0xc9, 0x9a, 0xea, 0xa9, 0xa4, 0xc0, 0xf0, 0xee, 0xb2, 0x44, 0x93,
-		0x93, 0xc9, 0xbf, 0xc5, 0x6f, 0xc7, 0x21, 0x79, 0xd3, 0xf2, 0x30, 0x7a, 0x21, 0x54, 0x00, 0xe6,
-		0x87, 0xf9, 0x73, 0x53, 0x6f, 0x9b, 0xb8, 0xb5, 0xee, 0xb6, 0x43, 0x3f, 0x2d, 0x10, 0xb8, 0x53,
-		0xbc, 0xc7, 0x9d, 0xe6, 0x61, 0xd2, 0xb1, 0xba, 0x66, 0x4b, 0xbc, 0xc2, 0x4a, 0x1b, 0xa8, 0x0e,
-		0x69, 0xdf, 0x4b, 0x92, 0x51, 0x5e, 0x32, 0x43, 0xbc, 0x84, 0xf8, 0x30, 0x27, 0xc8, 0x53, 0x3b,
-		0xdc, 0x59, 0x2a, 0x90, 0xf1, 0x83, 0x17, 0xf7, 0xb6, 0xf1, 0x1c, 0x36, 0x10, 0x23, 0xc9, 0xc4,
-		0x5f, 0x7b, 0xdf, 0x78, 0xcc, 0xe3, 0x24, 0xbf, 0x83, 0x5b, 0xaf, 0xc7, 0xad, 0xf8, 0xcf, 0x1c,
-		0x4c, 0xd1, 0x79, 0x05, 0x6e, 0xc5, 0x7e, 0xea, 0xe0, 0x31, 0xc8, 0xb8, 0x7a, 0xdb, 0x54, 0xbd,
-		0xae, 0x83, 0xb9, 0xe7, 0x05, 0x84, 0xe2, 0x57, 0x62, 0x90, 0x62, 0x9e, 0x1c, 0xb2, 0x5b, 0x6c,
-		0xb8, 0xdd, 0xe2, 0xa3, 0xec, 0x96, 0x78, 0x78, 0xbb, 0x95, 0x01, 0x7c, 0x65, 0x5c, 0xfe, 0xf5,
-		0xf9, 0x90, 0x8a, 0x81, 0xa9, 0xd8, 0xd4, 0xdb, 0x7c, 0xa3, 0x86, 0x84, 0x8a, 0xff, 0x31, 0x46,
-		0x8a, 0x58, 0xde, 0x8f, 0xca, 0x30, 0x2d, 0xf4, 0x52, 0x76, 0x0d, 0xb5, 0xcd, 0x7d, 0xe7, 0xe4,
-		0x48, 0xe5, 0xae, 0x1a, 0x6a, 0x5b, 0xce, 0x72, 0x7d, 0x48, 0x63, 0xf8, 0x3a, 0xc4, 0x47, 0xac,
-		0x43, 0xcf, 0xc2, 0x27, 0x1e, 0x6e, 0xe1, 0x7b, 0x96, 0x28, 0xd9, 0xbf, 0x44, 0x5f, 0x8c, 0xd3,
-		0xc3, 0x8c, 0x6d, 0xb9, 0xaa, 0xf1, 0xfd, 0xd8, 0x11, 0x27, 0x20, 0x63, 0x5b, 0x86, 0xc2, 0x7a,
-		0xd8, 0xab, 0xdd, 0x69, 0xdb, 0x32, 0xe4, 0x81, 0x65, 0x9f, 0x7c, 0x44, 0xdb, 0x25, 0xf5, 0x08,
-		0xac, 0x36, 0xd5, 0x6f, 0x35, 0x07, 0x72, 0xcc, 0x14, 0x3c, 0x97, 0x3d, 0x47, 0x6c, 0x40, 0x93,
-		0x63, 0x6c, 0x30, 0xf7, 0x32, 0xb5, 0x19, 0xa7, 0xcc, 0xf9, 0x88, 0x04, 0x0b, 0xfd, 0xc3, 0x4e,
-		0xc1, 0x61, 0xb7, 0x94, 0x39, 0x5f, 0xf1, 0xa7, 0x63, 0x00, 0x6b, 0xc4, 0xb2, 0x74, 0xbe, 0x24,
-		0x0b, 0xb9, 0x54, 0x05, 0xa5, 0x67, 0xe4, 0xc5, 0x51, 0x8b, 0xc6, 0xc7, 0xcf, 0xb9, 0x61, 0xbd,
-		0xab, 0x30, 0x1d, 0x38, 0xa3, 0x8b, 0x85, 0x32, 0x8b, 0x07, 0x54, 0xd5, 0x4d, 0xec, 0xc9, 0xb9,
-		0xdb, 0xa1, 0x56, 0xf1, 0xd7, 0x62, 0x90, 0xa1, 0x3a, 0xad, 0x63, 0x4f, 0xed, 0x59, 0xc3, 0xd8,
-		0xc3, 0xaf, 0xe1, 0x49, 0x00, 0x06, 0xe3, 0xea, 0xf7, 0x30, 0xf7, 0xac, 0x0c, 0xa5, 0x34, 0xf5,
-		0x7b, 0x18, 0x5d, 0xf4, 0x0d, 0x9e, 0x38, 0xd8, 0xe0, 0xa2, 0xea, 0xe6, 0x66, 0x3f, 0x06, 0x53,
-		0xf4, 0xd7, 0x9a, 0xee, 0xba, 0xbc, 0x90, 0x4e, 0x99, 0xdd, 0xce, 0xd6, 0x5d, 0xb7, 0xf8, 0x26,
-		0x4c, 0x6d, 0xdd, 0x65, 0x77, 0x23, 0x27, 0x20, 0xe3, 0x58, 0x16, 0xcf, 0xc9, 0xac, 0x16, 0x4a,
-		0x13, 0x02, 0x4d, 0x41, 0xe2, 0x3e, 0x20, 0x1e, 0xdc, 0x07, 0x04, 0x17, 0x1a, 0x89, 0xb1, 0x2e,
-		0x34, 0xce, 0xfc, 0x66, 0x0c, 0xb2, 0xa1, 0xf8, 0x80, 0x9e, 0x87, 0x23, 0x95, 0xb5, 0xcd, 0xea,
-		0x0d, 0x65, 0xb5, 0xa6, 0x5c, 0x5d, 0x2b, 0x5f, 0x0b, 0x3e, 0x5e, 0x5a, 0x38, 0x7a, 0xff, 0xc1,
-		0x32, 0x0a, 0xf1, 0x6e, 0x9b, 0xf4, 0x9e, 0x1e, 0x9d, 0x83, 0xf9, 0x5e, 0x91, 0x72, 0xa5, 0x59,
-		0xdf, 0xd8, 0x92, 0x62, 0x0b, 0x47, 0xee, 0x3f, 0x58, 0x9e, 0x0d, 0x49, 0x94, 0x77, 0x5c, 0x6c,
-		0x7a, 0x83, 0x02, 0xd5, 0xcd, 0xf5, 0xf5, 0xd5, 0x2d, 0x29, 0x3e, 0x20, 0xc0, 0x03, 0xf6, 0x69,
-		0x98, 0xed, 0x15, 0xd8, 0x58, 0x5d, 0x93, 0x12, 0x0b, 0xe8, 0xfe, 0x83, 0xe5, 0x7c, 0x88, 0x7b,
-		0x43, 0x37, 0x16, 0xd2, 0x1f, 0xfb, 0xec, 0xe2, 0xc4, 0x2f, 0xfd, 0xc2, 0x62, 0x8c, 0xcc, 0x6c,
-		0xba, 0x27, 0x46, 0xa0, 0x67, 0xe1, 0x58, 0x73, 0xf5, 0xda, 0x46, 0xbd, 0xa6, 0xac, 0x37, 0xaf,
-		0x89, 0x9b, 0x6e, 0x31, 0xbb, 0x99, 0xfb, 0x0f, 0x96, 0xb3, 0x7c, 0x4a, 0xa3, 0xb8, 0x1b, 0x72,
-		0xfd, 0xe6, 0xe6, 0x56, 0x5d, 0x8a, 0x31, 0xee, 0x86, 0x83, 0x6f, 0x5b, 0x1e, 0xfb, 0x39, 0xb7,
-		0xe7, 0xe0, 0xf8, 0x10, 0x6e, 0x7f, 0x62, 0xb3, 0xf7, 0x1f, 0x2c, 0x4f, 0x37, 0x1c, 0xcc, 0xf6,
-		0x0f, 0x95, 0x58, 0x81, 0xc2, 0xa0, 0xc4, 0x66, 0x63, 0xb3, 0x59, 0x5e, 0x93, 0x96, 0x17, 0xa4,
-		0xfb, 0x0f, 0x96, 0x73, 0x22, 0x18, 0x12, 0xfe, 0x60, 0x66, 0xef, 0xe7, 0x89, 0xe7, 0x27, 0x5e,
-		0x80, 0x93, 0xae, 0xa7, 0xde, 0xd2, 0xcd, 0xb6, 0x7f, 0x6b, 0xcb, 0xdb, 0xfc, 0xc8, 0x73, 0xd2,
-		0xd0, 0x3f, 0xd2, 0xd5, 0x5b, 0x82, 0x28, 0xfe, 0x46, 0x5c, 0xe1, 0x8e, 0x7c, 0x72, 0xb9, 0x10,
-		0xf1, 0x50, 0x2f, 0xfa, 0xe8, 0x34, 0xfa, 0x7a, 0x78, 0x21, 0xe2, 0x12, 0x7a, 0xe1, 0xc0, 0xc3,
-		0x5d, 0xf1, 0xe3, 0x31, 0xc8, 0xbf, 0xa2, 0xbb, 0x9e, 0xe5, 0xe8, 0x9a, 0x6a, 0xd0, 0x4f, 0x96,
-		0x2e, 0x8e, 0x1b, 0x5b, 0xfb, 0xb6, 0xfa, 0x55, 0x48, 0xdd, 0x56, 0x0d, 0x16, 0xd4, 0xd8, 0x57,
-		0x61, 0x07, 0x5a, 0x31, 0x88, 0x70, 0x02, 0x87, 0x49, 0x17, 0xbf, 0x10, 0x87, 0x19, 0xba, 0x27,
-		0x5c, 0xf6, 0xa3, 0x5c, 0xe4, 0xa8, 0xd5, 0x80, 0xa4, 0xa3, 0x7a, 0xfc, 0xee, 0xb0, 0xf2, 0x03,
-		0xfc, 0x3a, 0xf8, 0xa9, 0xe8, 0x4b, 0xdd, 0x95, 0xc1, 0x1b, 0x63, 0x8a, 0x84, 0x5e, 0x85, 0x74,
-		0x47, 0xbd, 0xab, 0x50, 0xd4, 0xf8, 0x23, 0x40, 0x9d, 0xea, 0xa8, 0x77, 0x89, 0xae, 0xa8, 0x05,
-		0x33, 0x04, 0x58, 0xdb, 0x53, 0xcd, 0x36, 0x66, 0xf8, 0x89, 0x47, 0x80, 0x3f, 0xdd, 0x51, 0xef,
-		0x56, 0x29, 0x26, 0x19, 0xa5, 0x94, 0xfe, 0xc4, 0xdb, 0x4b, 0x13, 0xf4, 0xb6, 0xfd, 0xd7, 0x62,
-		0x00, 0x81, 0xb9, 0x90, 0x06, 0x92, 0xe6, 0xb7, 0xe8, 0xf0, 0x2e, 0x5f, 0xc7, 0x95, 0x88, 0xf5,
-		0xe8, 0xb3, 0x39, 0x4b, 0xd3, 0x5f, 0x7b, 0x67, 0x29, 0x26, 0xcf, 0x68, 0x7d, 0xcb, 0x51, 0x87,
-		0x6c, 0xd7, 0x6e, 0xa9, 0x1e, 0x56, 0xe8, 0x91, 0x2e, 0x7e, 0x88, 0x94, 0x0f, 0x4c, 0x90, 0x74,
-		0x85, 0x26, 0xf1, 0x85, 0x18, 0x64, 0x6b, 0xa1, 0x47, 0x7e, 0x05, 0x98, 0xea, 0x58, 0xa6, 0x7e,
-		0x8b, 0x3b, 0x61, 0x46, 0x16, 0x4d, 0xb4, 0x00, 0x69, 0xf6, 0xe9, 0xa6, 0xb7, 0x2f, 0xee, 0x3f,
-		0x45, 0x9b, 0x48, 0xdd, 0xc1, 0x3b, 0xae, 0x2e, 0x4c, 0x2e, 0x8b, 0x26, 0x39, 0xc8, 0xb8, 0x58,
-		0xeb, 0x3a, 0xba, 0xb7, 0xaf, 0x68, 0x96, 0xe9, 0xa9, 0x9a, 0xc7, 0x3f, 0x02, 0x9c, 0x11, 0xf4,
-		0x2a, 0x23, 0x13, 0x90, 0x16, 0xf6, 0x54, 0xdd, 0x70, 0x0b, 0xec, 0xb1, 0x98, 0x68, 0x86, 0xd4,
-		0xfd, 0xed, 0xa9, 0xf0, 0x85, 0x55, 0x15, 0x24, 0xcb, 0xc6, 0x4e, 0x4f, 0x81, 0xc9, 0x1c, 0xb5,
-		0xf0, 0x1b, 0x5f, 0x3a, 0x3b, 0xcf, 0x17, 0x91, 0x97, 0x98, 0xec, 0x2d, 0x57, 0x79, 0x46, 0x48,
-		0x88, 0xca, 0xf3, 0x75, 0xb2, 0x6e, 0xe2, 0xf4, 0x67, 0x77, 0x77, 0x82, 0x4b, 0xae, 0xf9, 0x01,
-		0xbb, 0x96, 0xcd, 0xfd, 0x4a, 0xe1, 0xd7, 0x03, 0xe8, 0xe0, 0x66, 0xe9, 0x06, 0xde, 0x27, 0xab,
-		0xc5, 0x71, 0x1a, 0x14, 0x86, 0x14, 0x8c, 0x6f, 0xaa, 0xba, 0x21, 0xbe, 0x48, 0x97, 0x79, 0x0b,
-		0x95, 0x21, 0xe5, 0x7a, 0xaa, 0xd7, 0x75, 0xf9, 0x2f, 0xc7, 0x9d, 0x8e, 0x70, 0x90, 0x8a, 0x65,
-		0xb6, 0x9a, 0x54, 0x40, 0xe6, 0x82, 0x68, 0x0b, 0x52, 0x9e, 0x75, 0x0b, 0x9b, 0xdc, 0x56, 0x87,
-		0xf2, 0xf1, 0x21, 0x0f, 0xa8, 0x18, 0x16, 0x6a, 0x83, 0xd4, 0xc2, 0x06, 0x6e, 0xb3, 0x2a, 0x69,
-		0x4f, 0x25, 0x87, 0x89, 0xd4, 0x23, 0xd8, 0x43, 0x33, 0x3e, 0x6a, 0x93, 0x82, 0x22, 0xb9, 0xf7,
-		0xd9, 0x33, 0xfb, 0xb5, 0xc5, 0x33, 0x11, 0x66, 0x08, 0xf9, 0xa9, 0xb8, 0x68, 0x08, 0x3f, 0xad,
-		0x3e, 0x0d, 0x52, 0xd7, 0xdc, 0xb1, 0x4c, 0xfa, 0x15, 0x29, 0x2f, 0xd4, 0xd3, 0xb4, 0xf4, 0x99,
-		0xf1, 0xe9, 0xaf, 0xb0, 0x8a, 0xfd, 0x06, 0xe4, 0x03, 0x56, 0xba, 0x93, 0x32, 0x87, 0xd8, 0x49,
-		0xd3, 0xbe, 0x2c, 0xe9, 0x45, 0x9b, 0x00, 0xc1, 0x36, 0xa5, 0x57, 0x07, 0xd9, 0xc8, 0x15, 0x0d,
-		0xb6, 0xbc, 0x38, 0x89, 0x05, 0x10, 0xe8, 0xcf, 0xc0, 0x09, 0x7e, 0x87, 0xeb, 0x57, 0xac, 0x64,
-		0x3c, 0xb1, 0x20, 0xd9, 0x47, 0xb0, 0x20, 0x05, 0x76, 0x15, 0xec, 0x27, 0x02, 0xe2, 0x60, 0x6c,
-		0x65, 0x0c, 0x98, 0x63, 0x83, 0xb3, 0x09, 0x88, 0x41, 0x73, 0x8f, 0x60, 0xd0, 0x59, 0x0a, 0xbc,
-		0x46, 0x71, 0xd9, 0x68, 0xa5, 0xdc, 0xc7, 0xde, 0x5e, 0x9a, 0xe0, 0xbb, 0x7b, 0xa2, 0xd8, 0xa0,
-		0x57, 0xe8, 0x7c, 0x63, 0x62, 0x17, 0x5d, 0x84, 0x8c, 0x2a, 0x1a, 0xf4, 0x62, 0xe3, 0xa0, 0x8d,
-		0x1d, 0xb0, 0xb2, 0x78, 0xf1, 0xd6, 0x7f, 0x58, 0x8e, 0x15, 0x7f, 0x21, 0x06, 0xa9, 0xda, 0xcd,
-		0x86, 0xaa, 0x3b, 0xa8, 0x0e, 0xb3, 0x81, 0x6f, 0x8f, 0x1b, 0x2d, 0x82, 0xed, 0x20, 0xc2, 0x45,
-		0x7d, 0xd4, 0xa9, 0xf6, 0x40, 0x98, 0xfe, 0xf3, 0x6e, 0xdf, 0xc4, 0xd7, 0x60, 0x8a, 0x69, 0x49,
-		0x7f, 0x43, 0xc5, 0x26, 0xff, 0xf0, 0x27, 0x06, 0x4f, 0x46, 0xed, 0x09, 0x2a, 0xe6, 0x5f, 0x74,
-		0x12, 0xc9, 0xe2, 0xf7, 0x62, 0x00, 0xb5, 0x9b, 0x37, 0xb7, 0x1c, 0xdd, 0x36, 0xb0, 0xf7, 0xa8,
-		0x26, 0xbe, 0x06, 0x47, 0x42, 0x27, 0x28, 0x47, 0x1b, 0x7b, 0xf2, 0x73, 0xc1, 0x19, 0xca, 0xd1,
-		0x86, 0xa2, 0xb5, 0x5c, 0xcf, 0x47, 0x4b, 0x8c, 0x8d, 0x56, 0x73, 0xbd, 0xe1, 0xd6, 0x7c, 0x03,
-		0xb2, 0xc1, 0xf4, 0x5d, 0x74, 0x03, 0xd2, 0x1e, 0xff, 0x9f, 0x1b, 0xf5, 0x74, 0xa4, 0x51, 0x85,
-		0x34, 0x37, 0xac, 0x0f, 0x50, 0xfc, 0xc5, 0x38, 0x40, 0x8d, 0x99, 0x86, 0x6c, 0xd5, 0x3f, 0x52,
-		0x4e, 0x45, 0x92, 0x02, 0xdf, 0xae, 0x8f, 0xa2, 0xf0, 0xe1, 0x58, 0xe8, 0x49, 0xc8, 0xf7, 0x06,
-		0xa2, 0x02, 0xfb, 0xf6, 0x21, 0x38, 0x50, 0x93, 0xf0, 0xd1, 0xb7, 0x06, 0xf7, 0xe3, 0x30, 0xb7,
-		0x2d, 0xc2, 0xe4, 0x1f, 0x59, 0x83, 0xbd, 0x0a, 0x53, 0xd8, 0xf4, 0x1c, 0x9d, 0x5a, 0x8c, 0x78,
-		0xc6, 0xa5, 0x08, 0xcf, 0x18, 0x32, 0x25, 0xfa, 0x73, 0x4c, 0xe2, 0xce, 0x9e, 0xa3, 0xf5, 0x19,
-		0xe3, 0xb7, 0xe3, 0x50, 0x18, 0x25, 0x89, 0x9e, 0x86, 0x19, 0xcd, 0xc1, 0x94, 0xa0, 0xf4, 0x5c,
-		0x1c, 0xe6, 0x05, 0x99, 0x27, 0xad, 0x75, 0x20, 0xe5, 0x20, 0x71, 0x43, 0xc2, 0x7a, 0xe8, 0xfa,
-		0x2f, 0x1f, 0x08, 0xd3, 0xb4, 0x85, 0x61, 0x46, 0x37, 0x75, 0x4f, 0x57, 0x0d, 0x65, 0x47, 0x35,
-		0x54, 0x53, 0x7b, 0x98, 0x72, 0x79, 0xb0, 0x94, 0xc8, 0x73, 0xd0, 0x0a, 0xc3, 0x44, 0x37, 0x61,
-		0x4a, 0xc0, 0x27, 0x1f, 0x01, 0xbc, 0x00, 0x0b, 0xd5, 0x84, 0xff, 0x2e, 0x0e, 0xb3, 0x32, 0x6e,
-		0xfd, 0xf1, 0x32, 0xeb, 0x0f, 0x02, 0xb0, 0xed, 0x49, 0x82, 0xe7, 0x43, 0x58, 0x76, 0x70, 0xbb,
-		0x67, 0x18, 0x5e, 0xcd, 0xf5, 0x42, 0xb6, 0xfd, 0x7a, 0x1c, 0x72, 0x61, 0xdb, 0xfe, 0x31, 0x48,
-		0x26, 0xa8, 0x11, 0x04, 0x05, 0x76, 0x91, 0xfe, 0x5c, 0x44, 0x50, 0x18, 0x70, 0xbe, 0x83, 0xa3,
-		0xc1, 0xdb, 0x29, 0x48, 0x35, 0x54, 0x47, 0xed, 0xb8, 0xe8, 0xfa, 0x40, 0x1d, 0x2a, 0x2e, 0x12,
-		0x07, 0x7e, 0x35, 0x9c, 0xdf, 0x5b, 0x30, 0xcf, 0xfb, 0xc4, 0x90, 0x32, 0xf4, 0x49, 0xc8, 0x93,
-		0xe3, 0x6f, 0xe8, 0x9d, 0x83, 0x38, 0x7d, 0x92, 0x4a, 0xce, 0xaf, 0xc1, 0x03, 0x2f, 0xb4, 0x04,
-		0x59, 0xc2, 0x16, 0x84, 0x3d, 0xc2, 0x03, 0x1d, 0xf5, 0x6e, 0x9d, 0x51, 0xd0, 0x59, 0x40, 0x7b,
-		0xfe, 0xbd, 0x84, 0x12, 0x58, 0x82, 0xf0, 0xcd, 0x06, 0x3d, 0x82, 0xfd, 0x24, 0x00, 0x2d, 0x4e,
-		0xd9, 0x7b, 0x6c, 0xec, 0xe0, 0x96, 0x21, 0x94, 0x1a, 0x7d, 0x97, 0xed, 0x87, 0x60, 0xae, 0xa3,
-		0x9b, 0x4a, 0xdf, 0xc9, 0x98, 0x1f, 0x2a, 0xd6, 0x0e, 0xe7, 0xb0, 0x7f, 0xf0, 0xce, 0xd2, 0xc2,
-		0xbe, 0xda, 0x31, 0x4a, 0xc5, 0x21, 0x90, 0x45, 0x79, 0xb6, 0xa3, 0x9b, 0xbd, 0x47, 0x69, 0xf4,
-		0xe7, 0x63, 0x61, 0xcf, 0xa0, 0x7a, 0xee, 0xaa, 0x9a, 0x67, 0x39, 0xec, 0xe7, 0xae, 0x2b, 0x1b,
-		0x87, 0x56, 0xe0, 0x31, 0xa6, 0xc0, 0x50, 0xd0, 0x62, 0xc8, 0x9f, 0x48, 0x4a, 0xbc, 0x4a, 0xa9,
-		0xe8, 0xc7, 0x62, 0x70, 0xbc, 0x6d, 0x58, 0x3b, 0xa1, 0x9a, 0x9a, 0x39, 0x90, 0xa2, 0xa9, 0x36,
-		0xfb, 0x25, 0xa1, 0x8a, 0x7c, 0x68, 0x45, 0x96, 0x99, 0x22, 0x23, 0x81, 0x8b, 0xf2, 0x51, 0xd6,
-		0xc7, 0xeb, 0x6d, 0xd6, 0x53, 0x55, 0x6d, 0xf4, 0xd3, 0x31, 0x78, 0x2c, 0xd0, 0x7f, 0x88, 0x4a,
-		0x19, 0xaa, 0xd2, 0xf6, 0xa1, 0x55, 0x7a, 0xa2, 0xdf, 0x36, 0xc3, 0xb4, 0x3a, 0xee, 0x77, 0xf7,
-		0x2b, 0x16, 0x0a, 0x3b, 0x9f, 0x8f, 0x01, 0x0a, 0xf2, 0xa4, 0x8c, 0x5d, 0x9b, 0x9c, 0xac, 0xc9,
-		0x49, 0x2b, 0xd8, 0x69, 0x7c, 0xab, 0x44, 0xd6, 0x72, 0xbe, 0x80, 0x38, 0x69, 0x85, 0xa2, 0xd9,
-		0xe5, 0x20, 0x39, 0xc5, 0xf9, 0xc6, 0x1b, 0xf2, 0xe6, 0xe8, 0x4a, 0xd5, 0xd2, 0x85, 0xf4, 0x40,
-		0xfe, 0x99, 0x28, 0x7e, 0x23, 0x06, 0xc7, 0x07, 0x42, 0x80, 0xaf, 0x33, 0x06, 0xe4, 0x84, 0x3a,
-		0xf9, 0xaf, 0x39, 0x32, 0xdd, 0x1f, 0x36, 0xb0, 0xcc, 0x3a, 0x03, 0xe9, 0xee, 0xfd, 0x4a, 0xb3,
-		0xec, 0xc5, 0xd2, 0x7f, 0x1d, 0x83, 0xf9, 0xb0, 0x32, 0xfe, 0xec, 0xb6, 0x21, 0x17, 0xd6, 0x85,
-		0xcf, 0xeb, 0x99, 0x43, 0xcc, 0x8b, 0x4f, 0xa9, 0x07, 0x06, 0xbd, 0x16, 0x84, 0x60, 0x76, 0xa5,
-		0xf9, 0xd2, 0x61, 0x2d, 0x25, 0x34, 0xec, 0x0f, 0xc5, 0x49, 0xba, 0x64, 0x1f, 0x8d, 0x43, 0xb2,
-		0x61, 0x59, 0x06, 0xfa, 0xb3, 0x30, 0x6b, 0x5a, 0x1e, 0xdd, 0xc4, 0xb8, 0xa5, 0xf0, 0x1b, 0x15,
-		0x96, 0xce, 0x3e, 0x7c, 0x38, 0x03, 0xfe, 0xce, 0x3b, 0x4b, 0x83, 0x50, 0x7d, 0x56, 0x9d, 0x31,
-		0x2d, 0xaf, 0x42, 0xfb, 0xb7, 0xd8, 0x7d, 0x8b, 0x03, 0xd3, 0xbd, 0x43, 0xb3, 0xf4, 0xb7, 0x7e,
-		0xe8, 0xa1, 0xa7, 0x0f, 0x1a, 0x36, 0xb7, 0x13, 0x1a, 0x93, 0xbd, 0x80, 0xf7, 0xfb, 0x64, 0x55,
-		0x7f, 0x24, 0x06, 0x73, 0x94, 0xa8, 0xdf, 0xc3, 0xf4, 0x3c, 0x2e, 0x63, 0xcd, 0x72, 0x5a, 0x28,
-		0x0f, 0x71, 0xfe, 0x48, 0x2b, 0x29, 0xc7, 0xf5, 0x16, 0x9a, 0x87, 0x49, 0xeb, 0x8e, 0xc9, 0xdf,
-		0x87, 0xc9, 0xc8, 0xac, 0x41, 0xf3, 0x8d, 0xd5, 0xea, 0x1a, 0x58, 0x51, 0x35, 0x8d, 0xbe, 0xad,
-		0xcc, 0xae, 0xfe, 0xa6, 0x19, 0xb5, 0xcc, 0x88, 0xe8, 0x31, 0xc8, 0xf8, 0x3b, 0x9e, 0xdf, 0xfc,
-		0x05, 0x04, 0xee, 0x5e, 0x7f, 0x12, 0x8a, 0x0d, 0xcc, 0x32, 0x59, 0x58, 0x9d, 0x72, 0xd7, 0xdb,
-		0xb3, 0x1c, 0xfd, 0x9e, 0xca, 0x7e, 0xff, 0xef, 0x21, 0x6f, 0x03, 0xce, 0x7c, 0x39, 0x06, 0x10,
-		0xdc, 0xa0, 0xa1, 0x67, 0xe1, 0x58, 0x65, 0x73, 0xa3, 0xa6, 0x34, 0xb7, 0xca, 0x5b, 0xdb, 0xcd,
-		0xde, 0xaf, 0x11, 0xc4, 0x73, 0x1a, 0xd7, 0xc6, 0x9a, 0xbe, 0xab, 0xe3, 0x16, 0x7a, 0x0a, 0xe6,
-		0x7b, 0xb9, 0x49, 0xab, 0x5e, 0x93, 0x62, 0x0b, 0xb9, 0xfb, 0x0f, 0x96, 0xd3, 0xac, 0xaa, 0xc7,
-		0x2d, 0x74, 0x0a, 0x8e, 0x0c, 0xf2, 0xad, 0x6e, 0x5c, 0x93, 0xe2, 0x0b, 0xd3, 0xf7, 0x1f, 0x2c,
-		0x67, 0xfc, 0xf2, 0x1f, 0x15, 0x01, 0x85, 0x39, 0x39, 0x5e, 0x62, 0x01, 0xee, 0x3f, 0x58, 0x4e,
-		0x31, 0xef, 0x58, 0x48, 0x7e, 0xec, 0xb3, 0x8b, 0x13, 0x95, 0xd7, 0x47, 0x3e, 0x89, 0x79, 0x39,
-		0xe4, 0x18, 0xfa, 0x47, 0x8c, 0x2e, 0x49, 0x78, 0xba, 0xa9, 0x9d, 0x63, 0x9b, 0x44, 0xf7, 0xf6,
-		0xcf, 0xf2, 0x0d, 0x72, 0x96, 0x2d, 0xc6, 0xb9, 0xbb, 0xe2, 0x39, 0x4b, 0xef, 0x13, 0x99, 0xff,
-		0x17, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xaf, 0x09, 0xd8, 0xae, 0x69, 0x00, 0x00,
+func (m *PendingTokenizeShareRecordTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTokenizeShareRecordTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	r := bytes.NewReader(gzipped)
-	gzipr, err := compress_gzip.NewReader(r)
-	if err != nil {
-		panic(err)
+}
+func (m *PendingTokenizeShareRecordTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTokenizeShareRecordTransfer.Merge(m, src)
+}
+func (m *PendingTokenizeShareRecordTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTokenizeShareRecordTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTokenizeShareRecordTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTokenizeShareRecordTransfer proto.InternalMessageInfo
+
+func (m *PendingTokenizeShareRecordTransfer) GetTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.TokenizeShareRecordId
 	}
-	ungzipped, err := io_ioutil.ReadAll(gzipr)
-	if err != nil {
-		panic(err)
+	return 0
+}
+
+func (m *PendingTokenizeShareRecordTransfer) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if err := github_com_gogo_protobuf_proto.Unmarshal(ungzipped, d); err != nil {
-		panic(err)
+	return ""
+}
+
+func (m *PendingTokenizeShareRecordTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return d
+	return ""
 }
-func (this *CommissionRates) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PendingTokenizeShareRecordTransfer) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*CommissionRates)
-	if !ok {
-		that2, ok := that.(CommissionRates)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// PendingTokenizeShareRecordTransferIds stores a list of tokenize share record ids
+// that have a pending ownership transfer expiring at the same time
+type PendingTokenizeShareRecordTransferIds struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *PendingTokenizeShareRecordTransferIds) Reset()         { *m = PendingTokenizeShareRecordTransferIds{} }
+func (m *PendingTokenizeShareRecordTransferIds) String() string { return proto.CompactTextString(m) }
+func (*PendingTokenizeShareRecordTransferIds) ProtoMessage()    {}
+func (*PendingTokenizeShareRecordTransferIds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{23}
+}
+func (m *PendingTokenizeShareRecordTransferIds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTokenizeShareRecordTransferIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTokenizeShareRecordTransferIds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Rate.Equal(that1.Rate) {
-		return false
-	}
-	if !this.MaxRate.Equal(that1.MaxRate) {
-		return false
-	}
-	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
-		return false
-	}
-	return true
 }
-func (this *Commission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PendingTokenizeShareRecordTransferIds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTokenizeShareRecordTransferIds.Merge(m, src)
+}
+func (m *PendingTokenizeShareRecordTransferIds) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTokenizeShareRecordTransferIds) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTokenizeShareRecordTransferIds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTokenizeShareRecordTransferIds proto.InternalMessageInfo
+
+func (m *PendingTokenizeShareRecordTransferIds) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
 	}
+	return nil
+}
 
-	that1, ok := that.(*Commission)
-	if !ok {
-		that2, ok := that.(Commission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// TotalLiquidStakedRefresh tracks the progress of a recalculation of the global liquid
+// staked tokens and each validator's total liquid shares that is spread over several blocks
+type TotalLiquidStakedRefresh struct {
+	// liquid delegation index key of the next delegation to process, empty before the first batch
+	NextDelegationKey []byte `protobuf:"bytes,1,opt,name=next_delegation_key,json=nextDelegationKey,proto3" json:"next_delegation_key,omitempty"`
+	// number of delegations processed so far
+	DelegationsProcessed uint64 `protobuf:"varint,2,opt,name=delegations_processed,json=delegationsProcessed,proto3" json:"delegations_processed,omitempty"`
+	// block height at which the refresh started
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *TotalLiquidStakedRefresh) Reset()         { *m = TotalLiquidStakedRefresh{} }
+func (m *TotalLiquidStakedRefresh) String() string { return proto.CompactTextString(m) }
+func (*TotalLiquidStakedRefresh) ProtoMessage()    {}
+func (*TotalLiquidStakedRefresh) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{24}
+}
+func (m *TotalLiquidStakedRefresh) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalLiquidStakedRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalLiquidStakedRefresh.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CommissionRates.Equal(&that1.CommissionRates) {
-		return false
+}
+func (m *TotalLiquidStakedRefresh) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalLiquidStakedRefresh.Merge(m, src)
+}
+func (m *TotalLiquidStakedRefresh) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalLiquidStakedRefresh) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalLiquidStakedRefresh.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotalLiquidStakedRefresh proto.InternalMessageInfo
+
+func (m *TotalLiquidStakedRefresh) GetNextDelegationKey() []byte {
+	if m != nil {
+		return m.NextDelegationKey
 	}
-	if !this.UpdateTime.Equal(that1.UpdateTime) {
-		return false
+	return nil
+}
+
+func (m *TotalLiquidStakedRefresh) GetDelegationsProcessed() uint64 {
+	if m != nil {
+		return m.DelegationsProcessed
 	}
-	return true
+	return 0
 }
-func (this *Description) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TotalLiquidStakedRefresh) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
+	return 0
+}
 
-	that1, ok := that.(*Description)
-	if !ok {
-		that2, ok := that.(Description)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SlashRecord records a slash of a validator along with its exchange rate before and
+// after the slash, so that the value lost by each tokenize share record delegated to
+// the validator can be queried
+type SlashRecord struct {
+	// id of the slash record, unique across all validators
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator that was slashed
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// block height at which the slash was applied
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// block height at which the infraction was committed
+	InfractionHeight int64 `protobuf:"varint,4,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// type of the infraction
+	InfractionType string `protobuf:"bytes,5,opt,name=infraction_type,json=infractionType,proto3" json:"infraction_type,omitempty"`
+	// fraction of the stake at the infraction height that was slashed
+	SlashFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_factor,json=slashFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_factor"`
+	// fraction of the validator's tokens that were burned
+	EffectiveFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=effective_fraction,json=effectiveFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"effective_fraction"`
+	// tokens burned from the validator
+	TokensBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=tokens_burned,json=tokensBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens_burned"`
+	// value lost by the validator's liquid shares, deducted from the total liquid staked tokens
+	LiquidTokensDeducted github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=liquid_tokens_deducted,json=liquidTokensDeducted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_tokens_deducted"`
+	// validator's tokens before the slash
+	TokensBefore github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=tokens_before,json=tokensBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens_before"`
+	// validator's delegator shares at the time of the slash
+	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
+	// tokens of the burn that were absorbed by the validator bond delegations alone,
+	// before the rest was spread over all delegators
+	ValidatorBondFirstLoss github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=validator_bond_first_loss,json=validatorBondFirstLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_bond_first_loss"`
+	// shares removed from the validator bond delegations to absorb the first loss
+	ValidatorBondSharesRemoved github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=validator_bond_shares_removed,json=validatorBondSharesRemoved,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares_removed"`
+	// tokens paid out of the slash insurance fund to the affected tokenize share records
+	InsurancePayout github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=insurance_payout,json=insurancePayout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"insurance_payout"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{25}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Moniker != that1.Moniker {
-		return false
-	}
-	if this.Identity != that1.Identity {
-		return false
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if this.Website != that1.Website {
-		return false
+	return 0
+}
+
+func (m *SlashRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if this.SecurityContact != that1.SecurityContact {
-		return false
+	return ""
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if this.Details != that1.Details {
-		return false
+	return 0
+}
+
+func (m *SlashRecord) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
 	}
-	return true
+	return 0
 }
-func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SlashRecord) GetInfractionType() string {
+	if m != nil {
+		return m.InfractionType
 	}
+	return ""
+}
 
-	that1, ok := that.(*UnbondingDelegationEntry)
-	if !ok {
-		that2, ok := that.(UnbondingDelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SlashInsuranceCoverage sets how much of what tokenize share records lose to a slash
+// is reimbursed by the slash insurance fund. Payouts are disabled while either field is zero
+type SlashInsuranceCoverage struct {
+	// fraction of the value lost by each affected tokenize share record that is reimbursed
+	CoverageFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=coverage_fraction,json=coverageFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"coverage_fraction"`
+	// maximum tokens paid out for a single slash, over all affected records
+	MaxPayoutPerSlash github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_payout_per_slash,json=maxPayoutPerSlash,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_payout_per_slash"`
+}
+
+func (m *SlashInsuranceCoverage) Reset()         { *m = SlashInsuranceCoverage{} }
+func (m *SlashInsuranceCoverage) String() string { return proto.CompactTextString(m) }
+func (*SlashInsuranceCoverage) ProtoMessage()    {}
+func (*SlashInsuranceCoverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{26}
+}
+func (m *SlashInsuranceCoverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashInsuranceCoverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashInsuranceCoverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
-	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
-	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
-		return false
-	}
-	if !this.Balance.Equal(that1.Balance) {
-		return false
-	}
-	return true
 }
-func (this *RedelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *SlashInsuranceCoverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashInsuranceCoverage.Merge(m, src)
+}
+func (m *SlashInsuranceCoverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashInsuranceCoverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashInsuranceCoverage.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RedelegationEntry)
-	if !ok {
-		that2, ok := that.(RedelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_SlashInsuranceCoverage proto.InternalMessageInfo
+
+// SlashInsurancePayout records the tokens that the slash insurance fund delegated back
+// into a tokenize share record after a slash
+type SlashInsurancePayout struct {
+	// id of the slash record the payout reimburses
+	SlashRecordId uint64 `protobuf:"varint,1,opt,name=slash_record_id,json=slashRecordId,proto3" json:"slash_record_id,omitempty"`
+	// validator that was slashed
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// id of the tokenize share record that was reimbursed
+	TokenizeShareRecordId uint64 `protobuf:"varint,3,opt,name=tokenize_share_record_id,json=tokenizeShareRecordId,proto3" json:"tokenize_share_record_id,omitempty"`
+	// tokens delegated into the record
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// shares issued to the record's module account
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *SlashInsurancePayout) Reset()         { *m = SlashInsurancePayout{} }
+func (m *SlashInsurancePayout) String() string { return proto.CompactTextString(m) }
+func (*SlashInsurancePayout) ProtoMessage()    {}
+func (*SlashInsurancePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{27}
+}
+func (m *SlashInsurancePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashInsurancePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashInsurancePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
-	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
-	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
-		return false
+}
+func (m *SlashInsurancePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashInsurancePayout.Merge(m, src)
+}
+func (m *SlashInsurancePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashInsurancePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashInsurancePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashInsurancePayout proto.InternalMessageInfo
+
+func (m *SlashInsurancePayout) GetSlashRecordId() uint64 {
+	if m != nil {
+		return m.SlashRecordId
 	}
-	if !this.SharesDst.Equal(that1.SharesDst) {
-		return false
+	return 0
+}
+
+func (m *SlashInsurancePayout) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SlashInsurancePayout) GetTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.TokenizeShareRecordId
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// TokenizeShareRecordClaim holds the tokens that the share tokens of a released tokenize
+// share record can be burned for. A record is released when its validator is tombstoned or
+// left without tokens: its delegation is unbonded to the record's module account and the
+// record is deleted
+type TokenizeShareRecordClaim struct {
+	// the released record
+	Record TokenizeShareRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// shares unbonded from the validator
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// tokens unbonded from the validator
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// time at which the unbonding completes and the tokens can be claimed
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *TokenizeShareRecordClaim) Reset()         { *m = TokenizeShareRecordClaim{} }
+func (m *TokenizeShareRecordClaim) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecordClaim) ProtoMessage()    {}
+func (*TokenizeShareRecordClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{28}
+}
+func (m *TokenizeShareRecordClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecordClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecordClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.UnbondingTime != that1.UnbondingTime {
-		return false
-	}
-	if this.MaxValidators != that1.MaxValidators {
-		return false
-	}
-	if this.MaxEntries != that1.MaxEntries {
-		return false
-	}
-	if this.HistoricalEntries != that1.HistoricalEntries {
-		return false
-	}
-	if this.BondDenom != that1.BondDenom {
-		return false
-	}
-	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
-		return false
-	}
-	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
-		return false
-	}
-	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
-		return false
-	}
-	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
-		return false
+}
+func (m *TokenizeShareRecordClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecordClaim.Merge(m, src)
+}
+func (m *TokenizeShareRecordClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecordClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecordClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecordClaim proto.InternalMessageInfo
+
+func (m *TokenizeShareRecordClaim) GetRecord() TokenizeShareRecord {
+	if m != nil {
+		return m.Record
 	}
-	return true
+	return TokenizeShareRecord{}
 }
-func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TokenizeShareRecordClaim) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*RedelegationEntryResponse)
-	if !ok {
-		that2, ok := that.(RedelegationEntryResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// TokenizationPause stops new share tokens from being minted, either against every validator
+// or against a single validator, until governance lifts it
+type TokenizationPause struct {
+	// paused validator, or empty for the global pause
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// if true, share tokens cannot be redeemed either
+	PauseRedemptions bool `protobuf:"varint,2,opt,name=pause_redemptions,json=pauseRedemptions,proto3" json:"pause_redemptions,omitempty"`
+}
+
+func (m *TokenizationPause) Reset()         { *m = TokenizationPause{} }
+func (m *TokenizationPause) String() string { return proto.CompactTextString(m) }
+func (*TokenizationPause) ProtoMessage()    {}
+func (*TokenizationPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{29}
+}
+func (m *TokenizationPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizationPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizationPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
-		return false
-	}
-	if !this.Balance.Equal(that1.Balance) {
-		return false
+}
+func (m *TokenizationPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizationPause.Merge(m, src)
+}
+func (m *TokenizationPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizationPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizationPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizationPause proto.InternalMessageInfo
+
+func (m *TokenizationPause) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (this *Pool) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TokenizationPause) GetPauseRedemptions() bool {
+	if m != nil {
+		return m.PauseRedemptions
 	}
+	return false
+}
 
-	that1, ok := that.(*Pool)
-	if !ok {
-		that2, ok := that.(Pool)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorLiquidStakingPolicy is set by a validator's operator to limit the liquid stake that
+// the validator accepts, on top of the liquid staking caps of the module
+type ValidatorLiquidStakingPolicy struct {
+	// fraction of the validator's shares that can be liquid, used instead of the validator
+	// liquid staking cap param when it is lower. No additional cap applies if unset
+	LiquidStakingCap *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquid_staking_cap,json=liquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_staking_cap,omitempty"`
+	// liquid staking providers that can delegate to the validator, or empty to accept any provider
+	AllowedLiquidStakingProviders []string `protobuf:"bytes,2,rep,name=allowed_liquid_staking_providers,json=allowedLiquidStakingProviders,proto3" json:"allowed_liquid_staking_providers,omitempty"`
+	// if true, delegations to the validator cannot be tokenized
+	TokenizationDisabled bool `protobuf:"varint,3,opt,name=tokenization_disabled,json=tokenizationDisabled,proto3" json:"tokenization_disabled,omitempty"`
+}
+
+func (m *ValidatorLiquidStakingPolicy) Reset()         { *m = ValidatorLiquidStakingPolicy{} }
+func (m *ValidatorLiquidStakingPolicy) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiquidStakingPolicy) ProtoMessage()    {}
+func (*ValidatorLiquidStakingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{30}
+}
+func (m *ValidatorLiquidStakingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiquidStakingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiquidStakingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
-		return false
-	}
-	if !this.BondedTokens.Equal(that1.BondedTokens) {
-		return false
+}
+func (m *ValidatorLiquidStakingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiquidStakingPolicy.Merge(m, src)
+}
+func (m *ValidatorLiquidStakingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiquidStakingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiquidStakingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiquidStakingPolicy proto.InternalMessageInfo
+
+func (m *ValidatorLiquidStakingPolicy) GetAllowedLiquidStakingProviders() []string {
+	if m != nil {
+		return m.AllowedLiquidStakingProviders
 	}
-	return true
+	return nil
 }
-func (this *TokenizeShareRecord) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorLiquidStakingPolicy) GetTokenizationDisabled() bool {
+	if m != nil {
+		return m.TokenizationDisabled
 	}
+	return false
+}
 
-	that1, ok := that.(*TokenizeShareRecord)
-	if !ok {
-		that2, ok := that.(TokenizeShareRecord)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// UpdateParamsProposal is a gov Content type that replaces the x/staking params
+// once the proposal passes
+type UpdateParamsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// params defines the x/staking parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{31}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Owner != that1.Owner {
-		return false
-	}
-	if this.ModuleAccount != that1.ModuleAccount {
-		return false
-	}
-	if this.Validator != that1.Validator {
-		return false
-	}
-	return true
 }
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
 }
 
-func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
+// UpdateSlashInsuranceCoverageProposal is a gov Content type that sets the coverage of the
+// slash insurance fund once the proposal passes
+type UpdateSlashInsuranceCoverageProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// coverage defines the new coverage of the slash insurance fund.
+	Coverage SlashInsuranceCoverage `protobuf:"bytes,3,opt,name=coverage,proto3" json:"coverage"`
 }
 
-func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valset) > 0 {
-		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+func (m *UpdateSlashInsuranceCoverageProposal) Reset()      { *m = UpdateSlashInsuranceCoverageProposal{} }
+func (*UpdateSlashInsuranceCoverageProposal) ProtoMessage() {}
+func (*UpdateSlashInsuranceCoverageProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{32}
+}
+func (m *UpdateSlashInsuranceCoverageProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSlashInsuranceCoverageProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSlashInsuranceCoverageProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UpdateSlashInsuranceCoverageProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSlashInsuranceCoverageProposal.Merge(m, src)
+}
+func (m *UpdateSlashInsuranceCoverageProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSlashInsuranceCoverageProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSlashInsuranceCoverageProposal.DiscardUnknown(m)
 }
 
-func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_UpdateSlashInsuranceCoverageProposal proto.InternalMessageInfo
+
+// PauseTokenizationProposal is a gov Content type that pauses tokenization globally or for a
+// single validator once the proposal passes
+type PauseTokenizationProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pause defines the validator to pause, or the global pause if its address is empty.
+	Pause TokenizationPause `protobuf:"bytes,3,opt,name=pause,proto3" json:"pause"`
 }
 
-func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MaxChangeRate.Size()
-		i -= size
-		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MaxRate.Size()
-		i -= size
-		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PauseTokenizationProposal) Reset()      { *m = PauseTokenizationProposal{} }
+func (*PauseTokenizationProposal) ProtoMessage() {}
+func (*PauseTokenizationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{33}
+}
+func (m *PauseTokenizationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseTokenizationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseTokenizationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *Commission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PauseTokenizationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTokenizationProposal.Merge(m, src)
+}
+func (m *PauseTokenizationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseTokenizationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTokenizationProposal.DiscardUnknown(m)
 }
 
-func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PauseTokenizationProposal proto.InternalMessageInfo
+
+// UnpauseTokenizationProposal is a gov Content type that lifts a global or validator
+// tokenization pause once the proposal passes
+type UnpauseTokenizationProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// validator_address defines the validator to unpause, or the global pause if empty.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintStaking(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
+func (m *UnpauseTokenizationProposal) Reset()      { *m = UnpauseTokenizationProposal{} }
+func (*UnpauseTokenizationProposal) ProtoMessage() {}
+func (*UnpauseTokenizationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76a7656dabf68054, []int{34}
+}
+func (m *UnpauseTokenizationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseTokenizationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseTokenizationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *UnpauseTokenizationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseTokenizationProposal.Merge(m, src)
+}
+func (m *UnpauseTokenizationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseTokenizationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseTokenizationProposal.DiscardUnknown(m)
 }
 
-func (m *Description) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_UnpauseTokenizationProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("liquidstaking.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterType((*HistoricalInfo)(nil), "liquidstaking.staking.v1beta1.HistoricalInfo")
+	proto.RegisterType((*CommissionRates)(nil), "liquidstaking.staking.v1beta1.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "liquidstaking.staking.v1beta1.Commission")
+	proto.RegisterType((*Description)(nil), "liquidstaking.staking.v1beta1.Description")
+	proto.RegisterType((*Validator)(nil), "liquidstaking.staking.v1beta1.Validator")
+	proto.RegisterType((*ValAddresses)(nil), "liquidstaking.staking.v1beta1.ValAddresses")
+	proto.RegisterType((*DVPair)(nil), "liquidstaking.staking.v1beta1.DVPair")
+	proto.RegisterType((*DVPairs)(nil), "liquidstaking.staking.v1beta1.DVPairs")
+	proto.RegisterType((*DVVTriplet)(nil), "liquidstaking.staking.v1beta1.DVVTriplet")
+	proto.RegisterType((*DVVTriplets)(nil), "liquidstaking.staking.v1beta1.DVVTriplets")
+	proto.RegisterType((*Delegation)(nil), "liquidstaking.staking.v1beta1.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "liquidstaking.staking.v1beta1.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "liquidstaking.staking.v1beta1.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "liquidstaking.staking.v1beta1.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "liquidstaking.staking.v1beta1.Redelegation")
+	proto.RegisterType((*Params)(nil), "liquidstaking.staking.v1beta1.Params")
+	proto.RegisterType((*DelegationResponse)(nil), "liquidstaking.staking.v1beta1.DelegationResponse")
+	proto.RegisterType((*RedelegationEntryResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationEntryResponse")
+	proto.RegisterType((*RedelegationResponse)(nil), "liquidstaking.staking.v1beta1.RedelegationResponse")
+	proto.RegisterType((*Pool)(nil), "liquidstaking.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "liquidstaking.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*PendingTokenizeShareAuthorizations)(nil), "liquidstaking.staking.v1beta1.PendingTokenizeShareAuthorizations")
+	proto.RegisterType((*PendingTokenizeShareRecordTransfer)(nil), "liquidstaking.staking.v1beta1.PendingTokenizeShareRecordTransfer")
+	proto.RegisterType((*PendingTokenizeShareRecordTransferIds)(nil), "liquidstaking.staking.v1beta1.PendingTokenizeShareRecordTransferIds")
+	proto.RegisterType((*TotalLiquidStakedRefresh)(nil), "liquidstaking.staking.v1beta1.TotalLiquidStakedRefresh")
+	proto.RegisterType((*SlashRecord)(nil), "liquidstaking.staking.v1beta1.SlashRecord")
+	proto.RegisterType((*SlashInsuranceCoverage)(nil), "liquidstaking.staking.v1beta1.SlashInsuranceCoverage")
+	proto.RegisterType((*SlashInsurancePayout)(nil), "liquidstaking.staking.v1beta1.SlashInsurancePayout")
+	proto.RegisterType((*TokenizeShareRecordClaim)(nil), "liquidstaking.staking.v1beta1.TokenizeShareRecordClaim")
+	proto.RegisterType((*TokenizationPause)(nil), "liquidstaking.staking.v1beta1.TokenizationPause")
+	proto.RegisterType((*ValidatorLiquidStakingPolicy)(nil), "liquidstaking.staking.v1beta1.ValidatorLiquidStakingPolicy")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "liquidstaking.staking.v1beta1.UpdateParamsProposal")
+	proto.RegisterType((*UpdateSlashInsuranceCoverageProposal)(nil), "liquidstaking.staking.v1beta1.UpdateSlashInsuranceCoverageProposal")
+	proto.RegisterType((*PauseTokenizationProposal)(nil), "liquidstaking.staking.v1beta1.PauseTokenizationProposal")
+	proto.RegisterType((*UnpauseTokenizationProposal)(nil), "liquidstaking.staking.v1beta1.UnpauseTokenizationProposal")
 }
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("staking/v1beta1/staking.proto", fileDescriptor_76a7656dabf68054) }
+
+var fileDescriptor_76a7656dabf68054 = []byte{
+	// 2871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5d, 0x68, 0x5c, 0xc7,
+	0xf5, 0xd7, 0x5d, 0xad, 0xa5, 0xdd, 0xb3, 0x92, 0x56, 0x1a, 0xc9, 0xfe, 0xaf, 0x14, 0x5b, 0xd2,
+	0x7f, 0x5b, 0x27, 0x76, 0x52, 0x49, 0x89, 0x43, 0xbe, 0xdc, 0x42, 0xd1, 0x6a, 0xe5, 0x46, 0xb5,
+	0xe3, 0x6c, 0xaf, 0x24, 0xa7, 0x49, 0x0a, 0x97, 0xd9, 0x7b, 0x47, 0xab, 0xa9, 0xee, 0xde, 0xbb,
+	0xb9, 0x33, 0x2b, 0x6b, 0xd3, 0x96, 0x96, 0x16, 0x4a, 0x30, 0x04, 0xf2, 0x54, 0xd2, 0x07, 0x43,
+	0x68, 0x53, 0x0a, 0x25, 0x8f, 0xa1, 0xaf, 0x85, 0x3e, 0x94, 0x10, 0x08, 0xa4, 0x79, 0xea, 0x17,
+	0x6e, 0xb0, 0x5f, 0x4a, 0xa1, 0x10, 0xfa, 0xd4, 0x97, 0x42, 0x99, 0x8f, 0xfb, 0xb1, 0x1f, 0xd6,
+	0x7a, 0x9d, 0x0d, 0x04, 0xf2, 0x22, 0xed, 0xcc, 0x99, 0xf9, 0xcd, 0x39, 0x67, 0xce, 0x9c, 0x73,
+	0xe6, 0xcc, 0x85, 0x33, 0x8c, 0xe3, 0x03, 0xea, 0xd5, 0xd6, 0x0e, 0x1f, 0xab, 0x12, 0x8e, 0x1f,
+	0x5b, 0xd3, 0xed, 0xd5, 0x46, 0xe0, 0x73, 0x1f, 0x9d, 0x71, 0xe9, 0x2b, 0x4d, 0xea, 0x84, 0x9d,
+	0xe1, 0x7f, 0x3d, 0x78, 0x61, 0xae, 0xe6, 0xd7, 0x7c, 0x39, 0x72, 0x4d, 0xfc, 0x52, 0x93, 0x16,
+	0xe6, 0x6b, 0xbe, 0x5f, 0x73, 0xc9, 0x9a, 0x6c, 0x55, 0x9b, 0x7b, 0x6b, 0xd8, 0x6b, 0x69, 0xd2,
+	0x62, 0x27, 0xc9, 0x69, 0x06, 0x98, 0x53, 0xdf, 0xd3, 0xf4, 0xa5, 0x4e, 0x3a, 0xa7, 0x75, 0xc2,
+	0x38, 0xae, 0x37, 0x42, 0x6c, 0xdb, 0x67, 0x75, 0x9f, 0x59, 0x6a, 0x51, 0xd5, 0x08, 0xb1, 0x55,
+	0x6b, 0xad, 0x8a, 0x19, 0x89, 0xc4, 0xb1, 0x7d, 0x1a, 0x62, 0x9f, 0xe6, 0xc4, 0x73, 0x48, 0x50,
+	0xa7, 0x1e, 0x5f, 0xe3, 0xad, 0x06, 0x61, 0xea, 0xaf, 0xa2, 0x16, 0xdf, 0x30, 0x60, 0xea, 0x59,
+	0xca, 0xb8, 0x1f, 0x50, 0x1b, 0xbb, 0x5b, 0xde, 0x9e, 0x8f, 0x9e, 0x84, 0xb1, 0x7d, 0x82, 0x1d,
+	0x12, 0x14, 0x8c, 0x65, 0xe3, 0x5c, 0xee, 0x42, 0x61, 0x35, 0x46, 0x58, 0x55, 0x73, 0x9f, 0x95,
+	0xf4, 0x52, 0xfa, 0xbd, 0x5b, 0x4b, 0x23, 0xa6, 0x1e, 0x8d, 0x2e, 0xc1, 0xd8, 0x21, 0x76, 0x19,
+	0xe1, 0x85, 0xd4, 0xf2, 0xe8, 0xb9, 0xdc, 0x85, 0x73, 0xab, 0xc7, 0x6a, 0x71, 0xf5, 0x1a, 0x76,
+	0xa9, 0x83, 0xb9, 0x1f, 0xe1, 0xa8, 0xd9, 0xc5, 0x77, 0x52, 0x90, 0xdf, 0xf0, 0xeb, 0x75, 0xca,
+	0x18, 0xf5, 0x3d, 0x13, 0x73, 0xc2, 0x50, 0x05, 0xd2, 0x01, 0xe6, 0x44, 0x72, 0x94, 0x2d, 0x7d,
+	0x4d, 0x8c, 0xff, 0xcb, 0xad, 0xa5, 0x07, 0x6b, 0x94, 0xef, 0x37, 0xab, 0xab, 0xb6, 0x5f, 0xd7,
+	0x3a, 0xd1, 0xff, 0x56, 0x98, 0x73, 0xa0, 0xc5, 0x2c, 0x13, 0xfb, 0xa3, 0x77, 0x57, 0x40, 0xab,
+	0xac, 0x4c, 0x6c, 0x53, 0x22, 0xa1, 0x17, 0x20, 0x53, 0xc7, 0x47, 0x96, 0x44, 0x4d, 0x0d, 0x01,
+	0x75, 0xbc, 0x8e, 0x8f, 0x04, 0xaf, 0xc8, 0x81, 0xbc, 0x00, 0xb6, 0xf7, 0xb1, 0x57, 0x23, 0x0a,
+	0x7f, 0x74, 0x08, 0xf8, 0x93, 0x75, 0x7c, 0xb4, 0x21, 0x31, 0xc5, 0x2a, 0x17, 0x33, 0x6f, 0xbe,
+	0xb5, 0x34, 0xf2, 0x8f, 0xb7, 0x96, 0x8c, 0xe2, 0xef, 0x0d, 0x80, 0x58, 0x5d, 0xc8, 0x86, 0x69,
+	0x3b, 0x6a, 0xc9, 0xe5, 0x99, 0xde, 0xc7, 0xd5, 0x3e, 0xfb, 0xd1, 0xa1, 0xf3, 0x52, 0x46, 0xf0,
+	0xfb, 0xe1, 0xad, 0x25, 0xc3, 0xcc, 0xdb, 0x1d, 0xdb, 0xb1, 0x09, 0xb9, 0x66, 0xc3, 0xc1, 0x9c,
+	0x58, 0xc2, 0x50, 0xa5, 0xfe, 0x72, 0x17, 0x16, 0x56, 0x95, 0x15, 0xaf, 0x86, 0x56, 0xbc, 0xba,
+	0x13, 0x5a, 0xb1, 0xc2, 0x7a, 0xe3, 0xef, 0x4b, 0x86, 0x09, 0x6a, 0xa2, 0x20, 0x25, 0x84, 0x78,
+	0xc7, 0x80, 0x5c, 0x99, 0x30, 0x3b, 0xa0, 0x0d, 0x71, 0x2c, 0x50, 0x01, 0xc6, 0xeb, 0xbe, 0x47,
+	0x0f, 0xb4, 0x11, 0x66, 0xcd, 0xb0, 0x89, 0x16, 0x20, 0x43, 0x1d, 0xe2, 0x71, 0xca, 0x5b, 0x6a,
+	0xdf, 0xcc, 0xa8, 0x2d, 0x66, 0x5d, 0x27, 0x55, 0x46, 0x43, 0x95, 0x9b, 0x61, 0x13, 0x9d, 0x87,
+	0x69, 0x46, 0xec, 0x66, 0x40, 0x79, 0xcb, 0xb2, 0x7d, 0x8f, 0x63, 0x9b, 0x17, 0xd2, 0x72, 0x48,
+	0x3e, 0xec, 0xdf, 0x50, 0xdd, 0x02, 0xc4, 0x21, 0x1c, 0x53, 0x97, 0x15, 0x4e, 0x28, 0x10, 0xdd,
+	0x4c, 0xb0, 0xfb, 0x87, 0x2c, 0x64, 0x23, 0xf3, 0x45, 0x1b, 0x30, 0xed, 0x37, 0x48, 0x20, 0x7e,
+	0x5b, 0xd8, 0x71, 0x02, 0xc2, 0x98, 0x36, 0xd4, 0xc2, 0x47, 0xef, 0xae, 0xcc, 0xe9, 0x4d, 0x5c,
+	0x57, 0x94, 0x6d, 0x1e, 0x50, 0xaf, 0x66, 0xe6, 0xc3, 0x19, 0xba, 0x1b, 0xbd, 0x28, 0xf6, 0xcd,
+	0x63, 0xc4, 0x63, 0x4d, 0x66, 0x35, 0x9a, 0xd5, 0x03, 0xd2, 0xd2, 0x7a, 0x9d, 0xeb, 0xd2, 0xeb,
+	0xba, 0xd7, 0x2a, 0x15, 0xde, 0x8f, 0xa1, 0xed, 0xa0, 0xd5, 0xe0, 0xfe, 0x6a, 0xa5, 0x59, 0xbd,
+	0x4c, 0x5a, 0x66, 0x3e, 0xc2, 0xa9, 0x48, 0x18, 0x74, 0x0a, 0xc6, 0xbe, 0x8b, 0xa9, 0x4b, 0x1c,
+	0xa9, 0x95, 0x8c, 0xa9, 0x5b, 0x68, 0x1d, 0xc6, 0x18, 0xc7, 0xbc, 0xc9, 0xa4, 0x2a, 0xa6, 0x2e,
+	0x9c, 0xef, 0x63, 0x20, 0x25, 0xdf, 0x73, 0xb6, 0xe5, 0x04, 0x53, 0x4f, 0x44, 0x3b, 0x30, 0xc6,
+	0xfd, 0x03, 0xe2, 0x69, 0x5d, 0x0d, 0x64, 0xe3, 0x5b, 0x1e, 0x4f, 0xd8, 0xf8, 0x96, 0xc7, 0x4d,
+	0x8d, 0x85, 0x6a, 0x30, 0xed, 0x10, 0x97, 0xd4, 0xa4, 0x46, 0xd9, 0x3e, 0x0e, 0x08, 0x2b, 0x8c,
+	0x0d, 0xe1, 0x0c, 0xe5, 0x23, 0xd4, 0x6d, 0x09, 0x8a, 0x4c, 0xc8, 0x39, 0xb1, 0xd5, 0x15, 0xc6,
+	0xa5, 0xbe, 0x1f, 0xee, 0xa3, 0x86, 0x84, 0x9d, 0x6a, 0xcf, 0x95, 0x04, 0x11, 0xa6, 0xd6, 0xf4,
+	0xaa, 0xbe, 0xe7, 0x50, 0xaf, 0x66, 0xed, 0x13, 0x5a, 0xdb, 0xe7, 0x85, 0xcc, 0xb2, 0x71, 0x6e,
+	0xd4, 0xcc, 0x47, 0xfd, 0xcf, 0xca, 0x6e, 0x74, 0x19, 0xa6, 0xe2, 0xa1, 0xf2, 0x24, 0x65, 0x07,
+	0x38, 0x49, 0x93, 0xd1, 0x5c, 0x41, 0x45, 0xcf, 0x03, 0xc4, 0xc7, 0xb4, 0x00, 0x12, 0xe8, 0xfc,
+	0x3d, 0x1f, 0x79, 0x2d, 0x49, 0x02, 0x02, 0x7d, 0x0f, 0x1e, 0xe0, 0x3e, 0xc7, 0xae, 0x75, 0x18,
+	0x5a, 0xba, 0x25, 0xd6, 0x0b, 0x37, 0x24, 0x37, 0x84, 0x0d, 0x29, 0xc8, 0x05, 0xe2, 0x40, 0x20,
+	0x0c, 0x4c, 0xed, 0x8c, 0x0b, 0xb3, 0x6a, 0x71, 0x25, 0x40, 0xb8, 0xe8, 0xc4, 0x10, 0x16, 0x9d,
+	0x91, 0xc0, 0x57, 0x24, 0xae, 0x5e, 0x2d, 0x80, 0x53, 0x6a, 0x35, 0x69, 0x80, 0xf4, 0x55, 0x12,
+	0x2d, 0x38, 0x39, 0x84, 0x05, 0xe7, 0x24, 0xf6, 0x4e, 0x08, 0xad, 0xd7, 0x6c, 0xc2, 0xc9, 0x50,
+	0x36, 0xb5, 0x2b, 0x56, 0xc3, 0x77, 0xa9, 0xdd, 0x2a, 0x4c, 0xc9, 0xad, 0xfb, 0xea, 0xbd, 0x46,
+	0x4f, 0x2d, 0x88, 0x22, 0x57, 0x24, 0x84, 0xde, 0xcc, 0x59, 0xb7, 0x9b, 0x74, 0x71, 0xe2, 0xb5,
+	0xb7, 0x96, 0x46, 0xb4, 0x23, 0x1b, 0x29, 0x56, 0x60, 0xe2, 0x1a, 0x76, 0xb5, 0x0f, 0x22, 0x0c,
+	0x3d, 0x09, 0x59, 0x1c, 0x36, 0x0a, 0xc6, 0xf2, 0xe8, 0xb1, 0x3e, 0x2c, 0x1e, 0xaa, 0x5c, 0xe3,
+	0x8f, 0xfe, 0xb6, 0x6c, 0x14, 0xdf, 0x36, 0x60, 0xac, 0x7c, 0xad, 0x82, 0x69, 0x80, 0x36, 0x61,
+	0x26, 0x3e, 0xc6, 0xf7, 0xea, 0x18, 0xe3, 0x93, 0xaf, 0xfb, 0x05, 0x4c, 0x6c, 0x81, 0x21, 0x4c,
+	0xaa, 0x1f, 0x4c, 0x34, 0x45, 0xf7, 0x77, 0x08, 0x7e, 0x05, 0xc6, 0x15, 0x97, 0x0c, 0xad, 0xc3,
+	0x89, 0x86, 0xf8, 0x21, 0xe5, 0xcd, 0x5d, 0x38, 0xdb, 0xef, 0xf8, 0xcb, 0x69, 0x5a, 0xc5, 0x6a,
+	0x66, 0xf1, 0xbf, 0x06, 0x40, 0xf9, 0xda, 0xb5, 0x9d, 0x80, 0x36, 0x5c, 0xc2, 0x87, 0x25, 0xf8,
+	0x15, 0x38, 0x19, 0x0b, 0xce, 0x02, 0xfb, 0x9e, 0x85, 0x9f, 0x8d, 0xa6, 0x6d, 0x07, 0x76, 0x4f,
+	0x34, 0x87, 0xf1, 0x08, 0x6d, 0xf4, 0x9e, 0xd1, 0xca, 0x8c, 0xf7, 0xd6, 0xe6, 0x4b, 0x90, 0x8b,
+	0xc5, 0x67, 0xe8, 0x32, 0x64, 0xb8, 0xfe, 0xad, 0x95, 0x7a, 0xbe, 0xaf, 0x52, 0xc3, 0xd9, 0x5a,
+	0xb1, 0x11, 0x40, 0xf1, 0x57, 0x29, 0x80, 0xb2, 0x52, 0x8d, 0xf0, 0x4a, 0x9f, 0x2b, 0xa3, 0x12,
+	0xf1, 0x4f, 0x3b, 0x8a, 0x61, 0xe4, 0x78, 0x1a, 0x0b, 0x9d, 0x85, 0xa9, 0x76, 0x9f, 0x2b, 0x03,
+	0x74, 0xc6, 0x9c, 0x3c, 0x4c, 0x7a, 0xca, 0x8e, 0x3d, 0xb8, 0x91, 0x82, 0xd9, 0xdd, 0x30, 0x22,
+	0x7c, 0x6e, 0x15, 0xf6, 0x02, 0x8c, 0x13, 0x8f, 0x07, 0x54, 0x6a, 0x4c, 0x58, 0xc6, 0x53, 0x7d,
+	0x2c, 0xa3, 0x87, 0x48, 0x9b, 0x1e, 0x0f, 0x42, 0x1f, 0x17, 0xa2, 0x75, 0x28, 0xe3, 0xaf, 0x29,
+	0x28, 0xdc, 0x6d, 0x26, 0x7a, 0x08, 0xf2, 0x76, 0x40, 0x64, 0x47, 0x18, 0xa0, 0x0d, 0x19, 0xa0,
+	0xa7, 0xc2, 0x6e, 0x1d, 0x9f, 0x9f, 0x03, 0x91, 0xf9, 0x0a, 0x33, 0x14, 0x43, 0x07, 0x4e, 0x75,
+	0xa7, 0xe2, 0xc9, 0x82, 0x8c, 0x08, 0xe4, 0xa9, 0x47, 0x39, 0xc5, 0xae, 0x55, 0xc5, 0x2e, 0xf6,
+	0xec, 0xfb, 0xb9, 0x19, 0x74, 0x67, 0x4d, 0x53, 0x1a, 0xb4, 0xa4, 0x30, 0xd1, 0x35, 0x18, 0x0f,
+	0xe1, 0xd3, 0x43, 0x80, 0x0f, 0xc1, 0x12, 0xe9, 0xef, 0x9f, 0x53, 0x30, 0x63, 0x12, 0xe7, 0x8b,
+	0xa5, 0xd6, 0x97, 0x01, 0xd4, 0xf1, 0x14, 0xce, 0xb3, 0x90, 0x1e, 0xc2, 0x71, 0xcf, 0x2a, 0xbc,
+	0x32, 0xe3, 0x09, 0xdd, 0xfe, 0x31, 0x05, 0x13, 0x49, 0xdd, 0x7e, 0x01, 0x82, 0x09, 0xaa, 0xc4,
+	0x4e, 0x21, 0x2d, 0x9d, 0xc2, 0xa3, 0x7d, 0x9c, 0x42, 0x97, 0xf1, 0x1d, 0xef, 0x0d, 0xde, 0x1f,
+	0x87, 0xb1, 0x0a, 0x0e, 0x70, 0x9d, 0xa1, 0x6f, 0x76, 0xa5, 0xdc, 0xea, 0x72, 0x3c, 0xdf, 0x65,
+	0x7a, 0x65, 0x5d, 0xa2, 0x51, 0x96, 0xf7, 0x66, 0x8f, 0x8c, 0xfb, 0x2c, 0x4c, 0x89, 0x9b, 0x7e,
+	0x24, 0x91, 0xd2, 0xe5, 0xa4, 0xbc, 0xaa, 0x47, 0xe9, 0x19, 0x43, 0x4b, 0x90, 0x13, 0xc3, 0x62,
+	0xb7, 0x27, 0xc6, 0x40, 0x1d, 0x1f, 0x6d, 0xaa, 0x1e, 0xb4, 0x02, 0x68, 0x3f, 0x2a, 0xc1, 0x58,
+	0xb1, 0x26, 0xc4, 0xb8, 0x99, 0x98, 0x12, 0x0e, 0x3f, 0x03, 0x20, 0xf3, 0x70, 0x87, 0x78, 0x7e,
+	0x5d, 0xdf, 0x51, 0xb3, 0xa2, 0xa7, 0x2c, 0x3a, 0xd0, 0xf7, 0x61, 0xb6, 0x4e, 0x3d, 0xab, 0xa3,
+	0x08, 0xa0, 0xef, 0x4f, 0x57, 0x06, 0x33, 0xd8, 0x7f, 0xdf, 0x5a, 0x5a, 0x68, 0xe1, 0xba, 0x7b,
+	0xb1, 0xd8, 0x03, 0xb2, 0x68, 0xce, 0xd4, 0xa9, 0xd7, 0x5e, 0x35, 0x40, 0x3f, 0x36, 0x92, 0x96,
+	0x21, 0xf9, 0xdc, 0xc3, 0x36, 0xf7, 0x03, 0x79, 0xb9, 0xca, 0x96, 0xae, 0x0e, 0xcc, 0xc0, 0x69,
+	0xc5, 0x40, 0x4f, 0xd0, 0xa2, 0x39, 0xdb, 0x16, 0x12, 0x2f, 0xc9, 0x5e, 0xf4, 0xba, 0x01, 0xf3,
+	0x35, 0xd7, 0xaf, 0x26, 0xae, 0x0f, 0x3a, 0xc5, 0xb6, 0x71, 0x43, 0x5e, 0xc6, 0xb2, 0x25, 0x73,
+	0x60, 0x46, 0x96, 0x15, 0x23, 0x77, 0x05, 0x2e, 0x9a, 0xa7, 0x14, 0xad, 0x2d, 0x23, 0xdf, 0xc0,
+	0x0d, 0xf4, 0x33, 0x03, 0x4e, 0xc7, 0xfc, 0xf7, 0x60, 0x29, 0x2b, 0x59, 0xda, 0x1d, 0x98, 0xa5,
+	0x2f, 0x75, 0xea, 0xa6, 0x17, 0x57, 0xf3, 0x87, 0x3d, 0xaf, 0x0a, 0x82, 0xb1, 0x5f, 0x1b, 0xd0,
+	0xa5, 0x58, 0x1a, 0x30, 0x6e, 0xb9, 0x3e, 0x63, 0xd6, 0x5e, 0x80, 0x6d, 0x1e, 0x5e, 0x26, 0xb3,
+	0xa5, 0x97, 0x07, 0x66, 0xef, 0x7c, 0xef, 0xad, 0xeb, 0x5e, 0xa1, 0x68, 0x2e, 0xb6, 0xef, 0xa3,
+	0x18, 0x72, 0xc5, 0x67, 0xec, 0x92, 0x1e, 0x90, 0x70, 0x90, 0xbf, 0x31, 0x00, 0xc5, 0x11, 0xdd,
+	0x24, 0xac, 0xe1, 0x7b, 0x4c, 0x5e, 0x7f, 0x63, 0x9f, 0xa0, 0x0f, 0x75, 0xdf, 0xac, 0x33, 0x9a,
+	0x10, 0x5e, 0x7f, 0x13, 0x7e, 0xf7, 0x99, 0x38, 0x8c, 0xa6, 0xb4, 0x8b, 0xd0, 0x1e, 0x4d, 0x54,
+	0x5a, 0x13, 0x57, 0x68, 0x1a, 0xce, 0xee, 0x8a, 0x94, 0x23, 0xc5, 0x8f, 0x0d, 0x98, 0xef, 0x72,
+	0x56, 0x11, 0xcf, 0x04, 0x50, 0x90, 0x20, 0xca, 0xa3, 0xdf, 0xd2, 0xbc, 0xdf, 0xaf, 0x0b, 0x9c,
+	0x09, 0x3a, 0x09, 0x9f, 0x59, 0x42, 0x90, 0x96, 0xfb, 0xf1, 0x81, 0x01, 0x73, 0x49, 0x66, 0x22,
+	0xe9, 0x76, 0x61, 0x22, 0xc9, 0x8b, 0x96, 0xeb, 0x91, 0x01, 0xe4, 0xd2, 0x22, 0xb5, 0xc1, 0xa0,
+	0x6f, 0xc7, 0xc1, 0x42, 0xd5, 0x99, 0x9f, 0x1e, 0x54, 0x53, 0x21, 0x87, 0x9d, 0x41, 0x23, 0x2d,
+	0xb7, 0xec, 0x27, 0x29, 0x48, 0x57, 0x7c, 0xdf, 0x45, 0x3f, 0x80, 0x19, 0xcf, 0xe7, 0xd2, 0x66,
+	0x89, 0x63, 0xe9, 0x32, 0x97, 0x0a, 0xbc, 0xdf, 0x1a, 0x4c, 0x81, 0xff, 0xbc, 0xb5, 0xd4, 0x0d,
+	0xd5, 0xa1, 0xd5, 0xbc, 0xe7, 0xf3, 0x92, 0xa4, 0xcb, 0x42, 0x81, 0xa8, 0x49, 0x4c, 0xb6, 0x2f,
+	0xad, 0x02, 0xf5, 0x73, 0x03, 0x2f, 0x3d, 0x79, 0xdc, 0xb2, 0x13, 0xd5, 0xc4, 0x9a, 0x17, 0x33,
+	0x62, 0x47, 0x3f, 0x11, 0xbb, 0xfa, 0x53, 0x03, 0x66, 0xc3, 0x8a, 0x85, 0x2c, 0x58, 0x98, 0xc4,
+	0xf6, 0x03, 0x07, 0x4d, 0x41, 0x8a, 0x3a, 0x52, 0x0b, 0x69, 0x33, 0x45, 0x1d, 0x34, 0x07, 0x27,
+	0xfc, 0xeb, 0x1e, 0x09, 0x74, 0x2d, 0x56, 0x35, 0x64, 0x64, 0xf4, 0x9d, 0xa6, 0x4b, 0x2c, 0x6c,
+	0xdb, 0x7e, 0xd3, 0xe3, 0xba, 0x1e, 0x3b, 0xa9, 0x7a, 0xd7, 0x55, 0x27, 0x3a, 0x0d, 0xd9, 0xe8,
+	0xd8, 0xeb, 0x72, 0x6c, 0xdc, 0xa1, 0xcd, 0xeb, 0x3b, 0x50, 0xac, 0x10, 0x15, 0x73, 0x93, 0xec,
+	0xac, 0x37, 0xf9, 0xbe, 0x1f, 0xd0, 0x57, 0xe5, 0xae, 0xde, 0x77, 0xdd, 0xa2, 0xf8, 0xf3, 0x54,
+	0x6f, 0x78, 0x25, 0xed, 0x4e, 0x80, 0x3d, 0xb6, 0x47, 0x02, 0xf4, 0x14, 0x14, 0xc2, 0xca, 0x90,
+	0x2a, 0x0c, 0x59, 0x81, 0x1c, 0x60, 0x45, 0xba, 0x38, 0xc9, 0xbb, 0xa7, 0x6f, 0x39, 0x68, 0xb5,
+	0x4d, 0x3d, 0xc7, 0xf0, 0xa4, 0x15, 0xf7, 0x04, 0x64, 0x3d, 0x72, 0xdd, 0x52, 0x73, 0xfa, 0xe5,
+	0x52, 0x19, 0x8f, 0x5c, 0x7f, 0x5e, 0x4e, 0x7b, 0x0e, 0xf2, 0xe4, 0xa8, 0x41, 0x55, 0xc2, 0xa2,
+	0xd2, 0x9a, 0xf4, 0x20, 0x19, 0x75, 0x3c, 0x59, 0x90, 0xb5, 0xe6, 0x9f, 0x81, 0xb3, 0xfd, 0x55,
+	0xb3, 0xe5, 0x30, 0x34, 0x0d, 0xa3, 0xd4, 0x51, 0x6a, 0x4f, 0x9b, 0xe2, 0x67, 0xf1, 0x17, 0x06,
+	0x14, 0x76, 0x12, 0x55, 0x36, 0x8e, 0x0f, 0x88, 0x63, 0x92, 0xbd, 0x80, 0xb0, 0x7d, 0xb4, 0x0a,
+	0xb3, 0x1e, 0x39, 0xe2, 0x56, 0xc2, 0xf1, 0x89, 0x62, 0xb7, 0xd0, 0xe3, 0x84, 0x39, 0x23, 0x48,
+	0xb1, 0x5f, 0xbe, 0x4c, 0x5a, 0xe8, 0x71, 0x38, 0x19, 0x0f, 0x95, 0x4f, 0x60, 0xb6, 0xd8, 0x3c,
+	0x47, 0xea, 0x34, 0x6d, 0xce, 0x25, 0x88, 0x95, 0x90, 0x86, 0xfe, 0x1f, 0x26, 0x18, 0xc7, 0x01,
+	0x0f, 0x6f, 0x22, 0xa3, 0xf2, 0x26, 0x92, 0x93, 0x7d, 0xea, 0x1a, 0x52, 0x7c, 0x27, 0x0b, 0xb9,
+	0x6d, 0x17, 0xb3, 0xfd, 0xbb, 0x98, 0xf6, 0x90, 0x6e, 0xbc, 0xa7, 0xc4, 0x73, 0x5a, 0x82, 0x07,
+	0xdd, 0x42, 0x8f, 0xc0, 0x0c, 0xf5, 0xc2, 0x00, 0x18, 0xb2, 0x99, 0x96, 0x43, 0xa6, 0x63, 0x82,
+	0xbe, 0x32, 0x3d, 0x04, 0xf9, 0xb8, 0xcf, 0x12, 0xa7, 0x5b, 0x27, 0x7e, 0x53, 0x71, 0xf7, 0x4e,
+	0xab, 0x41, 0x90, 0x05, 0x13, 0x4c, 0xc8, 0x14, 0x66, 0x5d, 0xc3, 0x28, 0x9b, 0xe7, 0x24, 0xa2,
+	0xce, 0xad, 0x0e, 0x00, 0x91, 0xbd, 0x3d, 0x62, 0x73, 0x7a, 0x48, 0xe2, 0x0c, 0x61, 0x7c, 0x18,
+	0x75, 0xd9, 0x08, 0x37, 0x8c, 0xfa, 0x08, 0xc3, 0xa4, 0xf2, 0x5a, 0x56, 0xb5, 0x19, 0x78, 0xc4,
+	0x29, 0x64, 0x06, 0x5e, 0xa7, 0x3b, 0x7e, 0x4d, 0x28, 0xc8, 0x92, 0x44, 0x14, 0xa5, 0x5f, 0x9d,
+	0x34, 0xe9, 0x95, 0x1c, 0xe2, 0x34, 0x6d, 0x4e, 0x9c, 0x42, 0x76, 0xe0, 0xb5, 0x7a, 0x94, 0x7e,
+	0x15, 0xb6, 0x72, 0xaf, 0x65, 0x8d, 0x9c, 0x14, 0x8b, 0xec, 0xf9, 0x01, 0x29, 0xc0, 0xc0, 0x4b,
+	0xdd, 0x5d, 0x2c, 0x89, 0xd8, 0xf3, 0x09, 0x25, 0xf7, 0x59, 0x3c, 0xa1, 0x5c, 0x87, 0xf9, 0xbb,
+	0xe6, 0x77, 0x85, 0x89, 0x21, 0xc8, 0x75, 0xaa, 0x77, 0x66, 0x88, 0x7e, 0x08, 0x67, 0x7a, 0x3e,
+	0x4c, 0x58, 0x01, 0xa9, 0xfb, 0x87, 0xc4, 0x19, 0x4a, 0xe9, 0x7e, 0xe1, 0xb0, 0xfb, 0x6d, 0xc2,
+	0x54, 0xf8, 0x42, 0xc5, 0xd4, 0x63, 0xcd, 0x40, 0xe4, 0x42, 0x56, 0x03, 0xb7, 0xfc, 0x26, 0x2f,
+	0x4c, 0x0d, 0xbc, 0x66, 0xb7, 0xc0, 0xf9, 0x08, 0xb5, 0x22, 0x41, 0xb5, 0x3b, 0xfe, 0x8f, 0x01,
+	0xa7, 0xa4, 0xbb, 0xda, 0x0a, 0xc9, 0x1b, 0xfe, 0x21, 0x09, 0x70, 0x8d, 0x20, 0x0a, 0x33, 0xb6,
+	0xfe, 0x1d, 0x1f, 0xc9, 0x61, 0x3c, 0x95, 0x4f, 0x87, 0xb0, 0xd1, 0x89, 0xac, 0xc3, 0x9c, 0xb8,
+	0xcc, 0x2a, 0x71, 0xad, 0x06, 0x09, 0x2c, 0xe9, 0x1c, 0x0a, 0xa9, 0x21, 0x08, 0x3e, 0x53, 0xc7,
+	0x47, 0x4a, 0xe4, 0x0a, 0x09, 0xa4, 0xa8, 0x5a, 0xf4, 0x4f, 0x52, 0x30, 0xd7, 0x2e, 0xba, 0x1a,
+	0x86, 0x1e, 0x84, 0xbc, 0xf2, 0x76, 0x9d, 0xe1, 0x78, 0x92, 0xc5, 0x8e, 0x7d, 0x6b, 0x68, 0xae,
+	0xfc, 0xb8, 0x34, 0x60, 0xf4, 0xb8, 0x34, 0x60, 0x07, 0xc6, 0x70, 0x5d, 0xe6, 0x41, 0xc3, 0x48,
+	0xc0, 0x35, 0x56, 0xa2, 0xf8, 0x7c, 0x62, 0x78, 0xc5, 0x67, 0xad, 0xf2, 0x7f, 0xa5, 0x44, 0x04,
+	0xef, 0x92, 0x65, 0xc3, 0xc5, 0xb4, 0x8e, 0x2a, 0x30, 0xa6, 0x04, 0xd7, 0x39, 0xfd, 0x85, 0x3e,
+	0x19, 0x78, 0x0f, 0xa0, 0xf0, 0x9b, 0x0f, 0x85, 0x93, 0x10, 0x25, 0x35, 0xc4, 0x3a, 0x7a, 0xfc,
+	0x3a, 0x3d, 0x3a, 0xc4, 0xd7, 0xe9, 0x1e, 0xe5, 0xcb, 0xf4, 0xfd, 0x97, 0x2f, 0xb5, 0xbe, 0x5f,
+	0x37, 0x60, 0x46, 0xab, 0x49, 0xa6, 0x32, 0x15, 0xdc, 0x64, 0xa4, 0xb7, 0xdd, 0x1a, 0x03, 0xdb,
+	0xed, 0x23, 0x30, 0xd3, 0x10, 0x78, 0x96, 0xb8, 0x48, 0xd5, 0xe5, 0x33, 0xb5, 0x52, 0x74, 0xc6,
+	0x9c, 0x96, 0x04, 0x33, 0xee, 0xd7, 0xfc, 0xbc, 0x9d, 0x82, 0xd3, 0xc7, 0x3d, 0x31, 0xa2, 0x3d,
+	0x40, 0x3d, 0x0a, 0x19, 0x8a, 0xb7, 0xa7, 0xef, 0xdf, 0xe1, 0xb8, 0x9d, 0x25, 0x0a, 0x0c, 0xcb,
+	0xd8, 0x75, 0xfd, 0xeb, 0xc4, 0xe9, 0x2c, 0x6e, 0x34, 0x02, 0xff, 0x90, 0x3a, 0x24, 0x50, 0xf7,
+	0xc0, 0xe3, 0x34, 0x72, 0x46, 0x23, 0xb4, 0xcb, 0x11, 0x4e, 0x17, 0x09, 0x26, 0x4f, 0xa8, 0xde,
+	0x72, 0x28, 0xc3, 0xd5, 0xf8, 0x73, 0x89, 0xb9, 0x24, 0xb1, 0xac, 0x69, 0x5a, 0x4d, 0xbf, 0x33,
+	0x60, 0x6e, 0x57, 0x7e, 0xd0, 0xa2, 0xea, 0x8b, 0x95, 0xc0, 0x6f, 0xf8, 0x0c, 0xbb, 0xe2, 0x5e,
+	0xc4, 0x29, 0x77, 0xf5, 0x17, 0x4b, 0xa6, 0x6a, 0xa0, 0xe5, 0xf6, 0xef, 0x0d, 0xd4, 0x9d, 0x29,
+	0xd9, 0x85, 0x36, 0x60, 0xac, 0x21, 0x91, 0xe4, 0xe2, 0xfd, 0x5f, 0x23, 0xd5, 0xb2, 0xe1, 0x69,
+	0x52, 0x53, 0x2f, 0x3e, 0x9c, 0xac, 0x7e, 0xbe, 0xff, 0xee, 0xca, 0x82, 0xd6, 0x4a, 0xcd, 0x3f,
+	0x4c, 0xd4, 0x2d, 0x3c, 0x4e, 0x3c, 0x5e, 0xbc, 0x63, 0xc0, 0x97, 0x95, 0x04, 0xbd, 0x83, 0xcb,
+	0xa7, 0x96, 0xe8, 0x05, 0xc8, 0x84, 0x51, 0x44, 0xcb, 0xf4, 0x44, 0x1f, 0x99, 0x7a, 0x33, 0x12,
+	0x3e, 0x0c, 0x86, 0x60, 0x03, 0x49, 0xf9, 0x81, 0x01, 0xf3, 0xf2, 0x48, 0xb5, 0x9d, 0xb1, 0x4f,
+	0x2b, 0xda, 0x15, 0xf1, 0x72, 0xdc, 0x64, 0xa1, 0x5c, 0x8f, 0xde, 0x9b, 0x1b, 0x8c, 0xcf, 0x77,
+	0xfc, 0x88, 0xdc, 0x64, 0x83, 0xc9, 0xf3, 0x9e, 0x01, 0x0f, 0xec, 0x7a, 0x8d, 0xa1, 0x4b, 0xd4,
+	0xd3, 0xe1, 0x8c, 0x0e, 0xfc, 0xd6, 0x3e, 0x80, 0x28, 0x0f, 0xff, 0xd6, 0x00, 0x88, 0xbf, 0x2c,
+	0x42, 0x5f, 0x81, 0xff, 0x2b, 0x3d, 0x7f, 0xb5, 0x6c, 0x6d, 0xef, 0xac, 0xef, 0xec, 0x6e, 0x5b,
+	0xbb, 0x57, 0xb7, 0x2b, 0x9b, 0x1b, 0x5b, 0x97, 0xb6, 0x36, 0xcb, 0xd3, 0x23, 0x0b, 0xf9, 0x1b,
+	0x37, 0x97, 0x73, 0xbb, 0x1e, 0x6b, 0x10, 0x9b, 0xee, 0x51, 0xe2, 0xa0, 0x07, 0x61, 0xae, 0x7d,
+	0xb4, 0x68, 0x6d, 0x96, 0xa7, 0x8d, 0x85, 0x89, 0x1b, 0x37, 0x97, 0x33, 0xea, 0x09, 0x90, 0x38,
+	0xe8, 0x1c, 0x9c, 0xec, 0x1e, 0xb7, 0x75, 0xf5, 0x1b, 0xd3, 0xa9, 0x85, 0xc9, 0x1b, 0x37, 0x97,
+	0xb3, 0xd1, 0x5b, 0x21, 0x2a, 0x02, 0x4a, 0x8e, 0xd4, 0x78, 0xa3, 0x0b, 0x70, 0xe3, 0xe6, 0xf2,
+	0x98, 0x2a, 0xd0, 0x2c, 0xa4, 0x5f, 0xfb, 0xe5, 0xe2, 0x48, 0xe9, 0xc5, 0xf7, 0x6e, 0x2f, 0x1a,
+	0x1f, 0xde, 0x5e, 0x34, 0x3e, 0xbe, 0xbd, 0x68, 0xbc, 0x71, 0x67, 0x71, 0xe4, 0xc3, 0x3b, 0x8b,
+	0x23, 0x7f, 0xba, 0xb3, 0x38, 0xf2, 0xd2, 0xd7, 0x13, 0xbe, 0x8f, 0xbe, 0xe2, 0x36, 0x19, 0xf5,
+	0x3d, 0xea, 0xd9, 0x6b, 0xca, 0x3c, 0x28, 0x6f, 0xad, 0x68, 0xd3, 0x58, 0x51, 0xf5, 0x90, 0xb5,
+	0xa3, 0xf0, 0xfb, 0x53, 0xe5, 0x18, 0xab, 0x63, 0x32, 0x82, 0x3c, 0xfe, 0xbf, 0x01, 0x00, 0x0e,
+	0x55, 0xce, 0x33, 0xa7, 0x2a, 0x00, 0x00,
 }
 
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
+func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	return StakingDescription()
+}
+func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
+	var gzipped = []byte{
+		// 8993 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7d, 0x70, 0x24, 0xc7,
+		0x75, 0x1f, 0xf6, 0x03, 0xc0, 0xee, 0xc3, 0x02, 0x18, 0x0c, 0x70, 0xc7, 0x3d, 0x1c, 0x0f, 0x00,
+		0x97, 0x22, 0x79, 0x77, 0xd4, 0xe1, 0xc8, 0x23, 0xef, 0x8e, 0xb7, 0x27, 0x89, 0x59, 0x00, 0x7b,
+		0x47, 0x1c, 0xf1, 0xb1, 0x9a, 0x05, 0x8e, 0x1f, 0x4a, 0x6a, 0x32, 0x98, 0x6d, 0x2c, 0x86, 0x37,
+		0x3b, 0x33, 0x9a, 0x99, 0xc5, 0x1d, 0x18, 0x27, 0xa1, 0xa3, 0xc4, 0xb1, 0x2f, 0x51, 0x22, 0xc7,
+		0x29, 0x5b, 0x92, 0x75, 0x8a, 0x28, 0xcb, 0xa1, 0xa3, 0x28, 0x1f, 0xb6, 0x14, 0x39, 0x8e, 0x2b,
+		0x89, 0xe2, 0xaa, 0x38, 0x8a, 0xaa, 0x9c, 0x92, 0xfd, 0x47, 0xec, 0x7c, 0x31, 0x32, 0xe9, 0x4a,
+		0x14, 0x5b, 0x8a, 0x15, 0x87, 0xae, 0x72, 0x4a, 0xe5, 0x54, 0xea, 0xf5, 0xc7, 0xcc, 0xec, 0x17,
+		0x66, 0xf7, 0x04, 0x2a, 0xaa, 0xf2, 0x5f, 0xd8, 0x79, 0xfd, 0xde, 0xaf, 0xbb, 0x5f, 0xbf, 0x7e,
+		0xfd, 0xfa, 0x75, 0xcf, 0x00, 0x7e, 0x7b, 0x09, 0x16, 0xea, 0xb6, 0x5d, 0x37, 0xc9, 0x79, 0xc7,
+		0xb5, 0x7d, 0x7b, 0xa7, 0xb9, 0x7b, 0xbe, 0x46, 0x3c, 0xdd, 0x35, 0x1c, 0xdf, 0x76, 0x17, 0x29,
+		0x4d, 0x9e, 0x64, 0x1c, 0x8b, 0x82, 0xa3, 0xb0, 0x0e, 0x53, 0xd7, 0x0c, 0x93, 0xac, 0x04, 0x8c,
+		0x55, 0xe2, 0xcb, 0xcf, 0x40, 0x7a, 0xd7, 0x30, 0x49, 0x3e, 0xb1, 0x90, 0x3a, 0x3d, 0x76, 0xe1,
+		0x3d, 0x8b, 0x6d, 0x42, 0x8b, 0xad, 0x12, 0x15, 0x24, 0x2b, 0x54, 0xa2, 0xf0, 0x7f, 0xd3, 0x30,
+		0xdd, 0xa5, 0x54, 0x96, 0x21, 0x6d, 0x69, 0x0d, 0x44, 0x4c, 0x9c, 0xce, 0x2a, 0xf4, 0xb7, 0x9c,
+		0x87, 0x51, 0x47, 0xd3, 0x6f, 0x69, 0x75, 0x92, 0x4f, 0x52, 0xb2, 0x78, 0x94, 0xe7, 0x00, 0x6a,
+		0xc4, 0x21, 0x56, 0x8d, 0x58, 0xfa, 0x41, 0x3e, 0xb5, 0x90, 0x3a, 0x9d, 0x55, 0x22, 0x14, 0xf9,
+		0x71, 0x98, 0x72, 0x9a, 0x3b, 0xa6, 0xa1, 0xab, 0x11, 0x36, 0x58, 0x48, 0x9d, 0x1e, 0x56, 0x24,
+		0x56, 0xb0, 0x12, 0x32, 0x3f, 0x06, 0x93, 0xb7, 0x89, 0x76, 0x2b, 0xca, 0x3a, 0x46, 0x59, 0x27,
+		0x90, 0x1c, 0x61, 0x5c, 0x86, 0x5c, 0x83, 0x78, 0x9e, 0x56, 0x27, 0xaa, 0x7f, 0xe0, 0x90, 0x7c,
+		0x9a, 0xf6, 0x7e, 0xa1, 0xa3, 0xf7, 0xed, 0x3d, 0x1f, 0xe3, 0x52, 0x5b, 0x07, 0x0e, 0x91, 0x4b,
+		0x90, 0x25, 0x56, 0xb3, 0xc1, 0x10, 0x86, 0x7b, 0xe8, 0xaf, 0x6c, 0x35, 0x1b, 0xed, 0x28, 0x19,
+		0x14, 0xe3, 0x10, 0xa3, 0x1e, 0x71, 0xf7, 0x0d, 0x9d, 0xe4, 0x47, 0x28, 0xc0, 0x63, 0x1d, 0x00,
+		0x55, 0x56, 0xde, 0x8e, 0x21, 0xe4, 0xe4, 0x65, 0xc8, 0x92, 0x3b, 0x3e, 0xb1, 0x3c, 0xc3, 0xb6,
+		0xf2, 0xa3, 0x14, 0xe4, 0x91, 0x2e, 0xa3, 0x48, 0xcc, 0x5a, 0x3b, 0x44, 0x28, 0x27, 0x5f, 0x82,
+		0x51, 0xdb, 0xf1, 0x0d, 0xdb, 0xf2, 0xf2, 0x99, 0x85, 0xc4, 0xe9, 0xb1, 0x0b, 0x0f, 0x76, 0x35,
+		0x84, 0x4d, 0xc6, 0xa3, 0x08, 0x66, 0x79, 0x15, 0x24, 0xcf, 0x6e, 0xba, 0x3a, 0x51, 0x75, 0xbb,
+		0x46, 0x54, 0xc3, 0xda, 0xb5, 0xf3, 0x59, 0x0a, 0x30, 0xdf, 0xd9, 0x11, 0xca, 0xb8, 0x6c, 0xd7,
+		0xc8, 0xaa, 0xb5, 0x6b, 0x2b, 0x13, 0x5e, 0xcb, 0xb3, 0x7c, 0x1c, 0x46, 0xbc, 0x03, 0xcb, 0xd7,
+		0xee, 0xe4, 0x73, 0xd4, 0x42, 0xf8, 0x13, 0x9a, 0x0e, 0xa9, 0x19, 0x58, 0x5d, 0x7e, 0x9c, 0x99,
+		0x0e, 0x7f, 0x2c, 0xfc, 0xd2, 0x08, 0x4c, 0xf6, 0x63, 0x7c, 0x57, 0x61, 0x78, 0x17, 0xfb, 0x9f,
+		0x4f, 0x0e, 0xa2, 0x1d, 0x26, 0xd3, 0xaa, 0xde, 0x91, 0xfb, 0x54, 0x6f, 0x09, 0xc6, 0x2c, 0xe2,
+		0xf9, 0xa4, 0xc6, 0x6c, 0x25, 0xd5, 0xa7, 0xb5, 0x01, 0x13, 0xea, 0x34, 0xb6, 0xf4, 0x7d, 0x19,
+		0xdb, 0x8b, 0x30, 0x19, 0x34, 0x49, 0x75, 0x35, 0xab, 0x2e, 0xac, 0xf6, 0x7c, 0x5c, 0x4b, 0x16,
+		0xcb, 0x42, 0x4e, 0x41, 0x31, 0x65, 0x82, 0xb4, 0x3c, 0xcb, 0x2b, 0x00, 0xb6, 0x45, 0xec, 0x5d,
+		0xb5, 0x46, 0x74, 0x33, 0x9f, 0xe9, 0xa1, 0xa5, 0x4d, 0x64, 0xe9, 0xd0, 0x92, 0xcd, 0xa8, 0xba,
+		0x29, 0x5f, 0x09, 0x8d, 0x70, 0xb4, 0x87, 0x0d, 0xad, 0xb3, 0xe9, 0xd7, 0x61, 0x87, 0xdb, 0x30,
+		0xe1, 0x12, 0x9c, 0x11, 0xa4, 0xc6, 0x7b, 0x96, 0xa5, 0x8d, 0x58, 0x8c, 0xed, 0x99, 0xc2, 0xc5,
+		0x58, 0xc7, 0xc6, 0xdd, 0xe8, 0xa3, 0xfc, 0x30, 0x04, 0x04, 0x95, 0x9a, 0x15, 0x50, 0xff, 0x94,
+		0x13, 0xc4, 0x0d, 0xad, 0x41, 0x66, 0x5f, 0x85, 0x89, 0x56, 0xf5, 0xc8, 0x33, 0x30, 0xec, 0xf9,
+		0x9a, 0xeb, 0x53, 0x2b, 0x1c, 0x56, 0xd8, 0x83, 0x2c, 0x41, 0x8a, 0x58, 0x35, 0xea, 0xff, 0x86,
+		0x15, 0xfc, 0x29, 0xff, 0xa9, 0xb0, 0xc3, 0x29, 0xda, 0xe1, 0x47, 0x3b, 0x47, 0xb4, 0x05, 0xb9,
+		0xbd, 0xdf, 0xb3, 0x97, 0x61, 0xbc, 0xa5, 0x03, 0xfd, 0x56, 0x5d, 0xf8, 0x21, 0x38, 0xd6, 0x15,
+		0x5a, 0x7e, 0x11, 0x66, 0x9a, 0x96, 0x61, 0xf9, 0xc4, 0x75, 0x5c, 0x82, 0x16, 0xcb, 0xaa, 0xca,
+		0xff, 0xf7, 0xd1, 0x1e, 0x36, 0xb7, 0x1d, 0xe5, 0x66, 0x28, 0xca, 0x74, 0xb3, 0x93, 0x78, 0x36,
+		0x9b, 0xf9, 0xe6, 0xa8, 0xf4, 0xda, 0x6b, 0xaf, 0xbd, 0x96, 0x2c, 0xfc, 0xab, 0x11, 0x98, 0xe9,
+		0x36, 0x67, 0xba, 0x4e, 0xdf, 0xe3, 0x30, 0x62, 0x35, 0x1b, 0x3b, 0xc4, 0xa5, 0x4a, 0x1a, 0x56,
+		0xf8, 0x93, 0x5c, 0x82, 0x61, 0x53, 0xdb, 0x21, 0x66, 0x3e, 0xbd, 0x90, 0x38, 0x3d, 0x71, 0xe1,
+		0xf1, 0xbe, 0x66, 0xe5, 0xe2, 0x1a, 0x8a, 0x28, 0x4c, 0x52, 0xfe, 0x00, 0xa4, 0xb9, 0xf3, 0x46,
+		0x84, 0xb3, 0xfd, 0x21, 0xe0, 0x5c, 0x52, 0xa8, 0x9c, 0x7c, 0x12, 0xb2, 0xf8, 0x97, 0xd9, 0xc6,
+		0x08, 0x6d, 0x73, 0x06, 0x09, 0x68, 0x17, 0xf2, 0x2c, 0x64, 0xe8, 0x34, 0xa9, 0x11, 0xb1, 0xe8,
+		0x05, 0xcf, 0x68, 0x58, 0x35, 0xb2, 0xab, 0x35, 0x4d, 0x5f, 0xdd, 0xd7, 0xcc, 0x26, 0xa1, 0x06,
+		0x9f, 0x55, 0x72, 0x9c, 0x78, 0x13, 0x69, 0xf2, 0x3c, 0x8c, 0xb1, 0x59, 0x65, 0x58, 0x35, 0x72,
+		0x87, 0xfa, 0xd5, 0x61, 0x85, 0x4d, 0xb4, 0x55, 0xa4, 0x60, 0xf5, 0xaf, 0x78, 0xb6, 0x25, 0x4c,
+		0x93, 0x56, 0x81, 0x04, 0x5a, 0xfd, 0xe5, 0x76, 0x97, 0x7e, 0xaa, 0x7b, 0xf7, 0x3a, 0xe6, 0xd2,
+		0x63, 0x30, 0x49, 0x39, 0x9e, 0xe2, 0x43, 0xaf, 0x99, 0xf9, 0xa9, 0x85, 0xc4, 0xe9, 0x8c, 0x32,
+		0xc1, 0xc8, 0x9b, 0x9c, 0x5a, 0xf8, 0x72, 0x12, 0xd2, 0xd4, 0xb1, 0x4c, 0xc2, 0xd8, 0xd6, 0x4b,
+		0x95, 0xb2, 0xba, 0xb2, 0xb9, 0xbd, 0xb4, 0x56, 0x96, 0x12, 0xf2, 0x04, 0x00, 0x25, 0x5c, 0x5b,
+		0xdb, 0x2c, 0x6d, 0x49, 0xc9, 0xe0, 0x79, 0x75, 0x63, 0xeb, 0xd2, 0xd3, 0x52, 0x2a, 0x10, 0xd8,
+		0x66, 0x84, 0x74, 0x94, 0xe1, 0xa9, 0x0b, 0xd2, 0xb0, 0x2c, 0x41, 0x8e, 0x01, 0xac, 0xbe, 0x58,
+		0x5e, 0xb9, 0xf4, 0xb4, 0x34, 0xd2, 0x4a, 0x79, 0xea, 0x82, 0x34, 0x2a, 0x8f, 0x43, 0x96, 0x52,
+		0x96, 0x36, 0x37, 0xd7, 0xa4, 0x4c, 0x80, 0x59, 0xdd, 0x52, 0x56, 0x37, 0xae, 0x4b, 0xd9, 0x00,
+		0xf3, 0xba, 0xb2, 0xb9, 0x5d, 0x91, 0x20, 0x40, 0x58, 0x2f, 0x57, 0xab, 0xa5, 0xeb, 0x65, 0x69,
+		0x2c, 0xe0, 0x58, 0x7a, 0x69, 0xab, 0x5c, 0x95, 0x72, 0x2d, 0xcd, 0x7a, 0xea, 0x82, 0x34, 0x1e,
+		0x54, 0x51, 0xde, 0xd8, 0x5e, 0x97, 0x26, 0xe4, 0x29, 0x18, 0x67, 0x55, 0x88, 0x46, 0x4c, 0xb6,
+		0x91, 0x2e, 0x3d, 0x2d, 0x49, 0x61, 0x43, 0x18, 0xca, 0x54, 0x0b, 0xe1, 0xd2, 0xd3, 0x92, 0x5c,
+		0x58, 0x86, 0x61, 0x6a, 0x86, 0xb2, 0x0c, 0x13, 0x6b, 0xa5, 0xa5, 0xf2, 0x9a, 0xba, 0x59, 0xd9,
+		0x5a, 0xdd, 0xdc, 0x28, 0xad, 0x49, 0x89, 0x90, 0xa6, 0x94, 0x3f, 0xb8, 0xbd, 0xaa, 0x94, 0x57,
+		0xa4, 0x64, 0x94, 0x56, 0x29, 0x97, 0xb6, 0xca, 0x2b, 0x52, 0xaa, 0xa0, 0xc3, 0x4c, 0x37, 0x87,
+		0xda, 0x75, 0x0a, 0x45, 0x6c, 0x21, 0xd9, 0xc3, 0x16, 0x28, 0x56, 0xbb, 0x2d, 0x14, 0xde, 0x4e,
+		0xc2, 0x74, 0x97, 0x45, 0xa5, 0x6b, 0x25, 0xcf, 0xc2, 0x30, 0xb3, 0x65, 0xb6, 0xcc, 0x9e, 0xe9,
+		0xba, 0x3a, 0x51, 0xcb, 0xee, 0x58, 0x6a, 0xa9, 0x5c, 0x34, 0x08, 0x49, 0xf5, 0x08, 0x42, 0x10,
+		0xa2, 0xc3, 0x60, 0xff, 0x4c, 0x87, 0xf3, 0x67, 0xeb, 0xe3, 0xa5, 0x7e, 0xd6, 0x47, 0x4a, 0x1b,
+		0x6c, 0x11, 0x18, 0xee, 0xb2, 0x08, 0x5c, 0x85, 0xa9, 0x0e, 0xa0, 0xbe, 0x9d, 0xf1, 0x47, 0x12,
+		0x90, 0xef, 0xa5, 0x9c, 0x18, 0x97, 0x98, 0x6c, 0x71, 0x89, 0x57, 0xdb, 0x35, 0xf8, 0x50, 0xef,
+		0x41, 0xe8, 0x18, 0xeb, 0x37, 0x12, 0x70, 0xbc, 0x7b, 0xb0, 0xd9, 0xb5, 0x0d, 0x1f, 0x80, 0x91,
+		0x06, 0xf1, 0xf7, 0x6c, 0x11, 0x56, 0x3d, 0xda, 0x65, 0xb1, 0xc6, 0xe2, 0xf6, 0xc1, 0xe6, 0x52,
+		0xf2, 0x95, 0xf6, 0xb6, 0xce, 0xf7, 0x0a, 0x7d, 0x3b, 0x5a, 0xfa, 0x63, 0x49, 0x38, 0xd6, 0x15,
+		0xbc, 0x6b, 0x43, 0x4f, 0x01, 0x18, 0x96, 0xd3, 0xf4, 0x59, 0xe8, 0xc4, 0x3c, 0x71, 0x96, 0x52,
+		0xa8, 0xf3, 0x42, 0x2f, 0xdb, 0xf4, 0x83, 0xf2, 0x14, 0x2d, 0x07, 0x46, 0xa2, 0x0c, 0xcf, 0x84,
+		0x0d, 0x4d, 0xd3, 0x86, 0xce, 0xf5, 0xe8, 0x69, 0x87, 0x61, 0x3e, 0x01, 0x92, 0x6e, 0x1a, 0xc4,
+		0xf2, 0x55, 0xcf, 0x77, 0x89, 0xd6, 0x30, 0xac, 0x3a, 0x5d, 0x6a, 0x32, 0xc5, 0xe1, 0x5d, 0xcd,
+		0xf4, 0x88, 0x32, 0xc9, 0x8a, 0xab, 0xa2, 0x14, 0x25, 0xa8, 0x01, 0xb9, 0x11, 0x89, 0x91, 0x16,
+		0x09, 0x56, 0x1c, 0x48, 0x14, 0x7e, 0x3c, 0x0b, 0x63, 0x91, 0xd0, 0x5c, 0x7e, 0x08, 0x72, 0xaf,
+		0x68, 0xfb, 0x9a, 0x2a, 0xb6, 0x5b, 0x4c, 0x13, 0x63, 0x48, 0xab, 0x30, 0x92, 0xfc, 0x04, 0xcc,
+		0x50, 0x16, 0xbb, 0xe9, 0x13, 0x57, 0xd5, 0x4d, 0xcd, 0xf3, 0xa8, 0xd2, 0x32, 0x94, 0x55, 0xc6,
+		0xb2, 0x4d, 0x2c, 0x5a, 0x16, 0x25, 0xf2, 0x45, 0x98, 0xa6, 0x12, 0x8d, 0xa6, 0xe9, 0x1b, 0x8e,
+		0x49, 0x54, 0xdc, 0x00, 0x7a, 0x79, 0x88, 0xb6, 0x6c, 0x0a, 0x39, 0xd6, 0x39, 0x03, 0xb6, 0xc8,
+		0x93, 0x57, 0xe0, 0x14, 0x15, 0xab, 0x13, 0x8b, 0xb8, 0x9a, 0x4f, 0x54, 0xf2, 0xe1, 0xa6, 0x66,
+		0x7a, 0xaa, 0x66, 0xd5, 0xd4, 0x3d, 0xcd, 0xdb, 0xcb, 0xcf, 0x20, 0xc0, 0x52, 0x32, 0x9f, 0x50,
+		0x4e, 0x20, 0xe3, 0x75, 0xce, 0x57, 0xa6, 0x6c, 0x25, 0xab, 0xf6, 0x9c, 0xe6, 0xed, 0xc9, 0x45,
+		0x38, 0x4e, 0x51, 0x3c, 0xdf, 0x35, 0xac, 0xba, 0xaa, 0xef, 0x11, 0xfd, 0x96, 0xda, 0xf4, 0x77,
+		0x9f, 0xc9, 0x9f, 0x8c, 0xd6, 0x4f, 0x5b, 0x58, 0xa5, 0x3c, 0xcb, 0xc8, 0xb2, 0xed, 0xef, 0x3e,
+		0x23, 0x57, 0x21, 0x87, 0x83, 0xd1, 0x30, 0x5e, 0x25, 0xea, 0xae, 0xed, 0xd2, 0x35, 0x74, 0xa2,
+		0x8b, 0x6b, 0x8a, 0x68, 0x70, 0x71, 0x93, 0x0b, 0xac, 0xdb, 0x35, 0x52, 0x1c, 0xae, 0x56, 0xca,
+		0xe5, 0x15, 0x65, 0x4c, 0xa0, 0x5c, 0xb3, 0x5d, 0x34, 0xa8, 0xba, 0x1d, 0x28, 0x78, 0x8c, 0x19,
+		0x54, 0xdd, 0x16, 0xea, 0xbd, 0x08, 0xd3, 0xba, 0xce, 0xfa, 0x6c, 0xe8, 0x2a, 0xdf, 0xa6, 0x79,
+		0x79, 0xa9, 0x45, 0x59, 0xba, 0x7e, 0x9d, 0x31, 0x70, 0x1b, 0xf7, 0xe4, 0x2b, 0x70, 0x2c, 0x54,
+		0x56, 0x54, 0x70, 0xaa, 0xa3, 0x97, 0xed, 0xa2, 0x17, 0x61, 0xda, 0x39, 0xe8, 0x14, 0x94, 0x5b,
+		0x6a, 0x74, 0x0e, 0xda, 0xc5, 0x2e, 0xc3, 0x8c, 0xb3, 0xe7, 0x74, 0xca, 0x9d, 0x8d, 0xca, 0xc9,
+		0xce, 0x9e, 0xd3, 0x2e, 0xf8, 0x08, 0xdd, 0xb3, 0xbb, 0x44, 0xd7, 0x7c, 0x52, 0xcb, 0x3f, 0x10,
+		0x65, 0x8f, 0x14, 0xc8, 0x8b, 0x20, 0xe9, 0xba, 0x4a, 0x2c, 0x6d, 0xc7, 0x24, 0xaa, 0xe6, 0x12,
+		0x4b, 0xf3, 0xf2, 0xf3, 0x94, 0x39, 0xed, 0xbb, 0x4d, 0xa2, 0x4c, 0xe8, 0x7a, 0x99, 0x16, 0x96,
+		0x68, 0x99, 0x7c, 0x16, 0xa6, 0xec, 0x9d, 0x57, 0x74, 0x66, 0x91, 0xaa, 0xe3, 0x92, 0x5d, 0xe3,
+		0x4e, 0xfe, 0x3d, 0x54, 0xbd, 0x93, 0x58, 0x40, 0xed, 0xb1, 0x42, 0xc9, 0xf2, 0x19, 0x90, 0x74,
+		0x6f, 0x4f, 0x73, 0x1d, 0xea, 0x92, 0x3d, 0x47, 0xd3, 0x49, 0xfe, 0x11, 0xc6, 0xca, 0xe8, 0x1b,
+		0x82, 0x8c, 0x33, 0xc2, 0xbb, 0x6d, 0xec, 0xfa, 0x02, 0xf1, 0x31, 0x36, 0x23, 0x28, 0x8d, 0xa3,
+		0x9d, 0x06, 0x09, 0x35, 0xd1, 0x52, 0xf1, 0x69, 0xca, 0x36, 0xe1, 0xec, 0x39, 0xd1, 0x7a, 0x1f,
+		0x86, 0x71, 0x67, 0x2f, 0x5a, 0xe9, 0x19, 0x16, 0xb8, 0x39, 0x7b, 0x91, 0x1a, 0x9f, 0x86, 0xe3,
+		0xc8, 0xd4, 0x20, 0xbe, 0x56, 0xd3, 0x7c, 0x2d, 0xc2, 0xfd, 0x5e, 0xca, 0x8d, 0x6a, 0x5f, 0xe7,
+		0x85, 0x2d, 0xed, 0x74, 0x9b, 0x3b, 0x07, 0x81, 0x61, 0x9d, 0x63, 0xed, 0x44, 0x9a, 0x30, 0xad,
+		0x77, 0x2d, 0x38, 0x2f, 0x14, 0x21, 0x17, 0xb5, 0x7b, 0x39, 0x0b, 0xcc, 0xf2, 0xa5, 0x04, 0x06,
+		0x41, 0xcb, 0x9b, 0x2b, 0x18, 0xbe, 0xbc, 0x5c, 0x96, 0x92, 0x18, 0x46, 0xad, 0xad, 0x6e, 0x95,
+		0x55, 0x65, 0x7b, 0x63, 0x6b, 0x75, 0xbd, 0x2c, 0xa5, 0x22, 0x81, 0xfd, 0x8d, 0x74, 0xe6, 0x51,
+		0xe9, 0xb1, 0xc2, 0x2f, 0xa7, 0x60, 0xa2, 0x75, 0xa7, 0x26, 0xbf, 0x0f, 0x1e, 0x10, 0x09, 0x17,
+		0x8f, 0xf8, 0xea, 0x6d, 0xc3, 0xa5, 0x13, 0xb2, 0xa1, 0xb1, 0xc5, 0x31, 0xb0, 0x9f, 0x19, 0xce,
+		0x55, 0x25, 0xfe, 0x0b, 0x86, 0x8b, 0xd3, 0xad, 0xa1, 0xf9, 0xf2, 0x1a, 0xcc, 0x5b, 0xb6, 0xea,
+		0xf9, 0x9a, 0x55, 0xd3, 0xdc, 0x9a, 0x1a, 0xa6, 0xba, 0x54, 0x4d, 0xd7, 0x89, 0xe7, 0xd9, 0x6c,
+		0x21, 0x0c, 0x50, 0x1e, 0xb4, 0xec, 0x2a, 0x67, 0x0e, 0x57, 0x88, 0x12, 0x67, 0x6d, 0x33, 0xdf,
+		0x54, 0x2f, 0xf3, 0x3d, 0x09, 0xd9, 0x86, 0xe6, 0xa8, 0xc4, 0xf2, 0xdd, 0x03, 0x1a, 0x9f, 0x67,
+		0x94, 0x4c, 0x43, 0x73, 0xca, 0xf8, 0x2c, 0xdf, 0x84, 0x47, 0x43, 0x56, 0xd5, 0x24, 0x75, 0x4d,
+		0x3f, 0x50, 0x69, 0x30, 0x4e, 0xd3, 0x06, 0xaa, 0x6e, 0x5b, 0xbb, 0xa6, 0xa1, 0xfb, 0x5e, 0x7e,
+		0x2c, 0xf0, 0x71, 0x85, 0x50, 0x62, 0x8d, 0x0a, 0xdc, 0xf0, 0x6c, 0x8b, 0xc6, 0xe0, 0xcb, 0x82,
+		0xfb, 0xfb, 0xb2, 0xfd, 0xba, 0x91, 0xce, 0xa4, 0xa5, 0xe1, 0x1b, 0xe9, 0xcc, 0xb0, 0x34, 0x72,
+		0x23, 0x9d, 0x19, 0x91, 0x46, 0x6f, 0xa4, 0x33, 0x19, 0x29, 0x7b, 0x23, 0x9d, 0xc9, 0x4a, 0x50,
+		0xf8, 0xc5, 0x0c, 0xe4, 0xa2, 0x3b, 0x03, 0xdc, 0x68, 0xe9, 0x74, 0x6d, 0x4c, 0x50, 0xef, 0xf9,
+		0xf0, 0xa1, 0xfb, 0x88, 0xc5, 0x65, 0x5c, 0x34, 0x8b, 0x23, 0x2c, 0x0c, 0x57, 0x98, 0x24, 0x06,
+		0x2c, 0x68, 0xd6, 0x84, 0x85, 0x3d, 0x19, 0x85, 0x3f, 0xc9, 0xd7, 0x61, 0xe4, 0x15, 0x8f, 0x62,
+		0x8f, 0x50, 0xec, 0xf7, 0x1c, 0x8e, 0x7d, 0xa3, 0x4a, 0xc1, 0xb3, 0x37, 0xaa, 0xea, 0xc6, 0xa6,
+		0xb2, 0x5e, 0x5a, 0x53, 0xb8, 0xb8, 0x7c, 0x02, 0xd2, 0xa6, 0xf6, 0xea, 0x41, 0xeb, 0xf2, 0x4a,
+		0x49, 0xf2, 0x22, 0x4c, 0x36, 0xad, 0x7d, 0xe2, 0x1a, 0xbb, 0x06, 0x0e, 0x15, 0x72, 0x4d, 0x46,
+		0xb9, 0x26, 0xc2, 0xd2, 0x35, 0xe4, 0xef, 0xd3, 0x3c, 0x4e, 0x40, 0x1a, 0x93, 0x8a, 0xad, 0x8b,
+		0x20, 0x25, 0xc9, 0xa7, 0x21, 0x57, 0x23, 0x3b, 0xcd, 0xba, 0xea, 0x92, 0x9a, 0xa6, 0xfb, 0xad,
+		0xae, 0x7f, 0x8c, 0x16, 0x29, 0xb4, 0x44, 0x7e, 0x1e, 0xb2, 0x38, 0x46, 0x16, 0x1d, 0xe3, 0x29,
+		0xaa, 0x82, 0x73, 0x87, 0xab, 0x80, 0x0f, 0xb1, 0x10, 0x52, 0x42, 0x79, 0xf9, 0x1a, 0x8c, 0xf8,
+		0x9a, 0x5b, 0x27, 0x3e, 0xf5, 0xfc, 0x13, 0x17, 0x16, 0xfb, 0x41, 0xda, 0xa2, 0x12, 0x74, 0x4f,
+		0xcb, 0xa5, 0xdf, 0x45, 0x2f, 0x73, 0x1e, 0x86, 0xa9, 0x79, 0xc8, 0x00, 0xdc, 0x40, 0xa4, 0x21,
+		0x39, 0x03, 0xe9, 0xe5, 0x4d, 0x05, 0x3d, 0x8d, 0x04, 0x39, 0x46, 0x55, 0x2b, 0xab, 0xe5, 0xe5,
+		0xb2, 0x94, 0x2c, 0x5c, 0x84, 0x11, 0x36, 0xe6, 0xe8, 0x85, 0x82, 0x51, 0x97, 0x86, 0xf8, 0x23,
+		0xc7, 0x48, 0x88, 0xd2, 0xed, 0xf5, 0xa5, 0xb2, 0x22, 0x25, 0x0b, 0xdb, 0x30, 0xd9, 0xa6, 0x27,
+		0xf9, 0x18, 0x4c, 0x29, 0xe5, 0xad, 0xf2, 0x06, 0xee, 0xb3, 0xd4, 0xed, 0x8d, 0xe7, 0x37, 0x36,
+		0x5f, 0xd8, 0x90, 0x86, 0x5a, 0xc9, 0xc2, 0xa5, 0x25, 0xe4, 0x19, 0x90, 0x42, 0x72, 0x75, 0x73,
+		0x5b, 0xa1, 0xad, 0xf9, 0xeb, 0x49, 0x90, 0xda, 0xb5, 0x26, 0x3f, 0x00, 0xd3, 0x5b, 0x25, 0xe5,
+		0x7a, 0x79, 0x4b, 0x65, 0x7b, 0xc7, 0x00, 0x7a, 0x06, 0xa4, 0x68, 0xc1, 0xb5, 0x55, 0xba, 0x35,
+		0x9e, 0x87, 0x93, 0x51, 0x6a, 0xf9, 0xc5, 0xad, 0xf2, 0x46, 0x95, 0x56, 0x5e, 0xda, 0xb8, 0x8e,
+		0xfe, 0xb5, 0x0d, 0x4f, 0xec, 0x56, 0x53, 0xd8, 0xd4, 0x56, 0xbc, 0xf2, 0xda, 0x8a, 0x94, 0x6e,
+		0x27, 0x6f, 0x6e, 0x94, 0x37, 0xaf, 0x49, 0xc3, 0xed, 0xb5, 0xd3, 0x1d, 0xec, 0x88, 0x3c, 0x0b,
+		0xc7, 0xdb, 0xa9, 0x6a, 0x79, 0x63, 0x4b, 0x79, 0x49, 0x1a, 0x6d, 0xaf, 0xb8, 0x5a, 0x56, 0x6e,
+		0xae, 0x2e, 0x97, 0xa5, 0x8c, 0x7c, 0x1c, 0xe4, 0xd6, 0x16, 0x6d, 0x3d, 0xb7, 0xb9, 0x22, 0x65,
+		0x3b, 0x3c, 0x4a, 0xc1, 0x83, 0x5c, 0x74, 0x1b, 0xf9, 0xfd, 0xc9, 0x25, 0x7d, 0x3c, 0x09, 0x63,
+		0x91, 0x6d, 0x21, 0xc6, 0xf3, 0x9a, 0x69, 0xda, 0xb7, 0x55, 0xcd, 0x34, 0x34, 0x8f, 0xfb, 0x1b,
+		0xa0, 0xa4, 0x12, 0x52, 0xfa, 0x9d, 0xdf, 0xfd, 0x7b, 0xf8, 0x91, 0x1f, 0x44, 0x0f, 0x3f, 0x2c,
+		0x8d, 0x14, 0x3e, 0x9d, 0x00, 0xa9, 0x7d, 0xbf, 0xd7, 0xd6, 0xfd, 0x44, 0xaf, 0xee, 0x7f, 0x5f,
+		0xc6, 0xee, 0x53, 0x09, 0x98, 0x68, 0xdd, 0xe4, 0xb5, 0x35, 0xef, 0xa1, 0xff, 0xaf, 0xcd, 0xfb,
+		0x46, 0x12, 0xc6, 0x5b, 0xb6, 0x76, 0xfd, 0xb6, 0xee, 0xc3, 0x30, 0x65, 0xd4, 0x48, 0xc3, 0xb1,
+		0x7d, 0x3c, 0x6d, 0x52, 0x4d, 0xb2, 0x4f, 0xcc, 0x7c, 0x81, 0x3a, 0xe5, 0xf3, 0x87, 0x6f, 0x1e,
+		0x17, 0x57, 0x43, 0xb9, 0x35, 0x14, 0x2b, 0x4e, 0xaf, 0xae, 0x94, 0xd7, 0x2b, 0x9b, 0x5b, 0xe5,
+		0x8d, 0xe5, 0x97, 0x84, 0x77, 0x51, 0x24, 0xa3, 0x8d, 0xed, 0x5d, 0x74, 0xda, 0x15, 0x90, 0xda,
+		0x1b, 0x85, 0xbe, 0xa2, 0x4b, 0xb3, 0xa4, 0x21, 0x79, 0x1a, 0x26, 0x37, 0x36, 0xd5, 0xea, 0xea,
+		0x4a, 0x59, 0x2d, 0x5f, 0xbb, 0x56, 0x5e, 0xde, 0xaa, 0xb2, 0x74, 0x60, 0xc0, 0xbd, 0x25, 0x25,
+		0xa3, 0x2a, 0xfe, 0x64, 0x0a, 0xa6, 0xbb, 0xb4, 0x44, 0x2e, 0xf1, 0x8d, 0x3c, 0xcb, 0x2d, 0x9c,
+		0xeb, 0xa7, 0xf5, 0x8b, 0x18, 0x4a, 0x57, 0x34, 0xd7, 0xe7, 0xfb, 0xfe, 0x33, 0x80, 0x5a, 0xb2,
+		0x7c, 0x5c, 0xd9, 0x5d, 0x9e, 0x66, 0x65, 0xbb, 0xfb, 0xc9, 0x90, 0xce, 0x32, 0xad, 0xef, 0x05,
+		0xd9, 0xb1, 0x3d, 0xc3, 0x37, 0xf6, 0xf1, 0x0c, 0x4b, 0xe4, 0x64, 0x71, 0xb7, 0x9f, 0x56, 0x24,
+		0x51, 0xb2, 0x6a, 0xf9, 0x01, 0xb7, 0x45, 0xea, 0x5a, 0x1b, 0x37, 0x46, 0x1e, 0x29, 0x45, 0x12,
+		0x25, 0x01, 0xf7, 0x43, 0x90, 0xab, 0xd9, 0x4d, 0xdc, 0x02, 0x31, 0x3e, 0xf4, 0x16, 0x09, 0x65,
+		0x8c, 0xd1, 0x02, 0x16, 0xbe, 0xb9, 0x0d, 0x93, 0xc1, 0x39, 0x65, 0x8c, 0xd1, 0x18, 0xcb, 0x63,
+		0x30, 0xa9, 0xd5, 0xeb, 0x2e, 0x82, 0x0b, 0x20, 0xb6, 0x5d, 0x9f, 0x08, 0xc8, 0x94, 0x71, 0xf6,
+		0x06, 0x64, 0x84, 0x1e, 0x30, 0x82, 0x45, 0x4d, 0xa8, 0x0e, 0xcb, 0x41, 0x25, 0x31, 0x3f, 0x6c,
+		0x89, 0xc2, 0x87, 0x20, 0x67, 0x78, 0x6a, 0x78, 0xb6, 0x95, 0x5c, 0x48, 0x9e, 0xce, 0x28, 0x63,
+		0x86, 0x17, 0x9c, 0x0b, 0x14, 0xde, 0x48, 0xc2, 0x44, 0xeb, 0xa9, 0x9d, 0xbc, 0x02, 0x19, 0xd3,
+		0xd6, 0x35, 0x6a, 0x5a, 0xec, 0xc8, 0xf8, 0x74, 0xcc, 0x41, 0xdf, 0xe2, 0x1a, 0xe7, 0x57, 0x02,
+		0xc9, 0xd9, 0x7f, 0x97, 0x80, 0x8c, 0x20, 0xcb, 0xc7, 0x21, 0xed, 0x68, 0xfe, 0x1e, 0x85, 0x1b,
+		0x5e, 0x4a, 0x4a, 0x09, 0x85, 0x3e, 0x23, 0xdd, 0x73, 0x34, 0x2b, 0x9f, 0x0c, 0xe9, 0xf8, 0x8c,
+		0xe3, 0x6a, 0x12, 0xad, 0x46, 0x73, 0x01, 0x76, 0xa3, 0x41, 0x2c, 0xdf, 0x13, 0xe3, 0xca, 0xe9,
+		0xcb, 0x9c, 0x8c, 0x87, 0xc7, 0xbe, 0xab, 0x19, 0x66, 0x0b, 0x6f, 0x9a, 0xf2, 0x4a, 0xa2, 0x20,
+		0x60, 0x2e, 0xc2, 0x09, 0x81, 0x5b, 0x23, 0xbe, 0xa6, 0xef, 0x91, 0x5a, 0x28, 0x34, 0x42, 0x73,
+		0x7e, 0x0f, 0x70, 0x86, 0x15, 0x5e, 0x2e, 0x64, 0x0b, 0x5f, 0x4f, 0xc2, 0x94, 0xc8, 0x5e, 0xd4,
+		0x02, 0x65, 0xad, 0x03, 0x68, 0x96, 0x65, 0xfb, 0x51, 0x75, 0x75, 0x9a, 0x72, 0x87, 0xdc, 0x62,
+		0x29, 0x10, 0x52, 0x22, 0x00, 0xb3, 0xbf, 0x97, 0x00, 0x08, 0x8b, 0x7a, 0xea, 0x6d, 0x1e, 0xc6,
+		0xf8, 0x99, 0x2c, 0x3d, 0xd8, 0x67, 0x09, 0x2f, 0x60, 0x24, 0xcc, 0x73, 0x60, 0x5a, 0x72, 0x87,
+		0xd4, 0x0d, 0x8b, 0x9f, 0xa7, 0xb0, 0x07, 0x91, 0x96, 0x4c, 0x87, 0xc7, 0x53, 0x0a, 0x64, 0x3c,
+		0xd2, 0xd0, 0x2c, 0xdf, 0xd0, 0xf9, 0x09, 0xc9, 0xa5, 0x81, 0x1a, 0xbf, 0x58, 0xe5, 0xd2, 0x4a,
+		0x80, 0x53, 0x38, 0x0d, 0x19, 0x41, 0xc5, 0xc0, 0x6f, 0x63, 0x73, 0xa3, 0x2c, 0x0d, 0xc9, 0xa3,
+		0x90, 0xaa, 0x96, 0xb7, 0xa4, 0x04, 0x6e, 0x3b, 0x4b, 0x6b, 0xab, 0xa5, 0xaa, 0x94, 0x5c, 0xfa,
+		0x0b, 0x30, 0xad, 0xdb, 0x8d, 0xf6, 0x0a, 0x97, 0xa4, 0xb6, 0x94, 0x9f, 0xf7, 0x5c, 0xe2, 0xe5,
+		0x73, 0x9c, 0xa9, 0x6e, 0x9b, 0x9a, 0x55, 0x5f, 0xb4, 0xdd, 0x7a, 0x78, 0x2d, 0x02, 0x77, 0x07,
+		0x5e, 0xe4, 0x72, 0x84, 0xb3, 0xf3, 0x47, 0x89, 0xc4, 0x67, 0x93, 0xa9, 0xeb, 0x95, 0xa5, 0xcf,
+		0x27, 0x67, 0xaf, 0x33, 0xc1, 0x8a, 0xe8, 0x8e, 0x42, 0x76, 0x4d, 0xa2, 0x63, 0xe3, 0xe1, 0xdb,
+		0x8f, 0xc3, 0x4c, 0xdd, 0xae, 0xdb, 0x14, 0xe9, 0x3c, 0xfe, 0x62, 0x8d, 0x90, 0xb3, 0x01, 0x75,
+		0x36, 0xf6, 0x12, 0x46, 0x71, 0x03, 0xa6, 0x39, 0xb3, 0x4a, 0x8f, 0x6f, 0x59, 0x72, 0x41, 0x3e,
+		0x34, 0xb3, 0x9d, 0xff, 0xf9, 0xdf, 0xa1, 0x51, 0x89, 0x32, 0xc5, 0x45, 0xb1, 0x8c, 0xe5, 0x1f,
+		0x8a, 0x0a, 0x1c, 0x6b, 0xc1, 0x63, 0x3e, 0x82, 0xb8, 0x31, 0x88, 0xff, 0x9a, 0x23, 0x4e, 0x47,
+		0x10, 0xab, 0x5c, 0xb4, 0xb8, 0x0c, 0xe3, 0x83, 0x60, 0xfd, 0x2a, 0xc7, 0xca, 0x91, 0x28, 0xc8,
+		0x75, 0x98, 0xa4, 0x20, 0x7a, 0xd3, 0xf3, 0xed, 0x06, 0x75, 0xc0, 0x87, 0xc3, 0xfc, 0x9b, 0xdf,
+		0x61, 0x93, 0x76, 0x02, 0xc5, 0x96, 0x03, 0xa9, 0x62, 0x11, 0xe8, 0x89, 0x35, 0x9e, 0x24, 0xc7,
+		0x20, 0x7c, 0x95, 0x37, 0x24, 0xe0, 0x2f, 0xde, 0x84, 0x19, 0xfc, 0x4d, 0xfd, 0x63, 0xb4, 0x25,
+		0xf1, 0x69, 0xf0, 0xfc, 0xaf, 0x7f, 0x84, 0xf9, 0x85, 0xe9, 0x00, 0x20, 0xd2, 0xa6, 0xc8, 0x28,
+		0xd6, 0x89, 0xef, 0x13, 0xd7, 0x53, 0x35, 0xb3, 0x5b, 0xf3, 0x22, 0x79, 0xc4, 0xfc, 0x27, 0xbe,
+		0xd5, 0x3a, 0x8a, 0xd7, 0x99, 0x64, 0xc9, 0x34, 0x8b, 0xdb, 0xf0, 0x40, 0x17, 0xab, 0xe8, 0x03,
+		0xf3, 0x93, 0x1c, 0x73, 0xa6, 0xc3, 0x32, 0x10, 0xb6, 0x02, 0x82, 0x1e, 0x8c, 0x65, 0x1f, 0x98,
+		0x3f, 0xcd, 0x31, 0x65, 0x2e, 0x2b, 0x86, 0x14, 0x11, 0x6f, 0xc0, 0xd4, 0x3e, 0x71, 0x77, 0x6c,
+		0x8f, 0xe7, 0x6e, 0xfb, 0x80, 0xfb, 0x14, 0x87, 0x9b, 0xe4, 0x82, 0x34, 0x99, 0x8b, 0x58, 0x57,
+		0x20, 0xb3, 0xab, 0xe9, 0xa4, 0x0f, 0x88, 0x7b, 0x1c, 0x62, 0x14, 0xf9, 0x51, 0xb4, 0x04, 0xb9,
+		0xba, 0xcd, 0x97, 0xc8, 0x78, 0xf1, 0x4f, 0x73, 0xf1, 0x31, 0x21, 0xc3, 0x21, 0x1c, 0xdb, 0x69,
+		0x9a, 0xb8, 0x7e, 0xc6, 0x43, 0xfc, 0x1d, 0x01, 0x21, 0x64, 0x38, 0xc4, 0x00, 0x6a, 0xfd, 0x8c,
+		0x80, 0xf0, 0x22, 0xfa, 0x7c, 0x16, 0x8f, 0x74, 0xcd, 0x03, 0xdb, 0xea, 0xa7, 0x11, 0xaf, 0x73,
+		0x04, 0xe0, 0x22, 0x08, 0x70, 0x15, 0xb2, 0xfd, 0x0e, 0xc4, 0xcf, 0x7e, 0x4b, 0x4c, 0x0f, 0x31,
+		0x02, 0xd7, 0x61, 0x52, 0x38, 0x28, 0xbc, 0x02, 0x12, 0x0f, 0xf1, 0x77, 0x39, 0xc4, 0x44, 0x44,
+		0x8c, 0x77, 0xc3, 0x27, 0x9e, 0x5f, 0x27, 0xfd, 0x80, 0xbc, 0x21, 0xba, 0xc1, 0x45, 0xb8, 0x2a,
+		0x77, 0x88, 0xa5, 0xef, 0xf5, 0x87, 0xf0, 0x73, 0x42, 0x95, 0x42, 0x06, 0x21, 0x96, 0x61, 0xbc,
+		0xa1, 0xb9, 0xde, 0x9e, 0x66, 0xf6, 0x35, 0x1c, 0x7f, 0x8f, 0x63, 0xe4, 0x02, 0x21, 0xae, 0x91,
+		0xa6, 0x35, 0x08, 0xcc, 0xe7, 0x85, 0x46, 0x9a, 0x56, 0x0b, 0x50, 0x05, 0x66, 0x3c, 0x9f, 0x26,
+		0xba, 0x07, 0x41, 0xfb, 0xfb, 0x62, 0xea, 0x31, 0xd9, 0xf5, 0x28, 0xe2, 0x55, 0xc8, 0x7a, 0xc6,
+		0xab, 0x7d, 0xc1, 0x7c, 0x41, 0x8c, 0x34, 0x15, 0x40, 0xe1, 0x97, 0xe0, 0x44, 0xd7, 0x65, 0xa2,
+		0x0f, 0xb0, 0x7f, 0xc0, 0xc1, 0x8e, 0x77, 0x59, 0x2a, 0xb8, 0x4b, 0x18, 0x14, 0xf2, 0x1f, 0x0a,
+		0x97, 0x40, 0xda, 0xb0, 0x2a, 0xb8, 0x69, 0xf1, 0xb4, 0xdd, 0xc1, 0xb4, 0xf6, 0x8f, 0x84, 0xd6,
+		0x98, 0x6c, 0x8b, 0xd6, 0xb6, 0xe0, 0x38, 0x47, 0x1c, 0x6c, 0x5c, 0xff, 0xb1, 0x70, 0xac, 0x4c,
+		0x7a, 0xbb, 0x75, 0x74, 0x3f, 0x04, 0xb3, 0x81, 0x3a, 0x45, 0x74, 0xec, 0xa9, 0x98, 0x1d, 0x8e,
+		0x47, 0xfe, 0x79, 0x8e, 0x2c, 0x3c, 0x7e, 0x10, 0x5e, 0x7b, 0xeb, 0x9a, 0x83, 0xe0, 0x2f, 0x42,
+		0x5e, 0x80, 0x37, 0x2d, 0x97, 0xe8, 0x76, 0xdd, 0x32, 0x5e, 0x25, 0xb5, 0x3e, 0xa0, 0x7f, 0xa1,
+		0x6d, 0xa8, 0xb6, 0x23, 0xe2, 0x88, 0xbc, 0x0a, 0x52, 0x10, 0xab, 0xa8, 0x46, 0xc3, 0xb1, 0x5d,
+		0x3f, 0x06, 0xf1, 0x8b, 0x62, 0xa4, 0x02, 0xb9, 0x55, 0x2a, 0x56, 0x2c, 0x03, 0xbb, 0xfd, 0xd1,
+		0xaf, 0x49, 0x7e, 0x89, 0x03, 0x8d, 0x87, 0x52, 0xdc, 0x71, 0xe8, 0x76, 0xc3, 0xd1, 0xdc, 0x7e,
+		0xfc, 0xdf, 0x3f, 0x11, 0x8e, 0x83, 0x8b, 0x70, 0xc7, 0x81, 0x11, 0x1d, 0xae, 0xf6, 0x7d, 0x20,
+		0x7c, 0x59, 0x38, 0x0e, 0x21, 0xc3, 0x21, 0x44, 0xc0, 0xd0, 0x07, 0xc4, 0x2f, 0x0a, 0x08, 0x21,
+		0x83, 0x10, 0x1f, 0x0c, 0x17, 0x5a, 0x97, 0xd4, 0x0d, 0xcf, 0x77, 0x59, 0x48, 0x7e, 0x38, 0xd4,
+		0x3f, 0xfd, 0x56, 0x6b, 0x10, 0xa6, 0x44, 0x44, 0xd1, 0x13, 0xf1, 0xa3, 0x0f, 0xba, 0x65, 0x8b,
+		0x6f, 0xd8, 0x2f, 0x09, 0x4f, 0x14, 0x11, 0xc3, 0xb6, 0x45, 0x22, 0x44, 0x54, 0xbb, 0x8e, 0x1b,
+		0x95, 0x3e, 0xe0, 0xfe, 0x59, 0x5b, 0xe3, 0xaa, 0x42, 0x16, 0x31, 0x23, 0xf1, 0x4f, 0xd3, 0xba,
+		0x45, 0x0e, 0xfa, 0xb2, 0xce, 0x5f, 0x6e, 0x8b, 0x7f, 0xb6, 0x99, 0x24, 0xf3, 0x21, 0x93, 0x6d,
+		0xf1, 0x94, 0x1c, 0x77, 0xd7, 0x2f, 0xff, 0xc3, 0xef, 0xf0, 0xfe, 0xb6, 0x86, 0x53, 0xc5, 0x35,
+		0x90, 0x38, 0x25, 0x0c, 0x60, 0x63, 0xc1, 0x3e, 0xf2, 0x4e, 0x60, 0xe7, 0x2d, 0x31, 0x4f, 0xf1,
+		0x1a, 0x8c, 0xb7, 0x04, 0x3c, 0xf1, 0x50, 0x7f, 0x99, 0x43, 0xe5, 0xa2, 0xf1, 0x4e, 0xf1, 0x22,
+		0xa4, 0x31, 0x78, 0x89, 0x17, 0xff, 0x2b, 0x5c, 0x9c, 0xb2, 0x17, 0xdf, 0x0f, 0x19, 0x11, 0xb4,
+		0xc4, 0x8b, 0xfe, 0x08, 0x17, 0x0d, 0x44, 0x50, 0x5c, 0x04, 0x2c, 0xf1, 0xe2, 0x7f, 0x55, 0x88,
+		0x0b, 0x11, 0x14, 0xef, 0x5f, 0x85, 0x5f, 0xf9, 0x6b, 0x69, 0x26, 0x2e, 0x44, 0x8a, 0x78, 0xfb,
+		0x84, 0x45, 0x2a, 0xf1, 0xd2, 0x3f, 0xc6, 0x2b, 0x17, 0x12, 0xc5, 0xcb, 0x30, 0xdc, 0xa7, 0xc2,
+		0x3f, 0xca, 0x45, 0x19, 0x7f, 0x71, 0x19, 0xc6, 0x22, 0xd1, 0x49, 0xbc, 0xf8, 0xdf, 0xe0, 0xe2,
+		0x51, 0x29, 0x6c, 0x3a, 0x8f, 0x4e, 0xe2, 0x01, 0xfe, 0xa6, 0x68, 0x3a, 0x97, 0x40, 0xb5, 0x89,
+		0xc0, 0x24, 0x5e, 0xfa, 0x63, 0x42, 0xeb, 0x42, 0xa4, 0xf8, 0x2c, 0x64, 0x83, 0xc5, 0x26, 0x5e,
+		0xfe, 0xc7, 0xb9, 0x7c, 0x28, 0x83, 0x1a, 0x68, 0x5a, 0x03, 0x40, 0xfc, 0x2d, 0xa1, 0x81, 0x88,
+		0x14, 0x4e, 0xa3, 0xf6, 0x00, 0x26, 0x1e, 0xe9, 0x27, 0xc4, 0x34, 0x6a, 0x8b, 0x5f, 0x70, 0x34,
+		0xa9, 0xcf, 0x8f, 0x87, 0xf8, 0xdb, 0x62, 0x34, 0x29, 0x3f, 0x36, 0xa3, 0x3d, 0x22, 0x88, 0xc7,
+		0xf8, 0x29, 0xd1, 0x8c, 0xb6, 0x80, 0xa0, 0x58, 0x01, 0xb9, 0x33, 0x1a, 0x88, 0xc7, 0xfb, 0x38,
+		0xc7, 0x9b, 0xea, 0x08, 0x06, 0x8a, 0x2f, 0xc0, 0xf1, 0xee, 0x91, 0x40, 0x3c, 0xea, 0x27, 0xde,
+		0x69, 0xdb, 0xbb, 0x45, 0x03, 0x81, 0xe2, 0x16, 0xcc, 0x74, 0x8b, 0x02, 0xe2, 0x61, 0x3f, 0xf9,
+		0x4e, 0xab, 0xe3, 0x8e, 0x06, 0x01, 0xc5, 0x12, 0x40, 0xb8, 0x00, 0xc7, 0x63, 0x7d, 0x8a, 0x63,
+		0x45, 0x84, 0x70, 0x6a, 0xf0, 0xf5, 0x37, 0x5e, 0xfe, 0x9e, 0x98, 0x1a, 0x5c, 0x02, 0xa7, 0x86,
+		0x58, 0x7a, 0xe3, 0xa5, 0x3f, 0x2d, 0xa6, 0x86, 0x10, 0x41, 0xcb, 0x8e, 0xac, 0x6e, 0xf1, 0x08,
+		0xaf, 0x0b, 0xcb, 0x8e, 0x48, 0x15, 0x37, 0x60, 0xaa, 0x63, 0x41, 0x8c, 0x87, 0xfa, 0x2c, 0x87,
+		0x92, 0xda, 0xd7, 0xc3, 0xe8, 0xe2, 0xc5, 0x17, 0xc3, 0x78, 0xb4, 0x9f, 0x69, 0x5b, 0xbc, 0xf8,
+		0x5a, 0x58, 0xbc, 0x0a, 0x19, 0xab, 0x69, 0x9a, 0x38, 0x79, 0xe4, 0xc3, 0xef, 0xe7, 0xe6, 0xff,
+		0xc7, 0x77, 0xb9, 0x76, 0x84, 0x40, 0xf1, 0x22, 0x0c, 0x93, 0xc6, 0x0e, 0xa9, 0xc5, 0x49, 0xfe,
+		0xee, 0x77, 0x85, 0xc3, 0x44, 0xee, 0xe2, 0xb3, 0x00, 0x2c, 0x35, 0x42, 0x0f, 0xce, 0x63, 0x64,
+		0x7f, 0xef, 0xbb, 0xfc, 0x42, 0x5c, 0x28, 0x12, 0x02, 0xb0, 0xeb, 0x75, 0x87, 0x03, 0x7c, 0xab,
+		0x15, 0x80, 0x8e, 0xc8, 0x15, 0x18, 0xc5, 0x83, 0x34, 0x5f, 0xab, 0xc7, 0x49, 0x7f, 0x9b, 0x4b,
+		0x0b, 0x7e, 0x54, 0x58, 0xc3, 0x76, 0x89, 0xaf, 0xd5, 0xbd, 0x38, 0xd9, 0xff, 0xc9, 0x65, 0x03,
+		0x01, 0x14, 0xd6, 0x35, 0xcf, 0xef, 0xa7, 0xdf, 0xbf, 0x2f, 0x84, 0x85, 0x00, 0x36, 0x1a, 0x7f,
+		0xdf, 0x22, 0x07, 0x71, 0xb2, 0xdf, 0x11, 0x8d, 0xe6, 0xfc, 0xc5, 0xf7, 0x43, 0x16, 0x7f, 0xb2,
+		0x5b, 0xae, 0x31, 0xc2, 0xff, 0x8b, 0x0b, 0x87, 0x12, 0x58, 0xb3, 0xe7, 0xd7, 0x7c, 0x23, 0x5e,
+		0xd9, 0x7f, 0xc0, 0x47, 0x5a, 0xf0, 0x17, 0x4b, 0x30, 0xe6, 0xf9, 0xb5, 0x5a, 0x93, 0xc7, 0xa7,
+		0x31, 0xe2, 0xff, 0xfb, 0xbb, 0x41, 0xca, 0x22, 0x90, 0xc1, 0xd1, 0xbe, 0x7d, 0xcb, 0x77, 0x6c,
+		0x7a, 0xde, 0x12, 0x87, 0xf0, 0x0e, 0x47, 0x88, 0x88, 0x14, 0x97, 0x21, 0x87, 0x7d, 0x71, 0x89,
+		0x43, 0xe8, 0xe1, 0x58, 0x0c, 0xc4, 0x1f, 0x72, 0x05, 0xb4, 0x08, 0x2d, 0xfd, 0xd9, 0xaf, 0xbe,
+		0x35, 0x97, 0xf8, 0xfa, 0x5b, 0x73, 0x89, 0x6f, 0xbc, 0x35, 0x97, 0xf8, 0xd8, 0xdb, 0x73, 0x43,
+		0x5f, 0x7f, 0x7b, 0x6e, 0xe8, 0xb7, 0xde, 0x9e, 0x1b, 0xea, 0x9e, 0x25, 0x86, 0xeb, 0xf6, 0x75,
+		0x9b, 0xe5, 0x87, 0x5f, 0x7e, 0xa4, 0x6e, 0xf8, 0x7b, 0xcd, 0x9d, 0x45, 0xdd, 0x6e, 0x9c, 0xd7,
+		0x6d, 0xaf, 0x61, 0x7b, 0xe7, 0x5b, 0xf3, 0xba, 0xf4, 0x17, 0xfc, 0x61, 0x02, 0x4e, 0x30, 0x98,
+		0x30, 0x9d, 0xab, 0x59, 0x07, 0x3d, 0x5e, 0xa6, 0x9b, 0xed, 0x9a, 0x1b, 0x2e, 0xbc, 0x0f, 0x52,
+		0x25, 0xeb, 0x40, 0x3e, 0xc1, 0xdc, 0x9e, 0xda, 0x74, 0x4d, 0x7e, 0x01, 0x73, 0x14, 0x9f, 0xb7,
+		0x5d, 0x13, 0x93, 0xef, 0xe2, 0x96, 0x34, 0x1e, 0xf2, 0xb0, 0x87, 0x62, 0xfa, 0x3b, 0xaf, 0xcf,
+		0x0f, 0x2d, 0xdd, 0x6a, 0xef, 0xe4, 0x57, 0x62, 0x3b, 0x9a, 0x29, 0x59, 0x07, 0xb4, 0x9f, 0x95,
+		0xc4, 0xcb, 0xc3, 0x58, 0x87, 0x27, 0x72, 0xdb, 0x73, 0xed, 0xb9, 0xed, 0x17, 0x88, 0x69, 0x3e,
+		0x6f, 0xd9, 0xb7, 0x2d, 0xbc, 0xb6, 0xe0, 0xed, 0x8c, 0xb0, 0xdb, 0xfc, 0xf0, 0x13, 0x49, 0x98,
+		0x6b, 0xef, 0xb7, 0x18, 0xfc, 0x5e, 0x6f, 0x12, 0x16, 0x21, 0xb3, 0x22, 0x6c, 0x2a, 0x8f, 0xaf,
+		0xb0, 0xe9, 0xb6, 0x55, 0xf3, 0x68, 0x57, 0x53, 0x8a, 0x78, 0xc4, 0xae, 0x5a, 0x9a, 0x65, 0x7b,
+		0xfc, 0x92, 0x32, 0x7b, 0x58, 0xfa, 0xe9, 0xc4, 0x60, 0x43, 0x39, 0x2e, 0x6a, 0x12, 0xdd, 0x7c,
+		0x32, 0x36, 0xdb, 0x7f, 0x0b, 0x7b, 0x19, 0x74, 0xa2, 0x25, 0xe3, 0xdf, 0xaf, 0x56, 0x7e, 0x2a,
+		0x09, 0xf3, 0xed, 0x5a, 0xc1, 0x19, 0xe5, 0xf9, 0x5a, 0xc3, 0xe9, 0xa5, 0x96, 0xab, 0x90, 0xdd,
+		0x12, 0x3c, 0x03, 0xeb, 0xe5, 0xde, 0x80, 0x7a, 0x99, 0x08, 0xaa, 0x12, 0x8a, 0xb9, 0xd0, 0xa7,
+		0x62, 0x82, 0x7e, 0xdc, 0x97, 0x66, 0xfe, 0xcf, 0x08, 0x9c, 0x60, 0xd3, 0x48, 0x65, 0xe6, 0xcf,
+		0x1e, 0xb8, 0x4e, 0x72, 0xd1, 0xa2, 0xf8, 0xf3, 0x91, 0xc2, 0xf3, 0x30, 0xbd, 0x8a, 0x5e, 0x02,
+		0x77, 0x3f, 0xe1, 0xc9, 0x4e, 0xd7, 0x7b, 0xdc, 0x0b, 0x2d, 0x81, 0x3e, 0x3f, 0xd7, 0x8a, 0x92,
+		0x0a, 0x3f, 0x9c, 0x00, 0xa9, 0xaa, 0x6b, 0xa6, 0xe6, 0x7e, 0xaf, 0x50, 0xf2, 0x65, 0x00, 0x76,
+		0xcd, 0x23, 0x78, 0x61, 0x6f, 0xe2, 0x42, 0x7e, 0x31, 0xda, 0xb9, 0x45, 0x56, 0x13, 0xbd, 0x39,
+		0x95, 0xa5, 0xbc, 0xf8, 0xf3, 0xec, 0x8b, 0x00, 0x61, 0x81, 0x7c, 0x12, 0x1e, 0xa8, 0x2e, 0x97,
+		0xd6, 0x4a, 0x8a, 0xb8, 0x1c, 0x54, 0xad, 0x94, 0x97, 0x57, 0xaf, 0xad, 0x96, 0x57, 0xa4, 0x21,
+		0xbc, 0x57, 0x13, 0x2d, 0x0c, 0x2e, 0x33, 0x1d, 0x83, 0xa9, 0x28, 0x9d, 0xbd, 0x9d, 0x92, 0xc4,
+		0x08, 0xd1, 0x68, 0x38, 0x26, 0xa1, 0x27, 0x8e, 0xaa, 0x21, 0xb4, 0x16, 0x1f, 0x7c, 0xfc, 0xdb,
+		0x7f, 0xcf, 0xde, 0x58, 0x98, 0x0e, 0xc5, 0x03, 0x9d, 0x17, 0xd7, 0x60, 0x0a, 0xef, 0x50, 0x3a,
+		0x2d, 0x90, 0x31, 0x2e, 0x1a, 0x01, 0xe9, 0x19, 0x2a, 0x97, 0x0c, 0xd1, 0x2e, 0xc3, 0x88, 0x47,
+		0x7b, 0x1f, 0x07, 0xf1, 0x35, 0x0e, 0xc1, 0xd9, 0x8b, 0x16, 0x4c, 0x61, 0xc4, 0x87, 0x89, 0xa1,
+		0xb0, 0x19, 0x87, 0xe7, 0x17, 0xfe, 0xf9, 0x17, 0x9f, 0xa0, 0x27, 0xaa, 0x0f, 0xb5, 0x0e, 0x4b,
+		0x17, 0x73, 0x52, 0x24, 0x8e, 0x1d, 0x36, 0x94, 0xc0, 0x84, 0xa8, 0x8f, 0x37, 0xf8, 0xf0, 0xca,
+		0xfe, 0x05, 0xaf, 0x6c, 0xae, 0x9b, 0x0d, 0x44, 0x6a, 0x1a, 0xe7, 0xa8, 0xac, 0x60, 0xa9, 0xdc,
+		0x6b, 0x4e, 0xbf, 0xfc, 0x78, 0xe7, 0xaa, 0xc4, 0xfe, 0x9c, 0xa3, 0xc8, 0x57, 0xa3, 0xd5, 0x04,
+		0x73, 0xef, 0x37, 0x53, 0x30, 0xc7, 0x99, 0x77, 0x34, 0x8f, 0x9c, 0xdf, 0x7f, 0x72, 0x87, 0xf8,
+		0xda, 0x93, 0xe7, 0x75, 0xdb, 0x10, 0xbe, 0x7a, 0x9a, 0x4f, 0x47, 0x2c, 0x5f, 0xe4, 0xe5, 0xdd,
+		0x17, 0xab, 0xd9, 0xde, 0xd3, 0xb8, 0xb0, 0x0d, 0xe9, 0x65, 0xdb, 0xb0, 0xd0, 0x55, 0xd5, 0x88,
+		0x65, 0x37, 0xf8, 0xec, 0x61, 0x0f, 0xf2, 0x93, 0x30, 0xa2, 0x35, 0xec, 0xa6, 0xe5, 0xb3, 0x99,
+		0xb3, 0x74, 0xe2, 0xab, 0x6f, 0xce, 0x0f, 0xfd, 0xc7, 0x37, 0xe7, 0x53, 0xab, 0x96, 0xff, 0x1b,
+		0x5f, 0x3a, 0x07, 0x1c, 0x6a, 0xd5, 0xf2, 0x15, 0xce, 0x58, 0x4c, 0x7f, 0xf3, 0x33, 0xf3, 0x89,
+		0xc2, 0x8b, 0x30, 0xba, 0x42, 0xf4, 0xfb, 0x41, 0x5e, 0x21, 0x7a, 0x04, 0x79, 0x85, 0xe8, 0x6d,
+		0xc8, 0x97, 0x21, 0xb3, 0x6a, 0xf9, 0xec, 0x25, 0x90, 0xc7, 0x21, 0x65, 0x58, 0xec, 0x5e, 0xf1,
+		0xa1, 0x6d, 0x43, 0x2e, 0x14, 0x5c, 0x21, 0x7a, 0x20, 0x58, 0x23, 0x7a, 0x3e, 0x11, 0x57, 0x35,
+		0x72, 0x2d, 0xad, 0xfc, 0xd6, 0x6f, 0xcf, 0x0d, 0xbd, 0xf6, 0xd6, 0xdc, 0x50, 0xcf, 0x21, 0x2e,
+		0xf4, 0x1c, 0x62, 0xaf, 0x76, 0x8b, 0x79, 0xe4, 0x60, 0x64, 0x3f, 0x9f, 0x86, 0x53, 0xf4, 0xdd,
+		0x40, 0xb7, 0x61, 0x58, 0xfe, 0x79, 0xdd, 0x3d, 0x70, 0x7c, 0x1b, 0xfd, 0xa6, 0xbd, 0xcb, 0x07,
+		0x76, 0x2a, 0x2c, 0x5e, 0x64, 0xc5, 0x3d, 0x62, 0x90, 0x5d, 0x18, 0xae, 0xa0, 0x1c, 0xaa, 0xd8,
+		0xb7, 0x7d, 0xcd, 0xe4, 0xeb, 0x0f, 0x7b, 0x40, 0x2a, 0x7b, 0x9f, 0x30, 0xc9, 0xa8, 0x86, 0x78,
+		0x95, 0xd0, 0x24, 0xda, 0x2e, 0x7b, 0x2d, 0x23, 0x45, 0x43, 0x93, 0x0c, 0x12, 0xe8, 0x1b, 0x18,
+		0x33, 0x30, 0xac, 0x35, 0xd9, 0xd5, 0x89, 0x14, 0xc6, 0x2c, 0xf4, 0xa1, 0xf0, 0x3c, 0x8c, 0xf2,
+		0x13, 0x54, 0xbc, 0x3b, 0x70, 0x8b, 0x1c, 0xd0, 0x7a, 0x72, 0x0a, 0xfe, 0x94, 0x17, 0x61, 0x98,
+		0x36, 0x9e, 0xbf, 0x6f, 0x96, 0x5f, 0xec, 0x68, 0xfd, 0x22, 0x6d, 0xa4, 0xc2, 0xd8, 0x0a, 0x37,
+		0x20, 0xb3, 0x62, 0x37, 0x0c, 0xcb, 0x6e, 0x45, 0xcb, 0x32, 0x34, 0xda, 0x66, 0xa7, 0xc9, 0xad,
+		0x42, 0x61, 0x0f, 0x78, 0xa9, 0x98, 0xbd, 0xa6, 0xc3, 0xaf, 0x7f, 0xf0, 0xa7, 0xc2, 0x32, 0x8c,
+		0x52, 0xec, 0x4d, 0x07, 0x9d, 0x7f, 0x70, 0x73, 0x39, 0xcb, 0x5f, 0xda, 0xe4, 0xf0, 0xc9, 0xb0,
+		0xb1, 0x32, 0xa4, 0x6b, 0x9a, 0xaf, 0xf1, 0x7e, 0xd3, 0xdf, 0x85, 0x0f, 0x40, 0x86, 0x83, 0x78,
+		0xf2, 0x05, 0x48, 0xd9, 0x8e, 0xc7, 0x2f, 0x70, 0xcc, 0xf6, 0xea, 0xca, 0xa6, 0xb3, 0x94, 0x46,
+		0x9b, 0x51, 0x90, 0x79, 0x49, 0xe9, 0x69, 0x16, 0xcf, 0x44, 0xcc, 0x22, 0x32, 0xe4, 0x91, 0x9f,
+		0x6c, 0x48, 0x3b, 0xcc, 0x21, 0x30, 0x96, 0xd7, 0x93, 0x30, 0x17, 0x29, 0xdd, 0x27, 0xae, 0x67,
+		0xd8, 0x16, 0xb3, 0x28, 0x6e, 0x2d, 0x72, 0xa4, 0x91, 0xbc, 0xbc, 0x87, 0xb9, 0xbc, 0x1f, 0x52,
+		0x25, 0xc7, 0xc1, 0xb7, 0x55, 0xe9, 0xb3, 0x6e, 0x33, 0x7b, 0x49, 0x2b, 0xc1, 0x33, 0x96, 0x79,
+		0xf6, 0xae, 0x7f, 0x5b, 0x73, 0x83, 0x37, 0x59, 0xc5, 0x73, 0xe1, 0x0a, 0x64, 0x97, 0x6d, 0xcb,
+		0x23, 0x96, 0xd7, 0xa4, 0x91, 0xcd, 0x8e, 0x69, 0xeb, 0xb7, 0x38, 0x02, 0x7b, 0x40, 0x85, 0x6b,
+		0x8e, 0x43, 0x25, 0xd3, 0x0a, 0xfe, 0x64, 0x73, 0x76, 0xa9, 0xda, 0x53, 0x45, 0x57, 0x06, 0x57,
+		0x11, 0xef, 0x64, 0xa0, 0xa3, 0x3f, 0x4e, 0xc0, 0x83, 0x9d, 0x13, 0xea, 0x16, 0x39, 0xf0, 0x06,
+		0x9d, 0x4f, 0x2f, 0x42, 0xb6, 0x42, 0x3f, 0x34, 0xf1, 0x3c, 0x39, 0x90, 0x67, 0xf1, 0x6b, 0x04,
+		0x17, 0x2e, 0x5e, 0x7c, 0xf2, 0x0a, 0xb3, 0xf6, 0xe7, 0x86, 0x14, 0x41, 0x90, 0xe7, 0x20, 0xeb,
+		0x11, 0xdd, 0xb9, 0x70, 0xf1, 0xd2, 0xad, 0x27, 0x99, 0x79, 0x3d, 0x37, 0xa4, 0x84, 0xa4, 0x62,
+		0x06, 0x7b, 0xfd, 0xcd, 0xd7, 0xe7, 0x13, 0x4b, 0xc3, 0x90, 0xf2, 0x9a, 0x8d, 0x77, 0xd5, 0x46,
+		0x3e, 0x39, 0x0c, 0x0b, 0x51, 0x49, 0x1a, 0xff, 0xed, 0x6b, 0xa6, 0x51, 0xd3, 0xc2, 0x4f, 0x84,
+		0x48, 0x11, 0x1d, 0x50, 0x8e, 0x1e, 0x2b, 0xc5, 0xa1, 0x9a, 0x2c, 0xfc, 0x42, 0x02, 0x72, 0x37,
+		0x05, 0x32, 0x7e, 0x53, 0xe4, 0x2a, 0x40, 0x50, 0x93, 0x98, 0x36, 0x27, 0x17, 0xdb, 0xeb, 0x5a,
+		0x0c, 0x64, 0x94, 0x08, 0xbb, 0x7c, 0x99, 0x1a, 0xa2, 0x63, 0x7b, 0xfc, 0xed, 0xc6, 0x18, 0xd1,
+		0x80, 0x19, 0xaf, 0xe5, 0x51, 0x0f, 0xa7, 0xee, 0xdb, 0x3e, 0x5e, 0x14, 0x70, 0xec, 0xdb, 0xfc,
+		0x9d, 0xf1, 0x94, 0x22, 0xd1, 0x92, 0x9b, 0xb4, 0xa0, 0x82, 0x74, 0x6c, 0x74, 0x36, 0x40, 0xc1,
+		0x60, 0x5d, 0xab, 0xd5, 0x5c, 0xe2, 0x79, 0xdc, 0x89, 0x89, 0x47, 0x7c, 0xa5, 0xd2, 0x69, 0xee,
+		0xa8, 0xc2, 0x63, 0xe0, 0x4b, 0xa9, 0x5d, 0xe6, 0xbf, 0xb0, 0x0f, 0xee, 0x01, 0x46, 0x9c, 0xe6,
+		0x0e, 0x5a, 0xcb, 0x43, 0x90, 0xeb, 0xd2, 0x98, 0xb1, 0xfd, 0xb0, 0x1d, 0xf4, 0xfb, 0x26, 0xbc,
+		0x07, 0xaa, 0xe3, 0x1a, 0xb6, 0x6b, 0xf8, 0x07, 0xf4, 0x12, 0x56, 0x4a, 0x91, 0x44, 0x41, 0x85,
+		0xd3, 0x0b, 0xb7, 0x60, 0xb2, 0x4a, 0x83, 0xb8, 0xb0, 0xe5, 0x17, 0xc3, 0xf6, 0x25, 0xe2, 0xdb,
+		0xd7, 0xb3, 0x65, 0xc9, 0x8e, 0x96, 0x2d, 0x7d, 0xb0, 0xa7, 0x75, 0x5e, 0x1e, 0xdc, 0x3a, 0x5b,
+		0x57, 0xbb, 0xdf, 0x3f, 0x01, 0x0f, 0xb6, 0x17, 0xb6, 0xb8, 0xaf, 0x7e, 0x0d, 0x33, 0x6e, 0x8f,
+		0x36, 0x7b, 0xf8, 0xa2, 0x3a, 0x1b, 0xe3, 0x46, 0x67, 0x63, 0xa7, 0x50, 0xe1, 0x0a, 0x8c, 0xe3,
+		0x75, 0xca, 0x2a, 0xf1, 0x9f, 0x23, 0x5a, 0x8d, 0xb8, 0xad, 0xab, 0xee, 0xb8, 0x58, 0x75, 0x65,
+		0x48, 0xd3, 0xa5, 0x95, 0xad, 0x3a, 0xf4, 0x77, 0x61, 0x0f, 0xd2, 0x28, 0x1a, 0xae, 0xc8, 0x5c,
+		0x82, 0x3e, 0x50, 0x5f, 0x7a, 0xe0, 0x13, 0x4f, 0x24, 0x0a, 0xe8, 0x83, 0xfc, 0xb4, 0x58, 0x57,
+		0x53, 0x87, 0xaf, 0xab, 0xdc, 0x10, 0xf9, 0xea, 0x6a, 0xc2, 0xe8, 0x12, 0xba, 0xe2, 0xd5, 0x95,
+		0xa0, 0x21, 0x89, 0xb0, 0x21, 0xf2, 0x3a, 0x4c, 0x3a, 0x9a, 0xeb, 0xd3, 0x37, 0xb3, 0xf6, 0x68,
+		0x2f, 0xb8, 0xad, 0xcf, 0x77, 0xce, 0xbc, 0x96, 0xce, 0xf2, 0x5a, 0xc6, 0x9d, 0x28, 0xb1, 0xf0,
+		0xdf, 0xd2, 0x30, 0xc2, 0x95, 0xf1, 0x7e, 0x18, 0xe5, 0x6a, 0xe5, 0xd6, 0x79, 0x6a, 0xb1, 0x73,
+		0x61, 0x5a, 0x0c, 0x16, 0x10, 0x8e, 0x27, 0x64, 0xe4, 0x47, 0x21, 0xa3, 0xef, 0x69, 0x86, 0xa5,
+		0x1a, 0x35, 0x1e, 0x10, 0x8e, 0xbd, 0xf5, 0xe6, 0xfc, 0xe8, 0x32, 0xd2, 0x56, 0x57, 0x94, 0x51,
+		0x5a, 0xb8, 0x5a, 0xc3, 0x48, 0x60, 0x8f, 0x18, 0xf5, 0x3d, 0x9f, 0xcf, 0x30, 0xfe, 0x84, 0x1f,
+		0x37, 0x42, 0x83, 0xe0, 0xef, 0xed, 0xce, 0x76, 0x44, 0xf8, 0xc1, 0x16, 0x7a, 0x29, 0x83, 0x15,
+		0x7f, 0xec, 0xbf, 0xce, 0x27, 0x14, 0x2a, 0x21, 0x2f, 0xc3, 0xb8, 0xa9, 0x79, 0xbe, 0x4a, 0x57,
+		0x30, 0xac, 0x7e, 0x98, 0x42, 0x9c, 0xe8, 0x54, 0x08, 0x57, 0x2c, 0x6f, 0xfa, 0x18, 0x4a, 0x31,
+		0x52, 0x0d, 0x5f, 0x2b, 0xa4, 0x20, 0x78, 0x8b, 0xd4, 0xf0, 0x59, 0x6c, 0x35, 0x42, 0xf5, 0x3e,
+		0x81, 0xf4, 0x65, 0x4a, 0xa6, 0x11, 0xd6, 0x49, 0xc8, 0xd2, 0x37, 0x05, 0x29, 0x0b, 0xbb, 0xfe,
+		0x9b, 0x41, 0x02, 0x2d, 0x7c, 0x0c, 0x26, 0x43, 0xff, 0xc8, 0x58, 0x32, 0x0c, 0x25, 0x24, 0x53,
+		0xc6, 0x27, 0x60, 0xc6, 0x22, 0x77, 0x7c, 0x35, 0x24, 0x33, 0xee, 0x2c, 0xe5, 0x96, 0xb1, 0xec,
+		0x66, 0xab, 0xc4, 0x23, 0x30, 0xa1, 0x0b, 0xe5, 0x33, 0x5e, 0xa0, 0xbc, 0xe3, 0x01, 0x95, 0xb2,
+		0x9d, 0x80, 0x8c, 0xe6, 0x38, 0x8c, 0x61, 0x8c, 0xfb, 0x47, 0xc7, 0xa1, 0x45, 0x67, 0x61, 0x8a,
+		0xf6, 0xd1, 0x25, 0x5e, 0xd3, 0xf4, 0x39, 0x48, 0x8e, 0xf2, 0x4c, 0x62, 0x81, 0xc2, 0xe8, 0x94,
+		0xf7, 0x61, 0x18, 0x27, 0xfb, 0x46, 0x8d, 0x58, 0x3a, 0x61, 0x7c, 0xe3, 0x94, 0x2f, 0x27, 0x88,
+		0x94, 0xe9, 0x0c, 0x04, 0x7e, 0x4f, 0x15, 0x3e, 0x79, 0x82, 0xe1, 0x09, 0x7a, 0x89, 0x91, 0x0b,
+		0x79, 0x48, 0xaf, 0x68, 0xbe, 0x86, 0x01, 0x86, 0x7f, 0x87, 0x2d, 0x34, 0x39, 0x05, 0x7f, 0x16,
+		0xbe, 0x99, 0x84, 0xf4, 0x4d, 0xdb, 0x27, 0xf2, 0x53, 0x91, 0x00, 0x70, 0xa2, 0x9b, 0x3d, 0x57,
+		0x8d, 0xba, 0x45, 0x6a, 0xeb, 0x5e, 0x3d, 0xf2, 0x59, 0x8f, 0xd0, 0x9c, 0x92, 0x2d, 0xe6, 0x34,
+		0x03, 0xc3, 0xae, 0xdd, 0xb4, 0x6a, 0xe2, 0xe2, 0x2c, 0x7d, 0x90, 0xcb, 0x90, 0x09, 0xac, 0x24,
+		0x1d, 0x67, 0x25, 0x93, 0x68, 0x25, 0x68, 0xc3, 0x9c, 0xa0, 0x8c, 0xee, 0x70, 0x63, 0x59, 0x82,
+		0x6c, 0xe0, 0xbc, 0xf2, 0xc3, 0x03, 0x18, 0x6c, 0x28, 0x86, 0x8b, 0x49, 0x30, 0xf6, 0x81, 0xf2,
+		0x98, 0xc5, 0x49, 0x41, 0x01, 0xd7, 0x5e, 0x8b, 0x59, 0xf1, 0x4f, 0x8c, 0x8c, 0xd2, 0x7e, 0x85,
+		0x66, 0xc5, 0x3e, 0x33, 0xf2, 0x20, 0xde, 0x44, 0xaa, 0x5b, 0x9a, 0xdf, 0x74, 0x09, 0xb7, 0xbc,
+		0x90, 0x50, 0xf8, 0x4a, 0x02, 0x46, 0x98, 0x25, 0x47, 0xf4, 0x96, 0xe8, 0xae, 0xb7, 0x64, 0x2f,
+		0xbd, 0xa5, 0xee, 0x5f, 0x6f, 0x25, 0x80, 0xa0, 0x31, 0x1e, 0xff, 0xf2, 0x43, 0x97, 0x88, 0x81,
+		0x35, 0xb1, 0x6a, 0xd4, 0xf9, 0x44, 0x8d, 0x08, 0x15, 0xfe, 0x4b, 0x02, 0xb2, 0x41, 0xb9, 0x5c,
+		0x82, 0x71, 0xd1, 0x2e, 0x75, 0xd7, 0xd4, 0xea, 0xdc, 0x76, 0x4e, 0xf5, 0x6c, 0xdc, 0x35, 0x53,
+		0xab, 0x2b, 0x63, 0xbc, 0x3d, 0xf8, 0xd0, 0x7d, 0x1c, 0x92, 0x3d, 0xc6, 0xa1, 0x65, 0xe0, 0x53,
+		0xf7, 0x37, 0xf0, 0x2d, 0x43, 0x94, 0x6e, 0x1f, 0xa2, 0x2f, 0x26, 0xe9, 0x66, 0xc6, 0xb1, 0x3d,
+		0xcd, 0xfc, 0x7e, 0xcc, 0x88, 0x93, 0x90, 0x75, 0x6c, 0x53, 0x65, 0x25, 0xec, 0x42, 0x79, 0xc6,
+		0xb1, 0x4d, 0xa5, 0x63, 0xd8, 0x87, 0x8f, 0x68, 0xba, 0x8c, 0x1c, 0x81, 0xd6, 0x46, 0xdb, 0xb5,
+		0xe6, 0x42, 0x8e, 0xa9, 0x82, 0xaf, 0x65, 0x4f, 0xa0, 0x0e, 0xf0, 0x57, 0x3e, 0xd1, 0xb9, 0xf6,
+		0xb2, 0x66, 0x33, 0x4e, 0x65, 0x64, 0x2f, 0x90, 0x60, 0xae, 0x3f, 0x9f, 0xec, 0x25, 0xc1, 0xcc,
+		0x4e, 0xe1, 0x7c, 0x85, 0x9f, 0x4c, 0x00, 0xac, 0xa1, 0x66, 0x69, 0x7f, 0x71, 0x15, 0xf2, 0x68,
+		0x13, 0xd4, 0x96, 0x9a, 0xe7, 0x7a, 0x0d, 0x1a, 0xaf, 0x3f, 0xe7, 0x45, 0xdb, 0xbd, 0x0c, 0xe3,
+		0xa1, 0x31, 0x7a, 0x44, 0x34, 0x66, 0xee, 0x90, 0xa8, 0xba, 0x4a, 0x7c, 0x25, 0xb7, 0x1f, 0x79,
+		0x2a, 0xfc, 0x4a, 0x02, 0xb2, 0xb4, 0x4d, 0xf8, 0xde, 0x7a, 0xcb, 0x18, 0x26, 0xee, 0x7f, 0x0c,
+		0x4f, 0x01, 0x30, 0x18, 0x3c, 0x97, 0xe5, 0x96, 0x95, 0xa5, 0x14, 0x3c, 0x6d, 0x95, 0x2f, 0x05,
+		0x0a, 0x4f, 0x1d, 0xae, 0x70, 0x11, 0x75, 0x73, 0xb5, 0x3f, 0x00, 0xa3, 0xf4, 0x4b, 0x69, 0x77,
+		0x3c, 0x1e, 0x48, 0xe3, 0xe7, 0x51, 0xb6, 0xee, 0x78, 0x85, 0x57, 0x60, 0x74, 0xeb, 0x0e, 0xcb,
+		0x8d, 0x9c, 0x84, 0xac, 0x6b, 0xdb, 0x7c, 0x4d, 0x66, 0xb1, 0x50, 0x06, 0x09, 0x74, 0x09, 0x12,
+		0xf9, 0x80, 0x64, 0x98, 0x0f, 0x08, 0x13, 0x1a, 0xa9, 0xbe, 0x12, 0x1a, 0x67, 0x7f, 0x33, 0x01,
+		0x63, 0x11, 0xff, 0x20, 0x3f, 0x09, 0xc7, 0x96, 0xd6, 0x36, 0x97, 0x9f, 0x57, 0x57, 0x57, 0xd4,
+		0x6b, 0x6b, 0xa5, 0xeb, 0xe1, 0x3b, 0x53, 0xb3, 0xc7, 0xef, 0xde, 0x5b, 0x90, 0x23, 0xbc, 0xdb,
+		0x16, 0xcd, 0xd3, 0xcb, 0xe7, 0x61, 0xa6, 0x55, 0xa4, 0xb4, 0x54, 0xc5, 0x17, 0xa8, 0x12, 0xb3,
+		0xc7, 0xee, 0xde, 0x5b, 0x98, 0x8a, 0x48, 0x94, 0x76, 0x3c, 0x62, 0xf9, 0x9d, 0x02, 0xcb, 0x9b,
+		0xeb, 0xeb, 0xab, 0x5b, 0x52, 0xb2, 0x43, 0x80, 0x3b, 0xec, 0x33, 0x30, 0xd5, 0x2a, 0xb0, 0xb1,
+		0xba, 0x26, 0xa5, 0x66, 0xe5, 0xbb, 0xf7, 0x16, 0x26, 0x22, 0xdc, 0x1b, 0x86, 0x39, 0x9b, 0xf9,
+		0xd1, 0x9f, 0x99, 0x1b, 0xfa, 0xb9, 0xcf, 0xcd, 0x25, 0xb0, 0x67, 0xe3, 0x2d, 0x3e, 0x42, 0x7e,
+		0x2f, 0x3c, 0x50, 0x5d, 0xbd, 0xbe, 0x51, 0x5e, 0x51, 0xd7, 0xab, 0xd7, 0xdb, 0x5e, 0x83, 0x9d,
+		0x9d, 0xbc, 0x7b, 0x6f, 0x61, 0x8c, 0x77, 0xa9, 0x17, 0x77, 0x45, 0x29, 0xdf, 0xdc, 0xdc, 0x2a,
+		0x4b, 0x09, 0xc6, 0x5d, 0x71, 0xc9, 0xbe, 0xed, 0xb3, 0x8f, 0x2c, 0x3e, 0x01, 0x27, 0xba, 0x70,
+		0x07, 0x1d, 0x9b, 0xba, 0x7b, 0x6f, 0x61, 0xbc, 0xe2, 0x12, 0x36, 0x7f, 0xa8, 0xc4, 0x22, 0xe4,
+		0x3b, 0x25, 0x36, 0x2b, 0x9b, 0xd5, 0xd2, 0x9a, 0xb4, 0x30, 0x2b, 0xdd, 0xbd, 0xb7, 0x90, 0x13,
+		0xce, 0x10, 0xf9, 0xc3, 0x9e, 0xbd, 0x9b, 0x3b, 0x9e, 0x37, 0xb6, 0xe1, 0x94, 0xe7, 0x6b, 0xb7,
+		0x0c, 0xab, 0x1e, 0x64, 0x6d, 0xf9, 0x33, 0xdf, 0xf2, 0x9c, 0x32, 0x8d, 0x0f, 0x37, 0x8d, 0x9a,
+		0x20, 0x8a, 0xbf, 0x31, 0x29, 0xdc, 0x9e, 0x27, 0x96, 0xb3, 0x31, 0x87, 0x7a, 0xf1, 0x5b, 0xa7,
+		0xde, 0xe9, 0xe1, 0xd9, 0x98, 0x24, 0xf4, 0xec, 0xa1, 0x9b, 0xbb, 0xc2, 0xc7, 0x12, 0x30, 0xf1,
+		0x9c, 0xe1, 0xf9, 0xb6, 0x6b, 0xe8, 0x9a, 0x49, 0xdf, 0x94, 0xba, 0xd4, 0xaf, 0x6f, 0x6d, 0x9b,
+		0xea, 0xd7, 0x60, 0x64, 0x5f, 0x33, 0x99, 0x53, 0x63, 0x2f, 0xa3, 0x1d, 0xaa, 0xc5, 0xd0, 0xc3,
+		0x09, 0x1c, 0x26, 0x5d, 0xf8, 0x42, 0x12, 0x26, 0xe9, 0x9c, 0xf0, 0xd8, 0x07, 0xf1, 0x70, 0xab,
+		0x55, 0x81, 0xb4, 0xab, 0xf9, 0x3c, 0x77, 0xb8, 0xf4, 0x3e, 0x9e, 0x0e, 0x7e, 0x34, 0x3e, 0xa9,
+		0xbb, 0xd8, 0x99, 0x31, 0xa6, 0x48, 0xf2, 0x0b, 0x90, 0x69, 0x68, 0x77, 0x54, 0x8a, 0x9a, 0x3c,
+		0x02, 0xd4, 0xd1, 0x86, 0x76, 0x07, 0xdb, 0x2a, 0xd7, 0x60, 0x12, 0x81, 0xf5, 0x3d, 0xcd, 0xaa,
+		0x13, 0x86, 0x9f, 0x3a, 0x02, 0xfc, 0xf1, 0x86, 0x76, 0x67, 0x99, 0x62, 0x62, 0x2d, 0xc5, 0xcc,
+		0xc7, 0x3f, 0x33, 0x3f, 0x44, 0xb3, 0xed, 0xbf, 0x92, 0x00, 0x08, 0xd5, 0x25, 0xeb, 0x20, 0xe9,
+		0xc1, 0x13, 0xad, 0xde, 0xe3, 0xe3, 0xb8, 0x18, 0x33, 0x1e, 0x6d, 0x3a, 0x67, 0xcb, 0xf4, 0xd7,
+		0xdf, 0x9c, 0x4f, 0x28, 0x93, 0x7a, 0xdb, 0x70, 0x94, 0x61, 0xac, 0xe9, 0xd4, 0x34, 0x9f, 0xa8,
+		0x74, 0x4b, 0x97, 0x1c, 0x60, 0xc9, 0x07, 0x26, 0x88, 0x45, 0x91, 0x4e, 0x7c, 0x21, 0x01, 0x63,
+		0x2b, 0x91, 0x23, 0xbf, 0x3c, 0x8c, 0x36, 0x6c, 0xcb, 0xb8, 0xc5, 0x8d, 0x30, 0xab, 0x88, 0x47,
+		0xcc, 0x7f, 0xb2, 0x37, 0x46, 0xfd, 0x03, 0x91, 0xff, 0x14, 0xcf, 0x28, 0x75, 0x9b, 0xec, 0x78,
+		0x86, 0x50, 0xb9, 0x22, 0x1e, 0x71, 0x23, 0xe3, 0x11, 0xbd, 0x89, 0x89, 0x1b, 0x7c, 0x59, 0xdc,
+		0xc7, 0x2f, 0x41, 0xb0, 0x77, 0x8c, 0x26, 0x05, 0x7d, 0x99, 0x91, 0x11, 0xa4, 0x46, 0x7c, 0xcd,
+		0x30, 0xbd, 0x3c, 0x3b, 0x16, 0x13, 0x8f, 0x91, 0xe6, 0xfe, 0x6a, 0x36, 0x9a, 0xb0, 0x5a, 0x06,
+		0xc9, 0x76, 0x88, 0xdb, 0x12, 0x60, 0x32, 0x43, 0xcd, 0xff, 0xc6, 0x97, 0xce, 0xcd, 0xf0, 0x41,
+		0xe4, 0x21, 0x26, 0xbb, 0xdd, 0xaa, 0x4c, 0x0a, 0x09, 0x4e, 0x96, 0x5f, 0x02, 0x29, 0xd8, 0xe7,
+		0xa9, 0x4e, 0x73, 0x27, 0x4c, 0x72, 0xcd, 0x74, 0xe8, 0xb5, 0x64, 0x1d, 0x2c, 0xe5, 0xbf, 0x16,
+		0x42, 0x87, 0x99, 0x25, 0x4c, 0x2b, 0x4d, 0x06, 0x38, 0x15, 0x0a, 0x83, 0x01, 0xe3, 0x2b, 0x9a,
+		0x61, 0x8a, 0x17, 0xec, 0x15, 0xfe, 0x24, 0x97, 0x60, 0xc4, 0xf3, 0x35, 0xbf, 0xe9, 0xf1, 0xaf,
+		0x36, 0x9e, 0x89, 0x31, 0x90, 0x25, 0xdb, 0xaa, 0x55, 0xa9, 0x80, 0xc2, 0x05, 0xe5, 0x2d, 0x18,
+		0xf1, 0xed, 0x5b, 0xc4, 0xe2, 0xba, 0x1a, 0xc8, 0xc6, 0xbb, 0x1c, 0x50, 0x31, 0x2c, 0xb9, 0x0e,
+		0x52, 0x8d, 0x98, 0xa4, 0xce, 0xa2, 0xa4, 0x3d, 0x0d, 0x37, 0x13, 0x23, 0x47, 0x30, 0x87, 0x26,
+		0x03, 0xd4, 0x2a, 0x05, 0x95, 0x95, 0xd6, 0xb3, 0x67, 0xf6, 0xa5, 0xd3, 0xb3, 0x31, 0x6a, 0x88,
+		0xd8, 0xa9, 0x48, 0x34, 0x44, 0x40, 0xd0, 0xd4, 0x9a, 0xd6, 0x8e, 0x6d, 0xd1, 0x97, 0x57, 0x79,
+		0xa0, 0x9e, 0xa1, 0xa1, 0xcf, 0x64, 0x40, 0x7f, 0x8e, 0x92, 0xe5, 0xe7, 0x61, 0x22, 0x64, 0xa5,
+		0x33, 0x29, 0x3b, 0xc0, 0x4c, 0x1a, 0x0f, 0x64, 0xb1, 0x54, 0xde, 0x04, 0x08, 0xa7, 0x29, 0x4d,
+		0x1d, 0x8c, 0x5d, 0x38, 0xd3, 0xf7, 0x94, 0x17, 0x3b, 0xb1, 0x10, 0x42, 0xfe, 0x73, 0x70, 0x92,
+		0xe7, 0x70, 0x83, 0x88, 0x15, 0xeb, 0x13, 0x03, 0x32, 0x76, 0x04, 0x03, 0x92, 0x67, 0xa9, 0xe0,
+		0x60, 0x21, 0x40, 0x03, 0x63, 0x23, 0x63, 0xc2, 0x34, 0xab, 0x9c, 0x75, 0x40, 0x54, 0x9a, 0x3b,
+		0x82, 0x4a, 0xa7, 0x28, 0xf0, 0x1a, 0xc5, 0xe5, 0xb5, 0xb9, 0x70, 0x9c, 0xd5, 0x46, 0x0d, 0x90,
+		0xbe, 0xf1, 0xc1, 0x2b, 0x1c, 0x3f, 0x82, 0x0a, 0x67, 0x28, 0xf6, 0x96, 0x80, 0xe6, 0x75, 0x36,
+		0xe1, 0x98, 0xe8, 0x1b, 0x1b, 0x15, 0xd5, 0xb1, 0x4d, 0x43, 0x3f, 0xa0, 0x09, 0x96, 0xb1, 0x0b,
+		0x57, 0xfb, 0x5d, 0x3d, 0x79, 0x47, 0x58, 0x71, 0x85, 0x42, 0xf0, 0xc1, 0x9c, 0x36, 0x3b, 0x8b,
+		0x8a, 0xb9, 0x1f, 0xfd, 0xcc, 0xfc, 0x10, 0x77, 0x64, 0x43, 0x85, 0x0a, 0x3d, 0x2d, 0xe0, 0x3e,
+		0x88, 0x78, 0xf2, 0x25, 0xc8, 0x6a, 0xe2, 0x81, 0xe6, 0x70, 0x0e, 0xf3, 0x61, 0x21, 0x2b, 0x73,
+		0x8d, 0xaf, 0xfd, 0xe7, 0x85, 0x44, 0xe1, 0x73, 0x09, 0x18, 0x59, 0xb9, 0x59, 0xd1, 0x0c, 0x57,
+		0x2e, 0xc3, 0x54, 0x30, 0xe1, 0xfa, 0x76, 0x8c, 0xe1, 0xcc, 0xe7, 0x74, 0x84, 0xe9, 0xbe, 0x81,
+		0x3f, 0x14, 0xa6, 0x7d, 0x6b, 0xdf, 0xd6, 0xf1, 0x35, 0x18, 0x65, 0xad, 0xa4, 0x9f, 0x54, 0x72,
+		0xf0, 0x07, 0x3f, 0x1c, 0x79, 0x24, 0x6e, 0xfa, 0x53, 0xb1, 0x20, 0xa7, 0x8b, 0x92, 0x85, 0x3f,
+		0x4e, 0x00, 0xac, 0xdc, 0xbc, 0xb9, 0xe5, 0x1a, 0x8e, 0x49, 0xfc, 0xa3, 0xea, 0xf8, 0x1a, 0x1c,
+		0x0b, 0x3b, 0xee, 0xb9, 0x7a, 0xdf, 0x9d, 0x9f, 0x0e, 0xb7, 0x8b, 0xae, 0xde, 0x15, 0xad, 0xe6,
+		0xf9, 0x01, 0x5a, 0xaa, 0x6f, 0xb4, 0x15, 0xcf, 0xef, 0xae, 0xcd, 0x97, 0x61, 0x2c, 0xec, 0xbe,
+		0x27, 0x3f, 0x0f, 0x19, 0x9f, 0xff, 0xe6, 0x4a, 0x3d, 0x13, 0xab, 0x54, 0x21, 0xcd, 0x15, 0x1b,
+		0x00, 0x14, 0x7e, 0x36, 0x09, 0xb0, 0xc2, 0x54, 0x83, 0x5e, 0xe9, 0x07, 0xca, 0xa8, 0x70, 0xfd,
+		0xe3, 0x8e, 0xe2, 0x28, 0x62, 0x3c, 0x8e, 0x85, 0x99, 0xe0, 0x56, 0x9f, 0x9b, 0x67, 0xaf, 0x77,
+		0x8c, 0xef, 0x47, 0x3d, 0x65, 0xdb, 0x18, 0xdc, 0x4d, 0xe2, 0xd7, 0x3b, 0xf8, 0x8a, 0xf0, 0x03,
+		0xab, 0xb0, 0x17, 0x60, 0x94, 0x58, 0xbe, 0x6b, 0x50, 0x8d, 0xa1, 0x65, 0x5c, 0x8e, 0xb1, 0x8c,
+		0x2e, 0x5d, 0xa2, 0x5f, 0x7d, 0x13, 0xc7, 0x13, 0x1c, 0xad, 0x4d, 0x19, 0xff, 0x29, 0x09, 0xf9,
+		0x5e, 0x92, 0x98, 0x6c, 0xd5, 0x5d, 0x42, 0x09, 0x6a, 0x4b, 0x8e, 0x74, 0x42, 0x90, 0xf9, 0xfa,
+		0xbc, 0x0e, 0x18, 0xf9, 0xa2, 0x19, 0x22, 0xeb, 0xc0, 0xa1, 0xee, 0x44, 0x28, 0x8c, 0xc5, 0x32,
+		0x81, 0x49, 0xc3, 0x32, 0x7c, 0x43, 0x33, 0xd5, 0x1d, 0xcd, 0xd4, 0x2c, 0xfd, 0x7e, 0x76, 0x06,
+		0x9d, 0x51, 0xd3, 0x04, 0x07, 0x5d, 0x62, 0x98, 0xf2, 0x4d, 0x18, 0x15, 0xf0, 0xe9, 0x23, 0x80,
+		0x17, 0x60, 0x91, 0xf0, 0xf7, 0x3f, 0x24, 0x61, 0x4a, 0x21, 0xb5, 0x3f, 0x59, 0x6a, 0xfd, 0x10,
+		0x00, 0x9b, 0x9e, 0xe8, 0x3c, 0xf3, 0xe9, 0x23, 0x98, 0xee, 0x59, 0x86, 0xb7, 0xe2, 0xf9, 0x11,
+		0xdd, 0xfe, 0x7a, 0x12, 0x72, 0x51, 0xdd, 0xfe, 0x09, 0x58, 0x4c, 0xe4, 0x4a, 0xe8, 0x14, 0xd8,
+		0x99, 0xc1, 0x13, 0x31, 0x4e, 0xa1, 0xc3, 0xf8, 0x0e, 0xf7, 0x06, 0x5f, 0x1b, 0x85, 0x91, 0x8a,
+		0xe6, 0x6a, 0x0d, 0x4f, 0xbe, 0xd1, 0x11, 0x72, 0x8b, 0x9c, 0x69, 0xc7, 0x3f, 0x27, 0xe0, 0x29,
+		0x1a, 0x66, 0x79, 0x1f, 0xef, 0x12, 0x71, 0x3f, 0x02, 0x13, 0xb8, 0xd3, 0x8f, 0x5c, 0xaf, 0x48,
+		0xd2, 0x43, 0x63, 0xdc, 0xaa, 0x87, 0x67, 0x7b, 0xf8, 0x0d, 0x18, 0x64, 0x0b, 0xdd, 0x1e, 0xf2,
+		0x40, 0x43, 0xbb, 0x53, 0x66, 0x14, 0xf9, 0x1c, 0xc8, 0x7b, 0x41, 0x0a, 0x46, 0x0d, 0x35, 0x81,
+		0x7c, 0x53, 0x61, 0x89, 0x60, 0xc7, 0x4c, 0x2d, 0xc6, 0xe1, 0xec, 0xca, 0x1e, 0xdb, 0xa3, 0x66,
+		0x91, 0xb2, 0x82, 0x04, 0xf9, 0x87, 0x60, 0xba, 0x61, 0x58, 0x6a, 0x5b, 0x12, 0x80, 0xef, 0x9f,
+		0xd6, 0x06, 0x33, 0xd8, 0x3f, 0x78, 0x73, 0x7e, 0xf6, 0x40, 0x6b, 0x98, 0xc5, 0x42, 0x17, 0xc8,
+		0x82, 0x32, 0xd5, 0x30, 0xac, 0xd6, 0xac, 0x81, 0xfc, 0x97, 0x12, 0x51, 0xcb, 0xa0, 0xed, 0xdc,
+		0xd5, 0x74, 0xdf, 0x76, 0xd9, 0x57, 0xf5, 0x97, 0x36, 0x06, 0x6e, 0xc0, 0x83, 0xac, 0x01, 0x5d,
+		0x41, 0x0b, 0xca, 0x74, 0xcb, 0x92, 0x78, 0x8d, 0x52, 0xe5, 0x8f, 0xe2, 0xeb, 0x03, 0xa6, 0xbd,
+		0x13, 0xd9, 0x3e, 0xf0, 0x10, 0x5b, 0xd7, 0x1c, 0xf6, 0xad, 0xa6, 0x25, 0x65, 0xe0, 0x86, 0x2c,
+		0xb0, 0x86, 0xf4, 0x04, 0x2e, 0x28, 0xc7, 0x59, 0x59, 0x4b, 0x44, 0xbe, 0xac, 0x39, 0xf2, 0x4f,
+		0x26, 0xe0, 0xc1, 0xb0, 0xfd, 0x5d, 0x9a, 0x94, 0xa5, 0x4d, 0xda, 0x1e, 0xb8, 0x49, 0x0f, 0xb7,
+		0xeb, 0xa6, 0x5b, 0xab, 0x4e, 0xec, 0x77, 0xdd, 0x2a, 0x60, 0xc3, 0xde, 0x48, 0x40, 0x87, 0x62,
+		0x0d, 0xd7, 0xf3, 0x55, 0xd3, 0xf6, 0x3c, 0x75, 0xd7, 0xd5, 0x74, 0x5f, 0x6c, 0x26, 0xb3, 0x4b,
+		0x1f, 0x1a, 0xb8, 0x79, 0x67, 0xba, 0x0f, 0x5d, 0x67, 0x0d, 0x05, 0x65, 0xae, 0x75, 0x1c, 0x91,
+		0x65, 0xcd, 0xf6, 0xbc, 0x6b, 0x9c, 0x21, 0xe2, 0x20, 0x3f, 0x9f, 0x00, 0x39, 0x5c, 0xd1, 0x15,
+		0xe2, 0x39, 0xb6, 0xe5, 0xd1, 0xed, 0x6f, 0xe8, 0x13, 0xf8, 0xa4, 0x8e, 0x8d, 0x3a, 0x03, 0x01,
+		0xb1, 0xfd, 0x8d, 0xf8, 0xdd, 0x2b, 0xe1, 0x32, 0x9a, 0xe4, 0x2e, 0xa2, 0xcb, 0x75, 0xde, 0x45,
+		0xbc, 0x40, 0x2b, 0xbc, 0x4f, 0xfb, 0x4a, 0x39, 0x54, 0xf8, 0x46, 0x02, 0x4e, 0x74, 0x38, 0xab,
+		0xa0, 0xcd, 0x04, 0x64, 0x37, 0x52, 0xc8, 0x3f, 0x6f, 0xcb, 0xda, 0x7e, 0xbf, 0x2e, 0x70, 0xca,
+		0x6d, 0x2f, 0x78, 0xd7, 0x02, 0x02, 0x76, 0xdb, 0xf7, 0xd7, 0x12, 0x30, 0x13, 0x6d, 0x4c, 0xd0,
+		0xbb, 0x6d, 0xc8, 0x45, 0xdb, 0xc2, 0xfb, 0xf5, 0xf8, 0x00, 0xfd, 0xe2, 0x5d, 0x6a, 0x81, 0x91,
+		0x5f, 0x0c, 0x17, 0x0b, 0x96, 0x67, 0x7e, 0x66, 0x50, 0x4d, 0x89, 0x16, 0xb6, 0x2f, 0x1a, 0x69,
+		0x3a, 0x64, 0x1f, 0x49, 0x42, 0xba, 0x62, 0xdb, 0xa6, 0xfc, 0xe7, 0x61, 0xca, 0xb2, 0x7d, 0x6a,
+		0xb3, 0xa4, 0xa6, 0xf2, 0x34, 0x17, 0x5b, 0x78, 0x3f, 0x38, 0x98, 0x02, 0x7f, 0xf7, 0xcd, 0xf9,
+		0x4e, 0xa8, 0x36, 0xad, 0x4e, 0x5a, 0xb6, 0xbf, 0x44, 0xcb, 0x69, 0xa2, 0x00, 0x73, 0x12, 0xe3,
+		0xad, 0x55, 0xb3, 0x85, 0x7a, 0x7d, 0xe0, 0xaa, 0xc7, 0x0f, 0xab, 0x36, 0xb7, 0x13, 0xa9, 0x93,
+		0xdd, 0x8a, 0xfc, 0x0e, 0x8e, 0xea, 0x8f, 0x24, 0x60, 0x5a, 0x64, 0x2c, 0x68, 0xc2, 0x42, 0x21,
+		0xba, 0xed, 0xd6, 0xe4, 0x09, 0x48, 0xf2, 0x73, 0xc6, 0xb4, 0x92, 0x34, 0x6a, 0x78, 0xe8, 0x6c,
+		0xdf, 0xb6, 0xf8, 0x25, 0xa5, 0xac, 0xc2, 0x1e, 0xe8, 0xca, 0x68, 0xd7, 0x9a, 0x26, 0xc1, 0x6f,
+		0x42, 0xd3, 0x2b, 0xe4, 0x2c, 0x1f, 0x3b, 0xce, 0xa8, 0x25, 0x46, 0xc4, 0x33, 0xdf, 0x60, 0xda,
+		0xf3, 0x74, 0x6c, 0x48, 0xe0, 0xe6, 0xf5, 0xa7, 0xa1, 0x50, 0x21, 0x6c, 0xcd, 0x8d, 0x36, 0xa7,
+		0xd4, 0xf4, 0xf7, 0x6c, 0xd7, 0x78, 0x55, 0x63, 0xdf, 0x82, 0xbc, 0xcf, 0xbc, 0x45, 0xe1, 0x13,
+		0xc9, 0xee, 0xf0, 0xac, 0xb7, 0x5b, 0xae, 0x66, 0x79, 0xbb, 0xc4, 0x95, 0x2f, 0x43, 0x5e, 0x64,
+		0x86, 0x58, 0x62, 0x48, 0x75, 0x29, 0x83, 0x1a, 0xe8, 0xe2, 0x98, 0xdf, 0x29, 0xbe, 0x8a, 0x9f,
+		0x5d, 0x8f, 0xaa, 0xe7, 0x90, 0x36, 0x71, 0xc5, 0x5d, 0x84, 0xac, 0x45, 0x6e, 0xab, 0x4c, 0x26,
+		0x2e, 0x96, 0xca, 0x58, 0xe4, 0xf6, 0x26, 0x15, 0x5b, 0xc7, 0xff, 0x26, 0xe5, 0x18, 0x2c, 0x60,
+		0x51, 0x07, 0xbe, 0x66, 0x35, 0x11, 0x0a, 0x63, 0x31, 0xd7, 0xfc, 0x15, 0x78, 0x24, 0x5e, 0x35,
+		0xab, 0x35, 0x0f, 0xaf, 0xfc, 0x18, 0x35, 0xa6, 0xf6, 0xb4, 0x82, 0x3f, 0x0b, 0x9f, 0x4d, 0x40,
+		0x7e, 0x2b, 0x92, 0x65, 0xf3, 0xb5, 0x5b, 0xa4, 0xa6, 0x90, 0x5d, 0x97, 0x78, 0x7b, 0xf2, 0x22,
+		0x4c, 0xd3, 0x9b, 0x51, 0x11, 0xc7, 0x17, 0x5e, 0x58, 0x9f, 0xc2, 0xa2, 0xd0, 0x2f, 0xe3, 0xf5,
+		0xc8, 0xa7, 0xe0, 0x58, 0xc8, 0x4a, 0x8f, 0xc0, 0x74, 0x1c, 0xbc, 0x1a, 0xbf, 0xc4, 0x3c, 0x13,
+		0x29, 0xac, 0x88, 0x32, 0xf6, 0x19, 0x47, 0xbc, 0x47, 0xd7, 0x72, 0x17, 0x6d, 0x8c, 0xd2, 0xd8,
+		0x36, 0xa4, 0xf0, 0x85, 0x2c, 0x8c, 0x55, 0x4d, 0xcd, 0xdb, 0xeb, 0x61, 0xda, 0x47, 0xb4, 0xe3,
+		0xed, 0x75, 0x1f, 0xee, 0x71, 0x98, 0x32, 0x2c, 0xb1, 0x00, 0x8a, 0x66, 0xf2, 0xcb, 0xa6, 0x61,
+		0x01, 0xdf, 0x32, 0x3d, 0x06, 0x93, 0x21, 0x4d, 0x0d, 0xfe, 0x4f, 0x52, 0x56, 0x99, 0x08, 0xc9,
+		0xf4, 0xc0, 0x55, 0x85, 0x9c, 0x87, 0x7d, 0x12, 0x51, 0xd7, 0x51, 0xa4, 0xcd, 0xc7, 0x28, 0x22,
+		0x8f, 0xad, 0x6e, 0x81, 0x4c, 0x76, 0x77, 0x89, 0x4e, 0xbf, 0xb8, 0x19, 0x44, 0x08, 0xa3, 0x47,
+		0x91, 0x97, 0x0d, 0x70, 0xc5, 0xaa, 0x2f, 0x6b, 0x30, 0xce, 0xbc, 0x96, 0xba, 0xd3, 0x74, 0x2d,
+		0x52, 0xcb, 0x67, 0x06, 0xae, 0xa7, 0x73, 0xfd, 0xca, 0x31, 0xc8, 0x25, 0x8a, 0x88, 0xa9, 0x5f,
+		0x1e, 0x34, 0xf1, 0x9a, 0x6a, 0xa4, 0xd6, 0xd4, 0x7d, 0x52, 0xcb, 0x67, 0x07, 0xae, 0xab, 0x4b,
+		0xea, 0x97, 0x61, 0x33, 0xf7, 0xba, 0xc2, 0x91, 0xa3, 0xdd, 0x22, 0xbb, 0xb6, 0xcb, 0xff, 0x5f,
+		0xd4, 0x11, 0x75, 0x8b, 0x22, 0x76, 0x3d, 0x42, 0x19, 0x7b, 0x37, 0x8e, 0x50, 0x6e, 0xc3, 0x89,
+		0x9e, 0xf1, 0x5d, 0x3e, 0x77, 0x04, 0xfd, 0x3a, 0xde, 0x3d, 0x32, 0x94, 0xff, 0x22, 0x9c, 0xea,
+		0x7a, 0x30, 0xa1, 0xba, 0xa4, 0x61, 0xef, 0x93, 0xda, 0x91, 0xa4, 0xee, 0x67, 0xf7, 0x3b, 0xcf,
+		0x26, 0x14, 0x86, 0x8f, 0x2a, 0x36, 0x2c, 0xaf, 0xe9, 0x62, 0x2c, 0xa4, 0x3a, 0xda, 0x81, 0xdd,
+		0xf4, 0xf3, 0x13, 0x03, 0xd7, 0xd9, 0xd9, 0xe1, 0xc9, 0x00, 0xb5, 0x42, 0x41, 0xb9, 0x3b, 0xfe,
+		0x23, 0xfc, 0x97, 0x40, 0x38, 0x11, 0x57, 0x45, 0xf1, 0xb2, 0xbd, 0x4f, 0x5c, 0xad, 0x4e, 0x64,
+		0x03, 0xa6, 0x74, 0xfe, 0x3b, 0x9c, 0x92, 0x47, 0x71, 0x54, 0x2e, 0x09, 0xd8, 0x60, 0x46, 0x36,
+		0x60, 0x06, 0x37, 0xb3, 0xac, 0xbb, 0xaa, 0x43, 0x5c, 0x95, 0x3a, 0x87, 0x7c, 0xf2, 0x08, 0x3a,
+		0x3e, 0xd5, 0xd0, 0xee, 0xb0, 0x2e, 0x57, 0x88, 0x4b, 0xbb, 0xca, 0xbb, 0xfe, 0x9d, 0x24, 0xcc,
+		0xb4, 0x76, 0x9d, 0xb1, 0xc9, 0x8f, 0xc2, 0x24, 0xf3, 0x76, 0xed, 0xcb, 0xf1, 0xb8, 0x17, 0x3a,
+		0xf6, 0xd5, 0x23, 0x73, 0xe5, 0x87, 0x85, 0x01, 0xa9, 0xc3, 0xc2, 0x80, 0xad, 0xe0, 0x55, 0xba,
+		0xa3, 0x08, 0xc0, 0x39, 0x56, 0x24, 0xf9, 0x3c, 0x7c, 0x74, 0xc9, 0x67, 0xae, 0xf2, 0x6f, 0x27,
+		0x71, 0x05, 0xef, 0xe8, 0xcb, 0xb2, 0xa9, 0x19, 0x0d, 0xb9, 0x02, 0x23, 0xac, 0xe3, 0x3c, 0xa6,
+		0xbf, 0x10, 0x13, 0x81, 0x77, 0x01, 0x12, 0x77, 0x3e, 0x18, 0x4e, 0xa4, 0x2b, 0xc9, 0x23, 0xcc,
+		0xa3, 0x87, 0xa7, 0xd3, 0xa9, 0x23, 0x3c, 0x9d, 0xee, 0x92, 0xbe, 0x4c, 0xdf, 0x7f, 0xfa, 0x92,
+		0xeb, 0xfb, 0xa3, 0x09, 0x98, 0xe2, 0x6a, 0x62, 0x2f, 0xd8, 0x6b, 0x4d, 0x8f, 0x74, 0xb7, 0xdb,
+		0xc4, 0xc0, 0x76, 0x8b, 0xef, 0xb5, 0x20, 0x9e, 0x8a, 0x1b, 0xa9, 0x46, 0xe4, 0x9f, 0xcf, 0x65,
+		0x14, 0x89, 0x16, 0x28, 0x21, 0x9d, 0xb7, 0xe7, 0x73, 0x49, 0x78, 0xf0, 0xb0, 0x23, 0x46, 0x79,
+		0x17, 0xe4, 0x2e, 0x89, 0x0c, 0xd6, 0xb6, 0x67, 0xee, 0xdf, 0xe1, 0x98, 0xed, 0x29, 0x0a, 0x0d,
+		0x16, 0xe8, 0xff, 0x0b, 0x20, 0xb5, 0xf6, 0xe4, 0x86, 0xe3, 0xda, 0x78, 0x57, 0xdd, 0x65, 0xfb,
+		0xc0, 0xc3, 0x34, 0x72, 0x8a, 0x23, 0xb4, 0xf6, 0x43, 0x88, 0x63, 0x80, 0xe9, 0x47, 0x54, 0xaf,
+		0xd6, 0x0c, 0x0f, 0xbf, 0xe9, 0x22, 0xae, 0x4b, 0xcc, 0x44, 0x0b, 0x57, 0x78, 0x19, 0x57, 0xd3,
+		0xbf, 0x4c, 0xc0, 0xcc, 0x36, 0xbd, 0xd0, 0xc2, 0xf2, 0x8b, 0xc1, 0xcd, 0x5e, 0x7c, 0xf3, 0xc4,
+		0xf0, 0x4d, 0xf1, 0xb6, 0x23, 0x7b, 0xe8, 0xe3, 0x5d, 0xf7, 0x65, 0xfc, 0xe7, 0x2c, 0x88, 0xc4,
+		0xef, 0x36, 0xc6, 0x9d, 0x46, 0xb2, 0x6a, 0x83, 0x57, 0x9d, 0xe8, 0x53, 0xf1, 0x6c, 0x34, 0xfb,
+		0xf9, 0xb5, 0x2f, 0x9d, 0x9b, 0xe5, 0x5a, 0xa9, 0xdb, 0xfb, 0x91, 0xbc, 0x85, 0xe5, 0x13, 0xcb,
+		0x2f, 0xbc, 0x9d, 0x80, 0xf7, 0xb0, 0x1e, 0x74, 0x5f, 0x5c, 0xbe, 0xe7, 0x1e, 0xbd, 0x00, 0x19,
+		0xb1, 0x8a, 0xf0, 0x3e, 0x5d, 0x8c, 0xe9, 0x53, 0xf7, 0x86, 0x88, 0x83, 0x41, 0x01, 0x36, 0x50,
+		0x2f, 0x7f, 0x2d, 0x01, 0x27, 0xe8, 0x94, 0x6a, 0x99, 0x63, 0xdf, 0x6b, 0xd7, 0xd6, 0xf0, 0xe4,
+		0xb8, 0xe9, 0x89, 0x7e, 0x3d, 0xd1, 0x9f, 0x1b, 0x0c, 0xe7, 0x77, 0x78, 0x88, 0xdc, 0xf4, 0x06,
+		0xeb, 0xcf, 0x57, 0x13, 0x70, 0x72, 0xdb, 0x72, 0x8e, 0xbc, 0x47, 0x5d, 0x1d, 0x4e, 0x6a, 0xe0,
+		0xb3, 0xf6, 0x01, 0xba, 0x72, 0xf6, 0xcb, 0x09, 0x80, 0xf0, 0x66, 0x11, 0xde, 0x48, 0x5d, 0xda,
+		0xdc, 0x58, 0x51, 0xab, 0x5b, 0xa5, 0xad, 0xed, 0x6a, 0xeb, 0x57, 0x1a, 0xc4, 0xfd, 0x55, 0xcf,
+		0x21, 0x3a, 0xfd, 0x1f, 0x41, 0xf2, 0xa3, 0x30, 0xd3, 0xca, 0x8d, 0x4f, 0xf8, 0x9f, 0xb2, 0x66,
+		0x73, 0x77, 0xef, 0x2d, 0x64, 0xd8, 0x11, 0x20, 0xc1, 0xb7, 0x7f, 0x8e, 0x75, 0xf2, 0xe1, 0x17,
+		0x1e, 0x92, 0xb3, 0xe3, 0x77, 0xef, 0x2d, 0x64, 0x83, 0xb3, 0x42, 0xb9, 0x00, 0x72, 0x94, 0x93,
+		0xe3, 0xa5, 0x66, 0xe1, 0xee, 0xbd, 0x85, 0x11, 0x96, 0xa0, 0x99, 0x4d, 0xe3, 0x2d, 0xd5, 0xa5,
+		0x97, 0x7a, 0xde, 0x50, 0x7d, 0x36, 0xe2, 0xfb, 0x8c, 0x0f, 0x9b, 0x4d, 0xcf, 0xb0, 0x2d, 0xc3,
+		0xd2, 0xcf, 0x33, 0xf3, 0x30, 0xfc, 0x83, 0x73, 0xdc, 0x34, 0xce, 0xb1, 0x7c, 0xc8, 0xf9, 0x3b,
+		0xe2, 0xfe, 0x69, 0xeb, 0x4d, 0xd5, 0xff, 0x37, 0x00, 0x58, 0x37, 0x21, 0xbb, 0x5c, 0x7e, 0x00,
+		0x00,
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
+	r := bytes.NewReader(gzipped)
+	gzipr, err := compress_gzip.NewReader(r)
+	if err != nil {
+		panic(err)
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	ungzipped, err := io_ioutil.ReadAll(gzipr)
+	if err != nil {
+		panic(err)
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	if err := github_com_gogo_protobuf_proto.Unmarshal(ungzipped, d); err != nil {
+		panic(err)
 	}
-	return len(dAtA) - i, nil
+	return d
 }
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CommissionRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalLiquidShares.Size()
-		i -= size
-		if _, err := m.TotalLiquidShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*CommissionRates)
+	if !ok {
+		that2, ok := that.(CommissionRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.TotalValidatorBondShares.Size()
-		i -= size
-		if _, err := m.TotalValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if !this.Rate.Equal(that1.Rate) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x52
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err5 != nil {
-		return 0, err5
+	if !this.MaxRate.Equal(that1.MaxRate) {
+		return false
 	}
-	i -= n5
-	i = encodeVarintStaking(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x4a
-	if m.UnbondingHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
-		i--
-		dAtA[i] = 0x40
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
+		return false
 	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	return true
+}
+func (this *Commission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.DelegatorShares.Size()
-		i -= size
-		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+
+	that1, ok := that.(*Commission)
+	if !ok {
+		that2, ok := that.(Commission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Tokens.Size()
-		i -= size
-		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Status != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
+		return false
 	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *Description) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Description)
+	if !ok {
+		that2, ok := that.(Description)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.ConsensusPubkey != nil {
-		{
-			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintStaking(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.Moniker != that1.Moniker {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.SecurityContact != that1.SecurityContact {
+		return false
+	}
+	if this.Details != that1.Details {
+		return false
+	}
+	return true
 }
+func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*UnbondingDelegationEntry)
+	if !ok {
+		that2, ok := that.(UnbondingDelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
 }
+func (this *RedelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DVPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RedelegationEntry)
+	if !ok {
+		that2, ok := that.(RedelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.CreationHeight != that1.CreationHeight {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DVPairs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DVPairs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.SharesDst.Equal(that1.SharesDst) {
+		return false
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DVPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pairs) > 0 {
-		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DVVTriplet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DVVTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
 	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.MaxValidators != that1.MaxValidators {
+		return false
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.MaxEntries != that1.MaxEntries {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.HistoricalEntries != that1.HistoricalEntries {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DVVTriplets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorBondFirstLossFraction.Equal(that1.ValidatorBondFirstLossFraction) {
+		return false
+	}
+	return true
 }
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Triplets) > 0 {
-		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*RedelegationEntryResponse)
+	if !ok {
+		that2, ok := that.(RedelegationEntryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
 }
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorBond {
-		i--
-		if m.ValidatorBond {
-			dAtA[i] = 1
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+		return false
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if !this.BondedTokens.Equal(that1.BondedTokens) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.Id != that1.Id {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Owner != that1.Owner {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
 }
+func (this *PendingTokenizeShareRecordTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*PendingTokenizeShareRecordTransfer)
+	if !ok {
+		that2, ok := that.(PendingTokenizeShareRecordTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.InitialBalance.Size()
-		i -= size
-		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	if this.TokenizeShareRecordId != that1.TokenizeShareRecordId {
+		return false
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x12
-	if m.CreationHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
-		i--
-		dAtA[i] = 0x8
+	if this.Owner != that1.Owner {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.NewOwner != that1.NewOwner {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.ExpirationTime.Equal(that1.ExpirationTime) {
+		return false
+	}
+	return true
 }
+func (this *SlashRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SharesDst.Size()
-		i -= size
-		if _, err := m.SharesDst.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*SlashRecord)
+	if !ok {
+		that2, ok := that.(SlashRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.InitialBalance.Size()
-		i -= size
-		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err9 != nil {
-		return 0, err9
+	if this.Id != that1.Id {
+		return false
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x12
-	if m.CreationHeight != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
-		i--
-		dAtA[i] = 0x8
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if this.InfractionType != that1.InfractionType {
+		return false
+	}
+	if !this.SlashFactor.Equal(that1.SlashFactor) {
+		return false
+	}
+	if !this.EffectiveFraction.Equal(that1.EffectiveFraction) {
+		return false
+	}
+	if !this.TokensBurned.Equal(that1.TokensBurned) {
+		return false
+	}
+	if !this.LiquidTokensDeducted.Equal(that1.LiquidTokensDeducted) {
+		return false
+	}
+	if !this.TokensBefore.Equal(that1.TokensBefore) {
+		return false
+	}
+	if !this.DelegatorShares.Equal(that1.DelegatorShares) {
+		return false
+	}
+	if !this.ValidatorBondFirstLoss.Equal(that1.ValidatorBondFirstLoss) {
+		return false
+	}
+	if !this.ValidatorBondSharesRemoved.Equal(that1.ValidatorBondSharesRemoved) {
+		return false
+	}
+	if !this.InsurancePayout.Equal(that1.InsurancePayout) {
+		return false
+	}
+	return true
 }
+func (this *SlashInsuranceCoverage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Redelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	that1, ok := that.(*SlashInsuranceCoverage)
+	if !ok {
+		that2, ok := that.(SlashInsuranceCoverage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CoverageFraction.Equal(that1.CoverageFraction) {
+		return false
+	}
+	if !this.MaxPayoutPerSlash.Equal(that1.MaxPayoutPerSlash) {
+		return false
+	}
+	return true
+}
+func (this *SlashInsurancePayout) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashInsurancePayout)
+	if !ok {
+		that2, ok := that.(SlashInsurancePayout)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SlashRecordId != that1.SlashRecordId {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.TokenizeShareRecordId != that1.TokenizeShareRecordId {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.Shares.Equal(that1.Shares) {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecordClaim) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecordClaim)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecordClaim)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Record.Equal(&that1.Record) {
+		return false
+	}
+	if !this.Shares.Equal(that1.Shares) {
+		return false
+	}
+	if !this.Tokens.Equal(that1.Tokens) {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	return true
+}
+func (this *TokenizationPause) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizationPause)
+	if !ok {
+		that2, ok := that.(TokenizationPause)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.PauseRedemptions != that1.PauseRedemptions {
+		return false
+	}
+	return true
+}
+func (this *ValidatorLiquidStakingPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorLiquidStakingPolicy)
+	if !ok {
+		that2, ok := that.(ValidatorLiquidStakingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.LiquidStakingCap == nil {
+		if this.LiquidStakingCap != nil {
+			return false
+		}
+	} else if !this.LiquidStakingCap.Equal(*that1.LiquidStakingCap) {
+		return false
+	}
+	if len(this.AllowedLiquidStakingProviders) != len(that1.AllowedLiquidStakingProviders) {
+		return false
+	}
+	for i := range this.AllowedLiquidStakingProviders {
+		if this.AllowedLiquidStakingProviders[i] != that1.AllowedLiquidStakingProviders[i] {
+			return false
+		}
+	}
+	if this.TokenizationDisabled != that1.TokenizationDisabled {
+		return false
+	}
+	return true
+}
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -2939,20 +3360,20 @@ func (m *Redelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Valset) > 0 {
+		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2960,34 +3381,23 @@ func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2997,90 +3407,50 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ValidatorLiquidStakingCap.Size()
-		i -= size
-		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.GlobalLiquidStakingCap.Size()
+		size := m.MaxChangeRate.Size()
 		i -= size
-		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x1a
 	{
-		size := m.ValidatorBondFactor.Size()
+		size := m.MaxRate.Size()
 		i -= size
-		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
+	dAtA[i] = 0x12
 	{
-		size := m.MinCommissionRate.Size()
+		size := m.Rate.Size()
 		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxValidators != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
-		i--
-		dAtA[i] = 0x10
-	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *Commission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3090,28 +3460,26 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintStaking(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3123,7 +3491,7 @@ func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+func (m *Description) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3133,40 +3501,55 @@ func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3176,32 +3559,58 @@ func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.LiquidStakingPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x72
 	{
-		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		size := m.TotalTokenizedShares.Size()
+		i -= size
+		if _, err := m.TotalTokenizedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TotalLiquidShares.Size()
+		i -= size
+		if _, err := m.TotalLiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TotalValidatorBondShares.Size()
+		i -= size
+		if _, err := m.TotalValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3209,11 +3618,88 @@ func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x52
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStaking(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x4a
+	if m.UnbondingHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *ValAddresses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3223,40 +3709,29 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValAddresses) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.BondedTokens.Size()
-		i -= size
-		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.NotBondedTokens.Size()
-		i -= size
-		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+func (m *DVPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3266,46 +3741,34 @@ func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *DVPair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ModuleAccount) > 0 {
-		i -= len(m.ModuleAccount)
-		copy(dAtA[i:], m.ModuleAccount)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PendingTokenizeShareAuthorizations) Marshal() (dAtA []byte, err error) {
+func (m *DVPairs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3315,496 +3778,4111 @@ func (m *PendingTokenizeShareAuthorizations) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *PendingTokenizeShareAuthorizations) MarshalTo(dAtA []byte) (int, error) {
+func (m *DVPairs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PendingTokenizeShareAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DVPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DVVTriplet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DVVTriplet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVVTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DVVTriplets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *DVVTriplets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DVVTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Triplets) > 0 {
+		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		i--
+		if m.ValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Jailed {
-		n += 2
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.TotalValidatorBondShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.TotalLiquidShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *UnbondingDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DVVTriplet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	return n
+func (m *UnbondingDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DVVTriplets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Triplets) > 0 {
-		for _, e := range m.Triplets {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialBalance.Size()
+		i -= size
+		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.ValidatorBond {
-		n += 2
+	i -= n9
+	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.SharesDst.Size()
+		i -= size
+		if _, err := m.SharesDst.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialBalance.Size()
+		i -= size
+		if _, err := m.InitialBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *UnbondingDelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	var l int
-	_ = l
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.SharesDst.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Redelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
 	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovStaking(uint64(m.MaxValidators))
+	{
+		size := m.ValidatorBondFirstLossFraction.Size()
+		i -= size
+		if _, err := m.ValidatorBondFirstLossFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovStaking(uint64(m.MaxEntries))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.HistoricalEntries != 0 {
-		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.BondDenom)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.MaxEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.ValidatorBondFactor.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.GlobalLiquidStakingCap.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.ValidatorLiquidStakingCap.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	if m.MaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RedelegationEntry.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Redelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.NotBondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.BondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TokenizeShareRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovStaking(uint64(m.Id))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ModuleAccount)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	return n
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PendingTokenizeShareAuthorizations) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NotBondedTokens.Size()
+		i -= size
+		if _, err := m.NotBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenizeShareAuthorizations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenizeShareAuthorizations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenizeShareAuthorizations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenizeShareRecordTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenizeShareRecordTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenizeShareRecordTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpirationTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TokenizeShareRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenizeShareRecordTransferIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenizeShareRecordTransferIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenizeShareRecordTransferIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA18 := make([]byte, len(m.Ids)*10)
+		var j17 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintStaking(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalLiquidStakedRefresh) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalLiquidStakedRefresh) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalLiquidStakedRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegationsProcessed != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.DelegationsProcessed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NextDelegationKey) > 0 {
+		i -= len(m.NextDelegationKey)
+		copy(dAtA[i:], m.NextDelegationKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NextDelegationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InsurancePayout.Size()
+		i -= size
+		if _, err := m.InsurancePayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.ValidatorBondSharesRemoved.Size()
+		i -= size
+		if _, err := m.ValidatorBondSharesRemoved.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ValidatorBondFirstLoss.Size()
+		i -= size
+		if _, err := m.ValidatorBondFirstLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.TokensBefore.Size()
+		i -= size
+		if _, err := m.TokensBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LiquidTokensDeducted.Size()
+		i -= size
+		if _, err := m.LiquidTokensDeducted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TokensBurned.Size()
+		i -= size
+		if _, err := m.TokensBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EffectiveFraction.Size()
+		i -= size
+		if _, err := m.EffectiveFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFactor.Size()
+		i -= size
+		if _, err := m.SlashFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.InfractionType) > 0 {
+		i -= len(m.InfractionType)
+		copy(dAtA[i:], m.InfractionType)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.InfractionType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashInsuranceCoverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashInsuranceCoverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashInsuranceCoverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPayoutPerSlash.Size()
+		i -= size
+		if _, err := m.MaxPayoutPerSlash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CoverageFraction.Size()
+		i -= size
+		if _, err := m.CoverageFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashInsurancePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashInsurancePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashInsurancePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TokenizeShareRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.TokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SlashRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlashRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecordClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecordClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecordClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizationPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizationPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizationPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PauseRedemptions {
+		i--
+		if m.PauseRedemptions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiquidStakingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiquidStakingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiquidStakingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenizationDisabled {
+		i--
+		if m.TokenizationDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedLiquidStakingProviders) > 0 {
+		for iNdEx := len(m.AllowedLiquidStakingProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedLiquidStakingProviders[iNdEx])
+			copy(dAtA[i:], m.AllowedLiquidStakingProviders[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.AllowedLiquidStakingProviders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LiquidStakingCap != nil {
+		{
+			size := m.LiquidStakingCap.Size()
+			i -= size
+			if _, err := m.LiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateSlashInsuranceCoverageProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSlashInsuranceCoverageProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSlashInsuranceCoverageProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coverage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseTokenizationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseTokenizationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseTokenizationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseTokenizationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseTokenizationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseTokenizationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.TotalValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.TotalLiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.TotalTokenizedShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidStakingPolicy.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVVTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		n += 2
+	}
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SharesDst.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFirstLossFraction.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotBondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTokenizeShareAuthorizations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingTokenizeShareRecordTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenizeShareRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.TokenizeShareRecordId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *PendingTokenizeShareRecordTransferIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *TotalLiquidStakedRefresh) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextDelegationKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.DelegationsProcessed != 0 {
+		n += 1 + sovStaking(uint64(m.DelegationsProcessed))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovStaking(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovStaking(uint64(m.InfractionHeight))
+	}
+	l = len(m.InfractionType)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.SlashFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.EffectiveFraction.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.TokensBurned.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidTokensDeducted.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.TokensBefore.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFirstLoss.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondSharesRemoved.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InsurancePayout.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SlashInsuranceCoverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoverageFraction.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxPayoutPerSlash.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SlashInsurancePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.SlashRecordId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.TokenizeShareRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.TokenizeShareRecordId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecordClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizationPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.PauseRedemptions {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorLiquidStakingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidStakingCap != nil {
+		l = m.LiquidStakingCap.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.AllowedLiquidStakingProviders) > 0 {
+		for _, s := range m.AllowedLiquidStakingProviders {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.TokenizationDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UpdateSlashInsuranceCoverageProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Coverage.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *PauseTokenizationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UnpauseTokenizationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types2.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= stakingtypes.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokenizedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTokenizedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidStakingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorBond = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ValAddresses) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ValAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringStaking(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3827,15 +7905,34 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3862,15 +7959,15 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3880,23 +7977,57 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3921,7 +8052,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3944,17 +8075,36 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3964,29 +8114,28 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4014,13 +8163,13 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4048,7 +8197,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4073,7 +8222,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4096,17 +8245,17 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4116,28 +8265,91 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4164,7 +8376,8 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4189,7 +8402,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4212,17 +8425,17 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4232,29 +8445,30 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
 			}
-			var stringLen uint64
+			m.MaxValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4264,29 +8478,16 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxValidators |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			var stringLen uint64
+			m.MaxEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4296,27 +8497,33 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4344,11 +8551,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4376,61 +8583,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Validator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4458,13 +8617,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4474,33 +8635,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types2.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4510,34 +8669,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= stakingtypes.BondStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFirstLossFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4562,18 +8716,68 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFirstLossFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4583,29 +8787,28 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4632,32 +8835,63 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 9:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4684,15 +8918,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4702,30 +8936,81 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorBondShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4735,31 +9020,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4769,23 +9053,23 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4810,7 +9094,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4833,15 +9117,15 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4869,7 +9153,43 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4892,7 +9212,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4915,15 +9235,34 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4951,11 +9290,11 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4983,7 +9322,39 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5006,7 +9377,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
+func (m *PendingTokenizeShareAuthorizations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5029,17 +9400,17 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTokenizeShareAuthorizations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTokenizeShareAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5049,25 +9420,23 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5090,7 +9459,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *PendingTokenizeShareRecordTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5113,15 +9482,34 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTokenizeShareRecordTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTokenizeShareRecordTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecordId", wireType)
+			}
+			m.TokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5149,11 +9537,11 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5181,13 +9569,13 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5197,23 +9585,24 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5236,7 +9625,7 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+func (m *PendingTokenizeShareRecordTransferIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5259,46 +9648,88 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTokenizeShareRecordTransferIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTokenizeShareRecordTransferIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5320,7 +9751,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *TotalLiquidStakedRefresh) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5343,17 +9774,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLiquidStakedRefresh: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLiquidStakedRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDelegationKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5363,29 +9794,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.NextDelegationKey = append(m.NextDelegationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextDelegationKey == nil {
+				m.NextDelegationKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationsProcessed", wireType)
 			}
-			var stringLen uint64
+			m.DelegationsProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5395,63 +9828,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DelegationsProcessed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var v int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5461,12 +9847,11 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ValidatorBond = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5488,7 +9873,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5507,19 +9892,38 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5547,11 +9951,49 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5579,13 +10021,13 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.InfractionType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5595,81 +10037,31 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFraction", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5679,16 +10071,31 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensBurned", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5698,28 +10105,29 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokensBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokensDeducted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5747,13 +10155,13 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidTokensDeducted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5781,65 +10189,15 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokensBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5849,16 +10207,31 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFirstLoss", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5868,28 +10241,29 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorBondFirstLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondSharesRemoved", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5917,13 +10291,13 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorBondSharesRemoved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsurancePayout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5951,7 +10325,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InsurancePayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5976,7 +10350,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *SlashInsuranceCoverage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5999,15 +10373,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashInsuranceCoverage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashInsuranceCoverage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverageFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6035,43 +10409,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.CoverageFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayoutPerSlash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6099,39 +10443,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPayoutPerSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6156,7 +10468,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SlashInsurancePayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6179,17 +10491,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashInsurancePayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashInsurancePayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordId", wireType)
 			}
-			var msglen int
+			m.SlashRecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6199,30 +10511,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SlashRecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.MaxValidators = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6232,16 +10530,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecordId", wireType)
 			}
-			m.MaxEntries = 0
+			m.TokenizeShareRecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6251,16 +10562,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				m.TokenizeShareRecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.HistoricalEntries = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6270,14 +10581,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6305,13 +10631,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecordClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecordClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecordClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6321,29 +10699,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6371,13 +10748,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6405,15 +10782,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6423,23 +10800,22 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6464,7 +10840,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenizationPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6487,17 +10863,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizationPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizationPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6507,30 +10883,29 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseRedemptions", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6540,25 +10915,12 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.PauseRedemptions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6580,7 +10942,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorLiquidStakingPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6603,17 +10965,17 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorLiquidStakingPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorLiquidStakingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6623,28 +10985,31 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LiquidStakingCap = &v
+			if err := m.LiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLiquidStakingProviders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6672,10 +11037,28 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedLiquidStakingProviders = append(m.AllowedLiquidStakingProviders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizationDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenizationDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6697,7 +11080,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6720,17 +11103,17 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6740,28 +11123,59 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6788,8 +11202,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6814,7 +11227,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *UpdateSlashInsuranceCoverageProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6837,15 +11250,15 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateSlashInsuranceCoverageProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateSlashInsuranceCoverageProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6873,13 +11286,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6907,7 +11318,38 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coverage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6932,7 +11374,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+func (m *PauseTokenizationProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6955,34 +11397,15 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseTokenizationProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseTokenizationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7010,11 +11433,11 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7042,13 +11465,13 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7058,23 +11481,24 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7097,7 +11521,7 @@ func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingTokenizeShareAuthorizations) Unmarshal(dAtA []byte) error {
+func (m *UnpauseTokenizationProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7120,15 +11544,15 @@ func (m *PendingTokenizeShareAuthorizations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingTokenizeShareAuthorizations: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseTokenizationProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingTokenizeShareAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseTokenizationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7156,7 +11580,71 @@ func (m *PendingTokenizeShareAuthorizations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
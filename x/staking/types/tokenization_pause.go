@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsGlobal returns true if the pause applies to every validator
+func (p TokenizationPause) IsGlobal() bool {
+	return p.ValidatorAddress == ""
+}
+
+// Validate checks that the validator address of a validator pause is valid
+func (p TokenizationPause) Validate() error {
+	if p.IsGlobal() {
+		return nil
+	}
+
+	_, err := sdk.ValAddressFromBech32(p.ValidatorAddress)
+	return err
+}
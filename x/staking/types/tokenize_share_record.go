@@ -16,3 +16,27 @@ func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
 func (r TokenizeShareRecord) GetShareTokenDenom() string {
 	return fmt.Sprintf("%s/%s", strings.ToLower(r.Validator), strconv.Itoa(int(r.Id)))
 }
+
+// GetNFTID returns the id of the nft that represents the ownership of the record, which is
+// its share token denom
+func (r TokenizeShareRecord) GetNFTID() string {
+	return r.GetShareTokenDenom()
+}
+
+// ParseShareTokenDenom returns the validator and the record id of a share token denom,
+// which has the {validator}/{record id} format
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return nil, 0, fmt.Errorf("invalid share token denom: %s", denom)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+	recordID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+	return valAddr, recordID, nil
+}
@@ -62,6 +62,7 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 		Commission:               NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 		TotalValidatorBondShares: sdk.ZeroDec(),
 		TotalLiquidShares:        sdk.ZeroDec(),
+		TotalTokenizedShares:     sdk.ZeroDec(),
 	}, nil
 }
 
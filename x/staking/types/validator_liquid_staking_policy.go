@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that the liquid staking cap, if set, is in [0, 1] and that the allowed
+// liquid staking providers are valid, distinct addresses
+func (p ValidatorLiquidStakingPolicy) Validate() error {
+	if p.LiquidStakingCap != nil && (p.LiquidStakingCap.IsNegative() || p.LiquidStakingCap.GT(sdk.OneDec())) {
+		return fmt.Errorf("validator liquid staking cap must be between 0 and 1: %s", p.LiquidStakingCap)
+	}
+
+	providers := make(map[string]bool, len(p.AllowedLiquidStakingProviders))
+	for _, provider := range p.AllowedLiquidStakingProviders {
+		if _, err := sdk.AccAddressFromBech32(provider); err != nil {
+			return fmt.Errorf("invalid allowed liquid staking provider %s: %w", provider, err)
+		}
+		if providers[provider] {
+			return fmt.Errorf("duplicate allowed liquid staking provider %s", provider)
+		}
+		providers[provider] = true
+	}
+
+	return nil
+}
+
+// AllowsLiquidStakingProvider returns true if the liquid staking provider can delegate to
+// the validator, which is the case for every provider if no provider is listed
+func (p ValidatorLiquidStakingPolicy) AllowsLiquidStakingProvider(address sdk.AccAddress) bool {
+	if len(p.AllowedLiquidStakingProviders) == 0 {
+		return true
+	}
+
+	for _, provider := range p.AllowedLiquidStakingProviders {
+		if provider == address.String() {
+			return true
+		}
+	}
+	return false
+}